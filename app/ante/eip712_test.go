@@ -739,8 +739,8 @@ func (suite *EIP712TestSuite) TestEIP712Tx_DepositAndWithdraw() {
 	cdpWithdrawMsg := cdptypes.NewMsgRepayDebt(
 		suite.testAddr,
 		USDCCDPType,
-		sdk.NewCoin(cdptypes.DefaultStableDenom, usdxAmt),
-	)
+		sdk.NewCoin(cdptypes.DefaultStableDenom, usdxAmt), 0)
+
 	hardWithdrawMsg := hardtypes.NewMsgWithdraw(
 		suite.testAddr,
 		sdk.NewCoins(sdk.NewCoin(cdptypes.DefaultStableDenom, usdxAmt)),
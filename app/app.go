@@ -219,28 +219,30 @@ var (
 	// If these are changed, the permissions stored in accounts
 	// must also be migrated during a chain upgrade.
 	mAccPerms = map[string][]string{
-		authtypes.FeeCollectorName:      nil,
-		distrtypes.ModuleName:           nil,
-		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:             {authtypes.Burner},
-		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-		evmtypes.ModuleName:             {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		evmutiltypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
-		kavadisttypes.KavaDistMacc:      {authtypes.Minter},
-		auctiontypes.ModuleName:         nil,
-		issuancetypes.ModuleAccountName: {authtypes.Minter, authtypes.Burner},
-		bep3types.ModuleName:            {authtypes.Burner, authtypes.Minter},
-		swaptypes.ModuleName:            nil,
-		cdptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-		cdptypes.LiquidatorMacc:         {authtypes.Minter, authtypes.Burner},
-		hardtypes.ModuleAccountName:     {authtypes.Minter},
-		savingstypes.ModuleAccountName:  nil,
-		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
-		earntypes.ModuleAccountName:     nil,
-		kavadisttypes.FundModuleAccount: nil,
-		minttypes.ModuleName:            {authtypes.Minter},
-		communitytypes.ModuleName:       nil,
+		authtypes.FeeCollectorName:       nil,
+		distrtypes.ModuleName:            nil,
+		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:              {authtypes.Burner},
+		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		evmtypes.ModuleName:              {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		evmutiltypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		kavadisttypes.KavaDistMacc:       {authtypes.Minter},
+		auctiontypes.ModuleName:          nil,
+		issuancetypes.ModuleAccountName:  {authtypes.Minter, authtypes.Burner},
+		bep3types.ModuleName:             {authtypes.Burner, authtypes.Minter},
+		swaptypes.ModuleName:             nil,
+		swaptypes.ProtocolFeeAccountName: nil,
+		cdptypes.ModuleName:              {authtypes.Minter, authtypes.Burner},
+		cdptypes.LiquidatorMacc:          {authtypes.Minter, authtypes.Burner},
+		cdptypes.PegStabilityMacc:        nil,
+		hardtypes.ModuleAccountName:      {authtypes.Minter},
+		savingstypes.ModuleAccountName:   nil,
+		liquidtypes.ModuleAccountName:    {authtypes.Minter, authtypes.Burner},
+		earntypes.ModuleAccountName:      nil,
+		kavadisttypes.FundModuleAccount:  nil,
+		minttypes.ModuleName:             {authtypes.Minter},
+		communitytypes.ModuleName:        nil,
 	}
 )
 
@@ -574,13 +576,7 @@ func NewApp(
 		appCodec,
 		keys[pricefeedtypes.StoreKey],
 		pricefeedSubspace,
-	)
-	swapKeeper := swapkeeper.NewKeeper(
-		appCodec,
-		keys[swaptypes.StoreKey],
-		swapSubspace,
-		app.accountKeeper,
-		app.bankKeeper,
+		app.stakingKeeper,
 	)
 	cdpKeeper := cdpkeeper.NewKeeper(
 		appCodec,
@@ -600,6 +596,7 @@ func NewApp(
 		app.bankKeeper,
 		app.pricefeedKeeper,
 		app.auctionKeeper,
+		app.MsgServiceRouter(),
 	)
 	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
 		appCodec,
@@ -635,6 +632,14 @@ func NewApp(
 		app.distrKeeper,
 		hardKeeper,
 	)
+	swapKeeper := swapkeeper.NewKeeper(
+		appCodec,
+		keys[swaptypes.StoreKey],
+		swapSubspace,
+		app.accountKeeper,
+		app.bankKeeper,
+		app.communityKeeper,
+	)
 	app.kavadistKeeper = kavadistkeeper.NewKeeper(
 		appCodec,
 		keys[kavadisttypes.StoreKey],
@@ -723,6 +728,7 @@ func NewApp(
 		AddRoute(kavadisttypes.RouterKey, kavadist.NewCommunityPoolMultiSpendProposalHandler(app.kavadistKeeper)).
 		AddRoute(earntypes.RouterKey, earn.NewCommunityPoolProposalHandler(app.earnKeeper)).
 		AddRoute(communitytypes.RouterKey, community.NewCommunityPoolProposalHandler(app.communityKeeper)).
+		AddRoute(pricefeedtypes.RouterKey, pricefeed.NewProposalHandler(app.pricefeedKeeper)).
 		AddRoute(committeetypes.RouterKey, committee.NewProposalHandler(app.committeeKeeper))
 
 	govConfig := govtypes.DefaultConfig()
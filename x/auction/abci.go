@@ -9,11 +9,14 @@ import (
 	"github.com/kava-labs/kava/x/auction/types"
 )
 
-// BeginBlocker closes all expired auctions at the end of each block. It panics if
-// there's an error other than ErrAuctionNotFound.
+// BeginBlocker closes all expired auctions at the end of each block, then prunes
+// settled auction records past their retention period. It panics if there's an
+// error other than ErrAuctionNotFound.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	err := k.CloseExpiredAuctions(ctx)
 	if err != nil && !errors.Is(err, types.ErrAuctionNotFound) {
 		panic(err)
 	}
+
+	k.PruneSettledAuctions(ctx)
 }
@@ -27,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryAuction(),
 		GetCmdQueryAuctions(),
+		GetCmdQueryBidIntents(),
+		GetCmdQuerySettledAuction(),
+		GetCmdQuerySettledAuctions(),
 	}
 
 	for _, cmd := range cmds {
@@ -96,6 +99,39 @@ func GetCmdQueryAuction() *cobra.Command {
 	}
 }
 
+// GetCmdQueryBidIntents queries the bid intents on an auction
+func GetCmdQueryBidIntents() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bid-intents [auction-id]",
+		Short: "get the bid intents on an auction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			auctionID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.QueryBidIntentsRequest{
+				AuctionId: uint64(auctionID),
+			}
+
+			res, err := queryClient.BidIntents(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // Query auction flags
 const (
 	flagType  = "type"
@@ -111,7 +147,7 @@ func GetCmdQueryAuctions() *cobra.Command {
 		Short: "query auctions with optional filters",
 		Long:  "Query for all paginated auctions that match optional filters.",
 		Example: strings.Join([]string{
-			fmt.Sprintf("  $ %s q %s auctions --type=(collateral|surplus|debt)", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auctions --type=(collateral|surplus|debt|dutch)", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --owner=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --denom=bnb", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --phase=(forward|reverse)", version.AppName, types.ModuleName),
@@ -145,14 +181,15 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 				if auctionType != types.CollateralAuctionType &&
 					auctionType != types.SurplusAuctionType &&
-					auctionType != types.DebtAuctionType {
+					auctionType != types.DebtAuctionType &&
+					auctionType != types.DutchAuctionType {
 					return fmt.Errorf("invalid auction type %s", auctionType)
 				}
 			}
 
 			if len(owner) != 0 {
-				if auctionType != types.CollateralAuctionType {
-					return fmt.Errorf("cannot apply owner flag to non-collateral auction type")
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchAuctionType {
+					return fmt.Errorf("cannot apply owner flag to %s auction type", auctionType)
 				}
 				_, err := sdk.AccAddressFromBech32(owner)
 				if err != nil {
@@ -203,10 +240,133 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 	flags.AddPaginationFlagsToCmd(cmd, "auctions")
 
-	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus")
-	cmd.Flags().String(flagOwner, "", "(optional) filter by collateral auction owner")
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus, dutch")
+	cmd.Flags().String(flagOwner, "", "(optional) filter by collateral or dutch auction owner")
 	cmd.Flags().String(flagDenom, "", "(optional) filter by auction denom")
 	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse")
 
 	return cmd
 }
+
+// GetCmdQuerySettledAuction queries the settlement record of a closed auction
+func GetCmdQuerySettledAuction() *cobra.Command {
+	return &cobra.Command{
+		Use:   "settled-auction [auction-id]",
+		Short: "get the outcome of a closed auction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			auctionID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.QuerySettledAuctionRequest{
+				AuctionId: uint64(auctionID),
+			}
+
+			res, err := queryClient.SettledAuction(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.SettledAuction)
+		},
+	}
+}
+
+// GetCmdQuerySettledAuctions queries the settlement records of closed auctions in the store
+func GetCmdQuerySettledAuctions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settled-auctions",
+		Short: "query closed auction outcomes with optional filters",
+		Long:  "Query for all paginated settlement records of recently closed auctions that match optional filters.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  $ %s q %s settled-auctions --type=(collateral|surplus|debt|dutch)", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s settled-auctions --owner=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s settled-auctions --denom=bnb", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s settled-auctions --page=2 --limit=100", version.AppName, types.ModuleName),
+		}, "\n"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			auctionType, err := cmd.Flags().GetString(flagType)
+			if err != nil {
+				return err
+			}
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if len(auctionType) != 0 {
+				auctionType = strings.ToLower(auctionType)
+
+				if auctionType != types.CollateralAuctionType &&
+					auctionType != types.SurplusAuctionType &&
+					auctionType != types.DebtAuctionType &&
+					auctionType != types.DutchAuctionType {
+					return fmt.Errorf("invalid auction type %s", auctionType)
+				}
+			}
+
+			if len(owner) != 0 {
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchAuctionType {
+					return fmt.Errorf("cannot apply owner flag to %s auction type", auctionType)
+				}
+				_, err := sdk.AccAddressFromBech32(owner)
+				if err != nil {
+					return fmt.Errorf("cannot parse address from auction owner %s", owner)
+				}
+			}
+
+			if len(denom) != 0 {
+				err := sdk.ValidateDenom(denom)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			request := types.QuerySettledAuctionsRequest{
+				Type:       auctionType,
+				Owner:      owner,
+				Denom:      denom,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SettledAuctions(context.Background(), &request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "settled-auctions")
+
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus, dutch")
+	cmd.Flags().String(flagOwner, "", "(optional) filter by collateral or dutch auction owner")
+	cmd.Flags().String(flagDenom, "", "(optional) filter by auction denom")
+
+	return cmd
+}
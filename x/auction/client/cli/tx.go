@@ -24,6 +24,8 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPlaceBid(),
+		GetCmdPlaceBidIntent(),
+		GetCmdCancelBidIntent(),
 	}
 
 	for _, cmd := range cmds {
@@ -68,3 +70,71 @@ func GetCmdPlaceBid() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdPlaceBidIntent cli command for escrowing funds to bid with on an auction
+func GetCmdPlaceBidIntent() *cobra.Command {
+	return &cobra.Command{
+		Use:     "bid-intent [auction-id] [max-bid] [min-lot]",
+		Short:   "escrow funds to automatically bid on an auction",
+		Long:    "Escrow [max-bid] for the module to place the minimum bids needed to lead an auction, bidding up to [max-bid] in the forward phase and down to [min-lot] in the reverse phase.",
+		Example: fmt.Sprintf("  $ %s tx %s bid-intent 34 1000usdx 500000ukava --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			maxBid, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			minLot, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBidIntent(id, clientCtx.GetFromAddress().String(), maxBid, minLot)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdCancelBidIntent cli command for cancelling a bid intent
+func GetCmdCancelBidIntent() *cobra.Command {
+	return &cobra.Command{
+		Use:     "cancel-bid-intent [auction-id]",
+		Short:   "cancel a bid intent on an auction",
+		Long:    "Cancel a bid intent on an auction, returning its unused escrow. Any bid already placed by the intent remains.",
+		Example: fmt.Sprintf("  $ %s tx %s cancel-bid-intent 34 --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			msg := types.NewMsgCancelBidIntent(id, clientCtx.GetFromAddress().String())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
@@ -31,6 +31,19 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, bankKeeper types.BankKee
 		totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 	}
 
+	for _, bi := range gs.BidIntents {
+		keeper.SetBidIntent(ctx, bi)
+		totalAuctionCoins = totalAuctionCoins.Add(bi.Escrow)
+	}
+
+	for _, sa := range gs.SettledAuctions {
+		keeper.SetSettledAuction(ctx, sa)
+	}
+
+	for _, sl := range gs.StartingLots {
+		keeper.SetStartingLot(ctx, sl)
+	}
+
 	// check if the module account exists
 	moduleAcc := accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
@@ -70,5 +83,20 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	keeper.IterateBidIntents(ctx, func(bi types.BidIntent) bool {
+		gs.BidIntents = append(gs.BidIntents, bi)
+		return false
+	})
+
+	keeper.IterateSettledAuctions(ctx, func(sa types.SettledAuction) bool {
+		gs.SettledAuctions = append(gs.SettledAuctions, sa)
+		return false
+	})
+
+	keeper.IterateStartingLots(ctx, func(sl types.StartingLot) bool {
+		gs.StartingLots = append(gs.StartingLots, sl)
+		return false
+	})
+
 	return gs
 }
@@ -115,6 +115,7 @@ func (k Keeper) StartCollateralAuction(
 	if err != nil {
 		return 0, err
 	}
+	k.SetStartingLot(ctx, types.StartingLot{AuctionID: auctionID, Lot: lot})
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -129,6 +130,64 @@ func (k Keeper) StartCollateralAuction(
 	return auctionID, nil
 }
 
+// StartDutchAuction starts a new dutch (descending price) auction.
+// The price of the lot starts above the reference price and decays towards a floor below it, both set by the params.
+// The reference price is the price of one unit of lot in units of the max bid denom.
+func (k Keeper) StartDutchAuction(
+	ctx sdk.Context, seller string, lot, maxBid sdk.Coin, referencePrice sdk.Dec,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin,
+) (uint64, error) {
+	if !referencePrice.IsPositive() {
+		return 0, fmt.Errorf("dutch auction reference price must be positive: %s", referencePrice)
+	}
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	params := k.GetParams(ctx)
+	auction := types.NewDutchAuction(
+		seller,
+		lot,
+		maxBid,
+		weightedAddresses,
+		debt,
+		referencePrice.Mul(params.DutchStartPriceMultiplier),
+		referencePrice.Mul(params.DutchEndPriceMultiplier),
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(params.DutchPriceDecayDuration),
+		ctx.BlockTime().Add(params.MaxAuctionDuration),
+	)
+
+	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, &auction)
+	if err != nil {
+		return 0, err
+	}
+	k.SetStartingLot(ctx, types.StartingLot{AuctionID: auctionID, Lot: lot})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, auction.MaxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.StartPrice.String()),
+		),
+	)
+	return auctionID, nil
+}
+
 // PlaceBid places a bid on any auction.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) error {
 	auction, found := k.GetAuction(ctx, auctionID)
@@ -141,7 +200,16 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		return sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
 	}
 
-	// move coins and return updated auction
+	if err := k.placeBid(ctx, auction, bidder, newAmount); err != nil {
+		return err
+	}
+
+	// place any bids needed for bid intents to lead the auction again
+	return k.processBidIntents(ctx, auctionID)
+}
+
+// placeBid places a bid on an auction of any type, moving coins and storing the updated auction.
+func (k Keeper) placeBid(ctx sdk.Context, auction types.Auction, bidder sdk.AccAddress, newAmount sdk.Coin) error {
 	var (
 		err            error
 		updatedAuction types.Auction
@@ -157,6 +225,8 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		} else {
 			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, auctionType, bidder, newAmount)
 		}
+	case *types.DutchAuction:
+		updatedAuction, err = k.PlaceBidDutch(ctx, auctionType, bidder, newAmount)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
 	}
@@ -193,7 +263,7 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction *types.SurplusAuction,
 		if err != nil {
 			return auction, err
 		}
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Bidder, sdk.NewCoins(auction.Bid))
+		err = k.refundBid(ctx, auction.ID, auction.Bidder, auction.Bid)
 		if err != nil {
 			return auction, err
 		}
@@ -262,7 +332,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.Collat
 		if err != nil {
 			return auction, err
 		}
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Bidder, sdk.NewCoins(auction.Bid))
+		err = k.refundBid(ctx, auction.ID, auction.Bidder, auction.Bid)
 		if err != nil {
 			return auction, err
 		}
@@ -343,7 +413,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 		if err != nil {
 			return auction, err
 		}
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Bidder, sdk.NewCoins(auction.Bid))
+		err = k.refundBid(ctx, auction.ID, auction.Bidder, auction.Bid)
 		if err != nil {
 			return auction, err
 		}
@@ -388,6 +458,86 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 	return auction, nil
 }
 
+// PlaceBidDutch buys some of the lot of a dutch auction at the current price, moving coins and returning the updated auction.
+// The bid is the amount of lot to buy. If buying it would raise more than the auction's max bid, only enough lot to reach the max bid is bought.
+func (k Keeper) PlaceBidDutch(ctx sdk.Context, auction *types.DutchAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.DutchAuction, error) {
+	// Validate new bid
+	if lot.Denom != auction.Lot.Denom {
+		return auction, sdkerrors.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
+	}
+	if !lot.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrLotTooSmall, "%s ≤ %s%s", lot, sdk.ZeroInt(), auction.Lot.Denom)
+	}
+	if auction.IsComplete() {
+		return auction, sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d", auction.ID)
+	}
+	if lot.Amount.GT(auction.Lot.Amount) {
+		return auction, sdkerrors.Wrapf(types.ErrLotTooLarge, "%s > %s", lot, auction.Lot)
+	}
+
+	price := auction.GetCurrentPrice(ctx.BlockTime())
+	costAmt := sdk.NewDecFromInt(lot.Amount).Mul(price).Ceil().TruncateInt()
+
+	// Purchases are capped so the total raised never exceeds the max bid
+	remainingBidAmt := auction.MaxBid.Amount.Sub(auction.Bid.Amount)
+	if costAmt.GT(remainingBidAmt) {
+		costAmt = remainingBidAmt
+		// the lot is rounded down so the payment always covers the lot bought
+		lotAmt := sdk.NewDecFromInt(remainingBidAmt).Quo(price).TruncateInt()
+		lot = sdk.NewCoin(lot.Denom, sdk.MinInt(lot.Amount, lotAmt))
+	}
+	cost := sdk.NewCoin(auction.Bid.Denom, costAmt)
+
+	// Payment is sent to auction initiator
+	if cost.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(cost))
+		if err != nil {
+			return auction, err
+		}
+	}
+	// Debt coins are sent to liquidator (until there is no CorrespondingDebt left). Amount sent is equal to cost (or whatever is left if < cost).
+	if auction.CorrespondingDebt.IsPositive() && cost.IsPositive() {
+		debtAmountToReturn := sdk.MinInt(cost.Amount, auction.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(auction.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return auction, err
+		}
+		auction.CorrespondingDebt = auction.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ auction.CorrespondingDebt from the MinInt above
+	}
+	// Purchased lot is sent to the bidder
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(lot))
+	if err != nil {
+		return auction, err
+	}
+
+	// Update Auction
+	auction.Bidder = bidder
+	auction.Bid = auction.Bid.Add(cost)
+	auction.Lot = auction.Lot.Sub(lot)
+	auction.HasReceivedBids = true
+	// A filled auction closes at the end of this block
+	if auction.IsComplete() {
+		auction.EndTime = ctx.BlockTime()
+		auction.MaxEndTime = ctx.BlockTime()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, auction.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, cost.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, lot.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+
+	return auction, nil
+}
+
 // PlaceBidDebt places a reverse bid on a debt auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.DebtAuction, error) {
 	// Validate new bid
@@ -420,7 +570,7 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 		if oldBidder.Equals(authtypes.NewModuleAddress(auction.Initiator)) { // First bid on auction (where there is no previous bidder)
 			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.Bid))
 		} else { // Second and later bids on auction (where previous bidder is a user account)
-			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, oldBidder, sdk.NewCoins(auction.Bid))
+			err = k.refundBid(ctx, auction.ID, oldBidder, auction.Bid)
 		}
 		if err != nil {
 			return auction, err
@@ -482,6 +632,8 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		err = k.PayoutDebtAuction(ctx, auc)
 	case *types.CollateralAuction:
 		err = k.PayoutCollateralAuction(ctx, auc)
+	case *types.DutchAuction:
+		err = k.PayoutDutchAuction(ctx, auc)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auc.GetType())
 	}
@@ -490,6 +642,12 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		return err
 	}
 
+	if err := k.releaseBidIntents(ctx, auctionID); err != nil {
+		return err
+	}
+
+	k.settleAuction(ctx, auction)
+
 	k.DeleteAuction(ctx, auctionID)
 
 	ctx.EventManager().EmitEvent(
@@ -544,6 +702,35 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction *types.Collater
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
 }
 
+// PayoutDutchAuction returns the unsold lot and remaining debt of a dutch auction.
+// Bidders are paid as they bid, so any lot left at close is returned to the weighted addresses (normally the CDP depositors).
+func (k Keeper) PayoutDutchAuction(ctx sdk.Context, auction *types.DutchAuction) error {
+	if auction.Lot.IsPositive() {
+		// Note: splitting an integer amount across weighted buckets results in small errors.
+		lotPayouts, err := splitCoinIntoWeightedBuckets(auction.Lot, auction.LotReturns.Weights)
+		if err != nil {
+			return err
+		}
+		for i, payout := range lotPayouts {
+			// if the payout amount is 0, don't send 0 coins
+			if !payout.IsPositive() {
+				continue
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.LotReturns.Addresses[i], sdk.NewCoins(payout))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// if there is remaining debt after the auction, send it back to the initiating module for management
+	if !auction.CorrespondingDebt.IsPositive() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+}
+
 // CloseExpiredAuctions iterates over all the auctions stored by until the current
 // block timestamp and that are past (or at) their ending times and closes them,
 // paying out to the highest bidder.
@@ -194,6 +194,138 @@ func (suite *auctionTestSuite) TestCollateralAuctionDebtRemaining() {
 	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func (suite *auctionTestSuite) TestDutchAuctionBasic() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction with a reference price of 2 token2 per token1, so the price decays from 2.4 to 1.6
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), d("2"), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+	// Check seller's coins have decreased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+
+	// Buy part of the lot at the start price
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 5)))
+	// Check bidder has paid 5 * 2.4 token2 and received the lot straight away
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 105), c("token2", 88)))
+	// Check seller's coins have increased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 112), c("debt", 72)))
+
+	// Buy the rest of the lot half way through the price decay
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchPriceDecayDuration / 2))
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 15)))
+	// Check bidder has paid 15 * 2 token2
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 120), c("token2", 58)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 142), c("debt", 100)))
+
+	// The sold out auction can be closed in the same block
+	suite.NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+	_, found := suite.Keeper.GetAuction(ctx, auctionID)
+	suite.False(found)
+	// Check return addresses have not received coins
+	for _, ra := range suite.Addrs[1:] {
+		suite.CheckAccountBalanceEqual(ra, cs(c("token1", 100), c("token2", 100)))
+	}
+}
+
+func (suite *auctionTestSuite) TestDutchAuctionMaxBidReached() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(40, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 30), d("2"), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	// Bid for the whole lot, only enough to raise the max bid is bought, 30 token2 at 2.4 rounded down to 12 token1
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 20)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 112), c("token2", 70)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 130), c("debt", 90)))
+
+	// No more bids can be placed once the max bid is reached
+	err = suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 1))
+	suite.ErrorIs(err, types.ErrAuctionHasExpired)
+
+	// Remaining lot and debt are returned on close
+	suite.NoError(suite.Keeper.CloseAuction(suite.Ctx, auctionID))
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 105), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[2], cs(c("token1", 102), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[3], cs(c("token1", 101), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 130), c("debt", 100)))
+}
+
+func (suite *auctionTestSuite) TestDutchAuctionMaxBidReached_LotRoundedDown() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(40, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 25), d("2"), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	// Buy part of the lot at the start price of 2.4, paying 12 token2
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 5)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 105), c("token2", 88)))
+
+	// The remaining 13 token2 of the max bid buy 5.41 token1, so only 5 are bought and their cost of 12 is covered
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 15)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 110), c("token2", 75)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 125), c("debt", 85)))
+
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	suite.Equal(c("token1", 10), auction.GetLot())
+	suite.Equal(c("token2", 25), auction.GetBid())
+}
+
+func (suite *auctionTestSuite) TestDutchAuctionExpires() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(40, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 12), c("token2", 50), d("2"), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	// Auction cannot be closed before it expires
+	err = suite.Keeper.CloseAuction(suite.Ctx, auctionID)
+	suite.ErrorIs(err, types.ErrAuctionHasNotExpired)
+
+	// Price stays at the end price after the decay has finished
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchPriceDecayDuration * 2))
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 5)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 105), c("token2", 92)))
+
+	// Bids are rejected after the auction expires
+	ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultMaxAuctionDuration + time.Second))
+	err = suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 1))
+	suite.ErrorIs(err, types.ErrAuctionHasExpired)
+
+	// Unsold lot and remaining debt are returned on close
+	suite.NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 104), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[2], cs(c("token1", 102), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[3], cs(c("token1", 101), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 88), c("token2", 108), c("debt", 100)))
+}
+
 func (suite *auctionTestSuite) TestStartSurplusAuction() {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
@@ -0,0 +1,320 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// PlaceBidIntent escrows a bidder's max bid so the module can bid on their behalf, then places any bids needed for
+// the bidder to lead the auction.
+func (k Keeper) PlaceBidIntent(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, maxBid, minLot sdk.Coin) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	if ctx.BlockTime().After(auction.GetEndTime()) {
+		return sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+	}
+	if _, ok := auction.(*types.DutchAuction); ok {
+		return sdkerrors.Wrap(types.ErrBidIntentNotSupported, auction.GetType())
+	}
+	if maxBid.Denom != auction.GetBid().Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", maxBid.Denom, auction.GetBid().Denom)
+	}
+	if minLot.Denom != auction.GetLot().Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", minLot.Denom, auction.GetLot().Denom)
+	}
+	if _, found := k.GetBidIntent(ctx, auctionID, bidder); found {
+		return sdkerrors.Wrapf(types.ErrBidIntentExists, "auction %d, bidder %s", auctionID, bidder)
+	}
+
+	intent := types.NewBidIntent(auctionID, bidder, maxBid, minLot)
+	if err := intent.Validate(); err != nil {
+		return err
+	}
+
+	// NOTE: for the duration of the bid intent the auction module account holds the escrow
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(maxBid))
+	if err != nil {
+		return err
+	}
+	k.SetBidIntent(ctx, intent)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBidIntent,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, maxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyMinLot, minLot.String()),
+		),
+	)
+
+	return k.processBidIntents(ctx, auctionID)
+}
+
+// CancelBidIntent removes a bidder's bid intent, returning its unused escrow. Any current bid placed for the bidder remains.
+func (k Keeper) CancelBidIntent(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) error {
+	intent, found := k.GetBidIntent(ctx, auctionID, bidder)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrBidIntentNotFound, "auction %d, bidder %s", auctionID, bidder)
+	}
+
+	if err := k.returnEscrow(ctx, intent); err != nil {
+		return err
+	}
+	k.DeleteBidIntent(ctx, auctionID, bidder)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionCancelBidIntent,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrow, intent.Escrow.String()),
+		),
+	)
+	return nil
+}
+
+// releaseBidIntents removes all bid intents on an auction, returning their unused escrow.
+func (k Keeper) releaseBidIntents(ctx sdk.Context, auctionID uint64) error {
+	for _, intent := range k.GetBidIntentsByAuction(ctx, auctionID) {
+		if err := k.returnEscrow(ctx, intent); err != nil {
+			return err
+		}
+		k.DeleteBidIntent(ctx, auctionID, intent.Bidder)
+	}
+	return nil
+}
+
+func (k Keeper) returnEscrow(ctx sdk.Context, intent types.BidIntent) error {
+	if !intent.Escrow.IsPositive() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, intent.Bidder, sdk.NewCoins(intent.Escrow))
+}
+
+// refundBid pays back an outbid bidder. If the bidder has a bid intent on the auction the refund is added to its escrow.
+func (k Keeper) refundBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, bid sdk.Coin) error {
+	intent, found := k.GetBidIntent(ctx, auctionID, bidder)
+	if !found {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(bid))
+	}
+	intent.Escrow = intent.Escrow.Add(bid)
+	k.SetBidIntent(ctx, intent)
+	return nil
+}
+
+// processBidIntents places bids on behalf of bid intents until the auction is led by the strongest intent, or by a
+// bidder that no intent can outbid. Each bid is the minimum needed for its intent to lead.
+func (k Keeper) processBidIntents(ctx sdk.Context, auctionID uint64) error {
+	for {
+		auction, found := k.GetAuction(ctx, auctionID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+		}
+		intents := k.GetBidIntentsByAuction(ctx, auctionID)
+		if len(intents) == 0 {
+			return nil
+		}
+
+		var (
+			intent types.BidIntent
+			amount sdk.Int
+			cost   sdk.Int
+			ok     bool
+		)
+		switch auction.GetType() {
+		case types.SurplusAuctionType, types.CollateralAuctionType, types.DebtAuctionType:
+		default:
+			return nil
+		}
+		if auction.GetPhase() == types.ForwardAuctionPhase {
+			intent, amount, cost, ok = k.nextForwardIntentBid(ctx, auction, intents)
+		} else {
+			intent, amount, cost, ok = k.nextReverseIntentBid(ctx, auction, intents)
+		}
+		if !ok {
+			return nil
+		}
+
+		// fund the bid from escrow, the bid then moves the coins as if the bidder placed it
+		if cost.IsPositive() {
+			costCoin := sdk.NewCoin(intent.Escrow.Denom, cost)
+			intent.Escrow = intent.Escrow.Sub(costCoin)
+			k.SetBidIntent(ctx, intent)
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, intent.Bidder, sdk.NewCoins(costCoin))
+			if err != nil {
+				return err
+			}
+		}
+
+		var newAmount sdk.Coin
+		if auction.GetPhase() == types.ForwardAuctionPhase {
+			newAmount = sdk.NewCoin(auction.GetBid().Denom, amount)
+		} else {
+			newAmount = sdk.NewCoin(auction.GetLot().Denom, amount)
+		}
+		if err := k.placeBid(ctx, auction, intent.Bidder, newAmount); err != nil {
+			return err
+		}
+	}
+}
+
+// nextForwardIntentBid returns the intent with the highest max bid and the smallest bid that keeps it ahead of all
+// other intents, along with the coins it must pay from escrow. ok is false if no intent needs to bid.
+func (k Keeper) nextForwardIntentBid(ctx sdk.Context, auction types.Auction, intents []types.BidIntent) (types.BidIntent, sdk.Int, sdk.Int, bool) {
+	params := k.GetParams(ctx)
+	increment := params.IncrementSurplus
+	maxBid, hasMaxBid := sdk.Int{}, false
+	if collateralAuction, isCollateral := auction.(*types.CollateralAuction); isCollateral {
+		increment = params.IncrementCollateral
+		maxBid, hasMaxBid = collateralAuction.MaxBid.Amount, true
+	}
+	minNextBid := func(bid sdk.Int) sdk.Int {
+		next := bid.Add(sdk.MaxInt(sdk.OneInt(), sdk.NewDecFromInt(bid).Mul(increment).RoundInt()))
+		if hasMaxBid {
+			next = sdk.MinInt(next, maxBid)
+		}
+		return next
+	}
+
+	current := auction.GetBidder()
+	base := auction.GetBid().Amount
+	caps := make([]sdk.Int, len(intents))
+	for i, intent := range intents {
+		funds := intent.Escrow.Amount
+		if intent.Bidder.Equals(current) {
+			funds = funds.Add(base)
+		}
+		caps[i] = sdk.MinInt(intent.MaxBid.Amount, funds)
+		if hasMaxBid {
+			caps[i] = sdk.MinInt(caps[i], maxBid)
+		}
+	}
+	order := sortIntents(intents, current, func(i, j int) int { return caps[j].BigInt().Cmp(caps[i].BigInt()) })
+
+	leader := order[0]
+	isLeading := intents[leader].Bidder.Equals(current)
+	minNext := minNextBid(base)
+	if !isLeading && caps[leader].LT(minNext) {
+		return types.BidIntent{}, sdk.Int{}, sdk.Int{}, false
+	}
+
+	// the highest bid any other bidder would place
+	rival, challenged := base, false
+	for _, i := range order[1:] {
+		if intents[i].Bidder.Equals(current) || caps[i].GTE(minNext) {
+			rival = sdk.MaxInt(rival, caps[i])
+			challenged = challenged || !intents[i].Bidder.Equals(current)
+		}
+	}
+
+	var amount sdk.Int
+	switch {
+	case isLeading && !challenged:
+		return types.BidIntent{}, sdk.Int{}, sdk.Int{}, false
+	case !isLeading && rival.Equal(base):
+		amount = minNext
+	default:
+		amount = sdk.MinInt(caps[leader], minNextBid(rival))
+	}
+	if !isLeading {
+		amount = sdk.MaxInt(amount, minNext)
+	}
+	if amount.LTE(base) {
+		return types.BidIntent{}, sdk.Int{}, sdk.Int{}, false
+	}
+
+	cost := amount
+	if isLeading {
+		cost = amount.Sub(base)
+	}
+	return intents[leader], amount, cost, true
+}
+
+// nextReverseIntentBid returns the intent with the lowest min lot and the largest lot that keeps it ahead of all other
+// intents, along with the coins it must pay from escrow. ok is false if no intent needs to bid.
+func (k Keeper) nextReverseIntentBid(ctx sdk.Context, auction types.Auction, intents []types.BidIntent) (types.BidIntent, sdk.Int, sdk.Int, bool) {
+	params := k.GetParams(ctx)
+	increment := params.IncrementDebt
+	if _, isCollateral := auction.(*types.CollateralAuction); isCollateral {
+		increment = params.IncrementCollateral
+	}
+	maxNextLot := func(lot sdk.Int) sdk.Int {
+		return lot.Sub(sdk.MaxInt(sdk.OneInt(), sdk.NewDecFromInt(lot).Mul(increment).RoundInt()))
+	}
+
+	current := auction.GetBidder()
+	base := auction.GetLot().Amount
+	bid := auction.GetBid().Amount
+
+	// only intents that can pay the bid can take part
+	var able []types.BidIntent
+	for _, intent := range intents {
+		if intent.Bidder.Equals(current) || intent.Escrow.Amount.GTE(bid) {
+			able = append(able, intent)
+		}
+	}
+	if len(able) == 0 {
+		return types.BidIntent{}, sdk.Int{}, sdk.Int{}, false
+	}
+	order := sortIntents(able, current, func(i, j int) int { return able[i].MinLot.Amount.BigInt().Cmp(able[j].MinLot.Amount.BigInt()) })
+
+	leader := able[order[0]]
+	isLeading := leader.Bidder.Equals(current)
+	maxNext := maxNextLot(base)
+	if maxNext.IsNegative() || (!isLeading && leader.MinLot.Amount.GT(maxNext)) {
+		return types.BidIntent{}, sdk.Int{}, sdk.Int{}, false
+	}
+
+	// the lowest lot any other bidder would bid for
+	rival, challenged := base, false
+	for _, i := range order[1:] {
+		if able[i].Bidder.Equals(current) || able[i].MinLot.Amount.LTE(maxNext) {
+			rival = sdk.MinInt(rival, able[i].MinLot.Amount)
+			challenged = challenged || !able[i].Bidder.Equals(current)
+		}
+	}
+
+	var amount sdk.Int
+	switch {
+	case isLeading && !challenged:
+		return types.BidIntent{}, sdk.Int{}, sdk.Int{}, false
+	case !isLeading && rival.Equal(base):
+		amount = maxNext
+	default:
+		amount = sdk.MaxInt(leader.MinLot.Amount, maxNextLot(rival))
+	}
+	amount = sdk.MinInt(amount, maxNext)
+	if amount.IsNegative() {
+		return types.BidIntent{}, sdk.Int{}, sdk.Int{}, false
+	}
+
+	cost := bid
+	if isLeading {
+		cost = sdk.ZeroInt()
+	}
+	return leader, amount, cost, true
+}
+
+// sortIntents returns the indexes of the intents ordered by cmp, with ties going to the current bidder and then to
+// the store order of the intents.
+func sortIntents(intents []types.BidIntent, current sdk.AccAddress, cmp func(i, j int) int) []int {
+	order := make([]int, len(intents))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if c := cmp(order[a], order[b]); c != 0 {
+			return c < 0
+		}
+		return intents[order[a]].Bidder.Equals(current) && !intents[order[b]].Bidder.Equals(current)
+	})
+	return order
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"github.com/kava-labs/kava/x/auction/keeper"
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+func (suite *auctionTestSuite) checkModuleAccountInvariant() {
+	msg, broken := keeper.ModuleAccountInvariants(suite.Keeper)(suite.Ctx)
+	suite.False(broken, msg)
+}
+
+func (suite *auctionTestSuite) TestSurplusAuctionBidIntents() {
+	// Setup
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100)))
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+
+	// A bid intent escrows the max bid and places the minimum opening bid
+	suite.NoError(suite.Keeper.PlaceBidIntent(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 50), c("token1", 0)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 100), c("token2", 50)))
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	suite.Equal(suite.Addrs[0], auction.GetBidder())
+	suite.Equal(c("token2", 1), auction.GetBid())
+
+	// A manual bid is answered with the minimum increment, the refund of the outbid proxy bid returns to escrow
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[1], c("token2", 10)))
+	auction, _ = suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Equal(suite.Addrs[0], auction.GetBidder())
+	suite.Equal(c("token2", 11), auction.GetBid())
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 100), c("token2", 50)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 100), c("token2", 100)))
+
+	// A weaker intent only raises the leading intent's bid to just above the weaker intent's max
+	suite.NoError(suite.Keeper.PlaceBidIntent(suite.Ctx, auctionID, suite.Addrs[2], c("token2", 30), c("token1", 0)))
+	auction, _ = suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Equal(suite.Addrs[0], auction.GetBidder())
+	suite.Equal(c("token2", 32), auction.GetBid())
+	intent, found := suite.Keeper.GetBidIntent(suite.Ctx, auctionID, suite.Addrs[0])
+	suite.True(found)
+	suite.Equal(c("token2", 18), intent.Escrow)
+	suite.checkModuleAccountInvariant()
+
+	// Cancelling returns the unused escrow
+	suite.NoError(suite.Keeper.CancelBidIntent(suite.Ctx, auctionID, suite.Addrs[2]))
+	suite.CheckAccountBalanceEqual(suite.Addrs[2], cs(c("token1", 100), c("token2", 100)))
+	_, found = suite.Keeper.GetBidIntent(suite.Ctx, auctionID, suite.Addrs[2])
+	suite.False(found)
+	suite.checkModuleAccountInvariant()
+
+	// Closing the auction pays out the lot and returns the remaining escrow
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultForwardBidDuration))
+	suite.NoError(suite.Keeper.CloseAuction(suite.Ctx, auctionID))
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 120), c("token2", 68)))
+	suite.Empty(suite.Keeper.GetBidIntentsByAuction(suite.Ctx, auctionID))
+	suite.checkModuleAccountInvariant()
+}
+
+func (suite *auctionTestSuite) TestCollateralAuctionBidIntents() {
+	// Setup
+	returnAddrs := suite.Addrs[2:]
+	returnWeights := is(30, 20)
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 50))
+	suite.NoError(err)
+
+	suite.NoError(suite.Keeper.PlaceBidIntent(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 100), c("token1", 10)))
+	suite.NoError(suite.Keeper.PlaceBidIntent(suite.Ctx, auctionID, suite.Addrs[1], c("token2", 50), c("token1", 15)))
+
+	// Both intents can reach the max bid, so the leading intent bids it and then bids the lot down to just below
+	// the other intent's min lot
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	suite.Equal(types.ReverseAuctionPhase, auction.GetPhase())
+	suite.Equal(suite.Addrs[0], auction.GetBidder())
+	suite.Equal(c("token2", 50), auction.GetBid())
+	suite.Equal(c("token1", 14), auction.GetLot())
+	suite.CheckAccountBalanceEqual(suite.Addrs[2], cs(c("token1", 104), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[3], cs(c("token1", 102), c("token2", 100)))
+	suite.checkModuleAccountInvariant()
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultReverseBidDuration))
+	suite.NoError(suite.Keeper.CloseAuction(suite.Ctx, auctionID))
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 114), c("token2", 50)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 100), c("token2", 100)))
+	suite.checkModuleAccountInvariant()
+}
+
+func (suite *auctionTestSuite) TestBidIntentErrors() {
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+	dutchID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), d("2"), suite.Addrs[1:2], is(1), c("debt", 40))
+	suite.NoError(err)
+
+	err = suite.Keeper.PlaceBidIntent(suite.Ctx, auctionID+10, suite.Addrs[0], c("token2", 50), c("token1", 0))
+	suite.ErrorIs(err, types.ErrAuctionNotFound)
+	err = suite.Keeper.PlaceBidIntent(suite.Ctx, dutchID, suite.Addrs[0], c("token2", 50), c("token1", 0))
+	suite.ErrorIs(err, types.ErrBidIntentNotSupported)
+	err = suite.Keeper.PlaceBidIntent(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 50), c("token1", 0))
+	suite.ErrorIs(err, types.ErrInvalidBidDenom)
+	err = suite.Keeper.PlaceBidIntent(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 50), c("token2", 0))
+	suite.ErrorIs(err, types.ErrInvalidLotDenom)
+
+	suite.NoError(suite.Keeper.PlaceBidIntent(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 50), c("token1", 0)))
+	err = suite.Keeper.PlaceBidIntent(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 60), c("token1", 0))
+	suite.ErrorIs(err, types.ErrBidIntentExists)
+	err = suite.Keeper.CancelBidIntent(suite.Ctx, auctionID, suite.Addrs[1])
+	suite.ErrorIs(err, types.ErrBidIntentNotFound)
+}
@@ -461,6 +461,10 @@ func TestAuctionBidding(t *testing.T) {
 				types.DefaultIncrement,
 				types.DefaultIncrement,
 				types.DefaultIncrement,
+				types.DefaultDutchPriceDecayDuration,
+				types.DefaultDutchStartPriceMultiplier,
+				types.DefaultDutchEndPriceMultiplier,
+				types.DefaultSettledAuctionRetention,
 			)
 
 			auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
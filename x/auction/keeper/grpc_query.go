@@ -9,6 +9,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	proto "github.com/gogo/protobuf/proto"
@@ -86,7 +87,7 @@ func (s queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest
 		// True if empty owner, otherwise check if auction contains owner
 		ownerIsMatch := req.Owner == ""
 		if req.Owner != "" {
-			if cAuc, ok := result.(*types.CollateralAuction); ok {
+			if cAuc, ok := result.(types.LotReturnsAuction); ok {
 				for _, addr := range cAuc.GetLotReturns().Addresses {
 					if addr.String() == req.Owner {
 						ownerIsMatch = true
@@ -143,3 +144,78 @@ func (s queryServer) NextAuctionID(ctx context.Context, req *types.QueryNextAuct
 
 	return &types.QueryNextAuctionIDResponse{Id: nextAuctionID}, nil
 }
+
+func (s queryServer) BidIntents(ctx context.Context, req *types.QueryBidIntentsRequest) (*types.QueryBidIntentsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	intents := []types.BidIntent{} // return empty list instead of nil if no bid intents
+	intents = append(intents, s.keeper.GetBidIntentsByAuction(sdkCtx, req.AuctionId)...)
+
+	return &types.QueryBidIntentsResponse{BidIntents: intents}, nil
+}
+
+// SettledAuction implements the Query/SettledAuction gRPC method
+func (s queryServer) SettledAuction(c context.Context, req *types.QuerySettledAuctionRequest) (*types.QuerySettledAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	settled, found := s.keeper.GetSettledAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrSettledAuctionNotFound, "%d", req.AuctionId)
+	}
+
+	return &types.QuerySettledAuctionResponse{SettledAuction: settled}, nil
+}
+
+// SettledAuctions implements the Query/SettledAuctions gRPC method
+func (s queryServer) SettledAuctions(c context.Context, req *types.QuerySettledAuctionsRequest) (*types.QuerySettledAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	settledAuctions := []types.SettledAuction{} // return empty list instead of nil if no settled auctions
+	settledStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.SettledAuctionKeyPrefix)
+
+	pageRes, err := query.FilteredPaginate(settledStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var settled types.SettledAuction
+		if err := s.keeper.cdc.Unmarshal(value, &settled); err != nil {
+			return false, err
+		}
+
+		// True if empty owner, otherwise check if the auction's lot returns contain owner
+		ownerIsMatch := req.Owner == ""
+		for _, addr := range settled.LotReturns.Addresses {
+			if addr.String() == req.Owner {
+				ownerIsMatch = true
+				break
+			}
+		}
+
+		typeIsMatch := req.Type == "" || req.Type == settled.AuctionType
+		denomIsMatch := req.Denom == "" || req.Denom == settled.Bid.Denom || req.Denom == settled.Lot.Denom
+
+		if ownerIsMatch && typeIsMatch && denomIsMatch {
+			if accumulate {
+				settledAuctions = append(settledAuctions, settled)
+			}
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return &types.QuerySettledAuctionsResponse{}, err
+	}
+
+	return &types.QuerySettledAuctionsResponse{
+		SettledAuctions: settledAuctions,
+		Pagination:      pageRes,
+	}, nil
+}
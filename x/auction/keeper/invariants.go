@@ -20,7 +20,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ValidIndexInvariant(k))
 }
 
-// ModuleAccountInvariants checks that the module account's coins matches those stored in auctions
+// ModuleAccountInvariants checks that the module account's coins matches those stored in auctions and bid intents
 func ModuleAccountInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		totalAuctionCoins := sdk.NewCoins()
@@ -32,6 +32,10 @@ func ModuleAccountInvariants(k Keeper) sdk.Invariant {
 			totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 			return false
 		})
+		k.IterateBidIntents(ctx, func(bi types.BidIntent) bool {
+			totalAuctionCoins = totalAuctionCoins.Add(bi.Escrow)
+			return false
+		})
 
 		moduleAccCoins := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
 		broken := !moduleAccCoins.IsEqual(totalAuctionCoins)
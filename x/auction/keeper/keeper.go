@@ -215,3 +215,176 @@ func (k Keeper) GetAllAuctions(ctx sdk.Context) (auctions []types.Auction) {
 	})
 	return
 }
+
+// SetBidIntent puts a bid intent into the store.
+func (k Keeper) SetBidIntent(ctx sdk.Context, intent types.BidIntent) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidIntentKeyPrefix)
+	store.Set(types.GetBidIntentKey(intent.AuctionID, intent.Bidder), k.cdc.MustMarshal(&intent))
+}
+
+// GetBidIntent gets a bidder's bid intent on an auction from the store.
+func (k Keeper) GetBidIntent(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) (types.BidIntent, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidIntentKeyPrefix)
+	bz := store.Get(types.GetBidIntentKey(auctionID, bidder))
+	if bz == nil {
+		return types.BidIntent{}, false
+	}
+	var intent types.BidIntent
+	k.cdc.MustUnmarshal(bz, &intent)
+	return intent, true
+}
+
+// DeleteBidIntent removes a bidder's bid intent on an auction from the store.
+func (k Keeper) DeleteBidIntent(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidIntentKeyPrefix)
+	store.Delete(types.GetBidIntentKey(auctionID, bidder))
+}
+
+// IterateBidIntents provides an iterator over all stored bid intents.
+// For each bid intent, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateBidIntents(ctx sdk.Context, cb func(intent types.BidIntent) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BidIntentKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var intent types.BidIntent
+		k.cdc.MustUnmarshal(iterator.Value(), &intent)
+
+		if cb(intent) {
+			break
+		}
+	}
+}
+
+// GetBidIntentsByAuction returns all bid intents on an auction from the store
+func (k Keeper) GetBidIntentsByAuction(ctx sdk.Context, auctionID uint64) (intents []types.BidIntent) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidIntentKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.Uint64ToBytes(auctionID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var intent types.BidIntent
+		k.cdc.MustUnmarshal(iterator.Value(), &intent)
+		intents = append(intents, intent)
+	}
+	return
+}
+
+// SetSettledAuction puts a settled auction record into the store, and adds it to the settledAuctionsByTime index.
+func (k Keeper) SetSettledAuction(ctx sdk.Context, settled types.SettledAuction) {
+	existing, found := k.GetSettledAuction(ctx, settled.AuctionID)
+	if found {
+		k.removeFromSettledByTimeIndex(ctx, existing.CloseTime, existing.AuctionID)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SettledAuctionKeyPrefix)
+	store.Set(types.GetAuctionKey(settled.AuctionID), k.cdc.MustMarshal(&settled))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SettledAuctionByTimeKeyPrefix)
+	indexStore.Set(types.GetSettledAuctionByTimeKey(settled.CloseTime, settled.AuctionID), types.Uint64ToBytes(settled.AuctionID))
+}
+
+// GetSettledAuction gets a settled auction record from the store.
+func (k Keeper) GetSettledAuction(ctx sdk.Context, auctionID uint64) (types.SettledAuction, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SettledAuctionKeyPrefix)
+	bz := store.Get(types.GetAuctionKey(auctionID))
+	if bz == nil {
+		return types.SettledAuction{}, false
+	}
+	var settled types.SettledAuction
+	k.cdc.MustUnmarshal(bz, &settled)
+	return settled, true
+}
+
+// DeleteSettledAuction removes a settled auction record from the store, and the settledAuctionsByTime index.
+func (k Keeper) DeleteSettledAuction(ctx sdk.Context, auctionID uint64) {
+	settled, found := k.GetSettledAuction(ctx, auctionID)
+	if found {
+		k.removeFromSettledByTimeIndex(ctx, settled.CloseTime, auctionID)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SettledAuctionKeyPrefix)
+	store.Delete(types.GetAuctionKey(auctionID))
+}
+
+// removeFromSettledByTimeIndex removes an auction ID and close time from the settledAuctionsByTime index.
+func (k Keeper) removeFromSettledByTimeIndex(ctx sdk.Context, closeTime time.Time, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SettledAuctionByTimeKeyPrefix)
+	store.Delete(types.GetSettledAuctionByTimeKey(closeTime, auctionID))
+}
+
+// IterateSettledAuctionsByTime provides an iterator over settled auction records ordered by close time.
+// For each record cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateSettledAuctionsByTime(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(auctionID uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SettledAuctionByTimeKeyPrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoffTime)), // include any keys with times equal to inclusiveCutoffTime
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+
+		auctionID := types.Uint64FromBytes(iterator.Value())
+
+		if cb(auctionID) {
+			break
+		}
+	}
+}
+
+// IterateSettledAuctions provides an iterator over all stored settled auction records.
+// For each record, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateSettledAuctions(ctx sdk.Context, cb func(settled types.SettledAuction) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SettledAuctionKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var settled types.SettledAuction
+		k.cdc.MustUnmarshal(iterator.Value(), &settled)
+
+		if cb(settled) {
+			break
+		}
+	}
+}
+
+// SetStartingLot puts the starting lot of an auction into the store.
+func (k Keeper) SetStartingLot(ctx sdk.Context, startingLot types.StartingLot) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StartingLotKeyPrefix)
+	store.Set(types.GetAuctionKey(startingLot.AuctionID), k.cdc.MustMarshal(&startingLot))
+}
+
+// GetStartingLot gets the starting lot of an auction from the store.
+func (k Keeper) GetStartingLot(ctx sdk.Context, auctionID uint64) (types.StartingLot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StartingLotKeyPrefix)
+	bz := store.Get(types.GetAuctionKey(auctionID))
+	if bz == nil {
+		return types.StartingLot{}, false
+	}
+	var startingLot types.StartingLot
+	k.cdc.MustUnmarshal(bz, &startingLot)
+	return startingLot, true
+}
+
+// DeleteStartingLot removes the starting lot of an auction from the store.
+func (k Keeper) DeleteStartingLot(ctx sdk.Context, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StartingLotKeyPrefix)
+	store.Delete(types.GetAuctionKey(auctionID))
+}
+
+// IterateStartingLots provides an iterator over all stored auction starting lots.
+// For each starting lot, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateStartingLots(ctx sdk.Context, cb func(startingLot types.StartingLot) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.StartingLotKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var startingLot types.StartingLot
+		k.cdc.MustUnmarshal(iterator.Value(), &startingLot)
+
+		if cb(startingLot) {
+			break
+		}
+	}
+}
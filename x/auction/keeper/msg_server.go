@@ -41,3 +41,47 @@ func (k msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*typ
 	)
 	return &types.MsgPlaceBidResponse{}, nil
 }
+
+func (k msgServer) PlaceBidIntent(goCtx context.Context, msg *types.MsgPlaceBidIntent) (*types.MsgPlaceBidIntentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.PlaceBidIntent(ctx, msg.AuctionId, bidder, msg.MaxBid, msg.MinLot)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder),
+		),
+	)
+	return &types.MsgPlaceBidIntentResponse{}, nil
+}
+
+func (k msgServer) CancelBidIntent(goCtx context.Context, msg *types.MsgCancelBidIntent) (*types.MsgCancelBidIntentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.CancelBidIntent(ctx, msg.AuctionId, bidder)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder),
+		),
+	)
+	return &types.MsgCancelBidIntentResponse{}, nil
+}
@@ -125,7 +125,7 @@ func auctionIsMatch(auc types.Auction, params types.QueryAllAuctionParams) bool
 
 	// match auction owner (if supplied)
 	if len(params.Owner) > 0 {
-		if cAuc, ok := auc.(*types.CollateralAuction); ok {
+		if cAuc, ok := auc.(types.LotReturnsAuction); ok {
 			foundOwnerAddr := false
 			for _, addr := range cAuc.GetLotReturns().Addresses {
 				if addr.Equals(params.Owner) {
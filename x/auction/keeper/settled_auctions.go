@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// settleAuction stores a record of the outcome of an auction that is being closed, if settled auctions are retained.
+// It must be called before the auction is deleted.
+func (k Keeper) settleAuction(ctx sdk.Context, auction types.Auction) {
+	// the starting lot is only needed until the auction settles
+	startingLot, found := k.GetStartingLot(ctx, auction.GetID())
+	if !found {
+		// auctions without a recorded starting lot are treated as if none of the lot has been sold or returned
+		startingLot = types.StartingLot{AuctionID: auction.GetID(), Lot: auction.GetLot()}
+	}
+	k.DeleteStartingLot(ctx, auction.GetID())
+
+	if k.GetParams(ctx).SettledAuctionRetention <= 0 {
+		return
+	}
+
+	lot := auction.GetLot()
+	lotReturned := sdk.NewCoin(lot.Denom, sdk.ZeroInt())
+	switch auction.(type) {
+	case *types.CollateralAuction:
+		// the lot was bid down in the reverse phase, returning the difference to the lot return addresses
+		lotReturned = startingLot.Lot.Sub(lot)
+	case *types.DutchAuction:
+		// bidders bought part of the lot as they bid, the remainder is returned at close
+		lotReturned = lot
+		lot = startingLot.Lot.Sub(lotReturned)
+	}
+
+	k.SetSettledAuction(ctx, types.NewSettledAuction(auction, lot, lotReturned, ctx.BlockTime()))
+}
+
+// PruneSettledAuctions deletes settled auction records that are older than the settled auction retention period.
+func (k Keeper) PruneSettledAuctions(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-k.GetParams(ctx).SettledAuctionRetention)
+
+	var expiredIDs []uint64
+	k.IterateSettledAuctionsByTime(ctx, cutoff, func(auctionID uint64) bool {
+		expiredIDs = append(expiredIDs, auctionID)
+		return false
+	})
+
+	for _, id := range expiredIDs {
+		k.DeleteSettledAuction(ctx, id)
+	}
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/keeper"
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+func (suite *auctionTestSuite) TestSettledCollateralAuction() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+	_, found := suite.Keeper.GetStartingLot(suite.Ctx, auctionID)
+	suite.True(found)
+
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 50)))
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 15)))
+
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultReverseBidDuration))
+	suite.NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+
+	settled, found := suite.Keeper.GetSettledAuction(ctx, auctionID)
+	suite.True(found)
+	suite.Equal(types.SettledAuction{
+		AuctionID:   auctionID,
+		AuctionType: types.CollateralAuctionType,
+		Initiator:   sellerModName,
+		Winner:      buyer,
+		Bid:         c("token2", 50),
+		Lot:         c("token1", 15),
+		LotReturns:  types.WeightedAddresses{Addresses: returnAddrs, Weights: returnWeights},
+		LotReturned: c("token1", 5),
+		CloseTime:   ctx.BlockTime(),
+	}, settled)
+	_, found = suite.Keeper.GetStartingLot(ctx, auctionID)
+	suite.False(found)
+}
+
+func (suite *auctionTestSuite) TestSettledDutchAuction() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(40, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 12), c("token2", 50), d("2"), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	// Buy part of the lot at the end price
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchPriceDecayDuration))
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 5)))
+
+	ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultMaxAuctionDuration))
+	suite.NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+
+	settled, found := suite.Keeper.GetSettledAuction(ctx, auctionID)
+	suite.True(found)
+	suite.Equal(types.DutchAuctionType, settled.AuctionType)
+	suite.Equal(buyer, settled.Winner)
+	suite.Equal(c("token2", 8), settled.Bid)
+	suite.Equal(c("token1", 5), settled.Lot)
+	suite.Equal(c("token1", 7), settled.LotReturned)
+}
+
+func (suite *auctionTestSuite) TestPruneSettledAuctions() {
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100)))
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 10)))
+
+	closeTime := suite.Ctx.BlockTime().Add(types.DefaultForwardBidDuration)
+	suite.NoError(suite.Keeper.CloseAuction(suite.Ctx.WithBlockTime(closeTime), auctionID))
+
+	// Records are kept until the end of the retention period
+	ctx := suite.Ctx.WithBlockTime(closeTime.Add(types.DefaultSettledAuctionRetention - time.Second))
+	suite.Keeper.PruneSettledAuctions(ctx)
+	_, found := suite.Keeper.GetSettledAuction(ctx, auctionID)
+	suite.True(found)
+
+	ctx = suite.Ctx.WithBlockTime(closeTime.Add(types.DefaultSettledAuctionRetention))
+	suite.Keeper.PruneSettledAuctions(ctx)
+	_, found = suite.Keeper.GetSettledAuction(ctx, auctionID)
+	suite.False(found)
+
+	// No records are stored with a zero retention period
+	params := suite.Keeper.GetParams(ctx)
+	params.SettledAuctionRetention = 0
+	suite.Keeper.SetParams(ctx, params)
+
+	auctionID, err = suite.Keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID, suite.Addrs[0], c("token2", 10)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultForwardBidDuration))
+	suite.NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+	_, found = suite.Keeper.GetSettledAuction(ctx, auctionID)
+	suite.False(found)
+}
+
+func (suite *auctionTestSuite) TestGrpcSettledAuctions() {
+	returnAddrs := suite.Addrs[1:2]
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	surplusID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, surplusID, suite.Addrs[0], c("token2", 10)))
+	collateralID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token2", 20), c("token1", 50), returnAddrs, is(1), c("debt", 40))
+	suite.NoError(err)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, collateralID, suite.Addrs[0], c("token1", 10)))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultForwardBidDuration))
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(suite.Ctx))
+
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	goCtx := sdk.WrapSDKContext(suite.Ctx)
+
+	res, err := queryServer.SettledAuction(goCtx, &types.QuerySettledAuctionRequest{AuctionId: surplusID})
+	suite.NoError(err)
+	suite.Equal(surplusID, res.SettledAuction.AuctionID)
+	_, err = queryServer.SettledAuction(goCtx, &types.QuerySettledAuctionRequest{AuctionId: collateralID + 1})
+	suite.ErrorIs(err, types.ErrSettledAuctionNotFound)
+
+	testCases := []struct {
+		name        string
+		req         *types.QuerySettledAuctionsRequest
+		expectedIDs []uint64
+	}{
+		{"no filters", &types.QuerySettledAuctionsRequest{}, []uint64{surplusID, collateralID}},
+		{"type", &types.QuerySettledAuctionsRequest{Type: types.CollateralAuctionType}, []uint64{collateralID}},
+		{"owner", &types.QuerySettledAuctionsRequest{Owner: returnAddrs[0].String()}, []uint64{collateralID}},
+		{"denom", &types.QuerySettledAuctionsRequest{Denom: "token1"}, []uint64{surplusID, collateralID}},
+		{"type and denom", &types.QuerySettledAuctionsRequest{Type: types.SurplusAuctionType, Denom: "token2"}, []uint64{surplusID}},
+		{"no match", &types.QuerySettledAuctionsRequest{Owner: suite.Addrs[0].String()}, []uint64{}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := queryServer.SettledAuctions(goCtx, tc.req)
+			suite.NoError(err)
+
+			ids := []uint64{}
+			for _, sa := range res.SettledAuctions {
+				ids = append(ids, sa.AuctionID)
+			}
+			suite.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
@@ -60,6 +60,10 @@ func (suite *Suite) SetupTest(numAddrs int) {
 		types.DefaultIncrement,
 		types.DefaultIncrement,
 		types.DefaultIncrement,
+		types.DefaultDutchPriceDecayDuration,
+		types.DefaultDutchStartPriceMultiplier,
+		types.DefaultDutchEndPriceMultiplier,
+		types.DefaultSettledAuctionRetention,
 	)
 
 	auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
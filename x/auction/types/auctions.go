@@ -15,6 +15,7 @@ const (
 	CollateralAuctionType = "collateral"
 	SurplusAuctionType    = "surplus"
 	DebtAuctionType       = "debt"
+	DutchAuctionType      = "dutch"
 	ForwardAuctionPhase   = "forward"
 	ReverseAuctionPhase   = "reverse"
 )
@@ -31,6 +32,8 @@ var (
 	_ GenesisAuction = &DebtAuction{}
 	_ Auction        = &CollateralAuction{}
 	_ GenesisAuction = &CollateralAuction{}
+	_ Auction        = &DutchAuction{}
+	_ GenesisAuction = &DutchAuction{}
 )
 
 // --------------- Shared auction functionality ---------------
@@ -53,6 +56,12 @@ type Auction interface {
 	GetPhase() string
 }
 
+// LotReturnsAuction is an auction that returns unsold lot to a set of weighted addresses (normally the CDP depositors).
+type LotReturnsAuction interface {
+	Auction
+	GetLotReturns() WeightedAddresses
+}
+
 // --------------- BaseAuction ---------------
 
 func (a BaseAuction) GetID() uint64 { return a.ID }
@@ -249,6 +258,105 @@ func (a CollateralAuction) Validate() error {
 	return ValidateAuction(&a)
 }
 
+// --------------- DutchAuction ---------------
+
+// NewDutchAuction returns a new dutch auction.
+func NewDutchAuction(
+	seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturns WeightedAddresses, debt sdk.Coin,
+	startPrice, endPrice sdk.Dec, startTime, priceDecayEndTime, endTime time.Time,
+) DutchAuction {
+	auction := DutchAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime,
+		},
+		CorrespondingDebt: debt,
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+		StartPrice:        startPrice,
+		EndPrice:          endPrice,
+		StartTime:         startTime,
+		PriceDecayEndTime: priceDecayEndTime,
+	}
+	return auction
+}
+
+func (a DutchAuction) WithID(id uint64) Auction {
+	a.ID = id
+	return Auction(&a)
+}
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a DutchAuction) GetType() string { return DutchAuctionType }
+
+// GetPhase returns the direction of a dutch auction, which never changes.
+func (a DutchAuction) GetPhase() string { return ForwardAuctionPhase }
+
+// GetLotReturns returns the auction's lot returns as weighted addresses
+func (a DutchAuction) GetLotReturns() WeightedAddresses { return a.LotReturns }
+
+// IsComplete returns whether the auction has sold its whole lot or raised its max bid.
+func (a DutchAuction) IsComplete() bool {
+	return a.Lot.IsZero() || a.Bid.IsGTE(a.MaxBid)
+}
+
+// GetCurrentPrice returns the price of one unit of lot, in units of the bid denom, at the given time.
+// The price decays linearly from the start price to the end price, and stays at the end price after that.
+func (a DutchAuction) GetCurrentPrice(blockTime time.Time) sdk.Dec {
+	if !blockTime.After(a.StartTime) {
+		return a.StartPrice
+	}
+	if !blockTime.Before(a.PriceDecayEndTime) {
+		return a.EndPrice
+	}
+	elapsed := sdk.NewDec(blockTime.Sub(a.StartTime).Nanoseconds())
+	duration := sdk.NewDec(a.PriceDecayEndTime.Sub(a.StartTime).Nanoseconds())
+	decay := a.StartPrice.Sub(a.EndPrice).Mul(elapsed).Quo(duration)
+	return a.StartPrice.Sub(decay)
+}
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a DutchAuction) GetModuleAccountCoins() sdk.Coins {
+	// a.Bid is paid out on bids, so is never stored in the module account
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...)
+}
+
+// Validate validates the DutchAuction fields values.
+func (a DutchAuction) Validate() error {
+	if !a.CorrespondingDebt.IsValid() {
+		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
+	}
+	if !a.MaxBid.IsValid() {
+		return fmt.Errorf("invalid max bid: %s", a.MaxBid)
+	}
+	if err := a.LotReturns.Validate(); err != nil {
+		return fmt.Errorf("invalid lot returns: %w", err)
+	}
+	if a.StartPrice.IsNil() || !a.StartPrice.IsPositive() {
+		return fmt.Errorf("start price must be positive: %s", a.StartPrice)
+	}
+	if a.EndPrice.IsNil() || !a.EndPrice.IsPositive() {
+		return fmt.Errorf("end price must be positive: %s", a.EndPrice)
+	}
+	if a.EndPrice.GT(a.StartPrice) {
+		return fmt.Errorf("end price cannot be greater than start price (%s > %s)", a.EndPrice, a.StartPrice)
+	}
+	if a.PriceDecayEndTime.Before(a.StartTime) {
+		return fmt.Errorf("price decay end time cannot be before start time (%s < %s)", a.PriceDecayEndTime, a.StartTime)
+	}
+	if a.MaxBid.Denom != a.Bid.Denom {
+		return fmt.Errorf("max bid denom %s does not match bid denom %s", a.MaxBid.Denom, a.Bid.Denom)
+	}
+	return ValidateAuction(&a)
+}
+
 // NewWeightedAddresses returns a new list addresses with weights.
 func NewWeightedAddresses(addrs []sdk.AccAddress, weights []sdk.Int) (WeightedAddresses, error) {
 	wa := WeightedAddresses{
@@ -353,3 +353,124 @@ func TestNewCollateralAuction(t *testing.T) {
 	require.Equal(t, collateralAuction.LotReturns, weightedAddresses)
 	require.Equal(t, collateralAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
 }
+
+func TestNewDutchAuction(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress([]byte(testAccAddress1)),
+		sdk.AccAddress([]byte(testAccAddress2)),
+	}
+	weightedAddresses, _ := NewWeightedAddresses(addresses, is(6, 8))
+
+	startTime := time.Now()
+	decayEndTime := startTime.Add(time.Hour)
+	endTime := startTime.Add(2 * time.Hour)
+
+	dutchAuction := NewDutchAuction(
+		TestInitiatorModuleName,
+		c(TestLotDenom, TestLotAmount),
+		c(TestBidDenom, TestBidAmount),
+		weightedAddresses,
+		c(TestDebtDenom, TestDebtAmount2),
+		d("1.2"),
+		d("0.8"),
+		startTime,
+		decayEndTime,
+		endTime,
+	)
+
+	require.Equal(t, dutchAuction.Initiator, TestInitiatorModuleName)
+	require.Equal(t, dutchAuction.Lot, c(TestLotDenom, TestLotAmount))
+	require.Equal(t, dutchAuction.Bid, c(TestBidDenom, 0))
+	require.Equal(t, dutchAuction.EndTime, endTime)
+	require.Equal(t, dutchAuction.MaxEndTime, endTime)
+	require.Equal(t, dutchAuction.MaxBid, c(TestBidDenom, TestBidAmount))
+	require.Equal(t, dutchAuction.LotReturns, weightedAddresses)
+	require.Equal(t, dutchAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
+	require.Equal(t, DutchAuctionType, dutchAuction.GetType())
+	require.Equal(t, ForwardAuctionPhase, dutchAuction.GetPhase())
+	require.NoError(t, dutchAuction.Validate())
+}
+
+func TestDutchAuctionGetCurrentPrice(t *testing.T) {
+	startTime := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	auction := DutchAuction{
+		StartPrice:        d("1.2"),
+		EndPrice:          d("0.8"),
+		StartTime:         startTime,
+		PriceDecayEndTime: startTime.Add(4 * time.Hour),
+	}
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		expPrice  sdk.Dec
+	}{
+		{"before start", startTime.Add(-time.Hour), d("1.2")},
+		{"at start", startTime, d("1.2")},
+		{"quarter decayed", startTime.Add(time.Hour), d("1.1")},
+		{"half decayed", startTime.Add(2 * time.Hour), d("1.0")},
+		{"at decay end", startTime.Add(4 * time.Hour), d("0.8")},
+		{"after decay end", startTime.Add(24 * time.Hour), d("0.8")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPrice, auction.GetCurrentPrice(tc.blockTime))
+		})
+	}
+}
+
+func TestDutchAuctionValidate(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	now := time.Now()
+	validAuction := DutchAuction{
+		BaseAuction: BaseAuction{
+			ID:              1,
+			Initiator:       testAccAddress1,
+			Lot:             c("kava", 1),
+			Bidder:          addr1,
+			Bid:             c("usdx", 1),
+			EndTime:         now,
+			MaxEndTime:      now,
+			HasReceivedBids: true,
+		},
+		CorrespondingDebt: c("debt", 1),
+		MaxBid:            c("usdx", 1),
+		LotReturns: WeightedAddresses{
+			Addresses: []sdk.AccAddress{addr1},
+			Weights:   []sdk.Int{sdk.NewInt(1)},
+		},
+		StartPrice:        d("1.2"),
+		EndPrice:          d("0.8"),
+		StartTime:         now,
+		PriceDecayEndTime: now,
+	}
+
+	tests := []struct {
+		msg     string
+		modify  func(a *DutchAuction)
+		expPass bool
+	}{
+		{"valid auction", func(a *DutchAuction) {}, true},
+		{"invalid corresponding debt", func(a *DutchAuction) { a.CorrespondingDebt = sdk.Coin{Denom: "debt", Amount: sdk.NewInt(-1)} }, false},
+		{"invalid max bid", func(a *DutchAuction) { a.MaxBid = sdk.Coin{Denom: "usdx", Amount: sdk.NewInt(-1)} }, false},
+		{"mismatched max bid denom", func(a *DutchAuction) { a.MaxBid = c("kava", 1) }, false},
+		{"zero end price", func(a *DutchAuction) { a.EndPrice = sdk.ZeroDec() }, false},
+		{"end price > start price", func(a *DutchAuction) { a.EndPrice = d("1.5") }, false},
+		{"decay end before start", func(a *DutchAuction) { a.PriceDecayEndTime = now.Add(-time.Hour) }, false},
+	}
+
+	for _, tc := range tests {
+		auction := validAuction
+		tc.modify(&auction)
+
+		err := auction.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBidIntent returns a new bid intent with its max bid escrowed.
+func NewBidIntent(auctionID uint64, bidder sdk.AccAddress, maxBid, minLot sdk.Coin) BidIntent {
+	return BidIntent{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		MaxBid:    maxBid,
+		MinLot:    minLot,
+		Escrow:    maxBid,
+	}
+}
+
+// Validate performs basic validation of a bid intent.
+func (bi BidIntent) Validate() error {
+	if bi.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if bi.Bidder.Empty() {
+		return errors.New("bidder cannot be empty")
+	}
+	if !bi.MaxBid.IsValid() || bi.MaxBid.IsZero() {
+		return fmt.Errorf("invalid max bid: %s", bi.MaxBid)
+	}
+	if !bi.MinLot.IsValid() {
+		return fmt.Errorf("invalid min lot: %s", bi.MinLot)
+	}
+	if !bi.Escrow.IsValid() {
+		return fmt.Errorf("invalid escrow: %s", bi.Escrow)
+	}
+	if bi.Escrow.Denom != bi.MaxBid.Denom {
+		return fmt.Errorf("escrow denom %s does not match max bid denom %s", bi.Escrow.Denom, bi.MaxBid.Denom)
+	}
+	return nil
+}
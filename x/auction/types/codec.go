@@ -13,17 +13,22 @@ import (
 // governance module.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPlaceBid{}, "auction/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(&MsgPlaceBidIntent{}, "auction/MsgPlaceBidIntent", nil)
+	cdc.RegisterConcrete(&MsgCancelBidIntent{}, "auction/MsgCancelBidIntent", nil)
 
 	cdc.RegisterInterface((*GenesisAuction)(nil), nil)
 	cdc.RegisterInterface((*Auction)(nil), nil)
 	cdc.RegisterConcrete(&SurplusAuction{}, "auction/SurplusAuction", nil)
 	cdc.RegisterConcrete(&DebtAuction{}, "auction/DebtAuction", nil)
 	cdc.RegisterConcrete(&CollateralAuction{}, "auction/CollateralAuction", nil)
+	cdc.RegisterConcrete(&DutchAuction{}, "auction/DutchAuction", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPlaceBid{},
+		&MsgPlaceBidIntent{},
+		&MsgCancelBidIntent{},
 	)
 
 	registry.RegisterInterface(
@@ -32,6 +37,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SurplusAuction{},
 		&DebtAuction{},
 		&CollateralAuction{},
+		&DutchAuction{},
 	)
 
 	registry.RegisterInterface(
@@ -40,6 +46,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SurplusAuction{},
 		&DebtAuction{},
 		&CollateralAuction{},
+		&DutchAuction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
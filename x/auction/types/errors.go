@@ -27,4 +27,12 @@ var (
 	ErrLotTooSmall = sdkerrors.Register(ModuleName, 11, "lot is not greater than auction's min new lot amount")
 	// ErrLotTooLarge error for when lot is not smaller than auction's max new lot amount
 	ErrLotTooLarge = sdkerrors.Register(ModuleName, 12, "lot is greater than auction's max new lot amount")
+	// ErrBidIntentNotFound error for when a bid intent is not found
+	ErrBidIntentNotFound = sdkerrors.Register(ModuleName, 13, "bid intent not found")
+	// ErrBidIntentExists error for when a bidder already has a bid intent on an auction
+	ErrBidIntentExists = sdkerrors.Register(ModuleName, 14, "bid intent already exists")
+	// ErrBidIntentNotSupported error for when an auction type does not support bid intents
+	ErrBidIntentNotSupported = sdkerrors.Register(ModuleName, 15, "auction type does not support bid intents")
+	// ErrSettledAuctionNotFound error for when a settled auction record is not found
+	ErrSettledAuctionNotFound = sdkerrors.Register(ModuleName, 16, "settled auction not found")
 )
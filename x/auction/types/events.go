@@ -6,6 +6,9 @@ const (
 	EventTypeAuctionBid   = "auction_bid"
 	EventTypeAuctionClose = "auction_close"
 
+	EventTypeAuctionBidIntent       = "auction_bid_intent"
+	EventTypeAuctionCancelBidIntent = "auction_cancel_bid_intent"
+
 	AttributeValueCategory  = ModuleName
 	AttributeKeyAuctionID   = "auction_id"
 	AttributeKeyAuctionType = "auction_type"
@@ -14,5 +17,8 @@ const (
 	AttributeKeyMaxBid      = "max_bid"
 	AttributeKeyBid         = "bid"
 	AttributeKeyEndTime     = "end_time"
+	AttributeKeyPrice       = "price"
 	AttributeKeyCloseBlock  = "close_block"
+	AttributeKeyMinLot      = "min_lot"
+	AttributeKeyEscrow      = "escrow"
 )
@@ -111,6 +111,55 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("found auction ID ≥ the nextAuctionID (%d ≥ %d)", a.GetID(), gs.NextAuctionId)
 		}
 	}
+
+	intents := map[string]bool{}
+	for _, bi := range gs.BidIntents {
+		if err := bi.Validate(); err != nil {
+			return fmt.Errorf("found invalid bid intent: %w", err)
+		}
+
+		if !ids[bi.AuctionID] {
+			return fmt.Errorf("found bid intent for missing auction ID (%d)", bi.AuctionID)
+		}
+
+		key := string(GetBidIntentKey(bi.AuctionID, bi.Bidder))
+		if intents[key] {
+			return fmt.Errorf("found duplicate bid intent for auction ID (%d) and bidder %s", bi.AuctionID, bi.Bidder)
+		}
+		intents[key] = true
+	}
+
+	settledIDs := map[uint64]bool{}
+	for _, sa := range gs.SettledAuctions {
+		if err := sa.Validate(); err != nil {
+			return fmt.Errorf("found invalid settled auction: %w", err)
+		}
+
+		if settledIDs[sa.AuctionID] || ids[sa.AuctionID] {
+			return fmt.Errorf("found duplicate settled auction ID (%d)", sa.AuctionID)
+		}
+		settledIDs[sa.AuctionID] = true
+
+		if sa.AuctionID >= gs.NextAuctionId {
+			return fmt.Errorf("found settled auction ID ≥ the nextAuctionID (%d ≥ %d)", sa.AuctionID, gs.NextAuctionId)
+		}
+	}
+
+	startingLots := map[uint64]bool{}
+	for _, sl := range gs.StartingLots {
+		if err := sl.Validate(); err != nil {
+			return fmt.Errorf("found invalid starting lot: %w", err)
+		}
+
+		if !ids[sl.AuctionID] {
+			return fmt.Errorf("found starting lot for missing auction ID (%d)", sl.AuctionID)
+		}
+
+		if startingLots[sl.AuctionID] {
+			return fmt.Errorf("found duplicate starting lot for auction ID (%d)", sl.AuctionID)
+		}
+		startingLots[sl.AuctionID] = true
+	}
 	return nil
 }
 
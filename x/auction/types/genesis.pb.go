@@ -36,6 +36,12 @@ type GenesisState struct {
 	Params        Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// Genesis auctions
 	Auctions []*types.Any `protobuf:"bytes,3,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// Genesis bid intents
+	BidIntents []BidIntent `protobuf:"bytes,4,rep,name=bid_intents,json=bidIntents,proto3" json:"bid_intents"`
+	// Genesis settled auction records
+	SettledAuctions []SettledAuction `protobuf:"bytes,5,rep,name=settled_auctions,json=settledAuctions,proto3" json:"settled_auctions"`
+	// Genesis starting lots of auctions with lot returns
+	StartingLots []StartingLot `protobuf:"bytes,6,rep,name=starting_lots,json=startingLots,proto3" json:"starting_lots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +85,14 @@ type Params struct {
 	IncrementSurplus    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=increment_surplus,json=incrementSurplus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_surplus"`
 	IncrementDebt       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=increment_debt,json=incrementDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_debt"`
 	IncrementCollateral github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=increment_collateral,json=incrementCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_collateral"`
+	// dutch_price_decay_duration is how long the price of a dutch auction takes to fall from its start to its end price
+	DutchPriceDecayDuration time.Duration `protobuf:"bytes,8,opt,name=dutch_price_decay_duration,json=dutchPriceDecayDuration,proto3,stdduration" json:"dutch_price_decay_duration"`
+	// dutch_start_price_multiplier is applied to the reference price to get the start price of a dutch auction
+	DutchStartPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=dutch_start_price_multiplier,json=dutchStartPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_start_price_multiplier"`
+	// dutch_end_price_multiplier is applied to the reference price to get the end price of a dutch auction
+	DutchEndPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=dutch_end_price_multiplier,json=dutchEndPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_end_price_multiplier"`
+	// settled_auction_retention is how long records of closed auctions are kept
+	SettledAuctionRetention time.Duration `protobuf:"bytes,11,opt,name=settled_auction_retention,json=settledAuctionRetention,proto3,stdduration" json:"settled_auction_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -124,38 +138,51 @@ func init() {
 }
 
 var fileDescriptor_d0e5cb58293042f7 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x93, 0x35, 0x94, 0xca, 0xed, 0xc6, 0x30, 0x39, 0xa4, 0x13, 0x4a, 0xab, 0x1e, 0xa6,
-	0x72, 0xa8, 0xa3, 0x95, 0x1b, 0xb7, 0x85, 0x4a, 0x13, 0x9c, 0x50, 0xa6, 0x5d, 0xe0, 0x10, 0x39,
-	0x89, 0x17, 0xa2, 0x25, 0x71, 0x65, 0x3b, 0xa5, 0x7d, 0x0b, 0x8e, 0x3c, 0x08, 0x87, 0x3d, 0x42,
-	0xc5, 0x69, 0x47, 0xc4, 0x61, 0x40, 0xfb, 0x22, 0x28, 0x8e, 0x9b, 0x16, 0xd8, 0x65, 0x3b, 0xd5,
-	0xfe, 0xbe, 0xff, 0xf7, 0xfb, 0xff, 0xed, 0x3a, 0x60, 0x70, 0x85, 0x67, 0xd8, 0xc1, 0x45, 0x28,
-	0x12, 0x9a, 0x3b, 0xb3, 0x93, 0x80, 0x08, 0x7c, 0xe2, 0xc4, 0x24, 0x27, 0x3c, 0xe1, 0x68, 0xca,
-	0xa8, 0xa0, 0xd0, 0x2c, 0x35, 0x48, 0x69, 0x90, 0xd2, 0x1c, 0x75, 0x43, 0xca, 0x33, 0xca, 0x7d,
-	0xa9, 0x71, 0xaa, 0x4d, 0x35, 0x70, 0x64, 0xc6, 0x34, 0xa6, 0x55, 0xbd, 0x5c, 0xa9, 0x6a, 0x37,
-	0xa6, 0x34, 0x4e, 0x89, 0x23, 0x77, 0x41, 0x71, 0xe9, 0xe0, 0x7c, 0xa1, 0x5a, 0xf6, 0xbf, 0xad,
-	0xa8, 0x60, 0x58, 0xba, 0xc9, 0xca, 0xe0, 0x5a, 0x07, 0x9d, 0xb3, 0x2a, 0xd3, 0xb9, 0xc0, 0x82,
-	0xc0, 0x63, 0xf0, 0x24, 0x27, 0x73, 0xe1, 0xab, 0x50, 0x7e, 0x12, 0x59, 0x7a, 0x5f, 0x1f, 0x1a,
-	0xde, 0x7e, 0x59, 0x3e, 0xad, 0xaa, 0x6f, 0x22, 0xf8, 0x0a, 0x34, 0xa7, 0x98, 0xe1, 0x8c, 0x5b,
-	0x7b, 0x7d, 0x7d, 0xd8, 0x1e, 0x3f, 0x47, 0x77, 0x9d, 0x05, 0xbd, 0x93, 0x1a, 0xd7, 0x58, 0xde,
-	0xf6, 0x34, 0x4f, 0x4d, 0xc0, 0x09, 0x68, 0x29, 0x1d, 0xb7, 0x1a, 0xfd, 0xc6, 0xb0, 0x3d, 0x36,
-	0x51, 0x95, 0x13, 0x6d, 0x72, 0xa2, 0xd3, 0x7c, 0xe1, 0xc2, 0x6f, 0x5f, 0x47, 0x07, 0x2a, 0x9d,
-	0x72, 0xf6, 0xea, 0xc9, 0xc1, 0xb5, 0x01, 0x9a, 0x15, 0x1e, 0x5e, 0x00, 0x33, 0xc3, 0xf3, 0x3a,
-	0xf3, 0xe6, 0x8c, 0x32, 0x79, 0x7b, 0xdc, 0xfd, 0x0f, 0x3e, 0x51, 0x02, 0xb7, 0x55, 0xe6, 0xfa,
-	0xf2, 0xb3, 0xa7, 0x7b, 0x30, 0xc3, 0x73, 0xe5, 0xb1, 0xe9, 0x96, 0xd8, 0x4b, 0xca, 0x3e, 0x61,
-	0x16, 0xf9, 0x41, 0x12, 0x6d, 0xb1, 0xcd, 0x7b, 0x60, 0x15, 0xc0, 0x4d, 0xa2, 0x5d, 0x2c, 0x23,
-	0x33, 0xc2, 0x38, 0xf9, 0x1b, 0xfb, 0xf8, 0x1e, 0x58, 0x05, 0xd8, 0xc5, 0x7e, 0x00, 0x4f, 0x93,
-	0x3c, 0x64, 0x24, 0x23, 0xb9, 0xf0, 0x79, 0xc1, 0xa6, 0x69, 0x51, 0x5e, 0xaf, 0x3e, 0xec, 0xb8,
-	0xa8, 0x1c, 0xfc, 0x71, 0xdb, 0x3b, 0x8e, 0x13, 0xf1, 0xb1, 0x08, 0x50, 0x48, 0x33, 0xf5, 0xae,
-	0xd4, 0xcf, 0x88, 0x47, 0x57, 0x8e, 0x58, 0x4c, 0x09, 0x47, 0x13, 0x12, 0x7a, 0x87, 0x35, 0xe8,
-	0xbc, 0xe2, 0xc0, 0x0b, 0x70, 0xb0, 0x85, 0x47, 0x24, 0x10, 0x96, 0xf1, 0x20, 0xf2, 0x7e, 0x4d,
-	0x99, 0x90, 0x40, 0x40, 0x0c, 0xcc, 0x2d, 0x36, 0xa4, 0x69, 0x8a, 0x05, 0x61, 0x38, 0xb5, 0x1e,
-	0x3d, 0x08, 0xfe, 0xac, 0x66, 0xbd, 0xae, 0x51, 0x6f, 0x8d, 0xd6, 0xde, 0x61, 0xc3, 0xeb, 0xec,
-	0xde, 0xb4, 0x7b, 0xb6, 0xfc, 0x6d, 0x6b, 0xcb, 0x95, 0xad, 0xdf, 0xac, 0x6c, 0xfd, 0xd7, 0xca,
-	0xd6, 0x3f, 0xaf, 0x6d, 0xed, 0x66, 0x6d, 0x6b, 0xdf, 0xd7, 0xb6, 0xf6, 0xfe, 0xc5, 0x8e, 0x5d,
-	0xf9, 0xa8, 0x47, 0x29, 0x0e, 0xb8, 0x5c, 0x39, 0xf3, 0xfa, 0x83, 0x96, 0xae, 0x41, 0x53, 0xfe,
-	0x49, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x34, 0x3b, 0x9f, 0xed, 0x03, 0x00, 0x00,
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x63, 0x12, 0x42, 0xee, 0x24, 0xfc, 0xb9, 0x73, 0x23, 0x5d, 0x07, 0x21, 0x87, 0xa2,
+	0x0a, 0xa5, 0x0b, 0x6c, 0x41, 0x77, 0xdd, 0xe1, 0xa6, 0x45, 0x54, 0x54, 0x42, 0x46, 0x6c, 0xda,
+	0x85, 0x3b, 0xf6, 0x0c, 0xc6, 0xc2, 0xf6, 0x44, 0x33, 0x63, 0x9a, 0xbc, 0x45, 0x97, 0x7d, 0x81,
+	0xbe, 0x41, 0x1f, 0x02, 0x75, 0xc5, 0xb2, 0xed, 0x82, 0xb6, 0xf0, 0x22, 0x95, 0xc7, 0x63, 0x27,
+	0x40, 0x2a, 0x95, 0xac, 0x6c, 0x9f, 0xf9, 0xe6, 0x77, 0xbe, 0x73, 0xc6, 0x73, 0xc0, 0xc6, 0x19,
+	0x3a, 0x47, 0x16, 0x4a, 0x7d, 0x11, 0xd2, 0xc4, 0x3a, 0xdf, 0xf6, 0x88, 0x40, 0xdb, 0x56, 0x40,
+	0x12, 0xc2, 0x43, 0x6e, 0x0e, 0x18, 0x15, 0x14, 0xb6, 0x33, 0x8d, 0xa9, 0x34, 0xa6, 0xd2, 0xac,
+	0x76, 0x7c, 0xca, 0x63, 0xca, 0x5d, 0xa9, 0xb1, 0xf2, 0x8f, 0x7c, 0xc3, 0x6a, 0x3b, 0xa0, 0x01,
+	0xcd, 0xe3, 0xd9, 0x9b, 0x8a, 0x76, 0x02, 0x4a, 0x83, 0x88, 0x58, 0xf2, 0xcb, 0x4b, 0x4f, 0x2c,
+	0x94, 0x8c, 0xd4, 0x92, 0x71, 0x77, 0x09, 0xa7, 0x0c, 0xc9, 0x6c, 0xf9, 0xfa, 0x74, 0x97, 0x85,
+	0x23, 0xa9, 0xd9, 0xf8, 0x54, 0x05, 0xad, 0xbd, 0xdc, 0xf7, 0x91, 0x40, 0x82, 0xc0, 0x4d, 0xb0,
+	0x9c, 0x90, 0xa1, 0x70, 0x95, 0xcc, 0x0d, 0xb1, 0xae, 0xad, 0x6b, 0xbd, 0x9a, 0xb3, 0x98, 0x85,
+	0x77, 0xf3, 0xe8, 0x3e, 0x86, 0xcf, 0x40, 0x7d, 0x80, 0x18, 0x8a, 0xb9, 0x3e, 0xb7, 0xae, 0xf5,
+	0x9a, 0x3b, 0x6b, 0xe6, 0xb4, 0x7a, 0xcd, 0x43, 0xa9, 0xb1, 0x6b, 0x17, 0x57, 0xdd, 0x8a, 0xa3,
+	0x76, 0xc0, 0x3e, 0x68, 0x28, 0x1d, 0xd7, 0xab, 0xeb, 0xd5, 0x5e, 0x73, 0xa7, 0x6d, 0xe6, 0xb5,
+	0x98, 0x45, 0x2d, 0xe6, 0x6e, 0x32, 0xb2, 0xe1, 0x97, 0xcf, 0x5b, 0x4b, 0xca, 0x9d, 0xca, 0xec,
+	0x94, 0x3b, 0xe1, 0x4b, 0xd0, 0xf4, 0x42, 0xec, 0x86, 0x89, 0x20, 0x89, 0xe0, 0x7a, 0x4d, 0x82,
+	0xba, 0xd3, 0x6d, 0xd8, 0x21, 0xde, 0x97, 0x3a, 0xe5, 0x04, 0x78, 0x45, 0x80, 0xc3, 0x63, 0xb0,
+	0xc2, 0x89, 0x10, 0x11, 0xc1, 0x6e, 0xe9, 0x6a, 0x5e, 0xc2, 0x1e, 0x4f, 0x87, 0x1d, 0xe5, 0x6a,
+	0xe5, 0x48, 0x11, 0x97, 0xf9, 0xad, 0x28, 0x87, 0x07, 0x60, 0x91, 0x0b, 0xc4, 0x44, 0x98, 0x04,
+	0x6e, 0x44, 0x05, 0xd7, 0xeb, 0x92, 0xf9, 0xe8, 0x0f, 0x4c, 0x25, 0x3d, 0xa0, 0x85, 0xc5, 0x16,
+	0x1f, 0x87, 0xf8, 0xc6, 0xb7, 0x05, 0x50, 0xcf, 0x7b, 0x09, 0x8f, 0x41, 0x3b, 0x46, 0xc3, 0xf2,
+	0x80, 0x8a, 0x43, 0x97, 0xc7, 0xd4, 0xdc, 0xe9, 0xdc, 0xeb, 0x64, 0x5f, 0x09, 0xec, 0x46, 0xc6,
+	0xfd, 0xf8, 0xa3, 0xab, 0x39, 0x30, 0x46, 0x43, 0x65, 0xb4, 0x58, 0xcd, 0xb0, 0x27, 0x94, 0xbd,
+	0x47, 0x0c, 0xbb, 0x59, 0x5b, 0x4b, 0x6c, 0xfd, 0x01, 0x58, 0x05, 0xb0, 0x43, 0x3c, 0x89, 0x65,
+	0xe4, 0x9c, 0x30, 0x4e, 0x6e, 0x63, 0x17, 0x1e, 0x80, 0x55, 0x80, 0x49, 0xec, 0x5b, 0xf0, 0x6f,
+	0x98, 0xf8, 0x8c, 0xc4, 0x24, 0x11, 0x2e, 0x4f, 0xd9, 0x20, 0x4a, 0xb3, 0x7f, 0x49, 0xeb, 0xb5,
+	0x6c, 0x33, 0xdb, 0xf8, 0xfd, 0xaa, 0xbb, 0x19, 0x84, 0xe2, 0x34, 0xf5, 0x4c, 0x9f, 0xc6, 0xea,
+	0xa2, 0xa9, 0xc7, 0x16, 0xc7, 0x67, 0x96, 0x18, 0x0d, 0x08, 0x37, 0xfb, 0xc4, 0x77, 0x56, 0x4a,
+	0xd0, 0x51, 0xce, 0x81, 0xc7, 0x60, 0x69, 0x0c, 0xc7, 0xc4, 0x13, 0x7a, 0x6d, 0x26, 0xf2, 0x62,
+	0x49, 0xe9, 0x13, 0x4f, 0x40, 0x04, 0xda, 0x63, 0xac, 0x4f, 0xa3, 0x08, 0x09, 0xc2, 0x50, 0xa4,
+	0xcf, 0xcf, 0x04, 0xff, 0xaf, 0x64, 0x3d, 0x2f, 0x51, 0xf0, 0x1d, 0x58, 0xc5, 0xa9, 0xf0, 0x4f,
+	0xdd, 0x01, 0x0b, 0x7d, 0xe2, 0x62, 0xe2, 0xa3, 0xd1, 0xb8, 0xe7, 0x8d, 0xbf, 0xef, 0xf9, 0xff,
+	0x12, 0x73, 0x98, 0x51, 0xfa, 0x19, 0xa4, 0x6c, 0x3c, 0x05, 0x6b, 0x79, 0x06, 0xf9, 0x7b, 0xaa,
+	0x3c, 0x71, 0x1a, 0x89, 0x70, 0x10, 0x85, 0x84, 0xe9, 0xff, 0xcc, 0x54, 0x4c, 0x47, 0x32, 0xe5,
+	0x25, 0x90, 0x39, 0x5f, 0x97, 0x40, 0x78, 0x56, 0x94, 0x44, 0x12, 0x7c, 0x3f, 0x1d, 0x98, 0x29,
+	0x5d, 0x5e, 0xdd, 0x8b, 0x04, 0xdf, 0x4d, 0xe6, 0x82, 0xce, 0x9d, 0x59, 0xe0, 0x32, 0x92, 0x8d,
+	0x89, 0xac, 0x7d, 0xcd, 0x07, 0xb4, 0xef, 0xf6, 0x34, 0x70, 0x0a, 0xc6, 0xab, 0x5a, 0x63, 0x6e,
+	0xa5, 0xea, 0xb4, 0x26, 0xaf, 0x82, 0xbd, 0x77, 0xf1, 0xcb, 0xa8, 0x5c, 0x5c, 0x1b, 0xda, 0xe5,
+	0xb5, 0xa1, 0xfd, 0xbc, 0x36, 0xb4, 0x0f, 0x37, 0x46, 0xe5, 0xf2, 0xc6, 0xa8, 0x7c, 0xbd, 0x31,
+	0x2a, 0x6f, 0x9e, 0x4c, 0xd4, 0x94, 0x8d, 0x8e, 0xad, 0x08, 0x79, 0x5c, 0xbe, 0x59, 0xc3, 0x72,
+	0xb8, 0xcb, 0xd2, 0xbc, 0xba, 0xb4, 0xf4, 0xf4, 0xf7, 0x00, 0xbe, 0x26, 0xec, 0x78, 0x9f, 0x06,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +205,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StartingLots) > 0 {
+		for iNdEx := len(m.StartingLots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StartingLots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SettledAuctions) > 0 {
+		for iNdEx := len(m.SettledAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BidIntents) > 0 {
+		for iNdEx := len(m.BidIntents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidIntents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Auctions) > 0 {
 		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -230,21 +299,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReverseBidDuration):])
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SettledAuctionRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SettledAuctionRetention):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x3a
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	dAtA[i] = 0x5a
+	{
+		size := m.DutchEndPriceMultiplier.Size()
+		i -= size
+		if _, err := m.DutchEndPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DutchStartPriceMultiplier.Size()
+		i -= size
+		if _, err := m.DutchStartPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DutchPriceDecayDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DutchPriceDecayDuration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReverseBidDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x32
 	{
 		size := m.IncrementCollateral.Size()
@@ -276,12 +381,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAuctionDuration):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAuctionDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -315,6 +420,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BidIntents) > 0 {
+		for _, e := range m.BidIntents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettledAuctions) > 0 {
+		for _, e := range m.SettledAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StartingLots) > 0 {
+		for _, e := range m.StartingLots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +459,14 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReverseBidDuration)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DutchPriceDecayDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DutchStartPriceMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DutchEndPriceMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SettledAuctionRetention)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -460,6 +591,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidIntents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidIntents = append(m.BidIntents, BidIntent{})
+			if err := m.BidIntents[len(m.BidIntents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledAuctions = append(m.SettledAuctions, SettledAuction{})
+			if err := m.SettledAuctions[len(m.SettledAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingLots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartingLots = append(m.StartingLots, StartingLot{})
+			if err := m.StartingLots[len(m.StartingLots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -708,6 +941,138 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchPriceDecayDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DutchPriceDecayDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchStartPriceMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchStartPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchEndPriceMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchEndPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledAuctionRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SettledAuctionRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -40,6 +40,12 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 	}
 
+	validBidIntent := NewBidIntent(validAuction.ID, sdk.AccAddress("test bidder"), sdk.NewInt64Coin("usdx", 100), sdk.NewInt64Coin("btc", 0))
+
+	settledAuction := *validAuction
+	settledAuction.ID = validAuction.ID - 1
+	validSettledAuction := NewSettledAuction(&settledAuction, settledAuction.Lot, sdk.NewInt64Coin("btc", 1e8), arbitraryTime)
+
 	testCases := []struct {
 		name       string
 		genesis    *GenesisState
@@ -60,6 +66,9 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				nil,
+				nil,
+				nil,
 			},
 			false,
 		},
@@ -74,6 +83,93 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				nil,
+				nil,
+				nil,
+			},
+			false,
+		},
+		{
+			"valid bid intent",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{validAuction}),
+				[]BidIntent{validBidIntent},
+				nil,
+				nil,
+			},
+			true,
+		},
+		{
+			"invalid bid intent for missing auction",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{validAuction}),
+				[]BidIntent{NewBidIntent(validAuction.ID+1, sdk.AccAddress("test bidder"), sdk.NewInt64Coin("usdx", 100), sdk.NewInt64Coin("btc", 0))},
+				nil,
+				nil,
+			},
+			false,
+		},
+		{
+			"invalid repeated bid intent",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{validAuction}),
+				[]BidIntent{validBidIntent, validBidIntent},
+				nil,
+				nil,
+			},
+			false,
+		},
+		{
+			"valid settled auctions and starting lots",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{validAuction}),
+				nil,
+				[]SettledAuction{validSettledAuction},
+				[]StartingLot{{AuctionID: validAuction.ID, Lot: sdk.NewInt64Coin("btc", 2e8)}},
+			},
+			true,
+		},
+		{
+			"settled auction ID matches open auction",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{validAuction}),
+				nil,
+				[]SettledAuction{NewSettledAuction(validAuction, validAuction.Lot, sdk.NewInt64Coin("btc", 0), arbitraryTime)},
+				nil,
+			},
+			false,
+		},
+		{
+			"invalid settled auction type",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{validAuction}),
+				nil,
+				[]SettledAuction{{AuctionID: 1, AuctionType: "invalid", Bid: testCoin, Lot: testCoin, LotReturned: testCoin}},
+				nil,
+			},
+			false,
+		},
+		{
+			"starting lot for missing auction",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{validAuction}),
+				nil,
+				nil,
+				[]StartingLot{{AuctionID: validAuction.ID - 1, Lot: sdk.NewInt64Coin("btc", 2e8)}},
 			},
 			false,
 		},
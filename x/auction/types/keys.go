@@ -30,6 +30,13 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	BidIntentKeyPrefix = []byte{0x03} // prefix for keys that store bid intents
+
+	SettledAuctionKeyPrefix       = []byte{0x04} // prefix for keys that store settled auction records
+	SettledAuctionByTimeKeyPrefix = []byte{0x05} // prefix for keys that are part of the settledAuctionsByTime index
+
+	StartingLotKeyPrefix = []byte{0x06} // prefix for keys that store the starting lots of auctions with lot returns
 )
 
 // GetAuctionKey returns the bytes of an auction key
@@ -42,6 +49,16 @@ func GetAuctionByTimeKey(endTime time.Time, auctionID uint64) []byte {
 	return append(sdk.FormatTimeBytes(endTime), Uint64ToBytes(auctionID)...)
 }
 
+// GetBidIntentKey returns the key for a bid intent, prefixed by its auction id for iterating the intents of an auction
+func GetBidIntentKey(auctionID uint64, bidder sdk.AccAddress) []byte {
+	return append(Uint64ToBytes(auctionID), bidder...)
+}
+
+// GetSettledAuctionByTimeKey returns the key for iterating settled auctions by close time
+func GetSettledAuctionByTimeKey(closeTime time.Time, auctionID uint64) []byte {
+	return append(sdk.FormatTimeBytes(closeTime), Uint64ToBytes(auctionID)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func Uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
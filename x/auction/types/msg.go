@@ -54,3 +54,99 @@ func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{bidder}
 }
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgPlaceBidIntent{}
+	_ sdk.Msg = &MsgCancelBidIntent{}
+)
+
+// NewMsgPlaceBidIntent returns a new MsgPlaceBidIntent.
+func NewMsgPlaceBidIntent(auctionID uint64, bidder string, maxBid, minLot sdk.Coin) MsgPlaceBidIntent {
+	return MsgPlaceBidIntent{
+		AuctionId: auctionID,
+		Bidder:    bidder,
+		MaxBid:    maxBid,
+		MinLot:    minLot,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceBidIntent) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceBidIntent) Type() string { return "place_bid_intent" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgPlaceBidIntent) ValidateBasic() error {
+	if msg.AuctionId == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty or invalid")
+	}
+	if !msg.MaxBid.IsValid() || msg.MaxBid.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max bid amount %s", msg.MaxBid)
+	}
+	if !msg.MinLot.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "min lot amount %s", msg.MinLot)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceBidIntent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceBidIntent) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{bidder}
+}
+
+// NewMsgCancelBidIntent returns a new MsgCancelBidIntent.
+func NewMsgCancelBidIntent(auctionID uint64, bidder string) MsgCancelBidIntent {
+	return MsgCancelBidIntent{
+		AuctionId: auctionID,
+		Bidder:    bidder,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCancelBidIntent) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCancelBidIntent) Type() string { return "cancel_bid_intent" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgCancelBidIntent) ValidateBasic() error {
+	if msg.AuctionId == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty or invalid")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelBidIntent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelBidIntent) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{bidder}
+}
@@ -49,3 +49,56 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgPlaceBidIntent_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        MsgPlaceBidIntent
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgPlaceBidIntent(1, testAccAddress1, c("token", 10), c("lot", 5)),
+			true,
+		},
+		{
+			"zero min lot",
+			NewMsgPlaceBidIntent(1, testAccAddress1, c("token", 10), c("lot", 0)),
+			true,
+		},
+		{
+			"zero id",
+			NewMsgPlaceBidIntent(0, testAccAddress1, c("token", 10), c("lot", 5)),
+			false,
+		},
+		{
+			"empty address",
+			NewMsgPlaceBidIntent(1, "", c("token", 10), c("lot", 5)),
+			false,
+		},
+		{
+			"zero max bid",
+			NewMsgPlaceBidIntent(1, testAccAddress1, c("token", 0), c("lot", 5)),
+			false,
+		},
+		{
+			"negative min lot",
+			NewMsgPlaceBidIntent(1, testAccAddress1, c("token", 10), sdk.Coin{Denom: "lot", Amount: sdk.NewInt(-5)}),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestMsgCancelBidIntent_ValidateBasic(t *testing.T) {
+	require.NoError(t, NewMsgCancelBidIntent(1, testAccAddress1).ValidateBasic())
+	require.Error(t, NewMsgCancelBidIntent(0, testAccAddress1).ValidateBasic())
+	require.Error(t, NewMsgCancelBidIntent(1, "").ValidateBasic())
+}
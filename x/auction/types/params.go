@@ -19,18 +19,30 @@ const (
 	DefaultForwardBidDuration time.Duration = 24 * time.Hour
 	// DefaultReverseBidDuration how long an auction gets extended when someone bids for a reverse auction
 	DefaultReverseBidDuration time.Duration = 1 * time.Hour
+	// DefaultDutchPriceDecayDuration how long it takes the price of a dutch auction to decay from its start to its end price
+	DefaultDutchPriceDecayDuration time.Duration = 6 * time.Hour
+	// DefaultSettledAuctionRetention how long records of closed auctions are kept
+	DefaultSettledAuctionRetention time.Duration = 30 * 24 * time.Hour
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
+	// DefaultDutchStartPriceMultiplier is the multiple of the reference price a dutch auction starts at
+	DefaultDutchStartPriceMultiplier sdk.Dec = sdk.MustNewDecFromStr("1.2")
+	// DefaultDutchEndPriceMultiplier is the multiple of the reference price a dutch auction decays to
+	DefaultDutchEndPriceMultiplier sdk.Dec = sdk.MustNewDecFromStr("0.8")
 	// ParamStoreKeyParams Param store key for auction params
-	KeyForwardBidDuration  = []byte("ForwardBidDuration")
-	KeyReverseBidDuration  = []byte("ReverseBidDuration")
-	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
-	KeyIncrementSurplus    = []byte("IncrementSurplus")
-	KeyIncrementDebt       = []byte("IncrementDebt")
-	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyForwardBidDuration        = []byte("ForwardBidDuration")
+	KeyReverseBidDuration        = []byte("ReverseBidDuration")
+	KeyMaxAuctionDuration        = []byte("MaxAuctionDuration")
+	KeyIncrementSurplus          = []byte("IncrementSurplus")
+	KeyIncrementDebt             = []byte("IncrementDebt")
+	KeyIncrementCollateral       = []byte("IncrementCollateral")
+	KeyDutchPriceDecayDuration   = []byte("DutchPriceDecayDuration")
+	KeyDutchStartPriceMultiplier = []byte("DutchStartPriceMultiplier")
+	KeyDutchEndPriceMultiplier   = []byte("DutchEndPriceMultiplier")
+	KeySettledAuctionRetention   = []byte("SettledAuctionRetention")
 )
 
 // NewParams returns a new Params object.
@@ -39,14 +51,22 @@ func NewParams(
 	incrementSurplus,
 	incrementDebt,
 	incrementCollateral sdk.Dec,
+	dutchPriceDecayDuration time.Duration,
+	dutchStartPriceMultiplier,
+	dutchEndPriceMultiplier sdk.Dec,
+	settledAuctionRetention time.Duration,
 ) Params {
 	return Params{
-		MaxAuctionDuration:  maxAuctionDuration,
-		ForwardBidDuration:  forwardBidDuration,
-		ReverseBidDuration:  reverseBidDuration,
-		IncrementSurplus:    incrementSurplus,
-		IncrementDebt:       incrementDebt,
-		IncrementCollateral: incrementCollateral,
+		MaxAuctionDuration:        maxAuctionDuration,
+		ForwardBidDuration:        forwardBidDuration,
+		ReverseBidDuration:        reverseBidDuration,
+		IncrementSurplus:          incrementSurplus,
+		IncrementDebt:             incrementDebt,
+		IncrementCollateral:       incrementCollateral,
+		DutchPriceDecayDuration:   dutchPriceDecayDuration,
+		DutchStartPriceMultiplier: dutchStartPriceMultiplier,
+		DutchEndPriceMultiplier:   dutchEndPriceMultiplier,
+		SettledAuctionRetention:   settledAuctionRetention,
 	}
 }
 
@@ -59,6 +79,10 @@ func DefaultParams() Params {
 		DefaultIncrement,
 		DefaultIncrement,
 		DefaultIncrement,
+		DefaultDutchPriceDecayDuration,
+		DefaultDutchStartPriceMultiplier,
+		DefaultDutchEndPriceMultiplier,
+		DefaultSettledAuctionRetention,
 	)
 }
 
@@ -76,6 +100,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
 		paramtypes.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
 		paramtypes.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		paramtypes.NewParamSetPair(KeyDutchPriceDecayDuration, &p.DutchPriceDecayDuration, validateDutchPriceDecayDurationParam),
+		paramtypes.NewParamSetPair(KeyDutchStartPriceMultiplier, &p.DutchStartPriceMultiplier, validateDutchPriceMultiplierParam),
+		paramtypes.NewParamSetPair(KeyDutchEndPriceMultiplier, &p.DutchEndPriceMultiplier, validateDutchPriceMultiplierParam),
+		paramtypes.NewParamSetPair(KeySettledAuctionRetention, &p.SettledAuctionRetention, validateSettledAuctionRetentionParam),
 	}
 }
 
@@ -109,7 +137,35 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
+		return err
+	}
+
+	if err := validateDutchPriceDecayDurationParam(p.DutchPriceDecayDuration); err != nil {
+		return err
+	}
+
+	if err := validateDutchPriceMultiplierParam(p.DutchStartPriceMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateDutchPriceMultiplierParam(p.DutchEndPriceMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateSettledAuctionRetentionParam(p.SettledAuctionRetention); err != nil {
+		return err
+	}
+
+	if p.DutchPriceDecayDuration > p.MaxAuctionDuration {
+		return errors.New("dutch price decay duration param cannot be larger than max auction duration")
+	}
+
+	if p.DutchEndPriceMultiplier.GT(p.DutchStartPriceMultiplier) {
+		return errors.New("dutch end price multiplier cannot be larger than dutch start price multiplier")
+	}
+
+	return nil
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -188,3 +244,46 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateDutchPriceDecayDurationParam(i interface{}) error {
+	decayDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if decayDuration <= 0 {
+		return fmt.Errorf("dutch price decay duration must be positive %d", decayDuration)
+	}
+
+	return nil
+}
+
+func validateDutchPriceMultiplierParam(i interface{}) error {
+	multiplier, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if multiplier == emptyDec || multiplier.IsNil() {
+		return errors.New("dutch price multiplier cannot be nil or empty")
+	}
+
+	if !multiplier.IsPositive() {
+		return fmt.Errorf("dutch price multiplier must be positive %s", multiplier)
+	}
+
+	return nil
+}
+
+func validateSettledAuctionRetentionParam(i interface{}) error {
+	retention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if retention < 0 {
+		return fmt.Errorf("settled auction retention cannot be negative %d", retention)
+	}
+
+	return nil
+}
@@ -114,6 +114,67 @@ func TestParams_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"zero dutch price decay duration",
+			Params{
+				MaxAuctionDuration:        24 * time.Hour,
+				ForwardBidDuration:        1 * time.Hour,
+				ReverseBidDuration:        1 * time.Hour,
+				IncrementSurplus:          d("0.05"),
+				IncrementDebt:             d("0.05"),
+				IncrementCollateral:       d("0.05"),
+				DutchPriceDecayDuration:   0,
+				DutchStartPriceMultiplier: d("1.2"),
+				DutchEndPriceMultiplier:   d("0.8"),
+			},
+			true,
+		},
+		{
+			"zero dutch end price multiplier",
+			Params{
+				MaxAuctionDuration:        24 * time.Hour,
+				ForwardBidDuration:        1 * time.Hour,
+				ReverseBidDuration:        1 * time.Hour,
+				IncrementSurplus:          d("0.05"),
+				IncrementDebt:             d("0.05"),
+				IncrementCollateral:       d("0.05"),
+				DutchPriceDecayDuration:   6 * time.Hour,
+				DutchStartPriceMultiplier: d("1.2"),
+				DutchEndPriceMultiplier:   d("0"),
+			},
+			true,
+		},
+		{
+			"dutch end price multiplier > start price multiplier",
+			Params{
+				MaxAuctionDuration:        24 * time.Hour,
+				ForwardBidDuration:        1 * time.Hour,
+				ReverseBidDuration:        1 * time.Hour,
+				IncrementSurplus:          d("0.05"),
+				IncrementDebt:             d("0.05"),
+				IncrementCollateral:       d("0.05"),
+				DutchPriceDecayDuration:   6 * time.Hour,
+				DutchStartPriceMultiplier: d("0.8"),
+				DutchEndPriceMultiplier:   d("1.2"),
+			},
+			true,
+		},
+		{
+			"negative settled auction retention",
+			Params{
+				MaxAuctionDuration:        24 * time.Hour,
+				ForwardBidDuration:        1 * time.Hour,
+				ReverseBidDuration:        1 * time.Hour,
+				IncrementSurplus:          d("0.05"),
+				IncrementDebt:             d("0.05"),
+				IncrementCollateral:       d("0.05"),
+				DutchPriceDecayDuration:   6 * time.Hour,
+				DutchStartPriceMultiplier: d("1.2"),
+				DutchEndPriceMultiplier:   d("0.8"),
+				SettledAuctionRetention:   -1 * time.Hour,
+			},
+			true,
+		},
 		{
 			"zero value",
 			Params{},
@@ -375,6 +375,268 @@ func (m *QueryNextAuctionIDResponse) GetId() uint64 {
 	return 0
 }
 
+// QueryBidIntentsRequest is the request type for the Query/BidIntents RPC method.
+type QueryBidIntentsRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *QueryBidIntentsRequest) Reset()         { *m = QueryBidIntentsRequest{} }
+func (m *QueryBidIntentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidIntentsRequest) ProtoMessage()    {}
+func (*QueryBidIntentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{8}
+}
+func (m *QueryBidIntentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidIntentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidIntentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidIntentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidIntentsRequest.Merge(m, src)
+}
+func (m *QueryBidIntentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidIntentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidIntentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidIntentsRequest proto.InternalMessageInfo
+
+// QueryBidIntentsResponse is the response type for the Query/BidIntents RPC method.
+type QueryBidIntentsResponse struct {
+	BidIntents []BidIntent `protobuf:"bytes,1,rep,name=bid_intents,json=bidIntents,proto3" json:"bid_intents"`
+}
+
+func (m *QueryBidIntentsResponse) Reset()         { *m = QueryBidIntentsResponse{} }
+func (m *QueryBidIntentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidIntentsResponse) ProtoMessage()    {}
+func (*QueryBidIntentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{9}
+}
+func (m *QueryBidIntentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidIntentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidIntentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidIntentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidIntentsResponse.Merge(m, src)
+}
+func (m *QueryBidIntentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidIntentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidIntentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidIntentsResponse proto.InternalMessageInfo
+
+func (m *QueryBidIntentsResponse) GetBidIntents() []BidIntent {
+	if m != nil {
+		return m.BidIntents
+	}
+	return nil
+}
+
+// QuerySettledAuctionRequest is the request type for the Query/SettledAuction RPC method.
+type QuerySettledAuctionRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *QuerySettledAuctionRequest) Reset()         { *m = QuerySettledAuctionRequest{} }
+func (m *QuerySettledAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettledAuctionRequest) ProtoMessage()    {}
+func (*QuerySettledAuctionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{10}
+}
+func (m *QuerySettledAuctionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettledAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettledAuctionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettledAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettledAuctionRequest.Merge(m, src)
+}
+func (m *QuerySettledAuctionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettledAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettledAuctionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettledAuctionRequest proto.InternalMessageInfo
+
+// QuerySettledAuctionResponse is the response type for the Query/SettledAuction RPC method.
+type QuerySettledAuctionResponse struct {
+	SettledAuction SettledAuction `protobuf:"bytes,1,opt,name=settled_auction,json=settledAuction,proto3" json:"settled_auction"`
+}
+
+func (m *QuerySettledAuctionResponse) Reset()         { *m = QuerySettledAuctionResponse{} }
+func (m *QuerySettledAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettledAuctionResponse) ProtoMessage()    {}
+func (*QuerySettledAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{11}
+}
+func (m *QuerySettledAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettledAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettledAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettledAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettledAuctionResponse.Merge(m, src)
+}
+func (m *QuerySettledAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettledAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettledAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettledAuctionResponse proto.InternalMessageInfo
+
+func (m *QuerySettledAuctionResponse) GetSettledAuction() SettledAuction {
+	if m != nil {
+		return m.SettledAuction
+	}
+	return SettledAuction{}
+}
+
+// QuerySettledAuctionsRequest is the request type for the Query/SettledAuctions RPC method.
+type QuerySettledAuctionsRequest struct {
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySettledAuctionsRequest) Reset()         { *m = QuerySettledAuctionsRequest{} }
+func (m *QuerySettledAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettledAuctionsRequest) ProtoMessage()    {}
+func (*QuerySettledAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{12}
+}
+func (m *QuerySettledAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettledAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettledAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettledAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettledAuctionsRequest.Merge(m, src)
+}
+func (m *QuerySettledAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettledAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettledAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettledAuctionsRequest proto.InternalMessageInfo
+
+// QuerySettledAuctionsResponse is the response type for the Query/SettledAuctions RPC method.
+type QuerySettledAuctionsResponse struct {
+	SettledAuctions []SettledAuction `protobuf:"bytes,1,rep,name=settled_auctions,json=settledAuctions,proto3" json:"settled_auctions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySettledAuctionsResponse) Reset()         { *m = QuerySettledAuctionsResponse{} }
+func (m *QuerySettledAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettledAuctionsResponse) ProtoMessage()    {}
+func (*QuerySettledAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{13}
+}
+func (m *QuerySettledAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettledAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettledAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettledAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettledAuctionsResponse.Merge(m, src)
+}
+func (m *QuerySettledAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettledAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettledAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettledAuctionsResponse proto.InternalMessageInfo
+
+func (m *QuerySettledAuctionsResponse) GetSettledAuctions() []SettledAuction {
+	if m != nil {
+		return m.SettledAuctions
+	}
+	return nil
+}
+
+func (m *QuerySettledAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.auction.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.auction.v1beta1.QueryParamsResponse")
@@ -384,52 +646,72 @@ func init() {
 	proto.RegisterType((*QueryAuctionsResponse)(nil), "kava.auction.v1beta1.QueryAuctionsResponse")
 	proto.RegisterType((*QueryNextAuctionIDRequest)(nil), "kava.auction.v1beta1.QueryNextAuctionIDRequest")
 	proto.RegisterType((*QueryNextAuctionIDResponse)(nil), "kava.auction.v1beta1.QueryNextAuctionIDResponse")
+	proto.RegisterType((*QueryBidIntentsRequest)(nil), "kava.auction.v1beta1.QueryBidIntentsRequest")
+	proto.RegisterType((*QueryBidIntentsResponse)(nil), "kava.auction.v1beta1.QueryBidIntentsResponse")
+	proto.RegisterType((*QuerySettledAuctionRequest)(nil), "kava.auction.v1beta1.QuerySettledAuctionRequest")
+	proto.RegisterType((*QuerySettledAuctionResponse)(nil), "kava.auction.v1beta1.QuerySettledAuctionResponse")
+	proto.RegisterType((*QuerySettledAuctionsRequest)(nil), "kava.auction.v1beta1.QuerySettledAuctionsRequest")
+	proto.RegisterType((*QuerySettledAuctionsResponse)(nil), "kava.auction.v1beta1.QuerySettledAuctionsResponse")
 }
 
 func init() { proto.RegisterFile("kava/auction/v1beta1/query.proto", fileDescriptor_0afd5f8bae92c6bb) }
 
 var fileDescriptor_0afd5f8bae92c6bb = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0xed, 0x34, 0x4d, 0xd3, 0xfb, 0xe9, 0xc7, 0x70, 0x18, 0x29, 0x35, 0xc1, 0x89, 0x2c,
-	0xe8, 0x5f, 0x72, 0xee, 0x9f, 0xad, 0x03, 0x52, 0x0b, 0x2a, 0xea, 0x82, 0xa8, 0x47, 0x16, 0x74,
-	0x4e, 0x0e, 0xd7, 0xa2, 0xf1, 0xb9, 0x39, 0xa7, 0x24, 0x42, 0x2c, 0xb0, 0x20, 0xb1, 0x20, 0x10,
-	0x7b, 0x79, 0x23, 0xcc, 0x1d, 0x2b, 0x31, 0xc0, 0x84, 0x50, 0xc2, 0xc0, 0xcb, 0x40, 0xbe, 0x7b,
-	0x9c, 0xc4, 0x60, 0x42, 0x36, 0xdf, 0x73, 0xdf, 0xe7, 0xb9, 0xcf, 0x3d, 0xcf, 0xf7, 0x8c, 0xea,
-	0x4f, 0xe9, 0x19, 0x75, 0x68, 0xb7, 0x19, 0x07, 0x3c, 0x74, 0xce, 0xb6, 0x3c, 0x16, 0xd3, 0x2d,
-	0xe7, 0xb4, 0xcb, 0x3a, 0x7d, 0x12, 0x75, 0x78, 0xcc, 0xb1, 0x91, 0x28, 0x08, 0x28, 0x08, 0x28,
-	0xcc, 0xf5, 0x26, 0x17, 0x6d, 0x2e, 0x1c, 0x8f, 0x0a, 0xa6, 0xe4, 0xa3, 0xe4, 0x88, 0xfa, 0x41,
-	0x48, 0xa5, 0x5a, 0x56, 0x30, 0x0d, 0x9f, 0xfb, 0x5c, 0x7e, 0x3a, 0xc9, 0x17, 0x44, 0xab, 0x3e,
-	0xe7, 0xfe, 0x09, 0x73, 0x68, 0x14, 0x38, 0x34, 0x0c, 0x79, 0x2c, 0x53, 0x04, 0xec, 0x2e, 0xc1,
-	0xae, 0x5c, 0x79, 0xdd, 0x27, 0x0e, 0x0d, 0x01, 0xc8, 0xb4, 0x73, 0x91, 0x7d, 0x16, 0x32, 0x11,
-	0x40, 0xba, 0x6d, 0x20, 0x7c, 0x94, 0x40, 0x3d, 0xa4, 0x1d, 0xda, 0x16, 0x2e, 0x3b, 0xed, 0x32,
-	0x11, 0xdb, 0x47, 0xe8, 0x6a, 0x26, 0x2a, 0x22, 0x1e, 0x0a, 0x86, 0x77, 0x51, 0x29, 0x92, 0x91,
-	0x8a, 0x5e, 0xd7, 0x57, 0xff, 0xdb, 0xae, 0x92, 0xbc, 0x2b, 0x13, 0x95, 0xb5, 0x5f, 0xbc, 0xf8,
-	0x56, 0xd3, 0x5c, 0xc8, 0xb0, 0xef, 0x40, 0xc9, 0x3d, 0x25, 0x86, 0x93, 0xf0, 0x0d, 0x84, 0x20,
-	0xfd, 0x71, 0xd0, 0x92, 0x65, 0x8b, 0xee, 0x22, 0x44, 0x0e, 0x5b, 0xbb, 0xe5, 0xd7, 0xe7, 0x35,
-	0xed, 0xe7, 0x79, 0x4d, 0xb3, 0x0f, 0x90, 0x91, 0xcd, 0x07, 0x26, 0x82, 0x16, 0x40, 0x0e, 0x50,
-	0x06, 0x51, 0x1d, 0x21, 0x69, 0x47, 0xc8, 0x5e, 0xd8, 0x77, 0x53, 0x91, 0xfd, 0x49, 0xcf, 0x16,
-	0x4a, 0xef, 0x8c, 0x31, 0x2a, 0xc6, 0xfd, 0x88, 0xc9, 0x2a, 0x8b, 0xae, 0xfc, 0xc6, 0x06, 0x9a,
-	0xe7, 0xcf, 0x42, 0xd6, 0xa9, 0x14, 0x64, 0x50, 0x2d, 0x92, 0x68, 0x8b, 0x85, 0xbc, 0x5d, 0x99,
-	0x53, 0x51, 0xb9, 0x48, 0xa2, 0xd1, 0x31, 0x15, 0xac, 0x52, 0x54, 0x51, 0xb9, 0xc0, 0x07, 0x08,
-	0x8d, 0xc7, 0x5c, 0x99, 0x97, 0x84, 0xcb, 0x44, 0x79, 0x82, 0x24, 0x9e, 0x20, 0xca, 0x42, 0xe3,
-	0xde, 0xf9, 0x0c, 0x88, 0xdc, 0x89, 0xcc, 0x89, 0x46, 0xbc, 0xd3, 0xd1, 0xb5, 0xdf, 0x2e, 0x00,
-	0xad, 0xd8, 0x44, 0x65, 0xb8, 0x65, 0x32, 0xa0, 0xb9, 0xbf, 0xf6, 0x62, 0xa4, 0xc2, 0xf7, 0x33,
-	0x74, 0x05, 0x49, 0xb7, 0xf2, 0x4f, 0x3a, 0x75, 0xdc, 0x24, 0x9e, 0x7d, 0x1d, 0x2d, 0x49, 0xa6,
-	0x07, 0xac, 0x17, 0x03, 0xd7, 0xe1, 0xbd, 0xd4, 0x4d, 0xb7, 0x91, 0x99, 0xb7, 0x09, 0xd4, 0x57,
-	0x50, 0x61, 0x34, 0xf9, 0x42, 0xd0, 0xda, 0xfe, 0x52, 0x44, 0xf3, 0x52, 0x8e, 0x5f, 0xe9, 0xa8,
-	0xa4, 0xbc, 0x84, 0x57, 0xf3, 0x9d, 0xf6, 0xa7, 0x75, 0xcd, 0xb5, 0x19, 0x94, 0xea, 0x64, 0xfb,
-	0xe6, 0xcb, 0xcf, 0x3f, 0xde, 0x17, 0x2c, 0x5c, 0x75, 0x72, 0x1f, 0x8a, 0x32, 0x2e, 0xfe, 0xa0,
-	0xa3, 0x05, 0xa0, 0xc6, 0xd3, 0x8a, 0x67, 0x8d, 0x6d, 0xae, 0xcf, 0x22, 0x05, 0x90, 0x1d, 0x09,
-	0xd2, 0xc0, 0x1b, 0xf9, 0x20, 0xe9, 0xb8, 0x9c, 0xe7, 0xe3, 0xa7, 0xf2, 0x02, 0xbf, 0xd1, 0x51,
-	0x39, 0xb5, 0x00, 0x9e, 0xe1, 0xb4, 0x51, 0x87, 0x36, 0x66, 0xd2, 0x02, 0xda, 0xb2, 0x44, 0xab,
-	0x63, 0x6b, 0x3a, 0x1a, 0xfe, 0xa8, 0xa3, 0xff, 0x33, 0xf3, 0xc5, 0xce, 0x94, 0x63, 0xf2, 0x6c,
-	0x62, 0x6e, 0xce, 0x9e, 0x00, 0x70, 0x0d, 0x09, 0xb7, 0x82, 0x6f, 0xe5, 0xc3, 0x85, 0xac, 0x17,
-	0x37, 0x20, 0xd8, 0x08, 0x5a, 0xfb, 0x77, 0x2f, 0x06, 0x96, 0x7e, 0x39, 0xb0, 0xf4, 0xef, 0x03,
-	0x4b, 0x7f, 0x3b, 0xb4, 0xb4, 0xcb, 0xa1, 0xa5, 0x7d, 0x1d, 0x5a, 0xda, 0xa3, 0x35, 0x3f, 0x88,
-	0x8f, 0xbb, 0x1e, 0x69, 0xf2, 0xb6, 0x2c, 0xd5, 0x38, 0xa1, 0x9e, 0x50, 0x45, 0x7b, 0xa3, 0xb2,
-	0xc9, 0x1f, 0x41, 0x78, 0x25, 0xf9, 0x94, 0x76, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x51, 0x53,
-	0x3d, 0x4a, 0x10, 0x06, 0x00, 0x00,
+	// 852 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4f, 0xdc, 0x46,
+	0x14, 0xc7, 0x77, 0x96, 0x05, 0x96, 0x87, 0x0a, 0xd5, 0x74, 0xdb, 0x2e, 0x66, 0xeb, 0x45, 0x16,
+	0xe5, 0xb7, 0x6d, 0x7e, 0xa8, 0xaa, 0x4a, 0xa5, 0x56, 0xd0, 0x96, 0x8a, 0x4b, 0x55, 0x16, 0xf5,
+	0xd2, 0x0b, 0xb2, 0xf1, 0xd4, 0x58, 0x65, 0xed, 0x65, 0xc7, 0x4b, 0x41, 0x55, 0x2f, 0xed, 0xa5,
+	0x52, 0x2e, 0x51, 0xa2, 0xdc, 0xc9, 0x2d, 0xca, 0x25, 0x52, 0xfe, 0x00, 0xce, 0x1c, 0x91, 0x72,
+	0xc9, 0x29, 0x8a, 0x80, 0x43, 0xfe, 0x8c, 0xc8, 0x33, 0x6f, 0xbd, 0x18, 0x9c, 0x8d, 0x37, 0xe2,
+	0xe6, 0x79, 0xf3, 0x7e, 0x7c, 0xe6, 0x3b, 0x6f, 0xde, 0x2e, 0x4c, 0xfc, 0x69, 0x1d, 0x5a, 0xa6,
+	0xd5, 0xda, 0x0d, 0xbd, 0xc0, 0x37, 0x0f, 0x97, 0x6c, 0x16, 0x5a, 0x4b, 0xe6, 0x41, 0x8b, 0x35,
+	0x8f, 0x8d, 0x46, 0x33, 0x08, 0x03, 0x5a, 0x8a, 0x3c, 0x0c, 0xf4, 0x30, 0xd0, 0x43, 0x99, 0xdb,
+	0x0d, 0x78, 0x3d, 0xe0, 0xa6, 0x6d, 0x71, 0x26, 0xdd, 0xe3, 0xe0, 0x86, 0xe5, 0x7a, 0xbe, 0x25,
+	0xbc, 0x45, 0x06, 0xa5, 0xe4, 0x06, 0x6e, 0x20, 0x3e, 0xcd, 0xe8, 0x0b, 0xad, 0x15, 0x37, 0x08,
+	0xdc, 0x7d, 0x66, 0x5a, 0x0d, 0xcf, 0xb4, 0x7c, 0x3f, 0x08, 0x45, 0x08, 0xc7, 0xdd, 0x31, 0xdc,
+	0x15, 0x2b, 0xbb, 0xf5, 0x87, 0x69, 0xf9, 0x08, 0xa4, 0x68, 0xa9, 0xc8, 0x6d, 0xc0, 0x6e, 0x3e,
+	0x2e, 0xf3, 0x19, 0xf7, 0xb0, 0x84, 0x56, 0x02, 0xba, 0x15, 0x81, 0xff, 0x6a, 0x35, 0xad, 0x3a,
+	0xaf, 0xb1, 0x83, 0x16, 0xe3, 0xa1, 0xb6, 0x05, 0x9f, 0x24, 0xac, 0xbc, 0x11, 0xf8, 0x9c, 0xd1,
+	0x55, 0x18, 0x68, 0x08, 0x4b, 0x99, 0x4c, 0x90, 0x99, 0xe1, 0xe5, 0x8a, 0x91, 0x26, 0x8b, 0x21,
+	0xa3, 0xd6, 0x0b, 0x67, 0xaf, 0xaa, 0xb9, 0x1a, 0x46, 0x68, 0xdf, 0x61, 0xca, 0x35, 0xe9, 0x8c,
+	0x95, 0xe8, 0x17, 0x00, 0x18, 0xbe, 0xe3, 0x39, 0x22, 0x6d, 0xa1, 0x36, 0x84, 0x96, 0x4d, 0x67,
+	0xb5, 0xf8, 0xff, 0x49, 0x35, 0xf7, 0xe6, 0xa4, 0x9a, 0xd3, 0x36, 0xa0, 0x94, 0x8c, 0x47, 0x26,
+	0x03, 0x06, 0xd1, 0x1d, 0xa1, 0x4a, 0x86, 0x54, 0xcd, 0x68, 0xab, 0x66, 0xac, 0xf9, 0xc7, 0xb5,
+	0xb6, 0x93, 0x76, 0x4a, 0x92, 0x89, 0xda, 0x67, 0xa6, 0x14, 0x0a, 0xe1, 0x71, 0x83, 0x89, 0x2c,
+	0x43, 0x35, 0xf1, 0x4d, 0x4b, 0xd0, 0x1f, 0xfc, 0xe5, 0xb3, 0x66, 0x39, 0x2f, 0x8c, 0x72, 0x11,
+	0x59, 0x1d, 0xe6, 0x07, 0xf5, 0x72, 0x9f, 0xb4, 0x8a, 0x45, 0x64, 0x6d, 0xec, 0x59, 0x9c, 0x95,
+	0x0b, 0xd2, 0x2a, 0x16, 0x74, 0x03, 0xa0, 0xd3, 0x0a, 0xe5, 0x7e, 0x41, 0x38, 0x65, 0xc8, 0xbe,
+	0x31, 0xa2, 0xbe, 0x31, 0x64, 0x9b, 0x75, 0xb4, 0x73, 0x19, 0x12, 0xd5, 0xae, 0x45, 0x5e, 0x13,
+	0xe2, 0x01, 0x81, 0x4f, 0x6f, 0x1c, 0x00, 0xa5, 0x58, 0x84, 0x22, 0x9e, 0x32, 0xba, 0xa0, 0xbe,
+	0x77, 0x6a, 0x11, 0x7b, 0xd1, 0x9f, 0x13, 0x74, 0x79, 0x41, 0x37, 0xfd, 0x5e, 0x3a, 0x59, 0xee,
+	0x3a, 0x9e, 0x36, 0x0e, 0x63, 0x82, 0xe9, 0x17, 0x76, 0x14, 0x22, 0xd7, 0xe6, 0x8f, 0xed, 0x6e,
+	0x5a, 0x00, 0x25, 0x6d, 0x13, 0xa9, 0x47, 0x20, 0x1f, 0xdf, 0x7c, 0xde, 0x73, 0xb4, 0x35, 0xf8,
+	0x4c, 0x78, 0xaf, 0x7b, 0xce, 0xa6, 0x1f, 0x32, 0x3f, 0xe4, 0x3d, 0xf7, 0x8a, 0x05, 0x9f, 0xdf,
+	0x4a, 0x81, 0xd5, 0x36, 0x60, 0xd8, 0xf6, 0x9c, 0x1d, 0x4f, 0x9a, 0x51, 0xa6, 0x6a, 0x7a, 0x1f,
+	0xc7, 0xe1, 0xd8, 0xca, 0x60, 0xc7, 0xf9, 0xb4, 0x9f, 0xf0, 0x4c, 0xdb, 0x2c, 0x0c, 0xf7, 0x99,
+	0xf3, 0xa1, 0x5d, 0xdd, 0x84, 0xf1, 0xd4, 0x34, 0x48, 0xbb, 0x0d, 0xa3, 0x5c, 0xee, 0xec, 0x24,
+	0x9b, 0x7c, 0x32, 0x9d, 0x38, 0x99, 0x06, 0xb1, 0x47, 0x78, 0xc2, 0xaa, 0x3d, 0x23, 0xa9, 0x45,
+	0xef, 0xec, 0x21, 0x24, 0x5b, 0xbe, 0x70, 0x07, 0x2d, 0x7f, 0x4a, 0xa0, 0x92, 0x4e, 0x8c, 0x3a,
+	0xfd, 0x06, 0x1f, 0xdf, 0xd0, 0xa9, 0x7d, 0xb5, 0xbd, 0x08, 0x35, 0x9a, 0x14, 0xea, 0xee, 0x9e,
+	0xc7, 0xf2, 0x55, 0x11, 0xfa, 0xc5, 0x01, 0xe8, 0x7f, 0x04, 0x06, 0xe4, 0x7c, 0xa4, 0x33, 0xe9,
+	0x68, 0xb7, 0xc7, 0xb1, 0x32, 0x9b, 0xc1, 0x53, 0x56, 0xd5, 0x26, 0xff, 0x7d, 0x71, 0xf5, 0x30,
+	0xaf, 0xd2, 0x8a, 0x99, 0x3a, 0xfc, 0xe5, 0x30, 0xa6, 0x8f, 0x08, 0x0c, 0xe2, 0x29, 0x69, 0xb7,
+	0xe4, 0xc9, 0xb6, 0x56, 0xe6, 0xb2, 0xb8, 0x22, 0xc8, 0x8a, 0x00, 0xd1, 0xe9, 0xbc, 0xd9, 0xed,
+	0x97, 0x8a, 0x9b, 0x7f, 0x77, 0x1e, 0xca, 0x3f, 0xf4, 0x1e, 0x81, 0x62, 0xac, 0x7e, 0x86, 0x6a,
+	0xb1, 0x42, 0xf3, 0x99, 0x7c, 0x11, 0x6d, 0x4a, 0xa0, 0x4d, 0x50, 0xb5, 0x3b, 0x1a, 0x7d, 0x4c,
+	0xe0, 0xa3, 0xc4, 0xcc, 0xa2, 0x66, 0x97, 0x32, 0x69, 0xa3, 0x4f, 0x59, 0xcc, 0x1e, 0x80, 0x70,
+	0xba, 0x80, 0x9b, 0xa6, 0x5f, 0xa6, 0xc3, 0xf9, 0xec, 0x28, 0xd4, 0xd1, 0xa8, 0x7b, 0x0e, 0x7d,
+	0x4a, 0x00, 0x3a, 0x63, 0x8e, 0x2e, 0x74, 0xa9, 0x77, 0x6b, 0xa0, 0x2a, 0x7a, 0x46, 0x6f, 0x44,
+	0xfb, 0x5e, 0xa0, 0x7d, 0x43, 0xbf, 0xee, 0xe1, 0x4a, 0x4d, 0xdb, 0x73, 0x74, 0x9c, 0xb6, 0xf4,
+	0x39, 0x81, 0x91, 0xe4, 0xcb, 0xa3, 0xdd, 0x04, 0x4a, 0x9d, 0xad, 0xca, 0x52, 0x0f, 0x11, 0x08,
+	0xfe, 0xad, 0x00, 0xff, 0x8a, 0xae, 0xa4, 0x83, 0xe3, 0xb3, 0xd7, 0xd3, 0x7b, 0xf2, 0x09, 0x81,
+	0xd1, 0x1b, 0x73, 0x87, 0x66, 0x67, 0x88, 0xb5, 0x5e, 0xee, 0x25, 0x04, 0xb9, 0x0d, 0xc1, 0x3d,
+	0x43, 0xa7, 0xb2, 0x71, 0xaf, 0xff, 0x70, 0x76, 0xa1, 0x92, 0xf3, 0x0b, 0x95, 0xbc, 0xbe, 0x50,
+	0xc9, 0xfd, 0x4b, 0x35, 0x77, 0x7e, 0xa9, 0xe6, 0x5e, 0x5e, 0xaa, 0xb9, 0xdf, 0x67, 0x5d, 0x2f,
+	0xdc, 0x6b, 0xd9, 0xc6, 0x6e, 0x50, 0x17, 0xb9, 0xf4, 0x7d, 0xcb, 0xe6, 0x32, 0xeb, 0x51, 0x9c,
+	0x37, 0x9a, 0xf4, 0xdc, 0x1e, 0x10, 0xff, 0x15, 0x56, 0xde, 0x0e, 0x00, 0x5b, 0x26, 0xd1, 0x44,
+	0x15, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -452,6 +734,13 @@ type QueryClient interface {
 	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
 	// NextAuctionID queries the next auction ID
 	NextAuctionID(ctx context.Context, in *QueryNextAuctionIDRequest, opts ...grpc.CallOption) (*QueryNextAuctionIDResponse, error)
+	// BidIntents queries the bid intents on an auction
+	BidIntents(ctx context.Context, in *QueryBidIntentsRequest, opts ...grpc.CallOption) (*QueryBidIntentsResponse, error)
+	// SettledAuction queries the settlement record of a closed auction by auction ID
+	SettledAuction(ctx context.Context, in *QuerySettledAuctionRequest, opts ...grpc.CallOption) (*QuerySettledAuctionResponse, error)
+	// SettledAuctions queries settlement records of closed auctions filtered by asset denom, owner address, and auction
+	// type
+	SettledAuctions(ctx context.Context, in *QuerySettledAuctionsRequest, opts ...grpc.CallOption) (*QuerySettledAuctionsResponse, error)
 }
 
 type queryClient struct {
@@ -498,6 +787,33 @@ func (c *queryClient) NextAuctionID(ctx context.Context, in *QueryNextAuctionIDR
 	return out, nil
 }
 
+func (c *queryClient) BidIntents(ctx context.Context, in *QueryBidIntentsRequest, opts ...grpc.CallOption) (*QueryBidIntentsResponse, error) {
+	out := new(QueryBidIntentsResponse)
+	err := c.cc.Invoke(ctx, "/kava.auction.v1beta1.Query/BidIntents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettledAuction(ctx context.Context, in *QuerySettledAuctionRequest, opts ...grpc.CallOption) (*QuerySettledAuctionResponse, error) {
+	out := new(QuerySettledAuctionResponse)
+	err := c.cc.Invoke(ctx, "/kava.auction.v1beta1.Query/SettledAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettledAuctions(ctx context.Context, in *QuerySettledAuctionsRequest, opts ...grpc.CallOption) (*QuerySettledAuctionsResponse, error) {
+	out := new(QuerySettledAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/kava.auction.v1beta1.Query/SettledAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the auction module.
@@ -508,6 +824,13 @@ type QueryServer interface {
 	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
 	// NextAuctionID queries the next auction ID
 	NextAuctionID(context.Context, *QueryNextAuctionIDRequest) (*QueryNextAuctionIDResponse, error)
+	// BidIntents queries the bid intents on an auction
+	BidIntents(context.Context, *QueryBidIntentsRequest) (*QueryBidIntentsResponse, error)
+	// SettledAuction queries the settlement record of a closed auction by auction ID
+	SettledAuction(context.Context, *QuerySettledAuctionRequest) (*QuerySettledAuctionResponse, error)
+	// SettledAuctions queries settlement records of closed auctions filtered by asset denom, owner address, and auction
+	// type
+	SettledAuctions(context.Context, *QuerySettledAuctionsRequest) (*QuerySettledAuctionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -526,6 +849,15 @@ func (*UnimplementedQueryServer) Auctions(ctx context.Context, req *QueryAuction
 func (*UnimplementedQueryServer) NextAuctionID(ctx context.Context, req *QueryNextAuctionIDRequest) (*QueryNextAuctionIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextAuctionID not implemented")
 }
+func (*UnimplementedQueryServer) BidIntents(ctx context.Context, req *QueryBidIntentsRequest) (*QueryBidIntentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidIntents not implemented")
+}
+func (*UnimplementedQueryServer) SettledAuction(ctx context.Context, req *QuerySettledAuctionRequest) (*QuerySettledAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettledAuction not implemented")
+}
+func (*UnimplementedQueryServer) SettledAuctions(ctx context.Context, req *QuerySettledAuctionsRequest) (*QuerySettledAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettledAuctions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -603,6 +935,60 @@ func _Query_NextAuctionID_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BidIntents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidIntentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidIntents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.auction.v1beta1.Query/BidIntents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidIntents(ctx, req.(*QueryBidIntentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettledAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettledAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettledAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.auction.v1beta1.Query/SettledAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettledAuction(ctx, req.(*QuerySettledAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettledAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettledAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettledAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.auction.v1beta1.Query/SettledAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettledAuctions(ctx, req.(*QuerySettledAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.auction.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -623,6 +1009,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextAuctionID",
 			Handler:    _Query_NextAuctionID_Handler,
 		},
+		{
+			MethodName: "BidIntents",
+			Handler:    _Query_BidIntents_Handler,
+		},
+		{
+			MethodName: "SettledAuction",
+			Handler:    _Query_SettledAuction_Handler,
+		},
+		{
+			MethodName: "SettledAuctions",
+			Handler:    _Query_SettledAuctions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/auction/v1beta1/query.proto",
@@ -910,37 +1308,268 @@ func (m *QueryNextAuctionIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBidIntentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBidIntentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBidIntentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidIntentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidIntentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidIntentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BidIntents) > 0 {
+		for iNdEx := len(m.BidIntents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidIntents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettledAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettledAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SettledAuction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettledAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettledAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SettledAuctions) > 0 {
+		for iNdEx := len(m.SettledAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryAuctionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1007,41 +1636,876 @@ func (m *QueryAuctionsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryNextAuctionIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextAuctionIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextAuctionIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryBidIntentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryBidIntentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BidIntents) > 0 {
+		for _, e := range m.BidIntents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySettledAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QuerySettledAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SettledAuction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySettledAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettledAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SettledAuctions) > 0 {
+		for _, e := range m.SettledAuctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &types.Any{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextAuctionIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextAuctionIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextAuctionIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextAuctionIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextAuctionIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextAuctionIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryNextAuctionIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidIntentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1064,12 +2528,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidIntentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidIntentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1091,7 +2574,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidIntentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1114,15 +2597,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidIntentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidIntentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BidIntents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1149,7 +2632,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BidIntents = append(m.BidIntents, BidIntent{})
+			if err := m.BidIntents[len(m.BidIntents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1174,7 +2658,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySettledAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1197,10 +2681,10 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySettledAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySettledAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1243,7 +2727,7 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySettledAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1266,15 +2750,15 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySettledAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySettledAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledAuction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1301,10 +2785,7 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auction == nil {
-				m.Auction = &types.Any{}
-			}
-			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SettledAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1329,7 +2810,7 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySettledAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1352,10 +2833,10 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySettledAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySettledAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1455,38 +2936,6 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1543,7 +2992,7 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySettledAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1566,15 +3015,15 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySettledAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySettledAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledAuctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1601,8 +3050,8 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctions = append(m.Auctions, &types.Any{})
-			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SettledAuctions = append(m.SettledAuctions, SettledAuction{})
+			if err := m.SettledAuctions[len(m.SettledAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1663,125 +3112,6 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextAuctionIDRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextAuctionIDRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextAuctionIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNextAuctionIDResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextAuctionIDResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextAuctionIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSettledAuction returns a record of the outcome of an auction closed at closeTime. The lot paid out to bidders and
+// the lot returned are passed in as they depend on how the auction type pays out.
+func NewSettledAuction(auction Auction, lot, lotReturned sdk.Coin, closeTime time.Time) SettledAuction {
+	var lotReturns WeightedAddresses
+	if lra, ok := auction.(LotReturnsAuction); ok {
+		lotReturns = lra.GetLotReturns()
+	}
+
+	return SettledAuction{
+		AuctionID:   auction.GetID(),
+		AuctionType: auction.GetType(),
+		Initiator:   auction.GetInitiator(),
+		Winner:      auction.GetBidder(),
+		Bid:         auction.GetBid(),
+		Lot:         lot,
+		LotReturns:  lotReturns,
+		LotReturned: lotReturned,
+		CloseTime:   closeTime,
+	}
+}
+
+// Validate performs basic validation of a settled auction record.
+func (sa SettledAuction) Validate() error {
+	if sa.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	switch sa.AuctionType {
+	case SurplusAuctionType, DebtAuctionType, CollateralAuctionType, DutchAuctionType:
+	default:
+		return fmt.Errorf("invalid auction type: %s", sa.AuctionType)
+	}
+	if !sa.Bid.IsValid() {
+		return fmt.Errorf("invalid bid: %s", sa.Bid)
+	}
+	if !sa.Lot.IsValid() {
+		return fmt.Errorf("invalid lot: %s", sa.Lot)
+	}
+	if !sa.LotReturned.IsValid() {
+		return fmt.Errorf("invalid lot returned: %s", sa.LotReturned)
+	}
+	if len(sa.LotReturns.Addresses) > 0 {
+		if err := sa.LotReturns.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate performs basic validation of an auction starting lot.
+func (sl StartingLot) Validate() error {
+	if sl.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if !sl.Lot.IsValid() {
+		return fmt.Errorf("invalid lot: %s", sl.Lot)
+	}
+	return nil
+}
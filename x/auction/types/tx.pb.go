@@ -106,35 +106,202 @@ func (m *MsgPlaceBidResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPlaceBidResponse proto.InternalMessageInfo
 
+// MsgPlaceBidIntent represents a message used by bidders to escrow funds for proxy bids on an auction
+type MsgPlaceBidIntent struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// max_bid is escrowed and is the largest bid that will be placed for the bidder
+	MaxBid types.Coin `protobuf:"bytes,3,opt,name=max_bid,json=maxBid,proto3" json:"max_bid"`
+	// min_lot is the smallest lot that will be bid for in the reverse phase
+	MinLot types.Coin `protobuf:"bytes,4,opt,name=min_lot,json=minLot,proto3" json:"min_lot"`
+}
+
+func (m *MsgPlaceBidIntent) Reset()         { *m = MsgPlaceBidIntent{} }
+func (m *MsgPlaceBidIntent) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBidIntent) ProtoMessage()    {}
+func (*MsgPlaceBidIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_226282be4da73be5, []int{2}
+}
+func (m *MsgPlaceBidIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBidIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBidIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceBidIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBidIntent.Merge(m, src)
+}
+func (m *MsgPlaceBidIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBidIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBidIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceBidIntent proto.InternalMessageInfo
+
+// MsgPlaceBidIntentResponse defines the Msg/PlaceBidIntent response type.
+type MsgPlaceBidIntentResponse struct {
+}
+
+func (m *MsgPlaceBidIntentResponse) Reset()         { *m = MsgPlaceBidIntentResponse{} }
+func (m *MsgPlaceBidIntentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBidIntentResponse) ProtoMessage()    {}
+func (*MsgPlaceBidIntentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_226282be4da73be5, []int{3}
+}
+func (m *MsgPlaceBidIntentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBidIntentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBidIntentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceBidIntentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBidIntentResponse.Merge(m, src)
+}
+func (m *MsgPlaceBidIntentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBidIntentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBidIntentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceBidIntentResponse proto.InternalMessageInfo
+
+// MsgCancelBidIntent represents a message used by bidders to cancel a bid intent and reclaim its unused escrow
+type MsgCancelBidIntent struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+}
+
+func (m *MsgCancelBidIntent) Reset()         { *m = MsgCancelBidIntent{} }
+func (m *MsgCancelBidIntent) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBidIntent) ProtoMessage()    {}
+func (*MsgCancelBidIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_226282be4da73be5, []int{4}
+}
+func (m *MsgCancelBidIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBidIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBidIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelBidIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBidIntent.Merge(m, src)
+}
+func (m *MsgCancelBidIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBidIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBidIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelBidIntent proto.InternalMessageInfo
+
+// MsgCancelBidIntentResponse defines the Msg/CancelBidIntent response type.
+type MsgCancelBidIntentResponse struct {
+}
+
+func (m *MsgCancelBidIntentResponse) Reset()         { *m = MsgCancelBidIntentResponse{} }
+func (m *MsgCancelBidIntentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBidIntentResponse) ProtoMessage()    {}
+func (*MsgCancelBidIntentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_226282be4da73be5, []int{5}
+}
+func (m *MsgCancelBidIntentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBidIntentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBidIntentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelBidIntentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBidIntentResponse.Merge(m, src)
+}
+func (m *MsgCancelBidIntentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBidIntentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBidIntentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelBidIntentResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPlaceBid)(nil), "kava.auction.v1beta1.MsgPlaceBid")
 	proto.RegisterType((*MsgPlaceBidResponse)(nil), "kava.auction.v1beta1.MsgPlaceBidResponse")
+	proto.RegisterType((*MsgPlaceBidIntent)(nil), "kava.auction.v1beta1.MsgPlaceBidIntent")
+	proto.RegisterType((*MsgPlaceBidIntentResponse)(nil), "kava.auction.v1beta1.MsgPlaceBidIntentResponse")
+	proto.RegisterType((*MsgCancelBidIntent)(nil), "kava.auction.v1beta1.MsgCancelBidIntent")
+	proto.RegisterType((*MsgCancelBidIntentResponse)(nil), "kava.auction.v1beta1.MsgCancelBidIntentResponse")
 }
 
 func init() { proto.RegisterFile("kava/auction/v1beta1/tx.proto", fileDescriptor_226282be4da73be5) }
 
 var fileDescriptor_226282be4da73be5 = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x4f, 0x2c, 0x4d, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x01, 0x49, 0xeb, 0x41, 0xa5,
-	0xf5, 0xa0, 0xd2, 0x52, 0x72, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x49, 0x89, 0xc5, 0xa9,
-	0x70, 0x3d, 0xc9, 0xf9, 0x99, 0x79, 0x10, 0x5d, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6,
-	0x3e, 0x88, 0x05, 0x11, 0x55, 0x6a, 0x67, 0xe4, 0xe2, 0xf6, 0x2d, 0x4e, 0x0f, 0xc8, 0x49, 0x4c,
-	0x4e, 0x75, 0xca, 0x4c, 0x11, 0x92, 0xe5, 0xe2, 0x82, 0x1a, 0x1c, 0x9f, 0x99, 0x22, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x12, 0xc4, 0x09, 0x15, 0xf1, 0x4c, 0x11, 0x12, 0xe3, 0x62, 0x4b, 0xca, 0x4c,
-	0x49, 0x49, 0x2d, 0x92, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0xf2, 0x84, 0xcc, 0xb9, 0xd8,
-	0x12, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0x24, 0x98, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x24, 0xf5, 0x20,
-	0xae, 0xd1, 0x03, 0xb9, 0x06, 0xe6, 0x44, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27, 0x96, 0x13, 0xf7,
-	0xe4, 0x19, 0x82, 0xa0, 0xca, 0xad, 0x38, 0x3a, 0x16, 0xc8, 0x33, 0xbc, 0x58, 0x20, 0xcf, 0xa0,
-	0x24, 0xca, 0x25, 0x8c, 0xe4, 0x90, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa3, 0x78,
-	0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x08, 0x2e, 0x0e, 0xb8, 0x1b, 0x15, 0xf5, 0xb0, 0x05, 0x80,
-	0x1e, 0x92, 0x6e, 0x29, 0x4d, 0x82, 0x4a, 0x60, 0x16, 0x38, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x3e, 0xc8, 0x38, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x30, 0x4b, 0xbf, 0x02, 0x1e, 0x3b, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xd0, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xbd,
-	0x2b, 0xa7, 0xac, 0xba, 0x01, 0x00, 0x00,
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xf5, 0xb4, 0x51, 0x68, 0x6f, 0x25, 0x10, 0xa6, 0x20, 0xd7, 0x50, 0x27, 0x64, 0x83, 0xbb,
+	0x60, 0x86, 0x96, 0x05, 0x88, 0x65, 0xb2, 0xaa, 0x44, 0x24, 0x64, 0x09, 0x09, 0xb1, 0x89, 0xc6,
+	0xf6, 0xc8, 0x0c, 0xd8, 0x33, 0x51, 0x66, 0x12, 0x99, 0x2f, 0x80, 0x25, 0x9f, 0x90, 0xef, 0xe0,
+	0x0b, 0xb2, 0xcc, 0x92, 0x15, 0x42, 0xc9, 0x86, 0xcf, 0x40, 0x8e, 0x1f, 0xb2, 0x12, 0x50, 0x2c,
+	0xba, 0xbb, 0xbe, 0xf7, 0x9c, 0x7b, 0xce, 0x19, 0xcf, 0xc0, 0xf9, 0x27, 0x3a, 0xa3, 0x84, 0x4e,
+	0x03, 0xcd, 0xa5, 0x20, 0xb3, 0x4b, 0x9f, 0x69, 0x7a, 0x49, 0x74, 0x8a, 0xc7, 0x13, 0xa9, 0xa5,
+	0x79, 0x9a, 0x8d, 0x71, 0x31, 0xc6, 0xc5, 0xd8, 0x76, 0x02, 0xa9, 0x12, 0xa9, 0x88, 0x4f, 0x15,
+	0xab, 0x38, 0x81, 0xe4, 0x22, 0x67, 0xd9, 0xa7, 0x91, 0x8c, 0xe4, 0xa6, 0x24, 0x59, 0x95, 0x77,
+	0x7b, 0x5f, 0x10, 0x9c, 0x0c, 0x55, 0xf4, 0x26, 0xa6, 0x01, 0xeb, 0xf3, 0xd0, 0x3c, 0x07, 0x28,
+	0x16, 0x8f, 0x78, 0x68, 0xa1, 0x2e, 0x72, 0x5b, 0xde, 0x71, 0xd1, 0xb9, 0x0e, 0xcd, 0x07, 0xd0,
+	0xf6, 0x79, 0x18, 0xb2, 0x89, 0x75, 0xd0, 0x45, 0xee, 0xb1, 0x57, 0x7c, 0x99, 0x2f, 0xa0, 0x4d,
+	0x13, 0x39, 0x15, 0xda, 0x3a, 0xec, 0x22, 0xf7, 0xe4, 0xea, 0x0c, 0xe7, 0x6e, 0x70, 0xe6, 0xa6,
+	0xb4, 0x88, 0x07, 0x92, 0x8b, 0x7e, 0x6b, 0xf1, 0xb3, 0x63, 0x78, 0x05, 0xfc, 0xd5, 0xd1, 0xd7,
+	0x79, 0xc7, 0xf8, 0x3d, 0xef, 0x18, 0xbd, 0xfb, 0x70, 0xaf, 0x66, 0xc4, 0x63, 0x6a, 0x2c, 0x85,
+	0x62, 0xbd, 0x05, 0x82, 0xbb, 0xb5, 0xfe, 0xb5, 0xd0, 0x4c, 0xe8, 0xff, 0xb5, 0xf9, 0x12, 0x6e,
+	0x25, 0x34, 0x1d, 0xf9, 0x3c, 0x6c, 0xec, 0x33, 0xa1, 0x69, 0x76, 0x2e, 0x19, 0x93, 0x8b, 0x51,
+	0x2c, 0xb5, 0xd5, 0x6a, 0xca, 0xe4, 0xe2, 0xb5, 0xac, 0x27, 0x7c, 0x08, 0x67, 0x3b, 0x49, 0xaa,
+	0x9c, 0x6f, 0xc1, 0x1c, 0xaa, 0x68, 0x40, 0x45, 0xc0, 0xe2, 0x9b, 0xe6, 0xac, 0x69, 0x3e, 0x02,
+	0x7b, 0x77, 0x6d, 0x29, 0x7a, 0xf5, 0xfd, 0x00, 0x0e, 0x87, 0x2a, 0x32, 0xdf, 0xc1, 0x51, 0x75,
+	0x03, 0x1e, 0xe3, 0xbf, 0x5d, 0x2f, 0x5c, 0x73, 0x6e, 0x5f, 0xec, 0x85, 0x94, 0x0a, 0xe6, 0x47,
+	0xb8, 0xbd, 0xf5, 0xeb, 0x9e, 0xec, 0x25, 0xe7, 0x40, 0x9b, 0x34, 0x04, 0x56, 0x5a, 0x09, 0xdc,
+	0xd9, 0x3e, 0x3f, 0xf7, 0x9f, 0x3b, 0xb6, 0x90, 0xf6, 0xb3, 0xa6, 0xc8, 0x52, 0xae, 0x3f, 0x58,
+	0xac, 0x1c, 0xb4, 0x5c, 0x39, 0xe8, 0xd7, 0xca, 0x41, 0xdf, 0xd6, 0x8e, 0xb1, 0x5c, 0x3b, 0xc6,
+	0x8f, 0xb5, 0x63, 0xbc, 0xbf, 0x88, 0xb8, 0xfe, 0x30, 0xf5, 0x71, 0x20, 0x13, 0x92, 0x6d, 0x7d,
+	0x1a, 0x53, 0x5f, 0x6d, 0x2a, 0x92, 0x56, 0xcf, 0x5a, 0x7f, 0x1e, 0x33, 0xe5, 0xb7, 0x37, 0xcf,
+	0xf0, 0xf9, 0x9f, 0x01, 0x00, 0xe5, 0x34, 0x08, 0x7d, 0xf3, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -151,6 +318,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// PlaceBid message type used by bidders to place bids on auctions
 	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	// PlaceBidIntent message type used by bidders to escrow funds for the module to bid with on their behalf
+	PlaceBidIntent(ctx context.Context, in *MsgPlaceBidIntent, opts ...grpc.CallOption) (*MsgPlaceBidIntentResponse, error)
+	// CancelBidIntent message type used by bidders to cancel a bid intent and reclaim its unused escrow
+	CancelBidIntent(ctx context.Context, in *MsgCancelBidIntent, opts ...grpc.CallOption) (*MsgCancelBidIntentResponse, error)
 }
 
 type msgClient struct {
@@ -170,10 +341,32 @@ func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) PlaceBidIntent(ctx context.Context, in *MsgPlaceBidIntent, opts ...grpc.CallOption) (*MsgPlaceBidIntentResponse, error) {
+	out := new(MsgPlaceBidIntentResponse)
+	err := c.cc.Invoke(ctx, "/kava.auction.v1beta1.Msg/PlaceBidIntent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBidIntent(ctx context.Context, in *MsgCancelBidIntent, opts ...grpc.CallOption) (*MsgCancelBidIntentResponse, error) {
+	out := new(MsgCancelBidIntentResponse)
+	err := c.cc.Invoke(ctx, "/kava.auction.v1beta1.Msg/CancelBidIntent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PlaceBid message type used by bidders to place bids on auctions
 	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	// PlaceBidIntent message type used by bidders to escrow funds for the module to bid with on their behalf
+	PlaceBidIntent(context.Context, *MsgPlaceBidIntent) (*MsgPlaceBidIntentResponse, error)
+	// CancelBidIntent message type used by bidders to cancel a bid intent and reclaim its unused escrow
+	CancelBidIntent(context.Context, *MsgCancelBidIntent) (*MsgCancelBidIntentResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -183,6 +376,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
 }
+func (*UnimplementedMsgServer) PlaceBidIntent(ctx context.Context, req *MsgPlaceBidIntent) (*MsgPlaceBidIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBidIntent not implemented")
+}
+func (*UnimplementedMsgServer) CancelBidIntent(ctx context.Context, req *MsgCancelBidIntent) (*MsgCancelBidIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBidIntent not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -206,6 +405,42 @@ func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PlaceBidIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBidIntent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBidIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.auction.v1beta1.Msg/PlaceBidIntent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBidIntent(ctx, req.(*MsgPlaceBidIntent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBidIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBidIntent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBidIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.auction.v1beta1.Msg/CancelBidIntent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBidIntent(ctx, req.(*MsgCancelBidIntent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.auction.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -214,6 +449,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PlaceBid",
 			Handler:    _Msg_PlaceBid_Handler,
 		},
+		{
+			MethodName: "PlaceBidIntent",
+			Handler:    _Msg_PlaceBidIntent_Handler,
+		},
+		{
+			MethodName: "CancelBidIntent",
+			Handler:    _Msg_CancelBidIntent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/auction/v1beta1/tx.proto",
@@ -287,6 +530,142 @@ func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPlaceBidIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBidIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBidIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinLot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MaxBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBidIntentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBidIntentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBidIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBidIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBidIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBidIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBidIntentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBidIntentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBidIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -325,13 +704,67 @@ func (m *MsgPlaceBidResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceBidIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxBid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinLot.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceBidIntentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelBidIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelBidIntentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -515,6 +948,374 @@ func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPlaceBidIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBidIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBidIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBidIntentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBidIntentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBidIntentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBidIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBidIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBidIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBidIntentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBidIntentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBidIntentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,7 +37,7 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		}
 
 		err = k.LiquidateCdps(ctx, cp.LiquidationMarketID, cp.Type, cp.LiquidationRatio, cp.CheckCollateralizationIndexCount)
-		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
+		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) && !errors.Is(err, pricefeedtypes.ErrMarketSuspended) {
 			panic(err)
 		}
 	}
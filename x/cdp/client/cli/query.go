@@ -22,6 +22,7 @@ const (
 	flagOwner          = "owner"
 	flagID             = "id"
 	flagRatio          = "ratio" // returns CDPs under the given collateralization ratio threshold
+	flagMaxRatio       = "max-ratio"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -38,6 +39,9 @@ func GetQueryCmd() *cobra.Command {
 		QueryCdpDepositsCmd(),
 		QueryParamsCmd(),
 		QueryGetAccounts(),
+		QueryPegStabilityReservesCmd(),
+		QuerySystemHealthCmd(),
+		QueryRiskiestCdpsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -51,7 +55,7 @@ func GetQueryCmd() *cobra.Command {
 
 // QueryCdpCmd returns the command handler for querying a particular cdp
 func QueryCdpCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "cdp [owner-addr] [collateral-type]",
 		Short: "get info about a cdp",
 		Long: strings.TrimSpace(
@@ -74,9 +78,15 @@ $ %s query %s cdp kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 				return err
 			}
 
+			cdpID, err := cmd.Flags().GetUint64(flagID)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Cdp(context.Background(), &types.QueryCdpRequest{
 				Owner:          args[0],
 				CollateralType: args[1],
+				CdpID:          cdpID,
 			})
 			if err != nil {
 				return err
@@ -85,6 +95,10 @@ $ %s query %s cdp kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 			return clientCtx.PrintProto(res)
 		},
 	}
+
+	cmd.Flags().Uint64(flagID, 0, "(optional) id of the cdp, required if the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // QueryGetCdpsCmd queries the cdps in the store
@@ -185,7 +199,7 @@ $ kvcli q cdp cdps --page=2 --limit=100
 
 // QueryCdpDepositsCmd returns the command handler for querying the deposits of a particular cdp
 func QueryCdpDepositsCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposits [owner-addr] [collateral-type]",
 		Short: "get deposits for a cdp",
 		Long: strings.TrimSpace(
@@ -208,9 +222,15 @@ $ %s query %s deposits kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 				return err
 			}
 
+			cdpID, err := cmd.Flags().GetUint64(flagID)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Deposits(context.Background(), &types.QueryDepositsRequest{
 				Owner:          args[0],
 				CollateralType: args[1],
+				CdpID:          cdpID,
 			})
 			if err != nil {
 				return err
@@ -219,6 +239,10 @@ $ %s query %s deposits kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 			return clientCtx.PrintProto(res)
 		},
 	}
+
+	cmd.Flags().Uint64(flagID, 0, "(optional) id of the cdp, required if the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // QueryParamsCmd returns the command handler for cdp parameter querying
@@ -270,3 +294,118 @@ func QueryGetAccounts() *cobra.Command {
 		},
 	}
 }
+
+// QueryPegStabilityReservesCmd queries the peg stability module reserves
+func QueryPegStabilityReservesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "psm-reserves [denom]",
+		Short: "get the peg stability module reserves",
+		Long:  "get the stablecoin reserves of the peg stability module and the usdx minted against them, optionally for a single stablecoin.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryPegStabilityReservesRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.PegStabilityReserves(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QuerySystemHealthCmd returns the command handler for querying the debt and surplus accounting of the cdp system
+func QuerySystemHealthCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "system-health",
+		Short: "get the debt and surplus accounting of the cdp system",
+		Long:  "get the principal and fees of each collateral type, the liquidator debt and surplus, active liquidation auctions and the global collateralization ratio.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SystemHealth(context.Background(), &types.QuerySystemHealthRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryRiskiestCdpsCmd returns the command handler for querying the cdps of a collateral type closest to liquidation
+func QueryRiskiestCdpsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "riskiest-cdps [collateral-type]",
+		Short: "query the cdps of a collateral type closest to liquidation",
+		Long: strings.TrimSpace(`Query for the paginated cdps of a collateral type in order of collateralization ratio, lowest first, with their distance to liquidation:
+Example:
+$ kvcli q cdp riskiest-cdps bnb-a
+$ kvcli q cdp riskiest-cdps bnb-a --max-ratio=1.6
+$ kvcli q cdp riskiest-cdps bnb-a --page=2 --limit=100
+`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			strMaxRatio, err := cmd.Flags().GetString(flagMaxRatio)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryRiskiestCdpsRequest{
+				CollateralType: strings.ToLower(strings.TrimSpace(args[0])),
+				Pagination:     pageReq,
+			}
+
+			if len(strMaxRatio) != 0 {
+				maxRatio, err := sdk.NewDecFromStr(strMaxRatio)
+				if err != nil {
+					return fmt.Errorf("cannot parse max ratio %s", strMaxRatio)
+				}
+				req.MaxRatio = maxRatio.String()
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RiskiestCdps(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagMaxRatio, "", "(optional) only return CDPs at or below the collateralization ratio")
+
+	flags.AddPaginationFlagsToCmd(cmd, "riskiest cdps")
+
+	return cmd
+}
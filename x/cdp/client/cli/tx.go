@@ -29,6 +29,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDraw(),
 		GetCmdRepay(),
 		GetCmdLiquidate(),
+		GetCmdTransfer(),
+		GetCmdPegStabilityMint(),
+		GetCmdPegStabilityRedeem(),
 	}
 
 	for _, cmd := range cmds {
@@ -78,7 +81,7 @@ $ %s tx %s create 10000000uatom 1000usdx atom-a --from myKeyName
 
 // GetCmdDeposit cli command for depositing to a cdp.
 func GetCmdDeposit() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit [owner-addr] [collateral] [collateral-type]",
 		Short: "deposit collateral to an existing cdp",
 		Long: strings.TrimSpace(
@@ -102,7 +105,11 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom ato
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgDeposit(owner, clientCtx.GetFromAddress(), collateral, args[2])
+			cdpID, err := cmd.Flags().GetUint64(flagID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDeposit(owner, clientCtx.GetFromAddress(), collateral, args[2], cdpID)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -110,11 +117,15 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom ato
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Uint64(flagID, 0, "(optional) id of the cdp, required if the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // GetCmdWithdraw cli command for withdrawing from a cdp.
 func GetCmdWithdraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "withdraw [owner-addr] [collateral] [collateral-type]",
 		Short: "withdraw collateral from an existing cdp",
 		Long: strings.TrimSpace(
@@ -138,7 +149,11 @@ $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom at
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgWithdraw(owner, clientCtx.GetFromAddress(), collateral, args[2])
+			cdpID, err := cmd.Flags().GetUint64(flagID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdraw(owner, clientCtx.GetFromAddress(), collateral, args[2], cdpID)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -146,11 +161,15 @@ $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom at
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Uint64(flagID, 0, "(optional) id of the cdp, required if the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // GetCmdDraw cli command for depositing to a cdp.
 func GetCmdDraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "draw [collateral-type] [debt]",
 		Short: "draw debt off an existing cdp",
 		Long: strings.TrimSpace(
@@ -170,7 +189,11 @@ $ %s tx %s draw atom-a 1000usdx --from myKeyName
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgDrawDebt(clientCtx.GetFromAddress(), args[0], debt)
+			cdpID, err := cmd.Flags().GetUint64(flagID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDrawDebt(clientCtx.GetFromAddress(), args[0], debt, cdpID)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -178,11 +201,15 @@ $ %s tx %s draw atom-a 1000usdx --from myKeyName
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Uint64(flagID, 0, "(optional) id of the cdp, required if the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // GetCmdRepay cli command for depositing to a cdp.
 func GetCmdRepay() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "repay [collateral-name] [debt]",
 		Short: "repay debt to an existing cdp",
 		Long: strings.TrimSpace(
@@ -202,7 +229,11 @@ $ %s tx %s repay atom-a 1000usdx --from myKeyName
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgRepayDebt(clientCtx.GetFromAddress(), args[0], payment)
+			cdpID, err := cmd.Flags().GetUint64(flagID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRepayDebt(clientCtx.GetFromAddress(), args[0], payment, cdpID)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -210,11 +241,15 @@ $ %s tx %s repay atom-a 1000usdx --from myKeyName
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Uint64(flagID, 0, "(optional) id of the cdp, required if the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // GetCmdLiquidate cli command for liquidating a cdp.
 func GetCmdLiquidate() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "liquidate [cdp-owner-address] [collateral-type]",
 		Short: "liquidate a cdp",
 		Long: strings.TrimSpace(
@@ -234,7 +269,119 @@ $ %s tx %s liquidate kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm  btcb-a --from
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgLiquidate(clientCtx.GetFromAddress(), addr, args[1])
+			cdpID, err := cmd.Flags().GetUint64(flagID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgLiquidate(clientCtx.GetFromAddress(), addr, args[1], cdpID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagID, 0, "(optional) id of the cdp, required if the owner has several cdps of the collateral type")
+
+	return cmd
+}
+
+// GetCmdTransfer cli command for transferring a cdp to a new owner.
+func GetCmdTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [new-owner-address] [collateral-type]",
+		Short: "transfer a cdp to a new owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer a cdp and your deposit to a new owner.
+
+Example:
+$ %s tx %s transfer kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm btcb-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cdpID, err := cmd.Flags().GetUint64(flagID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferCDP(clientCtx.GetFromAddress(), newOwner, args[1], cdpID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagID, 0, "(optional) id of the cdp, required if the owner has several cdps of the collateral type")
+
+	return cmd
+}
+
+// GetCmdPegStabilityMint cli command for swapping a stablecoin for newly minted debt asset.
+func GetCmdPegStabilityMint() *cobra.Command {
+	return &cobra.Command{
+		Use:   "psm-mint [amount]",
+		Short: "swap a stablecoin for usdx through the peg stability module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap a whitelisted stablecoin for newly minted usdx, less the peg stability fee.
+
+Example:
+$ %s tx %s psm-mint 1000000000usdc --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgPegStabilityMint(clientCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdPegStabilityRedeem cli command for swapping debt asset for a stablecoin held in reserve.
+func GetCmdPegStabilityRedeem() *cobra.Command {
+	return &cobra.Command{
+		Use:   "psm-redeem [amount] [denom]",
+		Short: "swap usdx for a stablecoin through the peg stability module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap usdx, less the peg stability fee, for a whitelisted stablecoin held in reserve.
+
+Example:
+$ %s tx %s psm-redeem 1000000000usdx usdc --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgPegStabilityRedeem(clientCtx.GetFromAddress(), amount, args[1])
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
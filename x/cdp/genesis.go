@@ -24,6 +24,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 	if liqModuleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.LiquidatorMacc))
 	}
+	pegStabilityModuleAcc := ak.GetModuleAccount(ctx, types.PegStabilityMacc)
+	if pegStabilityModuleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.PegStabilityMacc))
+	}
 
 	// validate denoms - check that any collaterals in the params are in the pricefeed,
 	// pricefeed MUST call InitGenesis before cdp
@@ -63,6 +67,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 	for _, gtp := range gs.TotalPrincipals {
 		k.SetTotalPrincipal(ctx, gtp.CollateralType, types.DefaultStableDenom, gtp.TotalPrincipal)
 	}
+
+	for _, gpsm := range gs.PegStabilityMinted {
+		k.SetPegStabilityMinted(ctx, gpsm.Denom, gpsm.TotalMinted)
+	}
+
 	// add cdps
 	for _, cdp := range gs.CDPs {
 		if cdp.ID == gs.StartingCdpID {
@@ -129,5 +138,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		totalPrincipals = append(totalPrincipals, genTotalPrincipal)
 	}
 
-	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	var pegStabilityMinted types.GenesisPegStabilityMinteds
+	k.IteratePegStabilityMinted(ctx, func(denom string, total sdk.Int) bool {
+		pegStabilityMinted = append(pegStabilityMinted, types.NewGenesisPegStabilityMinted(denom, total))
+		return false
+	})
+
+	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals, pegStabilityMinted)
 }
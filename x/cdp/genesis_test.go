@@ -51,6 +51,7 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 		govDenom           string
 		genAccumTimes      types.GenesisAccumulationTimes
 		genTotalPrincipals types.GenesisTotalPrincipals
+		pegStabilityMinted types.GenesisPegStabilityMinteds
 	}
 	type errArgs struct {
 		expectPass bool
@@ -126,11 +127,28 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 				contains:   "total principal should be positive",
 			},
 		},
+		{
+			name: "negative peg stability minted",
+			args: args{
+				params:             types.DefaultParams(),
+				cdps:               types.CDPs{},
+				deposits:           types.Deposits{},
+				debtDenom:          types.DefaultDebtDenom,
+				govDenom:           types.DefaultGovDenom,
+				genAccumTimes:      types.DefaultGenesisState().PreviousAccumulationTimes,
+				genTotalPrincipals: types.DefaultGenesisState().TotalPrincipals,
+				pegStabilityMinted: types.GenesisPegStabilityMinteds{types.NewGenesisPegStabilityMinted("usdc", sdk.NewInt(-1))},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg stability total minted should not be negative",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			gs := types.NewGenesisState(tc.args.params, tc.args.cdps, tc.args.deposits, tc.args.startingID,
-				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals)
+				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals, tc.args.pegStabilityMinted)
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/cdp/types"
 )
@@ -68,10 +69,10 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 		penalty := k.ApplyLiquidationPenalty(ctx, collateralType, debtAmount)
 
-		_, err := k.auctionKeeper.StartCollateralAuction(
-			ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, auctionSize),
-			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), []sdk.AccAddress{returnAddr},
-			[]sdk.Int{auctionSize}, sdk.NewCoin(debtDenom, debtAmount),
+		err := k.startCollateralAuction(
+			ctx, collateralType, sdk.NewCoin(collateral.Denom, auctionSize),
+			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), returnAddr,
+			auctionSize, sdk.NewCoin(debtDenom, debtAmount),
 		)
 		if err != nil {
 			return err
@@ -93,12 +94,41 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 	penalty := k.ApplyLiquidationPenalty(ctx, collateralType, lastAuctionDebt)
 
-	_, err := k.auctionKeeper.StartCollateralAuction(
-		ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
-		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), []sdk.AccAddress{returnAddr},
-		[]sdk.Int{lastAuctionCollateral}, sdk.NewCoin(debtDenom, lastAuctionDebt),
+	return k.startCollateralAuction(
+		ctx, collateralType, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
+		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), returnAddr,
+		lastAuctionCollateral, sdk.NewCoin(debtDenom, lastAuctionDebt),
 	)
+}
+
+// startCollateralAuction starts an auction selling the lot using the auction type of the collateral type
+func (k Keeper) startCollateralAuction(
+	ctx sdk.Context, collateralType string, lot, maxBid sdk.Coin, returnAddr sdk.AccAddress, weight sdk.Int, debt sdk.Coin,
+) error {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return sdkerrors.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
 
+	if cp.AuctionType != types.AuctionTypeDutch {
+		_, err := k.auctionKeeper.StartCollateralAuction(
+			ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr}, []sdk.Int{weight}, debt,
+		)
+		return err
+	}
+
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil {
+		return err
+	}
+	// price of one unit of collateral in units of the principal denom
+	referencePrice := k.convertCollateralToBaseUnits(ctx, sdk.NewCoin(lot.Denom, sdk.OneInt()), collateralType).
+		Mul(price.Price).
+		Quo(k.convertDebtToBaseUnits(ctx, sdk.NewCoin(maxBid.Denom, sdk.OneInt())))
+
+	_, err = k.auctionKeeper.StartDutchAuction(
+		ctx, types.LiquidatorMacc, lot, maxBid, referencePrice, []sdk.AccAddress{returnAddr}, []sdk.Int{weight}, debt,
+	)
 	return err
 }
 
@@ -70,6 +70,33 @@ func (suite *AuctionTestSuite) TestCollateralAuction() {
 	suite.Require().NoError(err)
 }
 
+func (suite *AuctionTestSuite) TestDutchCollateralAuction() {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i := range params.CollateralParams {
+		if params.CollateralParams[i].Type == "bnb-a" {
+			params.CollateralParams[i].AuctionType = types.AuctionTypeDutch
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	bk := suite.app.GetBankKeeper()
+	err := bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 21000000000), c("bnb", 190000000000)))
+	suite.Require().NoError(err)
+	testDeposit := types.NewDeposit(1, suite.addrs[0], c("bnb", 190000000000))
+	err = suite.keeper.AuctionCollateral(suite.ctx, types.Deposits{testDeposit}, "bnb-a", i(21000000000), "usdx")
+	suite.Require().NoError(err)
+
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 4)
+	for _, auction := range auctions {
+		dutchAuction, ok := auction.(*auctiontypes.DutchAuction)
+		suite.Require().True(ok)
+		// reference price is 17.25 usd per bnb, converted from 8 to 6 decimal places
+		suite.Equal(d("0.1725").Mul(auctiontypes.DefaultDutchStartPriceMultiplier), dutchAuction.StartPrice)
+		suite.Equal(d("0.1725").Mul(auctiontypes.DefaultDutchEndPriceMultiplier), dutchAuction.EndPrice)
+	}
+}
+
 func (suite *AuctionTestSuite) TestSurplusAuction() {
 	bk := suite.app.GetBankKeeper()
 	ak := suite.app.GetAccountKeeper()
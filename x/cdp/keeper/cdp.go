@@ -11,7 +11,7 @@ import (
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
-// AddCdp adds a cdp for a specific owner and collateral type
+// AddCdp adds a cdp for a specific owner and collateral type, an owner may have several cdps of the same collateral type
 func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, principal sdk.Coin, collateralType string) error {
 	// validation
 	err := k.ValidateCollateral(ctx, collateral, collateralType)
@@ -22,10 +22,6 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	if err != nil {
 		return err
 	}
-	_, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
-	if found {
-		return sdkerrors.Wrapf(types.ErrCdpAlreadyExists, "owner %s, denom %s", owner, collateral.Denom)
-	}
 	err = k.ValidatePrincipalAdd(ctx, principal)
 	if err != nil {
 		return err
@@ -74,6 +70,9 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	// update total principal for input collateral type
 	k.IncrementTotalPrincipal(ctx, collateralType, principal)
 
+	// settle the rewards of the owner's existing cdps of the collateral type before the new cdp is added
+	k.synchronizeOwnerCdps(ctx, owner, collateralType)
+
 	// set the cdp, deposit, and indexes in the store
 	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, collateral, cdp.Type, principal)
 	err = k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
@@ -199,7 +198,8 @@ func (k Keeper) GetCdpIdsByOwner(ctx sdk.Context, owner sdk.AccAddress) ([]uint6
 	return index.CdpIDs, true
 }
 
-// GetCdpByOwnerAndCollateralType queries cdps owned by owner and returns the cdp with matching denom
+// GetCdpByOwnerAndCollateralType queries cdps owned by owner and returns the cdp with matching denom.
+// If the owner has several cdps of the collateral type, the cdp with the lowest id is returned.
 func (k Keeper) GetCdpByOwnerAndCollateralType(ctx sdk.Context, owner sdk.AccAddress, collateralType string) (types.CDP, bool) {
 	cdpIDs, found := k.GetCdpIdsByOwner(ctx, owner)
 	if !found {
@@ -214,6 +214,50 @@ func (k Keeper) GetCdpByOwnerAndCollateralType(ctx sdk.Context, owner sdk.AccAdd
 	return types.CDP{}, false
 }
 
+// GetCdpsByOwnerAndCollateralType returns all cdps owned by owner with matching collateral type, ordered by id
+func (k Keeper) GetCdpsByOwnerAndCollateralType(ctx sdk.Context, owner sdk.AccAddress, collateralType string) (cdps types.CDPs) {
+	cdpIDs, _ := k.GetCdpIdsByOwner(ctx, owner)
+	for _, id := range cdpIDs {
+		cdp, found := k.GetCDP(ctx, collateralType, id)
+		if found {
+			cdps = append(cdps, cdp)
+		}
+	}
+	return
+}
+
+// GetOwnerCdp returns the cdp with the input id owned by owner with matching collateral type.
+// An id of zero selects the owner's cdp of the collateral type, which must then be unique.
+func (k Keeper) GetOwnerCdp(ctx sdk.Context, owner sdk.AccAddress, collateralType string, cdpID uint64) (types.CDP, error) {
+	if cdpID != 0 {
+		cdp, found := k.GetCDP(ctx, collateralType, cdpID)
+		if !found || !cdp.Owner.Equals(owner) {
+			return types.CDP{}, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s, id %d", owner, collateralType, cdpID)
+		}
+		return cdp, nil
+	}
+
+	cdps := k.GetCdpsByOwnerAndCollateralType(ctx, owner, collateralType)
+	switch len(cdps) {
+	case 0:
+		return types.CDP{}, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	case 1:
+		return cdps[0], nil
+	default:
+		return types.CDP{}, sdkerrors.Wrapf(types.ErrCdpIDRequired, "owner %s has %d cdps of collateral %s", owner, len(cdps), collateralType)
+	}
+}
+
+// synchronizeOwnerCdps runs the modification hook on one of the owner's cdps of the collateral type, if any.
+// Rewards are tracked per owner and collateral type, so this settles the rewards of all those cdps before a
+// cdp is added to them.
+func (k Keeper) synchronizeOwnerCdps(ctx sdk.Context, owner sdk.AccAddress, collateralType string) {
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if found {
+		k.hooks.BeforeCDPModified(ctx, cdp)
+	}
+}
+
 // GetCDP returns the cdp associated with a particular collateral denom and id
 func (k Keeper) GetCDP(ctx sdk.Context, collateralType string, cdpID uint64) (types.CDP, bool) {
 	// get store
@@ -448,9 +492,11 @@ func (k Keeper) ValidateDebtLimit(ctx sdk.Context, collateralType string, princi
 	if totalPrincipal.GT(collateralLimit) {
 		return sdkerrors.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > collateral debt limit %s", sdk.NewCoins(sdk.NewCoin(principal.Denom, totalPrincipal)), sdk.NewCoins(sdk.NewCoin(principal.Denom, collateralLimit)))
 	}
+	// debt minted by the peg stability module counts toward the global debt limit
 	globalLimit := k.GetParams(ctx).GlobalDebtLimit.Amount
-	if totalPrincipal.GT(globalLimit) {
-		return sdkerrors.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > global debt limit  %s", sdk.NewCoin(principal.Denom, totalPrincipal), sdk.NewCoin(principal.Denom, globalLimit))
+	totalDebt := k.GetTotalIssuedDebt(ctx, principal.Denom).Add(principal.Amount)
+	if totalDebt.GT(globalLimit) {
+		return sdkerrors.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > global debt limit  %s", sdk.NewCoin(principal.Denom, totalDebt), sdk.NewCoin(principal.Denom, globalLimit))
 	}
 	return nil
 }
@@ -536,6 +582,7 @@ func (k Keeper) LoadCDPResponse(ctx sdk.Context, cdp types.CDP) types.CDPRespons
 	if found {
 		cdp.InterestFactor = globalInterestFactor
 	}
+	stabilityFee := k.GetStabilityFee(ctx, cdp.Type)
 	// calculate collateralization ratio
 	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
 	if err != nil {
@@ -548,6 +595,7 @@ func (k Keeper) LoadCDPResponse(ctx sdk.Context, cdp types.CDP) types.CDPRespons
 			AccumulatedFees: cdp.AccumulatedFees,
 			FeesUpdated:     cdp.FeesUpdated,
 			InterestFactor:  cdp.InterestFactor.String(),
+			StabilityFee:    stabilityFee.String(),
 		}
 	}
 	// convert collateral value to debt coin
@@ -555,7 +603,7 @@ func (k Keeper) LoadCDPResponse(ctx sdk.Context, cdp types.CDP) types.CDPRespons
 	collateralValueInDebtDenom := sdk.NewDecFromInt(totalDebt).Mul(collateralizationRatio)
 	collateralValueInDebt := sdk.NewCoin(cdp.Principal.Denom, collateralValueInDebtDenom.RoundInt())
 	// create new cdp response
-	return types.NewCDPResponse(cdp, collateralValueInDebt, collateralizationRatio)
+	return types.NewCDPResponse(cdp, collateralValueInDebt, collateralizationRatio, stabilityFee)
 }
 
 // CalculateCollateralizationRatio returns the collateralization ratio of the input collateral to the input debt plus fees
@@ -106,8 +106,21 @@ func (suite *CdpTestSuite) TestAddCdp() {
 
 	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("lol", 100), c("usdx", 10), "lol-a")
 	suite.Require().True(errors.Is(err, types.ErrCollateralNotSupported))
-	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 100), c("usdx", 10), "xrp-a")
-	suite.Require().True(errors.Is(err, types.ErrCdpAlreadyExists))
+
+	// an owner can open several cdps of the same collateral type
+	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.NoError(err)
+	id = suite.keeper.GetNextCdpID(suite.ctx)
+	suite.Equal(uint64(4), id)
+	tp = suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+	suite.Equal(i(20000000), tp)
+	ids, found := suite.keeper.GetCdpIdsByOwner(suite.ctx, addrs[0])
+	suite.True(found)
+	suite.Equal([]uint64{1, 2, 3}, ids)
+	cdps := suite.keeper.GetCdpsByOwnerAndCollateralType(suite.ctx, addrs[0], "xrp-a")
+	suite.Require().Len(cdps, 2)
+	suite.Equal(uint64(1), cdps[0].ID)
+	suite.Equal(uint64(3), cdps[1].ID)
 }
 
 func (suite *CdpTestSuite) TestGetCollateral() {
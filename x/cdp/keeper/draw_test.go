@@ -53,7 +53,7 @@ func (suite *DrawTestSuite) SetupTest() {
 }
 
 func (suite *DrawTestSuite) TestAddRepayPrincipal() {
-	err := suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	err := suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000), 0)
 	suite.NoError(err)
 
 	t, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
@@ -74,17 +74,17 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	acc := ak.GetModuleAccount(suite.ctx, types.ModuleName)
 	suite.Equal(cs(c("xrp", 400000000), c("debt", 20000000)), bk.GetAllBalances(suite.ctx, acc.GetAddress()))
 
-	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("susd", 10000000))
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("susd", 10000000), 0)
 	suite.Require().True(errors.Is(err, types.ErrInvalidDebtRequest))
 
-	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[1], "xrp-a", c("usdx", 10000000))
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[1], "xrp-a", c("usdx", 10000000), 0)
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
-	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("xusd", 10000000))
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("xusd", 10000000), 0)
 	suite.Require().True(errors.Is(err, types.ErrInvalidDebtRequest))
-	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 311000000))
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 311000000), 0)
 	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
 
-	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000), 0)
 	suite.NoError(err)
 
 	t, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
@@ -103,14 +103,14 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	acc = ak.GetModuleAccount(suite.ctx, types.ModuleName)
 	suite.Equal(cs(c("xrp", 400000000), c("debt", 10000000)), bk.GetAllBalances(suite.ctx, acc.GetAddress()))
 
-	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("xusd", 10000000))
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("xusd", 10000000), 0)
 	suite.Require().True(errors.Is(err, types.ErrInvalidPayment))
-	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[1], "xrp-a", c("xusd", 10000000))
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[1], "xrp-a", c("xusd", 10000000), 0)
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 
-	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 9000000))
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 9000000), 0)
 	suite.Require().True(errors.Is(err, types.ErrBelowDebtFloor))
-	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000), 0)
 	suite.NoError(err)
 
 	_, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
@@ -127,7 +127,7 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 }
 
 func (suite *DrawTestSuite) TestRepayPrincipalOverpay() {
-	err := suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 20000000))
+	err := suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 20000000), 0)
 	suite.NoError(err)
 	ak := suite.app.GetAccountKeeper()
 	bk := suite.app.GetBankKeeper()
@@ -144,9 +144,9 @@ func (suite *DrawTestSuite) TestPricefeedFailure() {
 	err := pfk.SetCurrentPrices(ctx, "xrp:usd")
 	suite.Error(err)
 
-	err = suite.keeper.AddPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	err = suite.keeper.AddPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000), 0)
 	suite.Error(err)
-	err = suite.keeper.RepayPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	err = suite.keeper.RepayPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000), 0)
 	suite.NoError(err)
 }
 
@@ -164,7 +164,7 @@ func (suite *DrawTestSuite) TestModuleAccountFailure() {
 
 	suite.Panics(func() {
 		// Error ignored here since this should panic
-		_ = suite.keeper.RepayPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+		_ = suite.keeper.RepayPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000), 0)
 	})
 }
 
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"sort"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -186,7 +188,7 @@ func (s QueryServer) Cdps(c context.Context, req *types.QueryCdpsRequest) (*type
 	}, nil
 }
 
-// Cdp queries a CDP with the input owner address and collateral type.
+// Cdp queries a CDP with the input owner address, collateral type and optional id.
 func (s QueryServer) Cdp(c context.Context, req *types.QueryCdpRequest) (*types.QueryCdpResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -200,9 +202,9 @@ func (s QueryServer) Cdp(c context.Context, req *types.QueryCdpRequest) (*types.
 		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, req.CollateralType)
 	}
 
-	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, req.CollateralType)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", req.Owner, req.CollateralType)
+	cdp, err := s.keeper.GetOwnerCdp(ctx, owner, req.CollateralType, req.CdpID)
+	if err != nil {
+		return nil, err
 	}
 
 	cdpResponse := s.keeper.LoadCDPResponse(ctx, cdp)
@@ -226,9 +228,9 @@ func (s QueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, req.CollateralType)
 	}
 
-	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, req.CollateralType)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", req.Owner, req.CollateralType)
+	cdp, err := s.keeper.GetOwnerCdp(ctx, owner, req.CollateralType, req.CdpID)
+	if err != nil {
+		return nil, err
 	}
 
 	deposits := s.keeper.GetDeposits(ctx, cdp.ID)
@@ -238,6 +240,162 @@ func (s QueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 	}, nil
 }
 
+// PegStabilityReserves queries the peg stability module reserves and the debt asset minted against them.
+func (s QueryServer) PegStabilityReserves(c context.Context, req *types.QueryPegStabilityReservesRequest) (*types.QueryPegStabilityReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var reserves []types.PegStabilityReserve
+	for _, psp := range s.keeper.GetParams(ctx).PegStabilityParams {
+		if req.Denom != "" && req.Denom != psp.Denom {
+			continue
+		}
+		reserves = append(reserves, s.keeper.GetPegStabilityReserves(ctx, psp.Denom))
+	}
+
+	if req.Denom != "" && len(reserves) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrPegStabilityAssetNotFound, req.Denom)
+	}
+
+	return &types.QueryPegStabilityReservesResponse{
+		Reserves: reserves,
+	}, nil
+}
+
+// SystemHealth queries the debt, surplus, and collateralization of the cdp system.
+func (s QueryServer) SystemHealth(c context.Context, req *types.QuerySystemHealthRequest) (*types.QuerySystemHealthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	health := s.keeper.GetSystemHealth(ctx)
+	return &health, nil
+}
+
+// RiskiestCdps queries the CDPs of a collateral type closest to liquidation, each page sorted by current collateralization ratio.
+func (s QueryServer) RiskiestCdps(c context.Context, req *types.QueryRiskiestCdpsRequest) (*types.QueryRiskiestCdpsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	collateralParam, found := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	var maxRatio sdk.Dec
+	if req.MaxRatio != "" {
+		var err error
+		maxRatio, err = sdk.NewDecFromStr(req.MaxRatio)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid max ratio")
+		}
+	}
+
+	price, err := s.keeper.pricefeedKeeper.GetCurrentPrice(ctx, collateralParam.LiquidationMarketID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPricefeedDown, collateralParam.LiquidationMarketID)
+	}
+
+	// the index is ordered by the collateral to debt ratio of each cdp when it was last modified, so the
+	// collateralization ratio is recalculated with interest synchronized up to the current block
+	indexStore := prefix.NewStore(ctx.KVStore(s.keeper.key), types.CollateralRatioIndexPrefix)
+	var store sdk.KVStore = prefix.NewStore(indexStore, types.DenomIterKey(req.CollateralType))
+	if !maxRatio.IsNil() && price.Price.IsPositive() {
+		// debt grows by at most the global interest factor since the cdp was indexed, so no cdp with an
+		// indexed ratio above maxRatio * interest factor / price can be at or below maxRatio, and the walk stops there
+		interestFactor, found := s.keeper.GetInterestFactor(ctx, req.CollateralType)
+		if !found {
+			interestFactor = sdk.OneDec()
+		}
+		bound := maxRatio.Mul(interestFactor).Quo(price.Price)
+		store = boundedStore{
+			KVStore: store,
+			end:     sdk.PrefixEndBytes(types.CollateralRatioBytes(bound)),
+		}
+	}
+
+	var augmentedCDPs types.AugmentedCDPs
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		id := types.GetCdpIDFromBytes(value)
+		cdp, found := s.keeper.GetCDP(ctx, req.CollateralType, id)
+		if !found {
+			return false, sdkerrors.Wrapf(types.ErrCdpNotFound, "%d", id)
+		}
+
+		augmentedCDP := s.keeper.LoadAugmentedCDP(ctx, cdp)
+		if !maxRatio.IsNil() && augmentedCDP.CollateralizationRatio.GT(maxRatio) {
+			return false, nil
+		}
+
+		if accumulate {
+			augmentedCDPs = append(augmentedCDPs, augmentedCDP)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// accrued interest can reorder cdps relative to the index, so the page is sorted by the current ratio
+	sort.SliceStable(augmentedCDPs, func(i, j int) bool {
+		return augmentedCDPs[i].CollateralizationRatio.LT(augmentedCDPs[j].CollateralizationRatio)
+	})
+
+	var cdps []types.RiskyCDPResponse
+	for _, augmentedCDP := range augmentedCDPs {
+		cdps = append(cdps, s.keeper.loadRiskyCDPResponse(ctx, augmentedCDP, price.Price, collateralParam.LiquidationRatio))
+	}
+
+	return &types.QueryRiskiestCdpsResponse{
+		Cdps:       cdps,
+		Pagination: pageRes,
+	}, nil
+}
+
+// boundedStore wraps a KVStore so that iteration stops before the end key, letting pagination
+// over an ordered index finish early instead of scanning the whole prefix
+type boundedStore struct {
+	sdk.KVStore
+	end []byte
+}
+
+// Iterator implements sdk.KVStore, clamping the range to the end key
+func (bs boundedStore) Iterator(start, end []byte) sdk.Iterator {
+	start, end = bs.clamp(start, end)
+	return bs.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements sdk.KVStore, clamping the range to the end key
+func (bs boundedStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	start, end = bs.clamp(start, end)
+	return bs.KVStore.ReverseIterator(start, end)
+}
+
+func (bs boundedStore) clamp(start, end []byte) ([]byte, []byte) {
+	if end == nil || bytes.Compare(end, bs.end) > 0 {
+		end = bs.end
+	}
+	if start != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return start, end
+}
+
+// loadRiskyCDPResponse creates a new RiskyCDPResponse from a cdp with synchronized interest and the liquidation market price
+func (k Keeper) loadRiskyCDPResponse(ctx sdk.Context, augmentedCDP types.AugmentedCDP, price sdk.Dec, liquidationRatio sdk.Dec) types.RiskyCDPResponse {
+	ratio := augmentedCDP.CollateralizationRatio
+	liquidationPrice := sdk.ZeroDec()
+	distance := sdk.OneDec().Neg()
+	if ratio.IsPositive() {
+		// the collateralization ratio scales with the price, the cdp can be liquidated once it falls to the liquidation ratio
+		liquidationPrice = price.Mul(liquidationRatio).Quo(ratio)
+		distance = sdk.OneDec().Sub(liquidationRatio.Quo(ratio))
+	}
+
+	return types.RiskyCDPResponse{
+		Cdp:                   types.NewCDPResponse(augmentedCDP.CDP, augmentedCDP.CollateralValue, ratio, k.GetStabilityFee(ctx, augmentedCDP.Type)),
+		LiquidationPrice:      liquidationPrice.String(),
+		DistanceToLiquidation: distance.String(),
+	}
+}
+
 // FilterCDPs queries the store for all CDPs that match query req
 func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (types.CDPResponses, error) {
 	// TODO: Ideally use query.Paginate() here over existing FilterCDPs. However
@@ -289,6 +447,7 @@ func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (type
 			InterestFactor:         cdp.InterestFactor.String(),
 			CollateralValue:        cdp.CollateralValue,
 			CollateralizationRatio: cdp.CollateralizationRatio.String(),
+			StabilityFee:           k.GetStabilityFee(ctx, cdp.Type).String(),
 		}
 		cdpResponses = append(cdpResponses, cdpResponse)
 	}
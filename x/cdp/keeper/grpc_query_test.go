@@ -9,6 +9,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 	"github.com/stretchr/testify/suite"
 	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -278,6 +279,131 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryDeposits() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQuerySystemHealth() {
+	suite.addCdp()
+
+	err := suite.tApp.GetPriceFeedKeeper().SetCurrentPrices(suite.ctx, "xrp:usd:30")
+	suite.Require().NoError(err)
+	err = suite.tApp.FundModuleAccount(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 30000000), c("debt", 10000000)))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryServer.SystemHealth(sdk.WrapSDKContext(suite.ctx), &types.QuerySystemHealthRequest{})
+	suite.Require().NoError(err)
+
+	suite.Contains(res.Collaterals, types.CollateralHealth{
+		CollateralType:  "xrp-a",
+		TotalCollateral: c("xrp", 100000000),
+		TotalPrincipal:  c("usdx", 10000000),
+		AccumulatedFees: c("usdx", 0),
+	})
+	suite.Equal(c("debt", 10000000), res.LiquidatorDebt)
+	suite.Equal(c("usdx", 20000000), res.SurplusPendingAuction)
+	suite.True(res.DebtPendingAuction.IsZero())
+	suite.Equal(uint64(0), res.ActiveCollateralAuctions)
+	suite.Equal(uint64(0), res.ActiveSurplusAuctions)
+	suite.Equal(uint64(0), res.ActiveDebtAuctions)
+	suite.Equal(d("2.5"), res.CollateralizationRatioSpot)
+	suite.Equal(d("2.5"), res.CollateralizationRatioLiquidation)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySystemHealth_PriceUnavailable() {
+	suite.addCdp()
+
+	pk := suite.tApp.GetPriceFeedKeeper()
+	err := pk.SetCurrentPrices(suite.ctx, "btc:usd")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 100000000), c("usdx", 1000000000), "btc-a")
+	suite.Require().NoError(err)
+	err = pk.SetCurrentPrices(suite.ctx, "xrp:usd:30")
+	suite.Require().NoError(err)
+	pk.SetMarketSuspension(suite.ctx, pricefeedtypes.NewMarketSuspension("btc:usd:30", d("8000"), d("4000"), suite.ctx.BlockTime()))
+
+	res, err := suite.queryServer.SystemHealth(sdk.WrapSDKContext(suite.ctx), &types.QuerySystemHealthRequest{})
+	suite.Require().NoError(err)
+
+	suite.Contains(res.Collaterals, types.CollateralHealth{
+		CollateralType:  "xrp-a",
+		TotalCollateral: c("xrp", 100000000),
+		TotalPrincipal:  c("usdx", 10000000),
+		AccumulatedFees: c("usdx", 0),
+	})
+	suite.Contains(res.Collaterals, types.CollateralHealth{
+		CollateralType:              "btc-a",
+		TotalCollateral:             c("btc", 100000000),
+		TotalPrincipal:              c("usdx", 1000000000),
+		AccumulatedFees:             c("usdx", 0),
+		LiquidationPriceUnavailable: true,
+	})
+	// the btc-a cdp is left out of both ratios
+	suite.Equal(d("2.5"), res.CollateralizationRatioSpot)
+	suite.Equal(d("2.5"), res.CollateralizationRatioLiquidation)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRiskiestCdps() {
+	suite.addCdp()
+
+	err := suite.tApp.FundAccount(suite.ctx, suite.addrs[1], cs(c("xrp", 100000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("xrp", 100000000), c("usdx", 12000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.tApp.GetPriceFeedKeeper().SetCurrentPrices(suite.ctx, "xrp:usd:30")
+	suite.Require().NoError(err)
+
+	res, err := suite.queryServer.RiskiestCdps(sdk.WrapSDKContext(suite.ctx), &types.QueryRiskiestCdpsRequest{
+		CollateralType: "xrp-a",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Cdps, 2)
+	suite.Equal(uint64(2), res.Cdps[0].Cdp.ID)
+	suite.Equal(d("0.24").String(), res.Cdps[0].LiquidationPrice)
+	suite.Equal(d("0.04").String(), res.Cdps[0].DistanceToLiquidation)
+	suite.Equal(uint64(1), res.Cdps[1].Cdp.ID)
+	suite.Equal(d("2.5").String(), res.Cdps[1].Cdp.CollateralizationRatio)
+	suite.Equal(d("0.2").String(), res.Cdps[1].LiquidationPrice)
+	suite.Equal(d("0.2").String(), res.Cdps[1].DistanceToLiquidation)
+
+	res, err = suite.queryServer.RiskiestCdps(sdk.WrapSDKContext(suite.ctx), &types.QueryRiskiestCdpsRequest{
+		CollateralType: "xrp-a",
+		MaxRatio:       "2.1",
+		Pagination:     &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Cdps, 1)
+	suite.Equal(uint64(2), res.Cdps[0].Cdp.ID)
+	suite.Equal(uint64(1), res.Pagination.Total)
+
+	// the walk stops at the max ratio bound, so cdps far above it aren't loaded
+	queryGas := func() sdk.Gas {
+		ctx := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := suite.queryServer.RiskiestCdps(sdk.WrapSDKContext(ctx), &types.QueryRiskiestCdpsRequest{
+			CollateralType: "xrp-a",
+			MaxRatio:       "2.1",
+		})
+		suite.Require().NoError(err)
+		return ctx.GasMeter().GasConsumed()
+	}
+	gasBefore := queryGas()
+	err = suite.tApp.FundAccount(suite.ctx, suite.addrs[2], cs(c("xrp", 400000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[2], c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+	suite.Equal(gasBefore, queryGas())
+
+	res, err = suite.queryServer.RiskiestCdps(sdk.WrapSDKContext(suite.ctx), &types.QueryRiskiestCdpsRequest{
+		CollateralType: "xrp-a",
+		Pagination:     &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Cdps, 1)
+	suite.Equal(uint64(2), res.Cdps[0].Cdp.ID)
+	suite.NotNil(res.Pagination.NextKey)
+
+	_, err = suite.queryServer.RiskiestCdps(sdk.WrapSDKContext(suite.ctx), &types.QueryRiskiestCdpsRequest{
+		CollateralType: "kava-a",
+	})
+	suite.ErrorIs(err, types.ErrInvalidCollateral)
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
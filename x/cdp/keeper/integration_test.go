@@ -223,6 +223,9 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 				ConversionFactor: i(6),
 				DebtFloor:        i(10000000),
 			},
+			PegStabilityParams: types.PegStabilityParams{
+				types.NewPegStabilityParam("usdc", sdk.NewInt64Coin("usdx", 100000000000), d("0.001"), i(6)),
+			},
 		},
 		StartingCdpID: types.DefaultCdpStartingID,
 		DebtDenom:     types.DefaultDebtDenom,
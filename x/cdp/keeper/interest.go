@@ -42,7 +42,7 @@ func (k Keeper) AccumulateInterest(ctx sdk.Context, ctype string) error {
 		return nil
 	}
 
-	borrowRateSpy := k.getFeeRate(ctx, ctype)
+	borrowRateSpy := k.GetStabilityFee(ctx, ctype)
 	if borrowRateSpy.Equal(sdk.OneDec()) {
 		k.SetPreviousAccrualTime(ctx, ctype, ctx.BlockTime())
 		return nil
@@ -260,6 +260,42 @@ func (suite *InterestTestSuite) TestAccumulateInterest() {
 	}
 }
 
+func (suite *InterestTestSuite) TestGetStabilityFee() {
+	suite.Require().Equal(d("1.000000001547125958"), suite.keeper.GetStabilityFee(suite.ctx, "bnb-a"))
+
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == "bnb-a" {
+			model := types.NewStabilityFeeModel(sdk.OneDec(), d("1.000000012857214317"), d("0.000000002"), "busd:usd", d("0.0000001"))
+			params.CollateralParams[i].StabilityFeeModel = &model
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// no utilization, stable asset on peg
+	suite.Require().Equal(d("1.000000001547125958"), suite.keeper.GetStabilityFee(suite.ctx, "bnb-a"))
+
+	// half of the debt limit is used
+	suite.keeper.SetTotalPrincipal(suite.ctx, "bnb-a", types.DefaultStableDenom, sdk.NewInt(250000000000))
+	suite.Require().Equal(d("1.000000002547125958"), suite.keeper.GetStabilityFee(suite.ctx, "bnb-a"))
+
+	// stable asset drops below peg
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "busd:usd", d("0.99"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "busd:usd"))
+	suite.Require().Equal(d("1.000000003547125958"), suite.keeper.GetStabilityFee(suite.ctx, "bnb-a"))
+
+	// interest accumulates at the effective rate
+	suite.keeper.SetPreviousAccrualTime(suite.ctx, "bnb-a", suite.ctx.BlockTime())
+	suite.keeper.SetInterestFactor(suite.ctx, "bnb-a", sdk.OneDec())
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(suite.keeper.AccumulateInterest(suite.ctx, "bnb-a"))
+	interestFactor, found := suite.keeper.GetInterestFactor(suite.ctx, "bnb-a")
+	suite.Require().True(found)
+	suite.Require().Equal(keeper.CalculateInterestFactor(d("1.000000003547125958"), sdk.NewInt(3600)), interestFactor)
+}
+
 // TestSynchronizeInterest tests the functionality of synchronizing the accumulated interest for CDPs
 func (suite *InterestTestSuite) TestSynchronizeInterest() {
 	type args struct {
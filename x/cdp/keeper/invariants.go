@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// RegisterInvariants registers the cdp module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "peg-stability-reserves", PegStabilityReservesInvariant(k))
+}
+
+// PegStabilityReservesInvariant checks that the peg stability module account holds enough reserves of each
+// stablecoin to back the debt asset minted against it
+func PegStabilityReservesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		dp := k.GetParams(ctx).DebtParam
+		macc := k.accountKeeper.GetModuleAddress(types.PegStabilityMacc)
+
+		broken := false
+		var brokenDenom string
+		var reserve sdk.Coin
+		var minted sdk.Int
+		k.IteratePegStabilityMinted(ctx, func(denom string, total sdk.Int) bool {
+			psp, found := k.GetPegStabilityParam(ctx, denom)
+			if !found {
+				return false
+			}
+			balance := k.bankKeeper.GetBalance(ctx, macc, denom)
+			if k.convertPegStabilityAssetToDebt(psp, dp, balance.Amount).LT(total) {
+				broken = true
+				brokenDenom, reserve, minted = denom, balance, total
+				return true
+			}
+			return false
+		})
+
+		message := sdk.FormatInvariant(
+			types.ModuleName,
+			"peg stability reserves",
+			fmt.Sprintf(
+				"\tpeg stability reserves %s do not back minted %s for %s\n",
+				reserve, minted, brokenDenom),
+		)
+		return message, broken
+	}
+}
@@ -27,6 +27,7 @@ func (k msgServer) CreateCDP(goCtx context.Context, msg *types.MsgCreateCDP) (*t
 		return nil, err
 	}
 
+	id := k.keeper.GetNextCdpID(ctx)
 	err = k.keeper.AddCdp(ctx, sender, msg.Collateral, msg.Principal, msg.CollateralType)
 	if err != nil {
 		return nil, err
@@ -40,7 +41,6 @@ func (k msgServer) CreateCDP(goCtx context.Context, msg *types.MsgCreateCDP) (*t
 		),
 	)
 
-	id, _ := k.keeper.GetCdpID(ctx, sender, msg.CollateralType)
 	return &types.MsgCreateCDPResponse{CdpID: id}, nil
 }
 
@@ -57,7 +57,7 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
-	err = k.keeper.DepositCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
+	err = k.keeper.DepositCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType, msg.CdpID)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +85,7 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		return nil, err
 	}
 
-	err = k.keeper.WithdrawCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
+	err = k.keeper.WithdrawCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType, msg.CdpID)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +108,7 @@ func (k msgServer) DrawDebt(goCtx context.Context, msg *types.MsgDrawDebt) (*typ
 		return nil, err
 	}
 
-	err = k.keeper.AddPrincipal(ctx, sender, msg.CollateralType, msg.Principal)
+	err = k.keeper.AddPrincipal(ctx, sender, msg.CollateralType, msg.Principal, msg.CdpID)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +131,7 @@ func (k msgServer) RepayDebt(goCtx context.Context, msg *types.MsgRepayDebt) (*t
 		return nil, err
 	}
 
-	err = k.keeper.RepayPrincipal(ctx, sender, msg.CollateralType, msg.Payment)
+	err = k.keeper.RepayPrincipal(ctx, sender, msg.CollateralType, msg.Payment, msg.CdpID)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +159,7 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 		return nil, err
 	}
 
-	err = k.keeper.AttemptKeeperLiquidation(ctx, keeper, borrower, msg.CollateralType)
+	err = k.keeper.AttemptKeeperLiquidation(ctx, keeper, borrower, msg.CollateralType, msg.CdpID)
 	if err != nil {
 		return nil, err
 	}
@@ -173,3 +173,77 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) TransferCDP(goCtx context.Context, msg *types.MsgTransferCDP) (*types.MsgTransferCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.TransferCdp(ctx, sender, newOwner, msg.CollateralType, msg.CdpID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgTransferCDPResponse{}, nil
+}
+
+func (k msgServer) PegStabilityMint(goCtx context.Context, msg *types.MsgPegStabilityMint) (*types.MsgPegStabilityMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.PegStabilityMint(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgPegStabilityMintResponse{}, nil
+}
+
+func (k msgServer) PegStabilityRedeem(goCtx context.Context, msg *types.MsgPegStabilityRedeem) (*types.MsgPegStabilityRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.PegStabilityRedeem(ctx, sender, msg.Amount, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgPegStabilityRedeemResponse{}, nil
+}
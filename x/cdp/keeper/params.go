@@ -50,6 +50,11 @@ func (k Keeper) GetDebtParam(ctx sdk.Context, denom string) (types.DebtParam, bo
 	return types.DebtParam{}, false
 }
 
+// GetPegStabilityParam returns the peg stability param with matching stablecoin denom
+func (k Keeper) GetPegStabilityParam(ctx sdk.Context, denom string) (types.PegStabilityParam, bool) {
+	return k.GetParams(ctx).PegStabilityParams.Get(denom)
+}
+
 func (k Keeper) getSpotMarketID(ctx sdk.Context, collateralType string) string {
 	cp, found := k.GetCollateral(ctx, collateralType)
 	if !found {
@@ -90,11 +95,33 @@ func (k Keeper) getAuctionSize(ctx sdk.Context, collateralType string) sdk.Int {
 	return cp.AuctionSize
 }
 
-// GetFeeRate returns the per second fee rate for the input denom
-func (k Keeper) getFeeRate(ctx sdk.Context, collateralType string) (fee sdk.Dec) {
+// GetStabilityFee returns the effective per second fee rate for the input collateral type.
+// If the collateral type has a stability fee model, the rate is calculated from the utilization of its
+// debt limit and the stable asset price, otherwise the fixed stability fee is returned.
+func (k Keeper) GetStabilityFee(ctx sdk.Context, collateralType string) (fee sdk.Dec) {
 	collalateralParam, found := k.GetCollateral(ctx, collateralType)
 	if !found {
 		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", collateralType))
 	}
-	return collalateralParam.StabilityFee
+	model := collalateralParam.StabilityFeeModel
+	if model == nil {
+		return collalateralParam.StabilityFee
+	}
+
+	utilization := sdk.ZeroDec()
+	if collalateralParam.DebtLimit.Amount.IsPositive() {
+		totalPrincipal := k.GetTotalPrincipal(ctx, collateralType, collalateralParam.DebtLimit.Denom)
+		utilization = sdk.NewDecFromInt(totalPrincipal).QuoInt(collalateralParam.DebtLimit.Amount)
+	}
+
+	// the peg term is skipped while the stable asset price is unavailable
+	pegDeviation := sdk.ZeroDec()
+	if model.PegMarketID != "" {
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, model.PegMarketID)
+		if err == nil {
+			pegDeviation = sdk.OneDec().Sub(price.Price)
+		}
+	}
+
+	return model.CalculateStabilityFee(collalateralParam.StabilityFee, utilization, pegDeviation)
 }
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// PegStabilityMint swaps a whitelisted stablecoin for newly minted debt asset. The stablecoin is held in the peg
+// stability module account as reserves, and the fee is paid to the liquidator module account as surplus.
+func (k Keeper) PegStabilityMint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) error {
+	psp, found := k.GetPegStabilityParam(ctx, amount.Denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrPegStabilityAssetNotFound, amount.Denom)
+	}
+	dp := k.GetParams(ctx).DebtParam
+
+	// only the part of the stablecoin amount that converts exactly to debt asset is swapped
+	minted := k.convertPegStabilityAssetToDebt(psp, dp, amount.Amount)
+	reserve := k.convertDebtToPegStabilityAsset(psp, dp, minted)
+	fee := sdk.NewDecFromInt(minted).Mul(psp.Fee).Ceil().TruncateInt()
+	if !minted.Sub(fee).IsPositive() {
+		return sdkerrors.Wrapf(types.ErrPegStabilitySwapTooSmall, "%s", amount)
+	}
+
+	if err := k.ValidatePegStabilityDebtLimit(ctx, psp, sdk.NewCoin(dp.Denom, minted)); err != nil {
+		return err
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.PegStabilityMacc, sdk.NewCoins(sdk.NewCoin(amount.Denom, reserve)))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(dp.Denom, minted)))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(sdk.NewCoin(dp.Denom, minted.Sub(fee))))
+	if err != nil {
+		return err
+	}
+	if fee.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, fee)))
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetPegStabilityMinted(ctx, psp.Denom, k.GetPegStabilityMinted(ctx, psp.Denom).Add(minted))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePegStabilityMint,
+			sdk.NewAttribute(types.AttributeKeyOwner, sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(amount.Denom, reserve).String()),
+			sdk.NewAttribute(types.AttributeKeyFee, sdk.NewCoin(dp.Denom, fee).String()),
+		),
+	)
+
+	return nil
+}
+
+// PegStabilityRedeem swaps debt asset for a whitelisted stablecoin held in the peg stability module reserves. The
+// debt asset backed by the redeemed reserves is burned, and the fee is paid to the liquidator module account as surplus.
+func (k Keeper) PegStabilityRedeem(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, denom string) error {
+	psp, found := k.GetPegStabilityParam(ctx, denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrPegStabilityAssetNotFound, denom)
+	}
+	dp := k.GetParams(ctx).DebtParam
+	if amount.Denom != dp.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidDebtRequest, "proposed %s, expected %s", amount.Denom, dp.Denom)
+	}
+
+	fee := sdk.NewDecFromInt(amount.Amount).Mul(psp.Fee).Ceil().TruncateInt()
+	if fee.GTE(amount.Amount) {
+		return sdkerrors.Wrapf(types.ErrPegStabilitySwapTooSmall, "%s", amount)
+	}
+
+	// only the part of the debt asset amount that converts exactly to the stablecoin is swapped
+	redeemed := k.convertDebtToPegStabilityAsset(psp, dp, amount.Amount.Sub(fee))
+	burned := k.convertPegStabilityAssetToDebt(psp, dp, redeemed)
+	if !redeemed.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrPegStabilitySwapTooSmall, "%s", amount)
+	}
+
+	totalMinted := k.GetPegStabilityMinted(ctx, psp.Denom)
+	if burned.GT(totalMinted) {
+		return sdkerrors.Wrapf(types.ErrInsufficientPegStabilityReserves, "%s > %s", sdk.NewCoin(dp.Denom, burned), sdk.NewCoin(dp.Denom, totalMinted))
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(sdk.NewCoin(dp.Denom, burned.Add(fee))))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(dp.Denom, burned)))
+	if err != nil {
+		return err
+	}
+	if fee.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, fee)))
+		if err != nil {
+			return err
+		}
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PegStabilityMacc, sender, sdk.NewCoins(sdk.NewCoin(psp.Denom, redeemed)))
+	if err != nil {
+		return err
+	}
+
+	k.SetPegStabilityMinted(ctx, psp.Denom, totalMinted.Sub(burned))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePegStabilityRedeem,
+			sdk.NewAttribute(types.AttributeKeyOwner, sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(psp.Denom, redeemed).String()),
+			sdk.NewAttribute(types.AttributeKeyFee, sdk.NewCoin(dp.Denom, fee).String()),
+		),
+	)
+
+	return nil
+}
+
+// ValidatePegStabilityDebtLimit validates that minting the input debt amount against a stablecoin does not exceed
+// the stablecoin's debt limit or the global debt limit
+func (k Keeper) ValidatePegStabilityDebtLimit(ctx sdk.Context, psp types.PegStabilityParam, principal sdk.Coin) error {
+	totalMinted := k.GetPegStabilityMinted(ctx, psp.Denom).Add(principal.Amount)
+	if totalMinted.GT(psp.DebtLimit.Amount) {
+		return sdkerrors.Wrapf(types.ErrExceedsPegStabilityDebtLimit, "debt increase %s > %s debt limit %s", sdk.NewCoin(principal.Denom, totalMinted), psp.Denom, psp.DebtLimit)
+	}
+	globalLimit := k.GetParams(ctx).GlobalDebtLimit.Amount
+	totalDebt := k.GetTotalIssuedDebt(ctx, principal.Denom).Add(principal.Amount)
+	if totalDebt.GT(globalLimit) {
+		return sdkerrors.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > global debt limit  %s", sdk.NewCoin(principal.Denom, totalDebt), sdk.NewCoin(principal.Denom, globalLimit))
+	}
+	return nil
+}
+
+// GetTotalIssuedDebt returns the total principal drawn across all collateral types plus the debt asset minted by
+// the peg stability module
+func (k Keeper) GetTotalIssuedDebt(ctx sdk.Context, principalDenom string) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, cp := range k.GetParams(ctx).CollateralParams {
+		total = total.Add(k.GetTotalPrincipal(ctx, cp.Type, principalDenom))
+	}
+	k.IteratePegStabilityMinted(ctx, func(_ string, minted sdk.Int) bool {
+		total = total.Add(minted)
+		return false
+	})
+	return total
+}
+
+// GetPegStabilityReserves returns the reserves held for a stablecoin and the debt asset minted against them
+func (k Keeper) GetPegStabilityReserves(ctx sdk.Context, denom string) types.PegStabilityReserve {
+	macc := k.accountKeeper.GetModuleAddress(types.PegStabilityMacc)
+	return types.PegStabilityReserve{
+		Denom:       denom,
+		Reserve:     k.bankKeeper.GetBalance(ctx, macc, denom),
+		TotalMinted: sdk.NewCoin(k.GetParams(ctx).DebtParam.Denom, k.GetPegStabilityMinted(ctx, denom)),
+	}
+}
+
+// GetPegStabilityMinted returns the debt asset minted by the peg stability module against a stablecoin
+func (k Keeper) GetPegStabilityMinted(ctx sdk.Context, denom string) (total sdk.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PegStabilityMintedPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return total
+}
+
+// SetPegStabilityMinted sets the debt asset minted by the peg stability module against a stablecoin
+func (k Keeper) SetPegStabilityMinted(ctx sdk.Context, denom string, total sdk.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PegStabilityMintedPrefix)
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// IteratePegStabilityMinted iterates over the debt asset minted against each stablecoin and performs a callback function
+func (k Keeper) IteratePegStabilityMinted(ctx sdk.Context, cb func(denom string, total sdk.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PegStabilityMintedPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var total sdk.Int
+		if err := total.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(string(iterator.Key()), total) {
+			break
+		}
+	}
+}
+
+// convertPegStabilityAssetToDebt converts a stablecoin amount to debt asset, rounding down
+func (k Keeper) convertPegStabilityAssetToDebt(psp types.PegStabilityParam, dp types.DebtParam, amount sdk.Int) sdk.Int {
+	return amount.Mul(sdkmath.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64()))).
+		Quo(sdkmath.NewIntWithDecimal(1, int(psp.ConversionFactor.Int64())))
+}
+
+// convertDebtToPegStabilityAsset converts a debt asset amount to the stablecoin, rounding down
+func (k Keeper) convertDebtToPegStabilityAsset(psp types.PegStabilityParam, dp types.DebtParam, amount sdk.Int) sdk.Int {
+	return amount.Mul(sdkmath.NewIntWithDecimal(1, int(psp.ConversionFactor.Int64()))).
+		Quo(sdkmath.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64())))
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type PegStabilityTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *PegStabilityTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	coins := []sdk.Coins{
+		cs(c("usdc", 1000000000)),
+		cs(c("xrp", 500000000)),
+	}
+
+	authGS := app.NewFundedGenStateWithCoins(cdc, coins, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func (suite *PegStabilityTestSuite) checkReservesInvariant() {
+	msg, broken := keeper.PegStabilityReservesInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken, msg)
+}
+
+func (suite *PegStabilityTestSuite) checkBalance(addr sdk.AccAddress, expected sdk.Coins) {
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(expected, bk.GetAllBalances(suite.ctx, addr))
+}
+
+func (suite *PegStabilityTestSuite) TestMintAndRedeem() {
+	ak := suite.app.GetAccountKeeper()
+	pegStabilityAddr := ak.GetModuleAddress(types.PegStabilityMacc)
+	liquidatorAddr := ak.GetModuleAddress(types.LiquidatorMacc)
+
+	// minting holds the stablecoin in reserve and pays the fee as surplus
+	err := suite.keeper.PegStabilityMint(suite.ctx, suite.addrs[0], c("usdc", 100000000))
+	suite.Require().NoError(err)
+	suite.checkBalance(suite.addrs[0], cs(c("usdc", 900000000), c("usdx", 99900000)))
+	suite.checkBalance(pegStabilityAddr, cs(c("usdc", 100000000)))
+	suite.checkBalance(liquidatorAddr, cs(c("usdx", 100000)))
+	suite.Equal(i(100000000), suite.keeper.GetPegStabilityMinted(suite.ctx, "usdc"))
+	suite.checkReservesInvariant()
+
+	// redeeming burns the debt asset backed by the released reserves
+	err = suite.keeper.PegStabilityRedeem(suite.ctx, suite.addrs[0], c("usdx", 50000000), "usdc")
+	suite.Require().NoError(err)
+	suite.checkBalance(suite.addrs[0], cs(c("usdc", 949950000), c("usdx", 49900000)))
+	suite.checkBalance(pegStabilityAddr, cs(c("usdc", 50050000)))
+	suite.checkBalance(liquidatorAddr, cs(c("usdx", 150000)))
+	suite.Equal(i(50050000), suite.keeper.GetPegStabilityMinted(suite.ctx, "usdc"))
+	suite.checkReservesInvariant()
+
+	reserves := suite.keeper.GetPegStabilityReserves(suite.ctx, "usdc")
+	suite.Equal(c("usdc", 50050000), reserves.Reserve)
+	suite.Equal(c("usdx", 50050000), reserves.TotalMinted)
+}
+
+func (suite *PegStabilityTestSuite) TestMintErrors() {
+	err := suite.keeper.PegStabilityMint(suite.ctx, suite.addrs[0], c("busd", 100000000))
+	suite.ErrorIs(err, types.ErrPegStabilityAssetNotFound)
+
+	err = suite.keeper.PegStabilityMint(suite.ctx, suite.addrs[0], c("usdc", 100000000001))
+	suite.ErrorIs(err, types.ErrExceedsPegStabilityDebtLimit)
+
+	err = suite.keeper.PegStabilityMint(suite.ctx, suite.addrs[0], c("usdc", 1))
+	suite.ErrorIs(err, types.ErrPegStabilitySwapTooSmall)
+}
+
+func (suite *PegStabilityTestSuite) TestRedeemInsufficientReserves() {
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	err = suite.keeper.PegStabilityRedeem(suite.ctx, suite.addrs[1], c("usdx", 10000000), "usdc")
+	suite.ErrorIs(err, types.ErrInsufficientPegStabilityReserves)
+}
+
+func (suite *PegStabilityTestSuite) TestGlobalDebtLimit() {
+	err := suite.keeper.PegStabilityMint(suite.ctx, suite.addrs[0], c("usdc", 100000000))
+	suite.Require().NoError(err)
+
+	// debt minted by the peg stability module counts toward the global debt limit of cdps
+	params := suite.keeper.GetParams(suite.ctx)
+	params.GlobalDebtLimit = c("usdx", 105000000)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.ErrorIs(err, types.ErrExceedsDebtLimit)
+
+	err = suite.keeper.PegStabilityMint(suite.ctx, suite.addrs[0], c("usdc", 10000000))
+	suite.ErrorIs(err, types.ErrExceedsDebtLimit)
+}
+
+func TestPegStabilityTestSuite(t *testing.T) {
+	suite.Run(t, new(PegStabilityTestSuite))
+}
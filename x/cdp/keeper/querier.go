@@ -346,10 +346,7 @@ func FilterCDPs(ctx sdk.Context, k Keeper, params types.QueryCdpsParams) (types.
 	if len(params.Owner) > 0 {
 		denoms := k.GetCollateralTypes(ctx)
 		for _, denom := range denoms {
-			cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, params.Owner, denom)
-			if found {
-				matchOwner = append(matchOwner, cdp)
-			}
+			matchOwner = append(matchOwner, k.GetCdpsByOwnerAndCollateralType(ctx, params.Owner, denom)...)
 		}
 	}
 
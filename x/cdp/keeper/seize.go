@@ -9,36 +9,59 @@ import (
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
-// AttemptKeeperLiquidation liquidates the cdp with the input collateral type and owner if it is below the required collateralization ratio
+// AttemptKeeperLiquidation liquidates the cdp with the input collateral type, owner and id if it is below the required collateralization ratio
 // if the cdp is liquidated, the keeper that sent the transaction is rewarded a percentage of the collateral according to that collateral types'
 // keeper reward percentage.
-func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccAddress, collateralType string) error {
-	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", owner, collateralType)
+func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccAddress, collateralType string, cdpID uint64) error {
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
 	}
 	k.hooks.BeforeCDPModified(ctx, cdp)
 	cdp = k.SynchronizeInterest(ctx, cdp)
 
-	err := k.ValidateLiquidation(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees)
+	err = k.ValidateLiquidation(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees)
 	if err != nil {
 		return err
 	}
-	cdp, err = k.payoutKeeperLiquidationReward(ctx, keeper, cdp)
+	collateralParam, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidCollateral, "%s", cdp.Type)
+	}
+	lot, debt, partial := k.calculatePartialLiquidation(ctx, cdp, collateralParam.KeeperRewardPercentage)
+	if partial {
+		cdp, err = k.payoutKeeperLiquidationReward(ctx, keeper, cdp, lot)
+		if err != nil {
+			return err
+		}
+		return k.seizePartialCollateral(ctx, cdp, lot, debt)
+	}
+	cdp, err = k.payoutKeeperLiquidationReward(ctx, keeper, cdp, cdp.Collateral)
 	if err != nil {
 		return err
 	}
-	return k.SeizeCollateral(ctx, cdp)
+	return k.seizeAllCollateral(ctx, cdp)
 }
 
 // SeizeCollateral liquidates the collateral in the input cdp.
+// If the collateral type has a liquidation target ratio, only enough collateral and debt to restore the cdp to the target
+// ratio is seized, otherwise the whole cdp is seized.
+func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
+	lot, debt, partial := k.calculatePartialLiquidation(ctx, cdp, sdk.ZeroDec())
+	if partial {
+		return k.seizePartialCollateral(ctx, cdp, lot, debt)
+	}
+	return k.seizeAllCollateral(ctx, cdp)
+}
+
+// seizeAllCollateral liquidates all of the collateral in the input cdp.
 // the following operations are performed:
 // 1. Collateral for all deposits is sent from the cdp module to the liquidator module account
 // 2. The liquidation penalty is applied
 // 3. Debt coins are sent from the cdp module to the liquidator module account
 // 4. The total amount of principal outstanding for that collateral type is decremented
 // (this is the equivalent of saying that fees are no longer accumulated by a cdp once it gets liquidated)
-func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
+func (k Keeper) seizeAllCollateral(ctx sdk.Context, cdp types.CDP) error {
 	// Calculate the previous collateral ratio
 	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
 
@@ -86,6 +109,141 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 	return k.DeleteCDP(ctx, cdp)
 }
 
+// seizePartialCollateral liquidates the input amounts of collateral and debt from the cdp, leaving the remainder owned by the cdp.
+// Collateral is taken from each deposit in proportion to its size, and debt is repaid from accumulated fees before principal.
+func (k Keeper) seizePartialCollateral(ctx sdk.Context, cdp types.CDP, lot sdk.Coin, debt sdk.Int) error {
+	// Move debt coins from cdp to liquidator account
+	modAccountDebt := k.getModAccountDebt(ctx, types.ModuleName)
+	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), sdk.MinInt(debt, modAccountDebt))
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
+	if err != nil {
+		return err
+	}
+
+	// liquidate a share of each deposit and send collateral from cdp to liquidator
+	deposits := k.GetDeposits(ctx, cdp.ID)
+	seized := splitLotByDeposits(deposits, lot.Amount)
+	var seizedDeposits types.Deposits
+	for i, dep := range deposits {
+		if seized[i].IsZero() {
+			continue
+		}
+		seizedDeposit := types.NewDeposit(dep.CdpID, dep.Depositor, sdk.NewCoin(dep.Amount.Denom, seized[i]))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(seizedDeposit.Amount)); err != nil {
+			return err
+		}
+
+		dep.Amount = dep.Amount.Sub(seizedDeposit.Amount)
+		if dep.Amount.IsZero() {
+			k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
+		} else {
+			k.SetDeposit(ctx, dep)
+		}
+		seizedDeposits = append(seizedDeposits, seizedDeposit)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, seizedDeposit.String()),
+			),
+		)
+	}
+
+	err = k.AuctionCollateral(ctx, seizedDeposits, cdp.Type, debtCoin.Amount, cdp.Principal.Denom)
+	if err != nil {
+		return err
+	}
+
+	// Decrement total principal for this collateral type
+	k.DecrementTotalPrincipal(ctx, cdp.Type, sdk.NewCoin(cdp.Principal.Denom, debt))
+
+	// Repay the seized debt, fees first
+	feePayment := sdk.MinInt(debt, cdp.AccumulatedFees.Amount)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.SubAmount(feePayment)
+	cdp.Principal = cdp.Principal.SubAmount(debt.Sub(feePayment))
+	cdp.Collateral = cdp.Collateral.Sub(lot)
+
+	ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, ratio)
+}
+
+// calculatePartialLiquidation returns the collateral and debt that must be seized from the cdp to restore it to its collateral
+// type's liquidation target ratio, after the liquidation penalty and the input keeper reward are paid from its collateral.
+// ok is false if the collateral type has no target ratio or if the whole cdp should be seized instead.
+func (k Keeper) calculatePartialLiquidation(ctx sdk.Context, cdp types.CDP, keeperReward sdk.Dec) (lot sdk.Coin, debt sdk.Int, ok bool) {
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found || cp.LiquidationTargetRatio == nil || !cp.LiquidationTargetRatio.IsPositive() {
+		return sdk.Coin{}, sdk.Int{}, false
+	}
+	dp, found := k.GetDebtParam(ctx, cdp.Principal.Denom)
+	if !found {
+		return sdk.Coin{}, sdk.Int{}, false
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil || !price.Price.IsPositive() {
+		return sdk.Coin{}, sdk.Int{}, false
+	}
+
+	// price of one unit of collateral in units of the principal denom
+	unitPrice := k.convertCollateralToBaseUnits(ctx, sdk.NewCoin(cdp.Collateral.Denom, sdk.OneInt()), cdp.Type).
+		Mul(price.Price).
+		Quo(k.convertDebtToBaseUnits(ctx, sdk.NewCoin(cdp.Principal.Denom, sdk.OneInt())))
+	if !unitPrice.IsPositive() {
+		return sdk.Coin{}, sdk.Int{}, false
+	}
+
+	// seizing debt d removes d * (1 + penalty) * (1 + reward) of collateral value, so the target ratio T is restored when
+	// (collateralValue - d * m) / (totalDebt - d) = T, ie d = (T * totalDebt - collateralValue) / (T - m)
+	target := *cp.LiquidationTargetRatio
+	lotMultiplier := sdk.OneDec().Add(cp.LiquidationPenalty)
+	m := lotMultiplier.Mul(sdk.OneDec().Add(keeperReward))
+	if target.LTE(m) {
+		return sdk.Coin{}, sdk.Int{}, false
+	}
+	totalDebt := cdp.GetTotalPrincipal().Amount
+	collateralValue := sdk.NewDecFromInt(cdp.Collateral.Amount).Mul(unitPrice)
+	shortfall := target.MulInt(totalDebt).Sub(collateralValue)
+	if !shortfall.IsPositive() {
+		return sdk.Coin{}, sdk.Int{}, false
+	}
+	debt = shortfall.Quo(target.Sub(m)).Ceil().TruncateInt()
+	if debt.GTE(totalDebt) || cdp.Principal.Amount.Sub(sdk.MaxInt(debt.Sub(cdp.AccumulatedFees.Amount), sdk.ZeroInt())).LT(dp.DebtFloor) {
+		return sdk.Coin{}, sdk.Int{}, false
+	}
+
+	lotAmount := lotMultiplier.MulInt(debt).Quo(unitPrice).Ceil().TruncateInt()
+	reward := sdk.NewDecFromInt(lotAmount).Mul(keeperReward).RoundInt()
+	if lotAmount.Add(reward).GTE(cdp.Collateral.Amount) {
+		return sdk.Coin{}, sdk.Int{}, false
+	}
+	return sdk.NewCoin(cdp.Collateral.Denom, lotAmount), debt, true
+}
+
+// splitLotByDeposits divides the lot between the deposits in proportion to their size, returning the amount taken from each.
+// The remainder left by rounding down is taken one unit at a time from the first deposits that can cover it.
+func splitLotByDeposits(deposits types.Deposits, lot sdk.Int) []sdk.Int {
+	total := deposits.SumCollateral()
+	shares := make([]sdk.Int, len(deposits))
+	allocated := sdk.ZeroInt()
+	for i, dep := range deposits {
+		shares[i] = dep.Amount.Amount.Mul(lot).Quo(total)
+		allocated = allocated.Add(shares[i])
+	}
+	remaining := lot.Sub(allocated)
+	for i, dep := range deposits {
+		if !remaining.IsPositive() {
+			break
+		}
+		if shares[i].LT(dep.Amount.Amount) {
+			shares[i] = shares[i].Add(sdk.OneInt())
+			remaining = remaining.Sub(sdk.OneInt())
+		}
+	}
+	return shares
+}
+
 // LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType string, liquidationRatio sdk.Dec, count sdk.Int) error {
 	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
@@ -135,12 +293,13 @@ func (k Keeper) getModAccountDebt(ctx sdk.Context, accountName string) sdk.Int {
 	return k.bankKeeper.GetBalance(ctx, macc.GetAddress(), k.GetDebtDenom(ctx)).Amount
 }
 
-func (k Keeper) payoutKeeperLiquidationReward(ctx sdk.Context, keeper sdk.AccAddress, cdp types.CDP) (types.CDP, error) {
+// payoutKeeperLiquidationReward pays the keeper a percentage of the input amount of liquidated collateral
+func (k Keeper) payoutKeeperLiquidationReward(ctx sdk.Context, keeper sdk.AccAddress, cdp types.CDP, liquidated sdk.Coin) (types.CDP, error) {
 	collateralParam, found := k.GetCollateral(ctx, cdp.Type)
 	if !found {
 		return types.CDP{}, sdkerrors.Wrapf(types.ErrInvalidCollateral, "%s", cdp.Type)
 	}
-	reward := sdk.NewDecFromInt(cdp.Collateral.Amount).Mul(collateralParam.KeeperRewardPercentage).RoundInt()
+	reward := sdk.NewDecFromInt(liquidated.Amount).Mul(collateralParam.KeeperRewardPercentage).RoundInt()
 	rewardCoin := sdk.NewCoin(cdp.Collateral.Denom, reward)
 	paidReward := false
 	deposits := k.GetDeposits(ctx, cdp.ID)
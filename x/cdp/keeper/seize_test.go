@@ -21,6 +21,7 @@ import (
 	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type SeizeTestSuite struct {
@@ -150,7 +151,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateral() {
 
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), bk.GetBalance(suite.ctx, acc.GetAddress(), "usdx").Amount.Int64())
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10), "xrp-a")
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 }
 
@@ -162,7 +163,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
 	suite.True(found)
 
-	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], c("xrp", 6999000000), "xrp-a")
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], c("xrp", 6999000000), "xrp-a", 0)
 	suite.NoError(err)
 
 	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
@@ -185,10 +186,102 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), bk.GetBalance(suite.ctx, acc.GetAddress(), "usdx").Amount.Int64())
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10), "xrp-a")
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 }
 
+func (suite *SeizeTestSuite) setLiquidationTargetRatio(collateralType string, targetRatio sdk.Dec) {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == collateralType {
+			params.CollateralParams[i].LiquidationTargetRatio = &targetRatio
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralPartial() {
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	suite.setLiquidationTargetRatio("xrp-a", d("2.5"))
+
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 10000000000), c("usdx", 1200000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 5000000000), "xrp-a", 1)
+	suite.Require().NoError(err)
+	suite.setPrice(d("0.15"), "xrp:usd:30")
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	// debt of (2.5 * 1200 - 2250) / (2.5 - 1.05) usdx is seized along with collateral worth the debt plus the penalty
+	cdp, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 682758620), cdp.Principal)
+	suite.Equal(c("xrp", 11379310340), cdp.Collateral)
+	suite.True(suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal()).Mul(d("0.15")).GTE(d("2.5")))
+
+	tpa := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+	suite.Equal(i(517241380), tpb.Sub(tpa))
+
+	// collateral is taken from each deposit in proportion to its size
+	deposits := suite.keeper.GetDeposits(suite.ctx, cdp.ID)
+	suite.Require().Equal(2, len(deposits))
+	suite.Equal(c("xrp", 7586206893), deposits[0].Amount)
+	suite.Equal(c("xrp", 3793103447), deposits[1].Amount)
+
+	auctionMacc := ak.GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("debt", 517241380), c("xrp", 3620689660)), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+	suite.Len(suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx), 2)
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralPartialBelowDebtFloor() {
+	suite.setLiquidationTargetRatio("xrp-a", d("2.5"))
+
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 100000000), c("usdx", 12000000), "xrp-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("0.22"), "xrp:usd:30")
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+
+	// restoring the target ratio would leave less principal than the debt floor, so the whole cdp is seized
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.False(found)
+}
+
+func (suite *SeizeTestSuite) TestKeeperLiquidationPartial() {
+	bk := suite.app.GetBankKeeper()
+	suite.setLiquidationTargetRatio("xrp-a", d("2.5"))
+
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 10000000000), c("usdx", 1200000000), "xrp-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("0.22"), "xrp:usd:30")
+
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", 1)
+	suite.Require().NoError(err)
+
+	// the keeper reward is paid on the seized collateral only
+	suite.Equal(i(10000000000+26524361), bk.GetBalance(suite.ctx, suite.addrs[1], "xrp").Amount)
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 644251476), cdp.Principal)
+	suite.Equal(c("xrp", 7321039501), cdp.Collateral)
+	suite.True(suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal()).Mul(d("0.22")).GTE(d("2.5")))
+
+	// the remaining cdp is healthy and can't be liquidated again
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", 1)
+	suite.ErrorIs(err, types.ErrNotLiquidatable)
+}
+
 func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.createCdps()
 	ak := suite.app.GetAccountKeeper()
@@ -210,6 +303,39 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(10, xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestLiquidateCdps_MarketSuspended() {
+	suite.createCdps()
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	acc := ak.GetModuleAccount(suite.ctx, types.ModuleName)
+
+	// suspend the market on moves of more than 10% per hour
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	for i, market := range pfParams.Markets {
+		if market.MarketID == "xrp:usd" {
+			pfParams.Markets[i].MaxDeviationBps = 1000
+			pfParams.Markets[i].DeviationInterval = time.Hour
+		}
+	}
+	pfKeeper.SetParams(suite.ctx, pfParams)
+
+	originalXrpCollateral := bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount
+	_, err := pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "xrp:usd", d("0.2"), suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.NoError(err)
+	err = pfKeeper.SetCurrentPrices(suite.ctx, "xrp:usd")
+	suite.NoError(err)
+
+	p, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.True(found)
+
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp-a", p.LiquidationRatio, p.CheckCollateralizationIndexCount)
+	suite.ErrorIs(err, pricefeedtypes.ErrMarketSuspended)
+
+	acc = ak.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(originalXrpCollateral, bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount)
+}
+
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
 	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp-a", i(1000))
 	suite.Equal(i(50), penalty)
@@ -421,7 +547,7 @@ func (suite *SeizeTestSuite) TestKeeperLiquidation() {
 			_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], tc.args.ctype)
 			suite.Require().True(found)
 
-			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], tc.args.ctype)
+			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], tc.args.ctype, 0)
 
 			if tc.errArgs.expectLiquidate {
 				suite.Require().NoError(err)
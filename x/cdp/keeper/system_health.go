@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// GetSystemHealth returns the collateral and debt of each collateral type, the liquidator debt and surplus and how
+// they net out into pending auctions, the active auctions started by the liquidator, and the collateralization of
+// all cdps at spot and liquidation prices. Collateral types with an unavailable price are marked and left out of the
+// collateralization ratios.
+func (k Keeper) GetSystemHealth(ctx sdk.Context) types.QuerySystemHealthResponse {
+	params := k.GetParams(ctx)
+	principalDenom := params.DebtParam.Denom
+
+	collateralValueSpot := sdk.ZeroDec()
+	collateralValueLiquidation := sdk.ZeroDec()
+	debtValue := sdk.ZeroDec()
+
+	var collaterals []types.CollateralHealth
+	for _, cp := range params.CollateralParams {
+		totalCollateral := sdk.NewCoin(cp.Denom, sdk.ZeroInt())
+		accumulatedFees := sdk.NewCoin(principalDenom, sdk.ZeroInt())
+		k.IterateCdpsByCollateralType(ctx, cp.Type, func(cdp types.CDP) bool {
+			totalCollateral = totalCollateral.Add(cdp.Collateral)
+			accumulatedFees = accumulatedFees.Add(cdp.AccumulatedFees)
+			return false
+		})
+		totalPrincipal := sdk.NewCoin(principalDenom, k.GetTotalPrincipal(ctx, cp.Type, principalDenom))
+
+		health := types.CollateralHealth{
+			CollateralType:  cp.Type,
+			TotalCollateral: totalCollateral,
+			TotalPrincipal:  totalPrincipal,
+			AccumulatedFees: accumulatedFees,
+		}
+		if totalCollateral.IsZero() {
+			collaterals = append(collaterals, health)
+			continue
+		}
+
+		// a collateral type with an unavailable price is reported without counting towards the system ratios
+		spotPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.SpotMarketID)
+		health.SpotPriceUnavailable = err != nil
+		liquidationPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+		health.LiquidationPriceUnavailable = err != nil
+		collaterals = append(collaterals, health)
+		if health.SpotPriceUnavailable || health.LiquidationPriceUnavailable {
+			continue
+		}
+
+		collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, totalCollateral, cp.Type)
+		collateralValueSpot = collateralValueSpot.Add(collateralBaseUnits.Mul(spotPrice.Price))
+		collateralValueLiquidation = collateralValueLiquidation.Add(collateralBaseUnits.Mul(liquidationPrice.Price))
+		debtValue = debtValue.Add(k.convertDebtToBaseUnits(ctx, totalPrincipal))
+	}
+
+	// the liquidator surplus and debt are netted before any surplus or debt auction is started
+	surplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc)
+	debt := k.GetTotalDebt(ctx, types.LiquidatorMacc)
+	netAmount := sdk.MinInt(surplus, debt)
+
+	var collateralAuctions, surplusAuctions, debtAuctions uint64
+	k.auctionKeeper.IterateAuctions(ctx, func(auction auctiontypes.Auction) bool {
+		if auction.GetInitiator() != types.LiquidatorMacc {
+			return false
+		}
+		switch auction.GetType() {
+		case auctiontypes.CollateralAuctionType, auctiontypes.DutchAuctionType:
+			collateralAuctions++
+		case auctiontypes.SurplusAuctionType:
+			surplusAuctions++
+		case auctiontypes.DebtAuctionType:
+			debtAuctions++
+		}
+		return false
+	})
+
+	ratioSpot := sdk.ZeroDec()
+	ratioLiquidation := sdk.ZeroDec()
+	if debtValue.IsPositive() {
+		ratioSpot = collateralValueSpot.Quo(debtValue)
+		ratioLiquidation = collateralValueLiquidation.Quo(debtValue)
+	}
+
+	return types.QuerySystemHealthResponse{
+		Collaterals:                       collaterals,
+		LiquidatorDebt:                    sdk.NewCoin(k.GetDebtDenom(ctx), debt),
+		SurplusPendingAuction:             sdk.NewCoin(principalDenom, surplus.Sub(netAmount)),
+		DebtPendingAuction:                sdk.NewCoin(k.GetDebtDenom(ctx), debt.Sub(netAmount)),
+		ActiveCollateralAuctions:          collateralAuctions,
+		ActiveSurplusAuctions:             surplusAuctions,
+		ActiveDebtAuctions:                debtAuctions,
+		CollateralizationRatioSpot:        ratioSpot,
+		CollateralizationRatioLiquidation: ratioLiquidation,
+	}
+}
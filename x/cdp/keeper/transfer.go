@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// TransferCdp moves a cdp of an owner, along with the owner's deposit, to a new owner.
+// Deposits of other depositors remain with their depositors.
+func (k Keeper) TransferCdp(ctx sdk.Context, owner, newOwner sdk.AccAddress, collateralType string, cdpID uint64) error {
+	if owner.Equals(newOwner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "cdp %s is already owned by %s", collateralType, owner)
+	}
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
+	}
+
+	// settle the rewards of both owners before the cdp changes hands
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	k.synchronizeOwnerCdps(ctx, newOwner, collateralType)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	k.RemoveCdpOwnerIndex(ctx, cdp)
+
+	deposit, found := k.GetDeposit(ctx, cdp.ID, owner)
+	if found {
+		k.DeleteDeposit(ctx, cdp.ID, owner)
+
+		newDeposit, found := k.GetDeposit(ctx, cdp.ID, newOwner)
+		if found {
+			newDeposit.Amount = newDeposit.Amount.Add(deposit.Amount)
+		} else {
+			newDeposit = types.NewDeposit(cdp.ID, newOwner, deposit.Amount)
+		}
+		k.SetDeposit(ctx, newDeposit)
+	}
+
+	cdp.Owner = newOwner
+	if err := k.SetCDP(ctx, cdp); err != nil {
+		return err
+	}
+	k.IndexCdpByOwner(ctx, cdp)
+
+	// start the new owner's rewards from the current reward index
+	k.hooks.AfterCDPCreated(ctx, cdp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpTransfer,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type TransferTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *TransferTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	coins := []sdk.Coins{
+		cs(c("xrp", 500000000), c("btc", 500000000)),
+		cs(c("xrp", 500000000)),
+		cs(c("xrp", 500000000)),
+	}
+
+	authGS := app.NewFundedGenStateWithCoins(cdc, coins, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.DepositCollateral(suite.ctx, addrs[0], addrs[2], c("xrp", 100000000), "xrp-a", 0)
+	suite.Require().NoError(err)
+}
+
+func (suite *TransferTestSuite) TestTransferCdp() {
+	err := suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", 0)
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.False(found)
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(uint64(1), cdp.ID)
+	suite.Equal(suite.addrs[1], cdp.Owner)
+	suite.Equal(c("xrp", 500000000), cdp.Collateral)
+
+	_, found = suite.keeper.GetCdpIdsByOwner(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	ids, found := suite.keeper.GetCdpIdsByOwner(suite.ctx, suite.addrs[1])
+	suite.True(found)
+	suite.Equal([]uint64{1}, ids)
+
+	// the owner's deposit moves to the new owner, other deposits are unchanged
+	_, found = suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[0])
+	suite.False(found)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 400000000), deposit.Amount)
+	deposit, found = suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[2])
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 100000000), deposit.Amount)
+
+	// the new owner can manage the cdp
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 100000000), "xrp-a", 0)
+	suite.NoError(err)
+}
+
+func (suite *TransferTestSuite) TestTransferCdpMergesDeposit() {
+	// the new owner previously deposited to the cdp
+	err := suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[2], "xrp-a", 0)
+	suite.Require().NoError(err)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[2])
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 500000000), deposit.Amount)
+	suite.Len(suite.keeper.GetDeposits(suite.ctx, 1), 1)
+}
+
+func (suite *TransferTestSuite) TestTransferCdpErrors() {
+	err := suite.keeper.TransferCdp(suite.ctx, suite.addrs[1], suite.addrs[2], "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[1], "btc-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", 2)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp-a", 0)
+	suite.Require().Error(err)
+}
+
+func (suite *TransferTestSuite) TestTransferCdpToOwnerOfSameCollateralType() {
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", 0)
+	suite.Require().NoError(err)
+
+	ids, found := suite.keeper.GetCdpIdsByOwner(suite.ctx, suite.addrs[1])
+	suite.True(found)
+	suite.Equal([]uint64{1, 2}, ids)
+
+	// the cdp must be selected by id once the owner has several cdps of the collateral type
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrCdpIDRequired))
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", 2)
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(uint64(2), cdp.ID)
+}
+
+func TestTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferTestSuite))
+}
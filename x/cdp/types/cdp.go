@@ -89,10 +89,15 @@ type CDPs []CDP
 
 // Validate validates each CDP
 func (cdps CDPs) Validate() error {
+	seenIDs := make(map[uint64]bool)
 	for _, cdp := range cdps {
 		if err := cdp.Validate(); err != nil {
 			return err
 		}
+		if seenIDs[cdp.ID] {
+			return fmt.Errorf("duplicate cdp id %d", cdp.ID)
+		}
+		seenIDs[cdp.ID] = true
 	}
 	return nil
 }
@@ -163,7 +168,7 @@ func (augcdps AugmentedCDPs) String() string {
 }
 
 // NewCDPResponse creates a new CDPResponse object
-func NewCDPResponse(cdp CDP, collateralValue sdk.Coin, collateralizationRatio sdk.Dec) CDPResponse {
+func NewCDPResponse(cdp CDP, collateralValue sdk.Coin, collateralizationRatio sdk.Dec, stabilityFee sdk.Dec) CDPResponse {
 	return CDPResponse{
 		ID:                     cdp.ID,
 		Owner:                  cdp.Owner.String(),
@@ -175,6 +180,7 @@ func NewCDPResponse(cdp CDP, collateralValue sdk.Coin, collateralizationRatio sd
 		InterestFactor:         cdp.InterestFactor.String(),
 		CollateralValue:        collateralValue,
 		CollateralizationRatio: collateralizationRatio.String(),
+		StabilityFee:           stabilityFee.String(),
 	}
 }
 
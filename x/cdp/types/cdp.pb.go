@@ -231,57 +231,159 @@ func (m *OwnerCDPIndex) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OwnerCDPIndex proto.InternalMessageInfo
 
+// PegStabilityReserve defines the reserves held by the peg stability module for a stablecoin and the debt asset
+// minted against them
+type PegStabilityReserve struct {
+	Denom       string     `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Reserve     types.Coin `protobuf:"bytes,2,opt,name=reserve,proto3" json:"reserve"`
+	TotalMinted types.Coin `protobuf:"bytes,3,opt,name=total_minted,json=totalMinted,proto3" json:"total_minted"`
+}
+
+func (m *PegStabilityReserve) Reset()         { *m = PegStabilityReserve{} }
+func (m *PegStabilityReserve) String() string { return proto.CompactTextString(m) }
+func (*PegStabilityReserve) ProtoMessage()    {}
+func (*PegStabilityReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{5}
+}
+func (m *PegStabilityReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PegStabilityReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PegStabilityReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PegStabilityReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PegStabilityReserve.Merge(m, src)
+}
+func (m *PegStabilityReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *PegStabilityReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_PegStabilityReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PegStabilityReserve proto.InternalMessageInfo
+
+// CollateralHealth defines the collateral and debt of all cdps of a collateral type
+type CollateralHealth struct {
+	CollateralType  string     `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	TotalCollateral types.Coin `protobuf:"bytes,2,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral"`
+	// total_principal is the debt drawn for the collateral type, including accumulated fees
+	TotalPrincipal types.Coin `protobuf:"bytes,3,opt,name=total_principal,json=totalPrincipal,proto3" json:"total_principal"`
+	// accumulated_fees are the fees last synchronized to the cdps of the collateral type
+	AccumulatedFees types.Coin `protobuf:"bytes,4,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
+	// spot_price_unavailable is true if the spot market price could not be read, in which case the collateral type is
+	// left out of the system collateralization ratios
+	SpotPriceUnavailable bool `protobuf:"varint,5,opt,name=spot_price_unavailable,json=spotPriceUnavailable,proto3" json:"spot_price_unavailable,omitempty"`
+	// liquidation_price_unavailable is true if the liquidation market price could not be read, in which case the
+	// collateral type is left out of the system collateralization ratios
+	LiquidationPriceUnavailable bool `protobuf:"varint,6,opt,name=liquidation_price_unavailable,json=liquidationPriceUnavailable,proto3" json:"liquidation_price_unavailable,omitempty"`
+}
+
+func (m *CollateralHealth) Reset()         { *m = CollateralHealth{} }
+func (m *CollateralHealth) String() string { return proto.CompactTextString(m) }
+func (*CollateralHealth) ProtoMessage()    {}
+func (*CollateralHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{6}
+}
+func (m *CollateralHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralHealth.Merge(m, src)
+}
+func (m *CollateralHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralHealth proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*CDP)(nil), "kava.cdp.v1beta1.CDP")
 	proto.RegisterType((*Deposit)(nil), "kava.cdp.v1beta1.Deposit")
 	proto.RegisterType((*TotalPrincipal)(nil), "kava.cdp.v1beta1.TotalPrincipal")
 	proto.RegisterType((*TotalCollateral)(nil), "kava.cdp.v1beta1.TotalCollateral")
 	proto.RegisterType((*OwnerCDPIndex)(nil), "kava.cdp.v1beta1.OwnerCDPIndex")
+	proto.RegisterType((*PegStabilityReserve)(nil), "kava.cdp.v1beta1.PegStabilityReserve")
+	proto.RegisterType((*CollateralHealth)(nil), "kava.cdp.v1beta1.CollateralHealth")
 }
 
 func init() { proto.RegisterFile("kava/cdp/v1beta1/cdp.proto", fileDescriptor_68a9ab097fb7be40) }
 
 var fileDescriptor_68a9ab097fb7be40 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xae, 0xbb, 0x36, 0x5b, 0xbd, 0xb1, 0x4e, 0x06, 0xa1, 0xac, 0x87, 0xa4, 0x1a, 0x02, 0x7a,
-	0x69, 0xa2, 0x01, 0x12, 0x17, 0x10, 0x5a, 0x1a, 0x0d, 0xca, 0x85, 0x29, 0x1a, 0x17, 0x0e, 0x54,
-	0xae, 0xed, 0x96, 0x68, 0x69, 0x1c, 0xc5, 0xee, 0xd8, 0x1e, 0x02, 0x69, 0x0f, 0xb3, 0x87, 0xd8,
-	0x81, 0xc3, 0xb4, 0x13, 0xe2, 0x10, 0x20, 0x7b, 0x0b, 0x4e, 0xc8, 0x4e, 0xba, 0xec, 0x58, 0x24,
-	0x38, 0xf5, 0xf7, 0xc7, 0xdf, 0xf7, 0x73, 0x7f, 0xdf, 0x17, 0xc3, 0xce, 0x11, 0x3e, 0xc6, 0x2e,
-	0xa1, 0x89, 0x7b, 0xbc, 0x3b, 0x66, 0x12, 0xef, 0xaa, 0xd8, 0x49, 0x52, 0x2e, 0x39, 0xda, 0x52,
-	0x3d, 0x47, 0xe5, 0x65, 0xaf, 0x63, 0x11, 0x2e, 0x66, 0x5c, 0xb8, 0x63, 0x2c, 0x58, 0x05, 0xe0,
-	0x61, 0x5c, 0x20, 0x3a, 0xdb, 0x45, 0x7f, 0xa4, 0x33, 0xb7, 0x48, 0xca, 0xd6, 0xbd, 0x29, 0x9f,
-	0xf2, 0xa2, 0xae, 0xa2, 0xb2, 0x6a, 0x4f, 0x39, 0x9f, 0x46, 0xcc, 0xd5, 0xd9, 0x78, 0x3e, 0x71,
-	0x65, 0x38, 0x63, 0x42, 0xe2, 0x59, 0x79, 0x87, 0x9d, 0x2f, 0x0d, 0xb8, 0x32, 0xf0, 0x0f, 0xd0,
-	0x7d, 0x58, 0x0f, 0xa9, 0x09, 0xba, 0xa0, 0xd7, 0xf0, 0x8c, 0x3c, 0xb3, 0xeb, 0x43, 0x3f, 0xa8,
-	0x87, 0x14, 0x7d, 0x84, 0x4d, 0xfe, 0x39, 0x66, 0xa9, 0x59, 0xef, 0x82, 0xde, 0x86, 0xf7, 0xe6,
-	0x77, 0x66, 0xf7, 0xa7, 0xa1, 0xfc, 0x34, 0x1f, 0x3b, 0x84, 0xcf, 0xca, 0x2b, 0x94, 0x3f, 0x7d,
-	0x41, 0x8f, 0x5c, 0x79, 0x9a, 0x30, 0xe1, 0xec, 0x11, 0xb2, 0x47, 0x69, 0xca, 0x84, 0xb8, 0x3a,
-	0xef, 0xdf, 0x2d, 0x2f, 0x5a, 0x56, 0xbc, 0x53, 0xc9, 0x44, 0x50, 0xd0, 0x22, 0x04, 0x1b, 0x0a,
-	0x61, 0xae, 0x74, 0x41, 0xaf, 0x15, 0xe8, 0x18, 0xbd, 0x82, 0x90, 0xf0, 0x28, 0xc2, 0x92, 0xa5,
-	0x38, 0x32, 0x1b, 0x5d, 0xd0, 0x5b, 0x7f, 0xb2, 0xed, 0x94, 0x24, 0x6a, 0x35, 0x8b, 0x7d, 0x39,
-	0x03, 0x1e, 0xc6, 0x5e, 0xe3, 0x22, 0xb3, 0x6b, 0xc1, 0x2d, 0x08, 0x7a, 0x09, 0x5b, 0x49, 0x1a,
-	0xc6, 0x24, 0x4c, 0x70, 0x64, 0x36, 0x97, 0xc3, 0x57, 0x08, 0xf4, 0x16, 0x6e, 0x61, 0x42, 0xe6,
-	0xb3, 0xb9, 0xe2, 0xa3, 0xa3, 0x09, 0x63, 0xc2, 0x34, 0x96, 0x63, 0x69, 0xdf, 0x02, 0xee, 0x33,
-	0x26, 0xd0, 0x6b, 0xb8, 0xa1, 0xf0, 0xa3, 0x79, 0x42, 0x55, 0xcd, 0x5c, 0xd5, 0x3c, 0x1d, 0xa7,
-	0xd0, 0xc5, 0x59, 0xe8, 0xe2, 0x1c, 0x2e, 0x74, 0xf1, 0xd6, 0x14, 0xd1, 0xd9, 0x0f, 0x1b, 0x04,
-	0xeb, 0x0a, 0xf9, 0xbe, 0x00, 0x22, 0x06, 0xdb, 0x61, 0x2c, 0x59, 0xca, 0x84, 0x1c, 0x4d, 0x30,
-	0x91, 0x3c, 0x35, 0xd7, 0xd4, 0xce, 0xbc, 0x17, 0xea, 0xfc, 0xf7, 0xcc, 0x7e, 0xb4, 0x84, 0x2c,
-	0x3e, 0x23, 0x57, 0xe7, 0x7d, 0x58, 0xfe, 0x09, 0x9f, 0x91, 0x60, 0x73, 0x41, 0xba, 0xaf, 0x39,
-	0x77, 0xbe, 0x02, 0xb8, 0xea, 0xb3, 0x84, 0x8b, 0x50, 0xa2, 0x2e, 0x34, 0x08, 0x4d, 0x46, 0x37,
-	0xbe, 0x68, 0xe5, 0x99, 0xdd, 0x1c, 0xd0, 0x64, 0xe8, 0x07, 0x4d, 0x42, 0x93, 0x21, 0x45, 0x13,
-	0xd8, 0xa2, 0xc5, 0x61, 0x5e, 0x38, 0xa4, 0xf5, 0x0f, 0x1d, 0x52, 0x51, 0xa3, 0xe7, 0xd0, 0xc0,
-	0x33, 0x3e, 0x8f, 0xa5, 0xf6, 0xc9, 0x12, 0x3a, 0x94, 0xc7, 0x77, 0x52, 0xb8, 0x79, 0xc8, 0x25,
-	0x8e, 0x0e, 0x6e, 0xc4, 0x7d, 0x0c, 0xdb, 0x95, 0x53, 0x46, 0xda, 0x7b, 0x40, 0x7b, 0x6f, 0xb3,
-	0x2a, 0x1f, 0x2a, 0x17, 0x56, 0x33, 0xeb, 0x7f, 0x37, 0x53, 0xc0, 0xb6, 0x9e, 0x39, 0xa8, 0x0c,
-	0xf9, 0xff, 0x87, 0x3e, 0x83, 0x77, 0xde, 0xa9, 0x0f, 0x6a, 0xe0, 0x1f, 0x0c, 0x63, 0xca, 0x4e,
-	0xd0, 0x03, 0xb8, 0x5a, 0x88, 0x27, 0x4c, 0xd0, 0x5d, 0xe9, 0x35, 0x3c, 0x98, 0x67, 0xb6, 0xa1,
-	0xd5, 0x13, 0x81, 0xa1, 0xe5, 0x13, 0xde, 0xe0, 0xe2, 0x97, 0x55, 0xbb, 0xc8, 0x2d, 0x70, 0x99,
-	0x5b, 0xe0, 0x67, 0x6e, 0x81, 0xb3, 0x6b, 0xab, 0x76, 0x79, 0x6d, 0xd5, 0xbe, 0x5d, 0x5b, 0xb5,
-	0x0f, 0x0f, 0x6f, 0xc9, 0xa8, 0x9e, 0xaa, 0x7e, 0x84, 0xc7, 0x42, 0x47, 0xee, 0x89, 0x7e, 0xd2,
-	0xb4, 0x92, 0x63, 0x43, 0x9b, 0xf8, 0xe9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0xe3, 0x65,
-	0xc4, 0xeb, 0x04, 0x00, 0x00,
+	// 778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0xf3, 0xc7, 0xd9, 0xcc, 0x2e, 0x49, 0x34, 0x5d, 0x55, 0x6e, 0x10, 0x76, 0x14, 0x04,
+	0xe4, 0x12, 0x5b, 0x85, 0x4a, 0x08, 0x09, 0x84, 0xea, 0x44, 0x65, 0x53, 0x09, 0x11, 0x99, 0xed,
+	0x85, 0x03, 0xd6, 0xd8, 0x33, 0x49, 0x47, 0xb5, 0x3d, 0xc6, 0x33, 0x0e, 0xdd, 0x0f, 0x81, 0xd4,
+	0x1b, 0xdf, 0x81, 0x73, 0x3f, 0xc4, 0x1e, 0x38, 0x54, 0x3d, 0x21, 0x0e, 0x01, 0xb2, 0xdf, 0x82,
+	0x13, 0x9a, 0xb1, 0x53, 0x87, 0xaa, 0x07, 0x83, 0xe0, 0x94, 0x79, 0x7f, 0x7e, 0x6f, 0xde, 0xbc,
+	0xdf, 0x2f, 0xcf, 0x60, 0xf4, 0x04, 0x6d, 0x91, 0x13, 0xe2, 0xd4, 0xd9, 0xde, 0x0d, 0x88, 0x40,
+	0x77, 0xe5, 0xd9, 0x4e, 0x33, 0x26, 0x18, 0x1c, 0xca, 0x98, 0x2d, 0xed, 0x32, 0x36, 0x32, 0x43,
+	0xc6, 0x63, 0xc6, 0x9d, 0x00, 0x71, 0x52, 0x01, 0x18, 0x4d, 0x0a, 0xc4, 0xe8, 0x4e, 0x11, 0xf7,
+	0x95, 0xe5, 0x14, 0x46, 0x19, 0x3a, 0xdf, 0xb0, 0x0d, 0x2b, 0xfc, 0xf2, 0x54, 0x7a, 0xad, 0x0d,
+	0x63, 0x9b, 0x88, 0x38, 0xca, 0x0a, 0xf2, 0xb5, 0x23, 0x68, 0x4c, 0xb8, 0x40, 0x71, 0xd9, 0xc3,
+	0xe4, 0x87, 0x36, 0x68, 0xcd, 0x17, 0x2b, 0x78, 0x1b, 0x34, 0x29, 0x36, 0xb4, 0xb1, 0x36, 0x6d,
+	0xbb, 0xfa, 0x7e, 0x67, 0x35, 0x97, 0x0b, 0xaf, 0x49, 0x31, 0xfc, 0x16, 0x74, 0xd8, 0xf7, 0x09,
+	0xc9, 0x8c, 0xe6, 0x58, 0x9b, 0x9e, 0xb9, 0x17, 0x7f, 0xee, 0xac, 0xd9, 0x86, 0x8a, 0xc7, 0x79,
+	0x60, 0x87, 0x2c, 0x2e, 0x5b, 0x28, 0x7f, 0x66, 0x1c, 0x3f, 0x71, 0xc4, 0x55, 0x4a, 0xb8, 0x7d,
+	0x3f, 0x0c, 0xef, 0x63, 0x9c, 0x11, 0xce, 0x5f, 0x3e, 0x9f, 0xdd, 0x2a, 0x1b, 0x2d, 0x3d, 0xee,
+	0x95, 0x20, 0xdc, 0x2b, 0xca, 0x42, 0x08, 0xda, 0x12, 0x61, 0xb4, 0xc6, 0xda, 0xb4, 0xe7, 0xa9,
+	0x33, 0xfc, 0x1c, 0x80, 0x90, 0x45, 0x11, 0x12, 0x24, 0x43, 0x91, 0xd1, 0x1e, 0x6b, 0xd3, 0xd3,
+	0x0f, 0xef, 0xd8, 0x65, 0x11, 0x39, 0x9a, 0xc3, 0xbc, 0xec, 0x39, 0xa3, 0x89, 0xdb, 0xbe, 0xde,
+	0x59, 0x0d, 0xef, 0x08, 0x02, 0x3f, 0x03, 0xbd, 0x34, 0xa3, 0x49, 0x48, 0x53, 0x14, 0x19, 0x9d,
+	0x7a, 0xf8, 0x0a, 0x01, 0x1f, 0x82, 0x21, 0x0a, 0xc3, 0x3c, 0xce, 0x65, 0x3d, 0xec, 0xaf, 0x09,
+	0xe1, 0x86, 0x5e, 0xaf, 0xca, 0xe0, 0x08, 0xf8, 0x80, 0x10, 0x0e, 0xbf, 0x00, 0x67, 0x12, 0xef,
+	0xe7, 0x29, 0x96, 0x3e, 0xa3, 0xab, 0xea, 0x8c, 0xec, 0x82, 0x17, 0xfb, 0xc0, 0x8b, 0x7d, 0x79,
+	0xe0, 0xc5, 0x3d, 0x91, 0x85, 0x9e, 0xfd, 0x66, 0x69, 0xde, 0xa9, 0x44, 0x3e, 0x2a, 0x80, 0x90,
+	0x80, 0x01, 0x4d, 0x04, 0xc9, 0x08, 0x17, 0xfe, 0x1a, 0x85, 0x82, 0x65, 0xc6, 0x89, 0x9c, 0x99,
+	0xfb, 0xa9, 0xcc, 0xff, 0x75, 0x67, 0xbd, 0x5f, 0x83, 0x96, 0x05, 0x09, 0x5f, 0x3e, 0x9f, 0x81,
+	0xf2, 0x11, 0x0b, 0x12, 0x7a, 0xfd, 0x43, 0xd1, 0x07, 0xaa, 0xe6, 0xe4, 0x67, 0x0d, 0x74, 0x17,
+	0x24, 0x65, 0x9c, 0x0a, 0x38, 0x06, 0x7a, 0x88, 0x53, 0xff, 0x95, 0x2e, 0x7a, 0xfb, 0x9d, 0xd5,
+	0x99, 0xe3, 0x74, 0xb9, 0xf0, 0x3a, 0x21, 0x4e, 0x97, 0x18, 0xae, 0x41, 0x0f, 0x17, 0xc9, 0xac,
+	0x50, 0x48, 0xef, 0x3f, 0x54, 0x48, 0x55, 0x1a, 0x7e, 0x0c, 0x74, 0x14, 0xb3, 0x3c, 0x11, 0x46,
+	0xab, 0x1e, 0x0f, 0x65, 0xfa, 0x24, 0x03, 0xfd, 0x4b, 0x26, 0x50, 0xb4, 0x7a, 0x45, 0xee, 0x07,
+	0x60, 0x50, 0x29, 0xc5, 0x57, 0xda, 0xd3, 0x94, 0xf6, 0xfa, 0x95, 0xfb, 0x52, 0xaa, 0xb0, 0xba,
+	0xb3, 0xf9, 0xcf, 0xee, 0xe4, 0x60, 0xa0, 0xee, 0x9c, 0x57, 0x82, 0xfc, 0xff, 0x2f, 0xbd, 0x07,
+	0xde, 0xfa, 0x4a, 0xfe, 0xa1, 0xe6, 0x8b, 0xd5, 0x32, 0xc1, 0xe4, 0x29, 0x7c, 0x17, 0x74, 0x0b,
+	0xf2, 0xb8, 0xa1, 0x8d, 0x5b, 0xd3, 0xb6, 0x0b, 0xf6, 0x3b, 0x4b, 0x57, 0xec, 0x71, 0x4f, 0x57,
+	0xf4, 0xf1, 0xc9, 0x4f, 0x1a, 0xb8, 0xb5, 0x22, 0x9b, 0xaf, 0x05, 0x0a, 0x68, 0x44, 0xc5, 0x95,
+	0x47, 0x38, 0xc9, 0xb6, 0x04, 0x9e, 0x83, 0x0e, 0x26, 0x09, 0x8b, 0xcb, 0x2e, 0x0b, 0x03, 0x7e,
+	0x02, 0xba, 0x59, 0x91, 0x50, 0xb7, 0xbb, 0x43, 0x3e, 0x74, 0xc1, 0x99, 0x90, 0x33, 0xf1, 0x63,
+	0xa9, 0x37, 0x5c, 0x97, 0xc6, 0x53, 0x05, 0xfa, 0x52, 0x61, 0x26, 0x3f, 0xb6, 0xc0, 0xb0, 0x9a,
+	0xe9, 0x05, 0x41, 0x91, 0x78, 0x5c, 0x7f, 0xb2, 0x0f, 0xc1, 0xb0, 0xe8, 0xa0, 0xf2, 0xd7, 0x7d,
+	0xc5, 0x40, 0xbc, 0x46, 0xe7, 0x05, 0x28, 0x5c, 0x7e, 0xb5, 0x65, 0x6a, 0x3e, 0xa8, 0x2f, 0xfe,
+	0xae, 0xc6, 0x37, 0xad, 0x9a, 0xf6, 0xbf, 0x5c, 0x35, 0xf7, 0xc0, 0x6d, 0x9e, 0x32, 0x21, 0x9b,
+	0x0a, 0x89, 0x9f, 0x27, 0x68, 0x8b, 0x68, 0x84, 0x82, 0x88, 0xa8, 0x15, 0x78, 0xe2, 0x9d, 0xcb,
+	0xe8, 0x4a, 0x06, 0x1f, 0x55, 0x31, 0xe8, 0x82, 0x77, 0x22, 0xfa, 0x5d, 0x4e, 0x31, 0x12, 0x94,
+	0x25, 0x6f, 0x00, 0xeb, 0x0a, 0xfc, 0xf6, 0x51, 0xd2, 0xeb, 0x35, 0xdc, 0xf9, 0xf5, 0x1f, 0x66,
+	0xe3, 0x7a, 0x6f, 0x6a, 0x2f, 0xf6, 0xa6, 0xf6, 0xfb, 0xde, 0xd4, 0x9e, 0xdd, 0x98, 0x8d, 0x17,
+	0x37, 0x66, 0xe3, 0x97, 0x1b, 0xb3, 0xf1, 0xcd, 0x7b, 0x47, 0xdb, 0x40, 0x7e, 0xf1, 0x66, 0x11,
+	0x0a, 0xb8, 0x3a, 0x39, 0x4f, 0xd5, 0x97, 0x51, 0x2d, 0x84, 0x40, 0x57, 0xbb, 0xf0, 0xa3, 0xbf,
+	0x06, 0x00, 0xbc, 0xe3, 0x4a, 0xa6, 0x32, 0x07, 0x00, 0x00,
 }
 
 func (m *CDP) Marshal() (dAtA []byte, err error) {
@@ -540,6 +642,136 @@ func (m *OwnerCDPIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PegStabilityReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PegStabilityReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PegStabilityReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Reserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidationPriceUnavailable {
+		i--
+		if m.LiquidationPriceUnavailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SpotPriceUnavailable {
+		i--
+		if m.SpotPriceUnavailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TotalPrincipal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCdp(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCdp(v)
 	base := offset
@@ -645,6 +877,48 @@ func (m *OwnerCDPIndex) Size() (n int) {
 	return n
 }
 
+func (m *PegStabilityReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.Reserve.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *CollateralHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.TotalCollateral.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.TotalPrincipal.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.AccumulatedFees.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	if m.SpotPriceUnavailable {
+		n += 2
+	}
+	if m.LiquidationPriceUnavailable {
+		n += 2
+	}
+	return n
+}
+
 func sovCdp(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1442,6 +1716,375 @@ func (m *OwnerCDPIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PegStabilityReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PegStabilityReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PegStabilityReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPrincipal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceUnavailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpotPriceUnavailable = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPriceUnavailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidationPriceUnavailable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCdp(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
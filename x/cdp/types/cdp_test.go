@@ -110,6 +110,17 @@ func (suite *CdpValidationSuite) TestCdpValidation() {
 	}
 }
 
+func (suite *CdpValidationSuite) TestCdpsValidation() {
+	cdps := types.CDPs{
+		types.NewCDP(1, suite.addrs[0], sdk.NewInt64Coin("bnb", 100000), "bnb-a", sdk.NewInt64Coin("usdx", 100000), tmtime.Now(), sdk.OneDec()),
+		types.NewCDP(2, suite.addrs[0], sdk.NewInt64Coin("bnb", 100000), "bnb-a", sdk.NewInt64Coin("usdx", 100000), tmtime.Now(), sdk.OneDec()),
+	}
+	suite.Require().NoError(cdps.Validate())
+
+	cdps = append(cdps, types.NewCDP(2, suite.addrs[0], sdk.NewInt64Coin("bnb", 100000), "bnb-a", sdk.NewInt64Coin("usdx", 100000), tmtime.Now(), sdk.OneDec()))
+	suite.Require().EqualError(cdps.Validate(), "duplicate cdp id 2")
+}
+
 func (suite *CdpValidationSuite) TestDepositValidation() {
 	type errArgs struct {
 		expectPass bool
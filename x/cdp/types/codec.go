@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDrawDebt{}, "cdp/MsgDrawDebt", nil)
 	cdc.RegisterConcrete(&MsgRepayDebt{}, "cdp/MsgRepayDebt", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "cdp/MsgLiquidate", nil)
+	cdc.RegisterConcrete(&MsgTransferCDP{}, "cdp/MsgTransferCDP", nil)
+	cdc.RegisterConcrete(&MsgPegStabilityMint{}, "cdp/MsgPegStabilityMint", nil)
+	cdc.RegisterConcrete(&MsgPegStabilityRedeem{}, "cdp/MsgPegStabilityRedeem", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +31,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDrawDebt{},
 		&MsgRepayDebt{},
 		&MsgLiquidate{},
+		&MsgTransferCDP{},
+		&MsgPegStabilityMint{},
+		&MsgPegStabilityRedeem{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
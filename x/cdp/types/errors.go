@@ -51,4 +51,14 @@ var (
 	ErrInsufficientBalance = sdkerrors.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = sdkerrors.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrCdpIDRequired error for when an owner has several cdps of a collateral type and no cdp id is specified
+	ErrCdpIDRequired = sdkerrors.Register(ModuleName, 24, "cdp id required for owner with multiple cdps of collateral type")
+	// ErrPegStabilityAssetNotFound error for when a stablecoin is not enabled in the peg stability module
+	ErrPegStabilityAssetNotFound = sdkerrors.Register(ModuleName, 25, "peg stability asset not found")
+	// ErrExceedsPegStabilityDebtLimit error for when a peg stability mint exceeds the asset's debt limit
+	ErrExceedsPegStabilityDebtLimit = sdkerrors.Register(ModuleName, 26, "proposed mint would exceed peg stability debt limit")
+	// ErrInsufficientPegStabilityReserves error for when a peg stability redemption exceeds the asset's reserves
+	ErrInsufficientPegStabilityReserves = sdkerrors.Register(ModuleName, 27, "insufficient peg stability reserves")
+	// ErrPegStabilitySwapTooSmall error for when a peg stability swap rounds down to zero
+	ErrPegStabilitySwapTooSmall = sdkerrors.Register(ModuleName, 28, "peg stability swap amount too small")
 )
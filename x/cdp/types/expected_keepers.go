@@ -7,6 +7,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
@@ -41,6 +42,8 @@ type AuctionKeeper interface {
 	StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, error)
 	StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, initialLot sdk.Coin, debt sdk.Coin) (uint64, error)
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, error)
+	StartDutchAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, referencePrice sdk.Dec, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, error)
+	IterateAuctions(ctx sdk.Context, cb func(auction auctiontypes.Auction) (stop bool))
 }
 
 // AccountKeeper expected interface for the account keeper
@@ -11,7 +11,7 @@ import (
 // NewGenesisState returns a new genesis state
 func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID uint64,
 	debtDenom, govDenom string, prevAccumTimes GenesisAccumulationTimes,
-	totalPrincipals GenesisTotalPrincipals,
+	totalPrincipals GenesisTotalPrincipals, pegStabilityMinted GenesisPegStabilityMinteds,
 ) GenesisState {
 	return GenesisState{
 		Params:                    params,
@@ -22,6 +22,7 @@ func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID
 		GovDenom:                  govDenom,
 		PreviousAccumulationTimes: prevAccumTimes,
 		TotalPrincipals:           totalPrincipals,
+		PegStabilityMinted:        pegStabilityMinted,
 	}
 }
 
@@ -36,6 +37,7 @@ func DefaultGenesisState() GenesisState {
 		DefaultGovDenom,
 		GenesisAccumulationTimes{},
 		GenesisTotalPrincipals{},
+		GenesisPegStabilityMinteds{},
 	)
 }
 
@@ -62,6 +64,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.PegStabilityMinted.Validate(); err != nil {
+		return err
+	}
+
 	if err := sdk.ValidateDenom(gs.DebtDenom); err != nil {
 		return fmt.Errorf(fmt.Sprintf("debt denom invalid: %v", err))
 	}
@@ -135,3 +141,42 @@ func (gats GenesisAccumulationTimes) Validate() error {
 	}
 	return nil
 }
+
+// NewGenesisPegStabilityMinted returns a new GenesisPegStabilityMinted
+func NewGenesisPegStabilityMinted(denom string, totalMinted sdk.Int) GenesisPegStabilityMinted {
+	return GenesisPegStabilityMinted{
+		Denom:       denom,
+		TotalMinted: totalMinted,
+	}
+}
+
+// GenesisPegStabilityMinteds slice of GenesisPegStabilityMinted
+type GenesisPegStabilityMinteds []GenesisPegStabilityMinted
+
+// Validate performs validation of GenesisPegStabilityMinted
+func (gpsm GenesisPegStabilityMinted) Validate() error {
+	if err := sdk.ValidateDenom(gpsm.Denom); err != nil {
+		return fmt.Errorf("peg stability denom invalid %s", gpsm.Denom)
+	}
+
+	if gpsm.TotalMinted.IsNil() || gpsm.TotalMinted.IsNegative() {
+		return fmt.Errorf("peg stability total minted should not be negative, is %s for %s", gpsm.TotalMinted, gpsm.Denom)
+	}
+
+	return nil
+}
+
+// Validate performs validation of GenesisPegStabilityMinteds
+func (gpsms GenesisPegStabilityMinteds) Validate() error {
+	denoms := make(map[string]bool)
+	for _, gpsm := range gpsms {
+		if err := gpsm.Validate(); err != nil {
+			return err
+		}
+		if denoms[gpsm.Denom] {
+			return fmt.Errorf("duplicate peg stability denom: %s", gpsm.Denom)
+		}
+		denoms[gpsm.Denom] = true
+	}
+	return nil
+}
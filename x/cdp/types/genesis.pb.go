@@ -41,6 +41,8 @@ type GenesisState struct {
 	GovDenom                  string                   `protobuf:"bytes,6,opt,name=gov_denom,json=govDenom,proto3" json:"gov_denom,omitempty"`
 	PreviousAccumulationTimes GenesisAccumulationTimes `protobuf:"bytes,7,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
 	TotalPrincipals           GenesisTotalPrincipals   `protobuf:"bytes,8,rep,name=total_principals,json=totalPrincipals,proto3,castrepeated=GenesisTotalPrincipals" json:"total_principals"`
+	// peg_stability_minted is the debt asset minted by the peg stability module against each stablecoin
+	PegStabilityMinted GenesisPegStabilityMinteds `protobuf:"bytes,9,rep,name=peg_stability_minted,json=pegStabilityMinted,proto3,castrepeated=GenesisPegStabilityMinteds" json:"peg_stability_minted"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +134,13 @@ func (m *GenesisState) GetTotalPrincipals() GenesisTotalPrincipals {
 	return nil
 }
 
+func (m *GenesisState) GetPegStabilityMinted() GenesisPegStabilityMinteds {
+	if m != nil {
+		return m.PegStabilityMinted
+	}
+	return nil
+}
+
 // Params defines the parameters for the cdp module.
 type Params struct {
 	CollateralParams        CollateralParams                       `protobuf:"bytes,1,rep,name=collateral_params,json=collateralParams,proto3,castrepeated=CollateralParams" json:"collateral_params"`
@@ -142,6 +151,8 @@ type Params struct {
 	DebtAuctionThreshold    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=debt_auction_threshold,json=debtAuctionThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_threshold"`
 	DebtAuctionLot          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=debt_auction_lot,json=debtAuctionLot,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_lot"`
 	CircuitBreaker          bool                                   `protobuf:"varint,8,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	// peg_stability_params are the stablecoins that can be swapped 1:1 for the debt asset
+	PegStabilityParams PegStabilityParams `protobuf:"bytes,9,rep,name=peg_stability_params,json=pegStabilityParams,proto3,castrepeated=PegStabilityParams" json:"peg_stability_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -205,6 +216,13 @@ func (m *Params) GetCircuitBreaker() bool {
 	return false
 }
 
+func (m *Params) GetPegStabilityParams() PegStabilityParams {
+	if m != nil {
+		return m.PegStabilityParams
+	}
+	return nil
+}
+
 // DebtParam defines governance params for debt assets
 type DebtParam struct {
 	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -274,6 +292,15 @@ type CollateralParam struct {
 	KeeperRewardPercentage           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
 	CheckCollateralizationIndexCount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=check_collateralization_index_count,json=checkCollateralizationIndexCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"check_collateralization_index_count"`
 	ConversionFactor                 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+	// stability_fee_model optionally adjusts the stability fee based on utilization and the stable asset price.
+	// When unset, the fixed stability_fee is used.
+	StabilityFeeModel *StabilityFeeModel `protobuf:"bytes,13,opt,name=stability_fee_model,json=stabilityFeeModel,proto3" json:"stability_fee_model,omitempty"`
+	// auction_type selects the style of auction used to sell liquidated collateral, either "collateral" or "dutch".
+	// When unset, collateral auctions are used.
+	AuctionType string `protobuf:"bytes,14,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	// liquidation_target_ratio enables partial liquidation when set. Liquidations then only seize enough
+	// collateral and debt to restore the cdp to this collateralization ratio, leaving the rest owned by the user.
+	LiquidationTargetRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=liquidation_target_ratio,json=liquidationTargetRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_target_ratio,omitempty"`
 }
 
 func (m *CollateralParam) Reset()         { *m = CollateralParam{} }
@@ -344,6 +371,133 @@ func (m *CollateralParam) GetLiquidationMarketID() string {
 	return ""
 }
 
+func (m *CollateralParam) GetStabilityFeeModel() *StabilityFeeModel {
+	if m != nil {
+		return m.StabilityFeeModel
+	}
+	return nil
+}
+
+func (m *CollateralParam) GetAuctionType() string {
+	if m != nil {
+		return m.AuctionType
+	}
+	return ""
+}
+
+// PegStabilityParam defines governance parameters for a stablecoin that can be swapped 1:1 for newly minted debt
+// asset through the peg stability module, and back
+type PegStabilityParam struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// debt_limit is the maximum amount of debt asset that can be minted against the stablecoin
+	DebtLimit types.Coin `protobuf:"bytes,2,opt,name=debt_limit,json=debtLimit,proto3" json:"debt_limit"`
+	// fee is the fraction of each swap, in either direction, paid to the cdp system as surplus
+	Fee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+	// conversion_factor is the number of decimals of the stablecoin
+	ConversionFactor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+}
+
+func (m *PegStabilityParam) Reset()         { *m = PegStabilityParam{} }
+func (m *PegStabilityParam) String() string { return proto.CompactTextString(m) }
+func (*PegStabilityParam) ProtoMessage()    {}
+func (*PegStabilityParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4494a90aaab0034, []int{4}
+}
+func (m *PegStabilityParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PegStabilityParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PegStabilityParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PegStabilityParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PegStabilityParam.Merge(m, src)
+}
+func (m *PegStabilityParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *PegStabilityParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_PegStabilityParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PegStabilityParam proto.InternalMessageInfo
+
+func (m *PegStabilityParam) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PegStabilityParam) GetDebtLimit() types.Coin {
+	if m != nil {
+		return m.DebtLimit
+	}
+	return types.Coin{}
+}
+
+// StabilityFeeModel defines a dynamic per second stability fee for a collateral type.
+// The fee is the collateral type's stability fee, increased with the utilization of its debt limit and with
+// the distance of the stable asset price below its peg, and bounded by the minimum and maximum fee.
+type StabilityFeeModel struct {
+	MinStabilityFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_stability_fee,json=minStabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_fee"`
+	MaxStabilityFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_stability_fee,json=maxStabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_stability_fee"`
+	// utilization_multiplier is the per second rate added at full utilization of the debt limit
+	UtilizationMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=utilization_multiplier,json=utilizationMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization_multiplier"`
+	// peg_market_id is the pricefeed market of the stable asset, the peg term is disabled when blank
+	PegMarketID string `protobuf:"bytes,4,opt,name=peg_market_id,json=pegMarketId,proto3" json:"peg_market_id,omitempty"`
+	// peg_multiplier is the per second rate added for each unit the stable asset price is below 1
+	PegMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=peg_multiplier,json=pegMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"peg_multiplier"`
+}
+
+func (m *StabilityFeeModel) Reset()         { *m = StabilityFeeModel{} }
+func (m *StabilityFeeModel) String() string { return proto.CompactTextString(m) }
+func (*StabilityFeeModel) ProtoMessage()    {}
+func (*StabilityFeeModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4494a90aaab0034, []int{5}
+}
+func (m *StabilityFeeModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StabilityFeeModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StabilityFeeModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StabilityFeeModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StabilityFeeModel.Merge(m, src)
+}
+func (m *StabilityFeeModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *StabilityFeeModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_StabilityFeeModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StabilityFeeModel proto.InternalMessageInfo
+
+func (m *StabilityFeeModel) GetPegMarketID() string {
+	if m != nil {
+		return m.PegMarketID
+	}
+	return ""
+}
+
 // GenesisAccumulationTime defines the previous distribution time and its corresponding denom
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -355,7 +509,7 @@ func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime
 func (m *GenesisAccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccumulationTime) ProtoMessage()    {}
 func (*GenesisAccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{4}
+	return fileDescriptor_e4494a90aaab0034, []int{6}
 }
 func (m *GenesisAccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +562,7 @@ func (m *GenesisTotalPrincipal) Reset()         { *m = GenesisTotalPrincipal{} }
 func (m *GenesisTotalPrincipal) String() string { return proto.CompactTextString(m) }
 func (*GenesisTotalPrincipal) ProtoMessage()    {}
 func (*GenesisTotalPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{5}
+	return fileDescriptor_e4494a90aaab0034, []int{7}
 }
 func (m *GenesisTotalPrincipal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,93 +598,160 @@ func (m *GenesisTotalPrincipal) GetCollateralType() string {
 	return ""
 }
 
+// GenesisPegStabilityMinted defines the debt asset minted by the peg stability module against a stablecoin
+type GenesisPegStabilityMinted struct {
+	Denom       string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_minted,json=totalMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_minted"`
+}
+
+func (m *GenesisPegStabilityMinted) Reset()         { *m = GenesisPegStabilityMinted{} }
+func (m *GenesisPegStabilityMinted) String() string { return proto.CompactTextString(m) }
+func (*GenesisPegStabilityMinted) ProtoMessage()    {}
+func (*GenesisPegStabilityMinted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4494a90aaab0034, []int{8}
+}
+func (m *GenesisPegStabilityMinted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPegStabilityMinted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPegStabilityMinted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisPegStabilityMinted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPegStabilityMinted.Merge(m, src)
+}
+func (m *GenesisPegStabilityMinted) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPegStabilityMinted) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPegStabilityMinted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPegStabilityMinted proto.InternalMessageInfo
+
+func (m *GenesisPegStabilityMinted) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.cdp.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "kava.cdp.v1beta1.Params")
 	proto.RegisterType((*DebtParam)(nil), "kava.cdp.v1beta1.DebtParam")
 	proto.RegisterType((*CollateralParam)(nil), "kava.cdp.v1beta1.CollateralParam")
+	proto.RegisterType((*PegStabilityParam)(nil), "kava.cdp.v1beta1.PegStabilityParam")
+	proto.RegisterType((*StabilityFeeModel)(nil), "kava.cdp.v1beta1.StabilityFeeModel")
 	proto.RegisterType((*GenesisAccumulationTime)(nil), "kava.cdp.v1beta1.GenesisAccumulationTime")
 	proto.RegisterType((*GenesisTotalPrincipal)(nil), "kava.cdp.v1beta1.GenesisTotalPrincipal")
+	proto.RegisterType((*GenesisPegStabilityMinted)(nil), "kava.cdp.v1beta1.GenesisPegStabilityMinted")
 }
 
 func init() { proto.RegisterFile("kava/cdp/v1beta1/genesis.proto", fileDescriptor_e4494a90aaab0034) }
 
 var fileDescriptor_e4494a90aaab0034 = []byte{
-	// 1179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xf6, 0x3a, 0x8a, 0x23, 0xd1, 0x8a, 0x24, 0x33, 0x4e, 0xb2, 0x76, 0x50, 0x49, 0x75, 0xd1,
-	0xc6, 0x3d, 0x58, 0x42, 0x52, 0x20, 0x40, 0x81, 0xa2, 0xa9, 0x65, 0xc1, 0x81, 0x11, 0x17, 0x10,
-	0xd6, 0x3e, 0xb5, 0x87, 0x05, 0x97, 0x4b, 0xc9, 0x84, 0x56, 0xcb, 0x2d, 0x49, 0xa9, 0xb6, 0x5f,
-	0xa1, 0x28, 0x1a, 0xf4, 0x25, 0x0a, 0xe4, 0xdc, 0x87, 0x48, 0x6f, 0x41, 0x4f, 0x45, 0x0f, 0x72,
-	0x21, 0xdf, 0xfb, 0x0c, 0x05, 0x7f, 0xf4, 0x63, 0xc9, 0x02, 0x02, 0x5b, 0xbd, 0x58, 0xcb, 0x19,
-	0xce, 0xf7, 0xcd, 0x90, 0x33, 0xc3, 0x31, 0x28, 0xb6, 0x51, 0x0f, 0x55, 0x71, 0x98, 0x54, 0x7b,
-	0xcf, 0x02, 0x22, 0xd1, 0xb3, 0x6a, 0x8b, 0xc4, 0x44, 0x50, 0x51, 0x49, 0x38, 0x93, 0x0c, 0x16,
-	0x94, 0xbe, 0x82, 0xc3, 0xa4, 0x62, 0xf5, 0x9b, 0x45, 0xcc, 0x44, 0x87, 0x89, 0x6a, 0x80, 0x04,
-	0x19, 0x19, 0x61, 0x46, 0x63, 0x63, 0xb1, 0xb9, 0x61, 0xf4, 0xbe, 0x5e, 0x55, 0xcd, 0xc2, 0xaa,
-	0xd6, 0x5b, 0xac, 0xc5, 0x8c, 0x5c, 0x7d, 0x59, 0x69, 0xa9, 0xc5, 0x58, 0x2b, 0x22, 0x55, 0xbd,
-	0x0a, 0xba, 0xcd, 0xaa, 0xa4, 0x1d, 0x22, 0x24, 0xea, 0x24, 0x76, 0xc3, 0xe6, 0x8c, 0x8f, 0xca,
-	0x1f, 0xad, 0xdb, 0xfa, 0x23, 0x05, 0xb2, 0xaf, 0x8c, 0xc7, 0x47, 0x12, 0x49, 0x02, 0x5f, 0x80,
-	0x95, 0x04, 0x71, 0xd4, 0x11, 0xae, 0x53, 0x76, 0xb6, 0x57, 0x9f, 0xbb, 0x95, 0xe9, 0x08, 0x2a,
-	0x0d, 0xad, 0xaf, 0xa5, 0xde, 0xf5, 0x4b, 0x4b, 0x9e, 0xdd, 0x0d, 0x5f, 0x82, 0x14, 0x0e, 0x13,
-	0xe1, 0x2e, 0x97, 0xef, 0x6c, 0xaf, 0x3e, 0x7f, 0x38, 0x6b, 0xb5, 0x57, 0x6f, 0xd4, 0xd6, 0x95,
-	0xc9, 0xa0, 0x5f, 0x4a, 0xed, 0xd5, 0x1b, 0xe2, 0xed, 0x85, 0xf9, 0xf5, 0xb4, 0x21, 0x7c, 0x05,
-	0xd2, 0x21, 0x49, 0x98, 0xa0, 0x52, 0xb8, 0x77, 0x34, 0xc8, 0xc6, 0x2c, 0x48, 0xdd, 0xec, 0xa8,
-	0x15, 0x14, 0xd0, 0xdb, 0x8b, 0x52, 0xda, 0x0a, 0x84, 0x37, 0x32, 0x86, 0x5f, 0x82, 0xbc, 0x90,
-	0x88, 0x4b, 0x1a, 0xb7, 0x7c, 0x1c, 0x26, 0x3e, 0x0d, 0xdd, 0x54, 0xd9, 0xd9, 0x4e, 0xd5, 0xd6,
-	0x06, 0xfd, 0xd2, 0xfd, 0x23, 0xab, 0xda, 0x0b, 0x93, 0x83, 0xba, 0x77, 0x5f, 0x4c, 0x2c, 0x43,
-	0xf8, 0x11, 0x00, 0x21, 0x09, 0xa4, 0x1f, 0x92, 0x98, 0x75, 0xdc, 0xbb, 0x65, 0x67, 0x3b, 0xe3,
-	0x65, 0x94, 0xa4, 0xae, 0x04, 0xf0, 0x09, 0xc8, 0xb4, 0x58, 0xcf, 0x6a, 0x57, 0xb4, 0x36, 0xdd,
-	0x62, 0x3d, 0xa3, 0xfc, 0xc9, 0x01, 0x4f, 0x12, 0x4e, 0x7a, 0x94, 0x75, 0x85, 0x8f, 0x30, 0xee,
-	0x76, 0xba, 0x11, 0x92, 0x94, 0xc5, 0xbe, 0xbe, 0x0f, 0xf7, 0x9e, 0x8e, 0xe9, 0xf3, 0xd9, 0x98,
-	0xec, 0xf1, 0xef, 0x4e, 0x98, 0x1c, 0xd3, 0x0e, 0xa9, 0x95, 0x6d, 0x8c, 0xee, 0x9c, 0x0d, 0xc2,
-	0xdb, 0x18, 0xf2, 0xcd, 0xa8, 0x20, 0x07, 0x05, 0xc9, 0x24, 0x8a, 0xfc, 0x84, 0xd3, 0x18, 0xd3,
-	0x04, 0x45, 0xc2, 0x4d, 0x6b, 0x0f, 0x9e, 0xce, 0xf5, 0xe0, 0x58, 0x19, 0x34, 0x86, 0xfb, 0x6b,
-	0x45, 0xcb, 0xff, 0xe8, 0x5a, 0xb5, 0xf0, 0xf2, 0xf2, 0xaa, 0x60, 0xeb, 0xdf, 0xbb, 0x60, 0xc5,
-	0xe4, 0x06, 0x3c, 0x01, 0x6b, 0x98, 0x45, 0x11, 0x92, 0x84, 0x2b, 0x1f, 0x86, 0x09, 0xa5, 0xf8,
-	0x3f, 0xbe, 0x26, 0x35, 0x46, 0x5b, 0xb5, 0x79, 0xcd, 0xb5, 0xcc, 0x85, 0x29, 0x85, 0xf0, 0x0a,
-	0x78, 0x4a, 0x02, 0xbf, 0xb1, 0x57, 0xa6, 0x39, 0xdc, 0x65, 0x9d, 0xb3, 0x4f, 0xae, 0x4b, 0x9c,
-	0x40, 0x1a, 0x70, 0x93, 0xb6, 0xfa, 0x56, 0xb5, 0x00, 0xbe, 0x06, 0x6b, 0xad, 0x88, 0x05, 0x28,
-	0xf2, 0x35, 0x50, 0x44, 0x3b, 0x54, 0xba, 0x77, 0x34, 0xd0, 0x46, 0xc5, 0xd6, 0x9f, 0x2a, 0xd6,
-	0x09, 0x77, 0x69, 0x6c, 0x61, 0xf2, 0xc6, 0x52, 0xa1, 0x1f, 0x2a, 0x3b, 0x78, 0x0a, 0x36, 0x44,
-	0x97, 0x27, 0x91, 0xca, 0x81, 0x2e, 0x36, 0xd7, 0x7f, 0xc2, 0x89, 0x38, 0x61, 0x91, 0x49, 0xc3,
-	0x4c, 0xed, 0x2b, 0x65, 0xf9, 0x77, 0xbf, 0xf4, 0x59, 0x8b, 0xca, 0x93, 0x6e, 0x50, 0xc1, 0xac,
-	0x63, 0xcb, 0xdc, 0xfe, 0xec, 0x88, 0xb0, 0x5d, 0x95, 0x67, 0x09, 0x11, 0x95, 0x83, 0x58, 0xfe,
-	0xf9, 0xfb, 0x0e, 0xb0, 0x5e, 0x1c, 0xc4, 0xd2, 0x7b, 0x6c, 0xe1, 0x77, 0x0d, 0xfa, 0xf1, 0x10,
-	0x1c, 0x46, 0xe0, 0xc1, 0x34, 0x73, 0xc4, 0xa4, 0x49, 0xe2, 0x5b, 0x72, 0xae, 0x5d, 0xe5, 0x3c,
-	0x64, 0x12, 0x72, 0xf0, 0x48, 0x9f, 0xd6, 0x6c, 0x90, 0x2b, 0x0b, 0x20, 0x5c, 0x57, 0xd8, 0x33,
-	0x11, 0x36, 0x41, 0xe1, 0x0a, 0xa7, 0x0a, 0xef, 0xde, 0x02, 0xd8, 0x72, 0x13, 0x6c, 0x2a, 0xb6,
-	0xa7, 0x20, 0x8f, 0x29, 0xc7, 0x5d, 0x2a, 0xfd, 0x80, 0x13, 0xd4, 0x26, 0xdc, 0x4d, 0x97, 0x9d,
-	0xed, 0xb4, 0x97, 0xb3, 0xe2, 0x9a, 0x91, 0x6e, 0xfd, 0xba, 0x0c, 0x32, 0xa3, 0xc4, 0x82, 0xeb,
-	0xe0, 0xae, 0xe9, 0x0c, 0x8e, 0xee, 0x0c, 0x66, 0xa1, 0xc0, 0x38, 0x69, 0x12, 0x4e, 0x62, 0x4c,
-	0x7c, 0x24, 0x04, 0x91, 0x3a, 0x49, 0x33, 0x5e, 0x6e, 0x24, 0xde, 0x55, 0x52, 0x48, 0x55, 0xc9,
-	0xc4, 0x3d, 0xc2, 0x85, 0x8a, 0xad, 0x89, 0xb0, 0x64, 0x5c, 0xa7, 0xe1, 0x6d, 0xc3, 0x2b, 0x8c,
-	0x61, 0xf7, 0x35, 0x2a, 0xfc, 0xde, 0xd6, 0x4c, 0x33, 0x62, 0x8c, 0x2f, 0x24, 0x2b, 0x75, 0x39,
-	0xed, 0x2b, 0xb8, 0xad, 0x5f, 0xd2, 0x20, 0x3f, 0x55, 0xb7, 0x73, 0x8e, 0x06, 0x82, 0x94, 0xc2,
-	0xb3, 0xe7, 0xa1, 0xbf, 0xd5, 0x29, 0x44, 0xf4, 0x87, 0x2e, 0x0d, 0x4d, 0xeb, 0xe4, 0xea, 0xe7,
-	0x06, 0xa7, 0x50, 0x27, 0x78, 0xc2, 0xc3, 0x3a, 0xc1, 0x5e, 0x61, 0x02, 0xd6, 0x53, 0x7f, 0xe1,
-	0xd7, 0xf6, 0x14, 0x4c, 0xc1, 0xa7, 0x3e, 0xac, 0xe0, 0x75, 0xa0, 0xa6, 0xd4, 0x11, 0x50, 0xaf,
-	0x47, 0x40, 0x23, 0x2a, 0xcf, 0xfc, 0x26, 0x21, 0x37, 0x28, 0xb5, 0x59, 0x37, 0xb3, 0x23, 0xc8,
-	0x7d, 0x42, 0xa0, 0x0f, 0xb2, 0xc3, 0x64, 0x17, 0xf4, 0x9c, 0x2c, 0xa4, 0xb6, 0x56, 0x2d, 0xe2,
-	0x11, 0x3d, 0x27, 0xb0, 0x03, 0x1e, 0x4c, 0x1e, 0x77, 0x42, 0x62, 0x14, 0xc9, 0xb3, 0x1b, 0x54,
-	0xd5, 0x6c, 0x24, 0x70, 0x02, 0xb8, 0x61, 0x70, 0xe1, 0x0b, 0x90, 0x13, 0x09, 0x93, 0x7e, 0x07,
-	0xf1, 0x36, 0x91, 0xea, 0x65, 0x4e, 0x6b, 0xa6, 0xc2, 0xa0, 0x5f, 0xca, 0x1e, 0x25, 0x4c, 0x7e,
-	0xab, 0x15, 0x07, 0x75, 0x2f, 0x2b, 0xc6, 0xab, 0x10, 0xbe, 0x06, 0x0f, 0x27, 0xdd, 0x1c, 0x9b,
-	0x67, 0xb4, 0xf9, 0xe3, 0x41, 0xbf, 0xf4, 0xe0, 0x70, 0xbc, 0x61, 0x84, 0x32, 0x19, 0xdc, 0x08,
-	0xac, 0x07, 0xdc, 0x36, 0x21, 0x09, 0xe1, 0x3e, 0x27, 0x3f, 0x22, 0x1e, 0xfa, 0x09, 0xe1, 0x98,
-	0xc4, 0x12, 0xb5, 0x88, 0x0b, 0x16, 0x10, 0xf8, 0x23, 0x83, 0xee, 0x69, 0xf0, 0xc6, 0x08, 0x5b,
-	0x0d, 0x08, 0x9f, 0xe0, 0x13, 0x82, 0xdb, 0xfe, 0xf8, 0x11, 0xa3, 0xe7, 0x26, 0x22, 0x1a, 0x87,
-	0xe4, 0xd4, 0xc7, 0xac, 0x1b, 0x4b, 0x77, 0x75, 0x01, 0x97, 0x5c, 0xd6, 0x44, 0x7b, 0xd3, 0x3c,
-	0x07, 0x8a, 0x66, 0x4f, 0xb1, 0x5c, 0xdf, 0x6e, 0xb2, 0xff, 0x47, 0xbb, 0xd9, 0xfa, 0x79, 0x19,
-	0x3c, 0x9e, 0x33, 0xc3, 0xe8, 0x5e, 0x3b, 0x1e, 0x14, 0x74, 0x3b, 0x30, 0x3d, 0x22, 0x37, 0x16,
-	0x1f, 0xab, 0xc6, 0x10, 0x80, 0xcd, 0xf9, 0xd3, 0x95, 0x7d, 0xf7, 0x37, 0x2b, 0x66, 0x14, 0xae,
-	0x0c, 0x47, 0xe1, 0xca, 0xf1, 0x70, 0x14, 0xae, 0xa5, 0x55, 0x50, 0x6f, 0x2e, 0x4a, 0x8e, 0xe7,
-	0xce, 0x9b, 0x9a, 0x20, 0x01, 0x79, 0x1a, 0x4b, 0xc2, 0x89, 0x90, 0x37, 0x6f, 0xc0, 0xb3, 0x09,
-	0x91, 0x1b, 0x82, 0xda, 0xf3, 0xf8, 0xcd, 0x01, 0x0f, 0xaf, 0x9d, 0xa9, 0x3e, 0xfc, 0x34, 0x08,
-	0xc8, 0x4f, 0x8d, 0x77, 0xa6, 0x8b, 0xde, 0xf6, 0x25, 0xbc, 0x3a, 0xd2, 0xd5, 0x5e, 0xbe, 0x1b,
-	0x14, 0x9d, 0xf7, 0x83, 0xa2, 0xf3, 0xcf, 0xa0, 0xe8, 0xbc, 0xb9, 0x2c, 0x2e, 0xbd, 0xbf, 0x2c,
-	0x2e, 0xfd, 0x75, 0x59, 0x5c, 0xfa, 0xee, 0xd3, 0x09, 0x7c, 0x35, 0x6c, 0xed, 0x44, 0x28, 0x10,
-	0xfa, 0xab, 0x7a, 0xaa, 0xff, 0xd5, 0xd0, 0x14, 0xc1, 0x8a, 0xbe, 0x89, 0x2f, 0xfe, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0xe6, 0x31, 0x9b, 0x4e, 0x27, 0x0d, 0x00, 0x00,
+	// 1467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x36, 0x65, 0xd9, 0x91, 0xc6, 0xb6, 0x24, 0x8f, 0x2f, 0xa1, 0x1d, 0xfc, 0x92, 0xa2, 0xe0,
+	0x6f, 0x5c, 0x14, 0x91, 0x90, 0x04, 0x08, 0x50, 0xa0, 0x68, 0x1a, 0x59, 0x48, 0x60, 0x24, 0x2e,
+	0x04, 0xda, 0xab, 0x76, 0x41, 0x8c, 0xc8, 0x31, 0x3d, 0x30, 0xc9, 0x61, 0x38, 0x23, 0xd5, 0xc9,
+	0xae, 0xeb, 0xa2, 0x40, 0x90, 0x67, 0x28, 0x50, 0x20, 0xeb, 0x3e, 0x44, 0x36, 0x05, 0x82, 0xae,
+	0x8a, 0x2e, 0x94, 0x56, 0x79, 0x87, 0x6e, 0x5b, 0xcc, 0x45, 0x12, 0x75, 0x03, 0xd2, 0x80, 0xd9,
+	0x48, 0x9c, 0x73, 0xe6, 0x7c, 0xe7, 0xc2, 0x73, 0x3e, 0x72, 0x08, 0xca, 0x17, 0xa8, 0x87, 0x1a,
+	0x8e, 0x1b, 0x35, 0x7a, 0xb7, 0x3b, 0x98, 0xa3, 0xdb, 0x0d, 0x0f, 0x87, 0x98, 0x11, 0x56, 0x8f,
+	0x62, 0xca, 0x29, 0x2c, 0x09, 0x7d, 0xdd, 0x71, 0xa3, 0xba, 0xd6, 0xef, 0x97, 0x1d, 0xca, 0x02,
+	0xca, 0x1a, 0x1d, 0xc4, 0xf0, 0xc8, 0xc8, 0xa1, 0x24, 0x54, 0x16, 0xfb, 0x7b, 0x4a, 0x6f, 0xcb,
+	0x55, 0x43, 0x2d, 0xb4, 0x6a, 0xdb, 0xa3, 0x1e, 0x55, 0x72, 0x71, 0xa5, 0xa5, 0x15, 0x8f, 0x52,
+	0xcf, 0xc7, 0x0d, 0xb9, 0xea, 0x74, 0xcf, 0x1a, 0x9c, 0x04, 0x98, 0x71, 0x14, 0x44, 0x7a, 0xc3,
+	0xfe, 0x4c, 0x8c, 0x8e, 0xab, 0x75, 0xb5, 0x5f, 0x57, 0xc0, 0xfa, 0x23, 0x15, 0xf1, 0x09, 0x47,
+	0x1c, 0xc3, 0x7b, 0x60, 0x35, 0x42, 0x31, 0x0a, 0x98, 0x69, 0x54, 0x8d, 0x83, 0xb5, 0x3b, 0x66,
+	0x7d, 0x3a, 0x83, 0x7a, 0x5b, 0xea, 0x9b, 0xd9, 0xd7, 0xfd, 0xca, 0x92, 0xa5, 0x77, 0xc3, 0xfb,
+	0x20, 0xeb, 0xb8, 0x11, 0x33, 0x33, 0xd5, 0xe5, 0x83, 0xb5, 0x3b, 0x3b, 0xb3, 0x56, 0x87, 0xad,
+	0x76, 0x73, 0x5b, 0x98, 0x0c, 0xfa, 0x95, 0xec, 0x61, 0xab, 0xcd, 0x5e, 0xbd, 0x55, 0xff, 0x96,
+	0x34, 0x84, 0x8f, 0x40, 0xce, 0xc5, 0x11, 0x65, 0x84, 0x33, 0x73, 0x59, 0x82, 0xec, 0xcd, 0x82,
+	0xb4, 0xd4, 0x8e, 0x66, 0x49, 0x00, 0xbd, 0x7a, 0x5b, 0xc9, 0x69, 0x01, 0xb3, 0x46, 0xc6, 0xf0,
+	0x73, 0x50, 0x64, 0x1c, 0xc5, 0x9c, 0x84, 0x9e, 0xed, 0xb8, 0x91, 0x4d, 0x5c, 0x33, 0x5b, 0x35,
+	0x0e, 0xb2, 0xcd, 0xcd, 0x41, 0xbf, 0xb2, 0x71, 0xa2, 0x55, 0x87, 0x6e, 0x74, 0xd4, 0xb2, 0x36,
+	0x58, 0x62, 0xe9, 0xc2, 0xff, 0x01, 0xe0, 0xe2, 0x0e, 0xb7, 0x5d, 0x1c, 0xd2, 0xc0, 0x5c, 0xa9,
+	0x1a, 0x07, 0x79, 0x2b, 0x2f, 0x24, 0x2d, 0x21, 0x80, 0xd7, 0x40, 0xde, 0xa3, 0x3d, 0xad, 0x5d,
+	0x95, 0xda, 0x9c, 0x47, 0x7b, 0x4a, 0xf9, 0x83, 0x01, 0xae, 0x45, 0x31, 0xee, 0x11, 0xda, 0x65,
+	0x36, 0x72, 0x9c, 0x6e, 0xd0, 0xf5, 0x11, 0x27, 0x34, 0xb4, 0xe5, 0xfd, 0x30, 0xaf, 0xc8, 0x9c,
+	0x3e, 0x9d, 0xcd, 0x49, 0x97, 0xff, 0x41, 0xc2, 0xe4, 0x94, 0x04, 0xb8, 0x59, 0xd5, 0x39, 0x9a,
+	0x0b, 0x36, 0x30, 0x6b, 0x6f, 0xe8, 0x6f, 0x46, 0x05, 0x63, 0x50, 0xe2, 0x94, 0x23, 0xdf, 0x8e,
+	0x62, 0x12, 0x3a, 0x24, 0x42, 0x3e, 0x33, 0x73, 0x32, 0x82, 0x9b, 0x0b, 0x23, 0x38, 0x15, 0x06,
+	0xed, 0xe1, 0xfe, 0x66, 0x59, 0xfb, 0xdf, 0x9d, 0xab, 0x66, 0x56, 0x91, 0x4f, 0x0a, 0xe0, 0xf7,
+	0x06, 0xd8, 0x8e, 0xb0, 0x67, 0x33, 0x8e, 0x3a, 0xc4, 0x27, 0xfc, 0x99, 0x1d, 0x90, 0x90, 0x63,
+	0xd7, 0xcc, 0x4b, 0xc7, 0x9f, 0x2d, 0x74, 0xdc, 0xc6, 0xde, 0xc9, 0xd0, 0xe6, 0x58, 0x9a, 0x34,
+	0x6b, 0xda, 0xf9, 0xfe, 0xc2, 0x2d, 0xcc, 0x82, 0xd1, 0x8c, 0xb0, 0xf6, 0xd7, 0x2a, 0x58, 0x55,
+	0xfd, 0x09, 0xcf, 0xc1, 0xa6, 0x43, 0x7d, 0x1f, 0x71, 0x1c, 0x8b, 0x3a, 0x0c, 0x9b, 0x5a, 0x84,
+	0x72, 0x7d, 0x4e, 0x7b, 0x8e, 0xb6, 0x4a, 0xf3, 0xa6, 0xa9, 0x03, 0x28, 0x4d, 0x29, 0x98, 0x55,
+	0x72, 0xa6, 0x24, 0xf0, 0x2b, 0xdd, 0x36, 0xd2, 0x87, 0x99, 0x91, 0x73, 0x73, 0x6d, 0x5e, 0xf3,
+	0x76, 0xb8, 0x02, 0x57, 0xa3, 0x93, 0x77, 0x87, 0x02, 0xf8, 0x18, 0x6c, 0x7a, 0x3e, 0xed, 0x20,
+	0xdf, 0x96, 0x40, 0x3e, 0x09, 0x08, 0x37, 0x97, 0x25, 0xd0, 0x5e, 0x5d, 0x73, 0x80, 0x20, 0x8c,
+	0x44, 0xb8, 0x24, 0xd4, 0x30, 0x45, 0x65, 0x29, 0xd0, 0x9f, 0x08, 0x3b, 0x78, 0x09, 0xf6, 0x58,
+	0x37, 0x8e, 0x7c, 0xd1, 0x87, 0x5d, 0x47, 0xb5, 0xe0, 0x79, 0x8c, 0xd9, 0x39, 0xf5, 0xd5, 0x28,
+	0xe4, 0x9b, 0x5f, 0x08, 0xcb, 0x3f, 0xfa, 0x95, 0x4f, 0x3c, 0xc2, 0xcf, 0xbb, 0x9d, 0xba, 0x43,
+	0x03, 0x4d, 0x35, 0xfa, 0xef, 0x16, 0x73, 0x2f, 0x1a, 0xfc, 0x59, 0x84, 0x59, 0xfd, 0x28, 0xe4,
+	0xbf, 0xfd, 0x72, 0x0b, 0xe8, 0x28, 0x8e, 0x42, 0x6e, 0x5d, 0xd5, 0xf0, 0x0f, 0x14, 0xfa, 0xe9,
+	0x10, 0x1c, 0xfa, 0x60, 0x6b, 0xda, 0xb3, 0x4f, 0xb9, 0xb9, 0x92, 0x82, 0xcf, 0xcd, 0x49, 0x9f,
+	0x4f, 0x28, 0x87, 0x31, 0xd8, 0x95, 0xd5, 0x9a, 0x4d, 0x72, 0x35, 0x05, 0x87, 0xdb, 0x02, 0x7b,
+	0x26, 0xc3, 0x33, 0x50, 0x9a, 0xf0, 0x29, 0xd2, 0xbb, 0x92, 0x82, 0xb7, 0x42, 0xc2, 0x9b, 0xc8,
+	0xed, 0x26, 0x28, 0x3a, 0x24, 0x76, 0xba, 0x84, 0xdb, 0x9d, 0x18, 0xa3, 0x0b, 0x1c, 0x9b, 0xb9,
+	0xaa, 0x71, 0x90, 0xb3, 0x0a, 0x5a, 0xdc, 0x54, 0x52, 0xf8, 0x74, 0x7a, 0xe6, 0x74, 0xa3, 0xab,
+	0x99, 0xbb, 0x31, 0x87, 0xbd, 0x13, 0x43, 0xa3, 0xba, 0x71, 0x5f, 0xb7, 0x3a, 0x9c, 0x51, 0x4d,
+	0xcd, 0x98, 0x92, 0xd5, 0x5e, 0x66, 0x40, 0x7e, 0xd4, 0xcb, 0x70, 0x1b, 0xac, 0x28, 0x42, 0x34,
+	0x24, 0x21, 0xaa, 0x85, 0x88, 0x3f, 0xc6, 0x67, 0x38, 0xc6, 0xa1, 0x83, 0x6d, 0xc4, 0x18, 0xe6,
+	0x72, 0x2e, 0xf2, 0x56, 0x61, 0x24, 0x7e, 0x20, 0xa4, 0x90, 0x88, 0x29, 0x0d, 0x7b, 0x38, 0x66,
+	0xa2, 0x9c, 0x67, 0xc8, 0xe1, 0x34, 0x36, 0x97, 0x53, 0xa8, 0x68, 0x69, 0x0c, 0xfb, 0x50, 0xa2,
+	0xc2, 0x6f, 0xf5, 0x98, 0x9e, 0xf9, 0x94, 0xc6, 0xa9, 0x0c, 0x82, 0x9c, 0xe0, 0x87, 0x02, 0xae,
+	0xf6, 0x4f, 0x1e, 0x14, 0xa7, 0xa8, 0x62, 0x41, 0x69, 0x20, 0xc8, 0x0a, 0x3c, 0x5d, 0x0f, 0x79,
+	0x2d, 0xaa, 0xe0, 0x93, 0xa7, 0x5d, 0xe2, 0xaa, 0x27, 0x46, 0x2c, 0xfe, 0x3e, 0xa0, 0x0a, 0x2d,
+	0xec, 0x24, 0x22, 0x6c, 0x61, 0xc7, 0x2a, 0x25, 0x60, 0x2d, 0xf1, 0x0b, 0xbf, 0x04, 0x20, 0xc1,
+	0x31, 0xd9, 0xf7, 0xe3, 0x98, 0xbc, 0x3b, 0x62, 0x17, 0x04, 0x36, 0xc6, 0xcd, 0x76, 0x86, 0xb1,
+	0xb9, 0x92, 0x42, 0x98, 0xeb, 0x23, 0xc8, 0x87, 0x18, 0x43, 0x1b, 0xac, 0x0f, 0xe7, 0x8b, 0x91,
+	0xe7, 0x38, 0x95, 0x71, 0x5e, 0xd3, 0x88, 0x27, 0xe4, 0x39, 0x86, 0x01, 0xd8, 0x4a, 0x96, 0x3b,
+	0xc2, 0x21, 0xf2, 0xf9, 0x33, 0xf3, 0x4a, 0x0a, 0x99, 0xc0, 0x04, 0x70, 0x5b, 0xe1, 0xc2, 0x7b,
+	0xa0, 0xc0, 0x22, 0xca, 0xed, 0x00, 0xc5, 0x17, 0x98, 0x8b, 0x17, 0x92, 0x9c, 0xf4, 0x54, 0x1a,
+	0xf4, 0x2b, 0xeb, 0x27, 0x11, 0xe5, 0xc7, 0x52, 0x71, 0xd4, 0xb2, 0xd6, 0xd9, 0x78, 0xe5, 0xc2,
+	0xc7, 0x60, 0x27, 0x19, 0xe6, 0xd8, 0x3c, 0x2f, 0xcd, 0xaf, 0x0e, 0xfa, 0x95, 0xad, 0x27, 0xe3,
+	0x0d, 0x23, 0x94, 0x2d, 0x7f, 0x46, 0xe8, 0xc2, 0x1e, 0x30, 0x2f, 0x30, 0x8e, 0x70, 0x6c, 0xc7,
+	0xf8, 0x3b, 0x14, 0xbb, 0x76, 0x84, 0x63, 0x07, 0x87, 0x1c, 0x79, 0xd8, 0x04, 0x29, 0x24, 0xbe,
+	0xab, 0xd0, 0x2d, 0x09, 0xde, 0x1e, 0x61, 0x8b, 0xf7, 0xa2, 0x1b, 0xce, 0x39, 0x76, 0x2e, 0xec,
+	0xf1, 0x73, 0x93, 0x3c, 0x57, 0x19, 0x91, 0xd0, 0xc5, 0x97, 0xb6, 0x43, 0xbb, 0x21, 0x37, 0xd7,
+	0x52, 0xb8, 0xc9, 0x55, 0xe9, 0xe8, 0x70, 0xda, 0xcf, 0x91, 0x70, 0x73, 0x28, 0xbc, 0xcc, 0xa7,
+	0x9b, 0xf5, 0x8f, 0x42, 0x37, 0x27, 0x60, 0x6b, 0x62, 0x50, 0xec, 0x80, 0xba, 0xd8, 0x37, 0x37,
+	0xaa, 0xc6, 0x7c, 0x62, 0x3e, 0x49, 0x8c, 0xc0, 0xb1, 0xd8, 0x6a, 0x6d, 0xb2, 0x69, 0x11, 0xbc,
+	0x3e, 0x1e, 0x0d, 0x49, 0x22, 0x05, 0x49, 0x22, 0xc3, 0xe6, 0x3e, 0x15, 0x5c, 0xd2, 0x03, 0x66,
+	0xb2, 0x6b, 0x38, 0x8a, 0x3d, 0xcc, 0x35, 0xa5, 0x14, 0x47, 0x99, 0x1a, 0x1f, 0x7e, 0xa3, 0x13,
+	0xe8, 0xa7, 0x12, 0x5c, 0x12, 0x4b, 0xed, 0xa7, 0x0c, 0xd8, 0x9c, 0x79, 0x82, 0x2c, 0xe0, 0xc0,
+	0x49, 0x12, 0xca, 0xfc, 0x67, 0x12, 0xfa, 0x1a, 0x2c, 0x0b, 0xea, 0x49, 0x83, 0x21, 0x05, 0xd0,
+	0xfc, 0xb6, 0xc8, 0x7e, 0x8c, 0xb6, 0xa8, 0xfd, 0xbd, 0x0c, 0x36, 0x67, 0x6e, 0xb5, 0x78, 0x59,
+	0x0d, 0x48, 0x68, 0x4f, 0x32, 0xab, 0x91, 0x42, 0x7a, 0xc5, 0x80, 0x84, 0x49, 0x77, 0xd2, 0x13,
+	0xba, 0x9c, 0xf2, 0x94, 0x49, 0xc5, 0x13, 0xba, 0x9c, 0xf0, 0xc4, 0xc0, 0x6e, 0x97, 0x93, 0xd1,
+	0xb0, 0x07, 0x5d, 0x9f, 0x93, 0xc8, 0x27, 0x38, 0x4e, 0xe5, 0xbe, 0xed, 0x24, 0xb0, 0x8f, 0x47,
+	0xd0, 0xf0, 0x2e, 0xd8, 0x10, 0xef, 0x43, 0x63, 0xae, 0x54, 0x77, 0xb1, 0x38, 0xe8, 0x57, 0xd6,
+	0xda, 0xd8, 0x1b, 0x71, 0xe4, 0x5a, 0x34, 0x5a, 0xb8, 0xd0, 0x01, 0x05, 0x69, 0x34, 0x8e, 0x30,
+	0x8d, 0x87, 0x9a, 0x08, 0x64, 0x1c, 0x59, 0xed, 0xc7, 0x0c, 0xb8, 0xba, 0xe0, 0x28, 0x27, 0x5f,
+	0xf7, 0xc6, 0x67, 0x15, 0x39, 0xd9, 0x6a, 0x5e, 0x0a, 0x63, 0xb1, 0x1c, 0xee, 0x0e, 0xd8, 0x5f,
+	0x7c, 0xc8, 0xd4, 0x83, 0xb4, 0x5f, 0x57, 0x5f, 0x04, 0xea, 0xc3, 0x2f, 0x02, 0xf5, 0xd3, 0xe1,
+	0x17, 0x81, 0x66, 0x4e, 0x64, 0xf4, 0xe2, 0x6d, 0xc5, 0xb0, 0xcc, 0x45, 0x87, 0x47, 0x88, 0x41,
+	0x91, 0x84, 0x1c, 0xc7, 0x98, 0xf1, 0x0f, 0x7f, 0x21, 0x9b, 0x2d, 0x47, 0x61, 0x08, 0xaa, 0x07,
+	0xe1, 0x67, 0x03, 0xec, 0xcc, 0x3d, 0x5a, 0xbe, 0x7f, 0x35, 0x30, 0x28, 0x4e, 0x9d, 0x72, 0xcd,
+	0x4c, 0x0a, 0x43, 0x5b, 0x98, 0x3c, 0xd9, 0xd6, 0x5e, 0x1a, 0x60, 0x6f, 0xe1, 0x39, 0x74, 0x01,
+	0xc3, 0xd9, 0x60, 0x5d, 0x85, 0xa6, 0xcf, 0xc0, 0x69, 0xc4, 0xb5, 0x26, 0x11, 0xf5, 0x09, 0xf9,
+	0xfe, 0xeb, 0x41, 0xd9, 0x78, 0x33, 0x28, 0x1b, 0x7f, 0x0e, 0xca, 0xc6, 0x8b, 0x77, 0xe5, 0xa5,
+	0x37, 0xef, 0xca, 0x4b, 0xbf, 0xbf, 0x2b, 0x2f, 0x7d, 0xf3, 0xff, 0x04, 0xb8, 0x78, 0xca, 0xdc,
+	0xf2, 0x51, 0x87, 0xc9, 0xab, 0xc6, 0xa5, 0xfc, 0x0c, 0x24, 0xf1, 0x3b, 0xab, 0xb2, 0x3d, 0xee,
+	0xfe, 0x3b, 0x00, 0xb0, 0xba, 0xce, 0xcf, 0xc3, 0x12, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -553,6 +774,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PegStabilityMinted) > 0 {
+		for iNdEx := len(m.PegStabilityMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PegStabilityMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.TotalPrincipals) > 0 {
 		for iNdEx := len(m.TotalPrincipals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -661,6 +896,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PegStabilityParams) > 0 {
+		for iNdEx := len(m.PegStabilityParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PegStabilityParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.CircuitBreaker {
 		i--
 		if m.CircuitBreaker {
@@ -825,6 +1074,37 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LiquidationTargetRatio != nil {
+		{
+			size := m.LiquidationTargetRatio.Size()
+			i -= size
+			if _, err := m.LiquidationTargetRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.StabilityFeeModel != nil {
+		{
+			size, err := m.StabilityFeeModel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	{
 		size := m.ConversionFactor.Size()
 		i -= size
@@ -936,6 +1216,136 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PegStabilityParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PegStabilityParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PegStabilityParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionFactor.Size()
+		i -= size
+		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DebtLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StabilityFeeModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StabilityFeeModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StabilityFeeModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PegMultiplier.Size()
+		i -= size
+		if _, err := m.PegMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PegMarketID) > 0 {
+		i -= len(m.PegMarketID)
+		copy(dAtA[i:], m.PegMarketID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PegMarketID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.UtilizationMultiplier.Size()
+		i -= size
+		if _, err := m.UtilizationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxStabilityFee.Size()
+		i -= size
+		if _, err := m.MaxStabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinStabilityFee.Size()
+		i -= size
+		if _, err := m.MinStabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisAccumulationTime) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -966,12 +1376,12 @@ func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	i--
 	dAtA[i] = 0x1a
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
 	if len(m.CollateralType) > 0 {
@@ -1024,14 +1434,54 @@ func (m *GenesisTotalPrincipal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
+func (m *GenesisPegStabilityMinted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPegStabilityMinted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPegStabilityMinted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
 	dAtA[offset] = uint8(v)
 	return base
 }
@@ -1078,6 +1528,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PegStabilityMinted) > 0 {
+		for _, e := range m.PegStabilityMinted {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1108,6 +1564,12 @@ func (m *Params) Size() (n int) {
 	if m.CircuitBreaker {
 		n += 2
 	}
+	if len(m.PegStabilityParams) > 0 {
+		for _, e := range m.PegStabilityParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1170,6 +1632,58 @@ func (m *CollateralParam) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ConversionFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.StabilityFeeModel != nil {
+		l = m.StabilityFeeModel.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LiquidationTargetRatio != nil {
+		l = m.LiquidationTargetRatio.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PegStabilityParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.DebtLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *StabilityFeeModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinStabilityFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxStabilityFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.UtilizationMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.PegMarketID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.PegMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1205,6 +1719,21 @@ func (m *GenesisTotalPrincipal) Size() (n int) {
 	return n
 }
 
+func (m *GenesisPegStabilityMinted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1492,6 +2021,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegStabilityMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PegStabilityMinted = append(m.PegStabilityMinted, GenesisPegStabilityMinted{})
+			if err := m.PegStabilityMinted[len(m.PegStabilityMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1798,6 +2361,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CircuitBreaker = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegStabilityParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PegStabilityParams = append(m.PegStabilityParams, PegStabilityParam{})
+			if err := m.PegStabilityParams[len(m.PegStabilityParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2429,61 +3026,11 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisAccumulationTime: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisAccumulationTime: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFeeModel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2493,29 +3040,33 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			if m.StabilityFeeModel == nil {
+				m.StabilityFeeModel = &StabilityFeeModel{}
+			}
+			if err := m.StabilityFeeModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccumulationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2525,28 +3076,27 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAccumulationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationTargetRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2574,7 +3124,9 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LiquidationTargetRatio = &v
+			if err := m.LiquidationTargetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2599,7 +3151,7 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisTotalPrincipal) Unmarshal(dAtA []byte) error {
+func (m *PegStabilityParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2622,15 +3174,15 @@ func (m *GenesisTotalPrincipal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisTotalPrincipal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PegStabilityParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisTotalPrincipal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PegStabilityParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2658,11 +3210,561 @@ func (m *GenesisTotalPrincipal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StabilityFeeModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StabilityFeeModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StabilityFeeModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilizationMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UtilizationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PegMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PegMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccumulationTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccumulationTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccumulationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAccumulationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisTotalPrincipal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisTotalPrincipal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisTotalPrincipal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2715,6 +3817,122 @@ func (m *GenesisTotalPrincipal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisPegStabilityMinted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPegStabilityMinted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPegStabilityMinted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
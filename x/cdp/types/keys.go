@@ -25,6 +25,9 @@ const (
 
 	// LiquidatorMacc module account for liquidator
 	LiquidatorMacc = "liquidator"
+
+	// PegStabilityMacc module account holding the peg stability module reserves
+	PegStabilityMacc = "peg_stability"
 )
 
 var sep = []byte(":")
@@ -59,6 +62,7 @@ var (
 	PricefeedStatusKeyPrefix   = []byte{0x10}
 	PreviousAccrualTimePrefix  = []byte{0x12}
 	InterestFactorPrefix       = []byte{0x13}
+	PegStabilityMintedPrefix   = []byte{0x14}
 )
 
 // GetCdpIDBytes returns the byte representation of the cdpID
@@ -17,6 +17,9 @@ var (
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgTransferCDP{}
+	_ sdk.Msg = &MsgPegStabilityMint{}
+	_ sdk.Msg = &MsgPegStabilityRedeem{}
 )
 
 // NewMsgCreateCDP returns a new MsgPlaceBid.
@@ -70,12 +73,13 @@ func (msg MsgCreateCDP) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgDeposit returns a new MsgDeposit
-func NewMsgDeposit(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) MsgDeposit {
+func NewMsgDeposit(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64) MsgDeposit {
 	return MsgDeposit{
 		Owner:          owner.String(),
 		Depositor:      depositor.String(),
 		Collateral:     collateral,
 		CollateralType: collateralType,
+		CdpID:          cdpID,
 	}
 }
 
@@ -121,12 +125,13 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgWithdraw returns a new MsgDeposit
-func NewMsgWithdraw(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) MsgWithdraw {
+func NewMsgWithdraw(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64) MsgWithdraw {
 	return MsgWithdraw{
 		Owner:          owner.String(),
 		Depositor:      depositor.String(),
 		Collateral:     collateral,
 		CollateralType: collateralType,
+		CdpID:          cdpID,
 	}
 }
 
@@ -172,11 +177,12 @@ func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgDrawDebt returns a new MsgDrawDebt
-func NewMsgDrawDebt(sender sdk.AccAddress, collateralType string, principal sdk.Coin) MsgDrawDebt {
+func NewMsgDrawDebt(sender sdk.AccAddress, collateralType string, principal sdk.Coin, cdpID uint64) MsgDrawDebt {
 	return MsgDrawDebt{
 		Sender:         sender.String(),
 		CollateralType: collateralType,
 		Principal:      principal,
+		CdpID:          cdpID,
 	}
 }
 
@@ -218,11 +224,12 @@ func (msg MsgDrawDebt) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgRepayDebt returns a new MsgRepayDebt
-func NewMsgRepayDebt(sender sdk.AccAddress, collateralType string, payment sdk.Coin) MsgRepayDebt {
+func NewMsgRepayDebt(sender sdk.AccAddress, collateralType string, payment sdk.Coin, cdpID uint64) MsgRepayDebt {
 	return MsgRepayDebt{
 		Sender:         sender.String(),
 		CollateralType: collateralType,
 		Payment:        payment,
+		CdpID:          cdpID,
 	}
 }
 
@@ -264,11 +271,12 @@ func (msg MsgRepayDebt) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgLiquidate returns a new MsgLiquidate
-func NewMsgLiquidate(keeper, borrower sdk.AccAddress, ctype string) MsgLiquidate {
+func NewMsgLiquidate(keeper, borrower sdk.AccAddress, ctype string, cdpID uint64) MsgLiquidate {
 	return MsgLiquidate{
 		Keeper:         keeper.String(),
 		Borrower:       borrower.String(),
 		CollateralType: ctype,
+		CdpID:          cdpID,
 	}
 }
 
@@ -309,3 +317,145 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgTransferCDP returns a new MsgTransferCDP
+func NewMsgTransferCDP(sender, newOwner sdk.AccAddress, collateralType string, cdpID uint64) MsgTransferCDP {
+	return MsgTransferCDP{
+		Sender:         sender.String(),
+		NewOwner:       newOwner.String(),
+		CollateralType: collateralType,
+		CdpID:          cdpID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgTransferCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgTransferCDP) Type() string { return "transfer_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTransferCDP) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address %s", err)
+	}
+	if sender.Equals(newOwner) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new owner cannot be the sender")
+	}
+
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return sdkerrors.Wrap(ErrInvalidCollateral, "collateral type cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTransferCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTransferCDP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgPegStabilityMint returns a new MsgPegStabilityMint
+func NewMsgPegStabilityMint(sender sdk.AccAddress, amount sdk.Coin) MsgPegStabilityMint {
+	return MsgPegStabilityMint{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPegStabilityMint) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPegStabilityMint) Type() string { return "peg_stability_mint" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPegStabilityMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPegStabilityMint) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPegStabilityMint) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgPegStabilityRedeem returns a new MsgPegStabilityRedeem
+func NewMsgPegStabilityRedeem(sender sdk.AccAddress, amount sdk.Coin, denom string) MsgPegStabilityRedeem {
+	return MsgPegStabilityRedeem{
+		Sender: sender.String(),
+		Amount: amount,
+		Denom:  denom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPegStabilityRedeem) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPegStabilityRedeem) Type() string { return "peg_stability_redeem" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPegStabilityRedeem) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s", msg.Amount)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "denom %s", msg.Denom)
+	}
+	if msg.Denom == msg.Amount.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "denom cannot be the amount denom")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPegStabilityRedeem) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPegStabilityRedeem) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -70,8 +70,8 @@ func TestMsgDeposit(t *testing.T) {
 			tc.sender,
 			tc.depositor,
 			tc.collateral,
-			tc.collateralType,
-		)
+			tc.collateralType, 0)
+
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
 		} else {
@@ -102,8 +102,8 @@ func TestMsgWithdraw(t *testing.T) {
 			tc.sender,
 			tc.depositor,
 			tc.collateral,
-			tc.collateralType,
-		)
+			tc.collateralType, 0)
+
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
 		} else {
@@ -130,8 +130,8 @@ func TestMsgDrawDebt(t *testing.T) {
 		msg := NewMsgDrawDebt(
 			tc.sender,
 			tc.collateralType,
-			tc.principal,
-		)
+			tc.principal, 0)
+
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
 		} else {
@@ -158,8 +158,91 @@ func TestMsgRepayDebt(t *testing.T) {
 		msg := NewMsgRepayDebt(
 			tc.sender,
 			tc.denom,
-			tc.payment,
-		)
+			tc.payment, 0)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgTransferCDP(t *testing.T) {
+	tests := []struct {
+		description    string
+		sender         sdk.AccAddress
+		newOwner       sdk.AccAddress
+		collateralType string
+		expectPass     bool
+	}{
+		{"transfer", addrs[0], addrs[1], "type-a", true},
+		{"transfer empty sender", sdk.AccAddress{}, addrs[1], "type-a", false},
+		{"transfer empty new owner", addrs[0], sdk.AccAddress{}, "type-a", false},
+		{"transfer to sender", addrs[0], addrs[0], "type-a", false},
+		{"transfer empty collateral type", addrs[0], addrs[1], "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTransferCDP(
+			tc.sender,
+			tc.newOwner,
+			tc.collateralType, 0)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgPegStabilityMint(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"mint", addrs[0], sdk.NewInt64Coin("usdc", 1000000), true},
+		{"mint empty sender", sdk.AccAddress{}, sdk.NewInt64Coin("usdc", 1000000), false},
+		{"mint zero amount", addrs[0], sdk.NewInt64Coin("usdc", 0), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgPegStabilityMint(
+			tc.sender,
+			tc.amount)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgPegStabilityRedeem(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		denom       string
+		expectPass  bool
+	}{
+		{"redeem", addrs[0], sdk.NewInt64Coin("usdx", 1000000), "usdc", true},
+		{"redeem empty sender", sdk.AccAddress{}, sdk.NewInt64Coin("usdx", 1000000), "usdc", false},
+		{"redeem zero amount", addrs[0], sdk.NewInt64Coin("usdx", 0), "usdc", false},
+		{"redeem empty denom", addrs[0], sdk.NewInt64Coin("usdx", 1000000), "", false},
+		{"redeem same denom", addrs[0], sdk.NewInt64Coin("usdx", 1000000), "usdx", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgPegStabilityRedeem(
+			tc.sender,
+			tc.amount,
+			tc.denom)
+
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
 		} else {
@@ -11,18 +11,20 @@ import (
 
 // Parameter keys
 var (
-	KeyGlobalDebtLimit      = []byte("GlobalDebtLimit")
-	KeyCollateralParams     = []byte("CollateralParams")
-	KeyDebtParam            = []byte("DebtParam")
-	KeyCircuitBreaker       = []byte("CircuitBreaker")
-	KeyDebtThreshold        = []byte("DebtThreshold")
-	KeyDebtLot              = []byte("DebtLot")
-	KeySurplusThreshold     = []byte("SurplusThreshold")
-	KeySurplusLot           = []byte("SurplusLot")
-	DefaultGlobalDebt       = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
-	DefaultCircuitBreaker   = false
-	DefaultCollateralParams = CollateralParams{}
-	DefaultDebtParam        = DebtParam{
+	KeyGlobalDebtLimit        = []byte("GlobalDebtLimit")
+	KeyCollateralParams       = []byte("CollateralParams")
+	KeyDebtParam              = []byte("DebtParam")
+	KeyCircuitBreaker         = []byte("CircuitBreaker")
+	KeyDebtThreshold          = []byte("DebtThreshold")
+	KeyDebtLot                = []byte("DebtLot")
+	KeySurplusThreshold       = []byte("SurplusThreshold")
+	KeySurplusLot             = []byte("SurplusLot")
+	KeyPegStabilityParams     = []byte("PegStabilityParams")
+	DefaultGlobalDebt         = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
+	DefaultCircuitBreaker     = false
+	DefaultCollateralParams   = CollateralParams{}
+	DefaultPegStabilityParams = PegStabilityParams{}
+	DefaultDebtParam          = DebtParam{
 		Denom:            "usdx",
 		ReferenceAsset:   "usd",
 		ConversionFactor: sdk.NewInt(6),
@@ -39,10 +41,16 @@ var (
 	stabilityFeeMax         = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
 )
 
+// Auction types that can be used to sell liquidated collateral
+const (
+	AuctionTypeCollateral = "collateral"
+	AuctionTypeDutch      = "dutch"
+)
+
 // NewParams returns a new params object
 func NewParams(
 	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
-	surplusLot, debtThreshold, debtLot sdk.Int, breaker bool,
+	surplusLot, debtThreshold, debtLot sdk.Int, breaker bool, pegStabilityParams PegStabilityParams,
 ) Params {
 	return Params{
 		GlobalDebtLimit:         debtLimit,
@@ -53,6 +61,7 @@ func NewParams(
 		DebtAuctionThreshold:    debtThreshold,
 		DebtAuctionLot:          debtLot,
 		CircuitBreaker:          breaker,
+		PegStabilityParams:      pegStabilityParams,
 	}
 }
 
@@ -61,7 +70,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
 		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot,
-		DefaultCircuitBreaker,
+		DefaultCircuitBreaker, DefaultPegStabilityParams,
 	)
 }
 
@@ -89,6 +98,55 @@ func NewCollateralParam(
 // CollateralParams array of CollateralParam
 type CollateralParams []CollateralParam
 
+// NewStabilityFeeModel returns a new StabilityFeeModel
+func NewStabilityFeeModel(minFee, maxFee, utilizationMultiplier sdk.Dec, pegMarketID string, pegMultiplier sdk.Dec) StabilityFeeModel {
+	return StabilityFeeModel{
+		MinStabilityFee:       minFee,
+		MaxStabilityFee:       maxFee,
+		UtilizationMultiplier: utilizationMultiplier,
+		PegMarketID:           pegMarketID,
+		PegMultiplier:         pegMultiplier,
+	}
+}
+
+// Validate performs basic validation of stability fee model parameters
+func (sfm StabilityFeeModel) Validate() error {
+	if sfm.MinStabilityFee.IsNil() || sfm.MinStabilityFee.LT(sdk.OneDec()) {
+		return fmt.Errorf("min stability fee must be ≥ 1.0, is %s", sfm.MinStabilityFee)
+	}
+	if sfm.MaxStabilityFee.IsNil() || sfm.MaxStabilityFee.GT(stabilityFeeMax) {
+		return fmt.Errorf("max stability fee must be ≤ %s, is %s", stabilityFeeMax, sfm.MaxStabilityFee)
+	}
+	if sfm.MinStabilityFee.GT(sfm.MaxStabilityFee) {
+		return fmt.Errorf("min stability fee %s cannot be greater than max stability fee %s", sfm.MinStabilityFee, sfm.MaxStabilityFee)
+	}
+	if sfm.UtilizationMultiplier.IsNil() || sfm.UtilizationMultiplier.IsNegative() {
+		return fmt.Errorf("utilization multiplier cannot be negative, is %s", sfm.UtilizationMultiplier)
+	}
+	if sfm.PegMultiplier.IsNil() || sfm.PegMultiplier.IsNegative() {
+		return fmt.Errorf("peg multiplier cannot be negative, is %s", sfm.PegMultiplier)
+	}
+	if sfm.PegMultiplier.IsPositive() && strings.TrimSpace(sfm.PegMarketID) == "" {
+		return fmt.Errorf("peg market id cannot be blank when peg multiplier is set")
+	}
+	return nil
+}
+
+// CalculateStabilityFee returns the per second stability fee for the given base fee, debt limit utilization, and
+// peg deviation. The peg deviation is 1 minus the stable asset price, so it is negative when the price is above peg.
+func (sfm StabilityFeeModel) CalculateStabilityFee(baseFee, utilization, pegDeviation sdk.Dec) sdk.Dec {
+	fee := baseFee.
+		Add(utilization.Mul(sfm.UtilizationMultiplier)).
+		Add(pegDeviation.Mul(sfm.PegMultiplier))
+	if fee.LT(sfm.MinStabilityFee) {
+		return sfm.MinStabilityFee
+	}
+	if fee.GT(sfm.MaxStabilityFee) {
+		return sfm.MaxStabilityFee
+	}
+	return fee
+}
+
 // NewDebtParam returns a new DebtParam
 func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdk.Int) DebtParam {
 	return DebtParam{
@@ -102,6 +160,29 @@ func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdk.Int) D
 // DebtParams array of DebtParam
 type DebtParams []DebtParam
 
+// NewPegStabilityParam returns a new PegStabilityParam
+func NewPegStabilityParam(denom string, debtLimit sdk.Coin, fee sdk.Dec, conversionFactor sdk.Int) PegStabilityParam {
+	return PegStabilityParam{
+		Denom:            denom,
+		DebtLimit:        debtLimit,
+		Fee:              fee,
+		ConversionFactor: conversionFactor,
+	}
+}
+
+// PegStabilityParams array of PegStabilityParam
+type PegStabilityParams []PegStabilityParam
+
+// Get returns the peg stability param for the given stablecoin denom
+func (psps PegStabilityParams) Get(denom string) (PegStabilityParam, bool) {
+	for _, psp := range psps {
+		if psp.Denom == denom {
+			return psp, true
+		}
+	}
+	return PegStabilityParam{}, false
+}
+
 // ParamKeyTable Key declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -120,6 +201,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySurplusLot, &p.SurplusAuctionLot, validateSurplusAuctionLotParam),
 		paramtypes.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		paramtypes.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
+		paramtypes.NewParamSetPair(KeyPegStabilityParams, &p.PegStabilityParams, validatePegStabilityParams),
 	}
 }
 
@@ -157,6 +239,23 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePegStabilityParams(p.PegStabilityParams); err != nil {
+		return err
+	}
+
+	for _, psp := range p.PegStabilityParams {
+		if psp.Denom == p.DebtParam.Denom {
+			return fmt.Errorf("peg stability denom cannot be the debt denom %s", psp.Denom)
+		}
+		if psp.DebtLimit.Denom != p.GlobalDebtLimit.Denom {
+			return fmt.Errorf("peg stability debt limit denom %s does not match global debt limit denom %s",
+				psp.DebtLimit.Denom, p.GlobalDebtLimit.Denom)
+		}
+		if psp.DebtLimit.Amount.GT(p.GlobalDebtLimit.Amount) {
+			return fmt.Errorf("peg stability debt limit %s exceeds global debt limit: %s", psp.DebtLimit, p.GlobalDebtLimit)
+		}
+	}
+
 	if len(p.CollateralParams) == 0 { // default value OK
 		return nil
 	}
@@ -261,9 +360,25 @@ func validateCollateralParams(i interface{}) error {
 		if cp.StabilityFee.LT(sdk.OneDec()) || cp.StabilityFee.GT(stabilityFeeMax) {
 			return fmt.Errorf("stability fee must be ≥ 1.0, ≤ %s, is %s for %s", stabilityFeeMax, cp.StabilityFee, cp.Denom)
 		}
+		if cp.StabilityFeeModel != nil {
+			if err := cp.StabilityFeeModel.Validate(); err != nil {
+				return fmt.Errorf("invalid stability fee model for %s: %w", cp.Denom, err)
+			}
+		}
 		if cp.KeeperRewardPercentage.IsNegative() || cp.KeeperRewardPercentage.GT(sdk.OneDec()) {
 			return fmt.Errorf("keeper reward percentage should be between 0 and 1, is %s for %s", cp.KeeperRewardPercentage, cp.Denom)
 		}
+		switch cp.AuctionType {
+		case "", AuctionTypeCollateral, AuctionTypeDutch:
+		default:
+			return fmt.Errorf("auction type should be %s or %s, is %s for %s", AuctionTypeCollateral, AuctionTypeDutch, cp.AuctionType, cp.Denom)
+		}
+		if cp.LiquidationTargetRatio != nil {
+			minTargetRatio := sdk.MaxDec(cp.LiquidationRatio, sdk.OneDec().Add(cp.LiquidationPenalty))
+			if cp.LiquidationTargetRatio.IsNil() || cp.LiquidationTargetRatio.LTE(minTargetRatio) {
+				return fmt.Errorf("liquidation target ratio must be > liquidation ratio and > 1 + liquidation penalty, is %s for %s", cp.LiquidationTargetRatio, cp.Denom)
+			}
+		}
 		if cp.CheckCollateralizationIndexCount.IsNegative() {
 			return fmt.Errorf("keeper reward percentage should be positive, is %s for %s", cp.CheckCollateralizationIndexCount, cp.Denom)
 		}
@@ -344,3 +459,34 @@ func validateDebtAuctionLotParam(i interface{}) error {
 
 	return nil
 }
+
+func validatePegStabilityParams(i interface{}) error {
+	pegStabilityParams, ok := i.(PegStabilityParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denomDupMap := make(map[string]bool)
+	for _, psp := range pegStabilityParams {
+		if err := sdk.ValidateDenom(psp.Denom); err != nil {
+			return fmt.Errorf("peg stability denom invalid %s", psp.Denom)
+		}
+
+		if denomDupMap[psp.Denom] {
+			return fmt.Errorf("duplicate peg stability denom: %s", psp.Denom)
+		}
+		denomDupMap[psp.Denom] = true
+
+		if !psp.DebtLimit.IsValid() {
+			return fmt.Errorf("debt limit for all peg stability assets should be positive, is %s for %s", psp.DebtLimit, psp.Denom)
+		}
+		if psp.Fee.IsNil() || psp.Fee.IsNegative() || psp.Fee.GTE(sdk.OneDec()) {
+			return fmt.Errorf("peg stability fee should be ≥ 0 and < 1, is %s for %s", psp.Fee, psp.Denom)
+		}
+		if psp.ConversionFactor.IsNil() || psp.ConversionFactor.IsNegative() {
+			return fmt.Errorf("peg stability conversion factor should not be negative, is %s for %s", psp.ConversionFactor, psp.Denom)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,19 +20,22 @@ func (suite *ParamsTestSuite) SetupTest() {
 
 func (suite *ParamsTestSuite) TestParamValidation() {
 	type args struct {
-		globalDebtLimit  sdk.Coin
-		collateralParams types.CollateralParams
-		debtParam        types.DebtParam
-		surplusThreshold sdk.Int
-		surplusLot       sdk.Int
-		debtThreshold    sdk.Int
-		debtLot          sdk.Int
-		breaker          bool
+		globalDebtLimit    sdk.Coin
+		collateralParams   types.CollateralParams
+		debtParam          types.DebtParam
+		surplusThreshold   sdk.Int
+		surplusLot         sdk.Int
+		debtThreshold      sdk.Int
+		debtLot            sdk.Int
+		breaker            bool
+		pegStabilityParams types.PegStabilityParams
 	}
 	type errArgs struct {
 		expectPass bool
 		contains   string
 	}
+	targetRatio := sdk.MustNewDecFromStr("1.75")
+	lowTargetRatio := sdk.MustNewDecFromStr("1.25")
 
 	testCases := []struct {
 		name    string
@@ -674,6 +678,255 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "liquidation ratio must be > 0",
 			},
 		},
+		{
+			name: "valid collateral params stability fee model",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						StabilityFeeModel: &types.StabilityFeeModel{
+							MinStabilityFee:       sdk.MustNewDecFromStr("1.0"),
+							MaxStabilityFee:       sdk.MustNewDecFromStr("1.000000012857214317"),
+							UtilizationMultiplier: sdk.MustNewDecFromStr("0.000000003170979198"),
+							PegMarketID:           "usdx:usd",
+							PegMultiplier:         sdk.MustNewDecFromStr("0.00000001"),
+						},
+					},
+				},
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral params stability fee model min above max",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						StabilityFeeModel: &types.StabilityFeeModel{
+							MinStabilityFee:       sdk.MustNewDecFromStr("1.000000012857214317"),
+							MaxStabilityFee:       sdk.MustNewDecFromStr("1.000000001547125958"),
+							UtilizationMultiplier: sdk.MustNewDecFromStr("0"),
+							PegMarketID:           "",
+							PegMultiplier:         sdk.MustNewDecFromStr("0"),
+						},
+					},
+				},
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "min stability fee 1.000000012857214317 cannot be greater than max stability fee",
+			},
+		},
+		{
+			name: "invalid collateral params stability fee model missing peg market",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						StabilityFeeModel: &types.StabilityFeeModel{
+							MinStabilityFee:       sdk.MustNewDecFromStr("1.0"),
+							MaxStabilityFee:       sdk.MustNewDecFromStr("1.000000012857214317"),
+							UtilizationMultiplier: sdk.MustNewDecFromStr("0"),
+							PegMarketID:           "",
+							PegMultiplier:         sdk.MustNewDecFromStr("0.00000001"),
+						},
+					},
+				},
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg market id cannot be blank",
+			},
+		},
+		{
+			name: "valid collateral params dutch auction type",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						AuctionType:                      "dutch",
+					},
+				},
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral params unknown auction type",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						AuctionType:                      "english",
+					},
+				},
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "auction type should be",
+			},
+		},
+		{
+			name: "valid collateral params liquidation target ratio",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						LiquidationTargetRatio:           &targetRatio,
+					},
+				},
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral params liquidation target ratio below liquidation ratio",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						LiquidationTargetRatio:           &lowTargetRatio,
+					},
+				},
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "liquidation target ratio must be",
+			},
+		},
 		{
 			name: "invalid debt param empty denom",
 			args: args{
@@ -796,10 +1049,111 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "debt auction lot should be positive",
 			},
 		},
+		{
+			name: "valid peg stability params",
+			args: args{
+				globalDebtLimit:  sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt64Coin("usdx", 1000000000000), sdk.MustNewDecFromStr("0.001"), sdk.NewInt(6)),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid peg stability fee",
+			args: args{
+				globalDebtLimit:  sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt64Coin("usdx", 1000000000000), sdk.OneDec(), sdk.NewInt(6)),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg stability fee should be ≥ 0 and < 1",
+			},
+		},
+		{
+			name: "duplicate peg stability denom",
+			args: args{
+				globalDebtLimit:  sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt64Coin("usdx", 1000000000000), sdk.ZeroDec(), sdk.NewInt(6)),
+					types.NewPegStabilityParam("usdc", sdk.NewInt64Coin("usdx", 1000000000000), sdk.ZeroDec(), sdk.NewInt(6)),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate peg stability denom",
+			},
+		},
+		{
+			name: "peg stability debt limit exceeds global debt limit",
+			args: args{
+				globalDebtLimit:  sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt64Coin("usdx", 3000000000000), sdk.ZeroDec(), sdk.NewInt(6)),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg stability debt limit 3000000000000usdx exceeds global debt limit",
+			},
+		},
+		{
+			name: "peg stability denom is debt denom",
+			args: args{
+				globalDebtLimit:  sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdx", sdk.NewInt64Coin("usdx", 1000000000000), sdk.ZeroDec(), sdk.NewInt(6)),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg stability denom cannot be the debt denom",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker)
+			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, tc.args.pegStabilityParams)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -814,3 +1168,33 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
+
+func TestStabilityFeeModel_CalculateStabilityFee(t *testing.T) {
+	model := types.NewStabilityFeeModel(
+		sdk.MustNewDecFromStr("1.000000000100000000"),
+		sdk.MustNewDecFromStr("1.000000010000000000"),
+		sdk.MustNewDecFromStr("0.000000002000000000"),
+		"usdx:usd",
+		sdk.MustNewDecFromStr("0.000000100000000000"),
+	)
+	baseFee := sdk.MustNewDecFromStr("1.000000001000000000")
+
+	testCases := []struct {
+		name         string
+		utilization  sdk.Dec
+		pegDeviation sdk.Dec
+		expectedFee  sdk.Dec
+	}{
+		{"no utilization, on peg", sdk.ZeroDec(), sdk.ZeroDec(), baseFee},
+		{"half utilization", sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), sdk.MustNewDecFromStr("1.000000002000000000")},
+		{"below peg", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.02"), sdk.MustNewDecFromStr("1.000000003000000000")},
+		{"above peg", sdk.ZeroDec(), sdk.MustNewDecFromStr("-0.005"), sdk.MustNewDecFromStr("1.000000000500000000")},
+		{"clamped to min", sdk.ZeroDec(), sdk.MustNewDecFromStr("-0.5"), sdk.MustNewDecFromStr("1.000000000100000000")},
+		{"clamped to max", sdk.OneDec(), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("1.000000010000000000")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedFee, model.CalculateStabilityFee(baseFee, tc.utilization, tc.pegDeviation))
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -198,6 +199,7 @@ func (m *QueryAccountsResponse) GetAccounts() []types.ModuleAccount {
 type QueryCdpRequest struct {
 	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 	Owner          string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	CdpID          uint64 `protobuf:"varint,3,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *QueryCdpRequest) Reset()         { *m = QueryCdpRequest{} }
@@ -247,6 +249,13 @@ func (m *QueryCdpRequest) GetOwner() string {
 	return ""
 }
 
+func (m *QueryCdpRequest) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // QueryCdpResponse defines the response type for the Query/Cdp RPC method.
 type QueryCdpResponse struct {
 	Cdp CDPResponse `protobuf:"bytes,1,opt,name=cdp,proto3" json:"cdp"`
@@ -427,6 +436,7 @@ func (m *QueryCdpsResponse) GetPagination() *query.PageResponse {
 type QueryDepositsRequest struct {
 	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 	Owner          string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	CdpID          uint64 `protobuf:"varint,3,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
@@ -476,6 +486,13 @@ func (m *QueryDepositsRequest) GetOwner() string {
 	return ""
 }
 
+func (m *QueryDepositsRequest) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // QueryDepositsResponse defines the response type for the Query/Deposits RPC method.
 type QueryDepositsResponse struct {
 	Deposits Deposits `protobuf:"bytes,1,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
@@ -701,6 +718,415 @@ func (m *QueryTotalCollateralResponse) GetTotalCollateral() TotalCollaterals {
 	return nil
 }
 
+// QueryPegStabilityReservesRequest defines the request type for the Query/PegStabilityReserves RPC method.
+type QueryPegStabilityReservesRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryPegStabilityReservesRequest) Reset()         { *m = QueryPegStabilityReservesRequest{} }
+func (m *QueryPegStabilityReservesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPegStabilityReservesRequest) ProtoMessage()    {}
+func (*QueryPegStabilityReservesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd68799328aaf74a, []int{14}
+}
+func (m *QueryPegStabilityReservesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPegStabilityReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPegStabilityReservesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPegStabilityReservesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPegStabilityReservesRequest.Merge(m, src)
+}
+func (m *QueryPegStabilityReservesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPegStabilityReservesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPegStabilityReservesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPegStabilityReservesRequest proto.InternalMessageInfo
+
+func (m *QueryPegStabilityReservesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryPegStabilityReservesResponse defines the response type for the Query/PegStabilityReserves RPC method.
+type QueryPegStabilityReservesResponse struct {
+	Reserves []PegStabilityReserve `protobuf:"bytes,1,rep,name=reserves,proto3" json:"reserves"`
+}
+
+func (m *QueryPegStabilityReservesResponse) Reset()         { *m = QueryPegStabilityReservesResponse{} }
+func (m *QueryPegStabilityReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPegStabilityReservesResponse) ProtoMessage()    {}
+func (*QueryPegStabilityReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd68799328aaf74a, []int{15}
+}
+func (m *QueryPegStabilityReservesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPegStabilityReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPegStabilityReservesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPegStabilityReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPegStabilityReservesResponse.Merge(m, src)
+}
+func (m *QueryPegStabilityReservesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPegStabilityReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPegStabilityReservesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPegStabilityReservesResponse proto.InternalMessageInfo
+
+func (m *QueryPegStabilityReservesResponse) GetReserves() []PegStabilityReserve {
+	if m != nil {
+		return m.Reserves
+	}
+	return nil
+}
+
+// QuerySystemHealthRequest defines the request type for the Query/SystemHealth RPC method.
+type QuerySystemHealthRequest struct {
+}
+
+func (m *QuerySystemHealthRequest) Reset()         { *m = QuerySystemHealthRequest{} }
+func (m *QuerySystemHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySystemHealthRequest) ProtoMessage()    {}
+func (*QuerySystemHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd68799328aaf74a, []int{16}
+}
+func (m *QuerySystemHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySystemHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySystemHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySystemHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySystemHealthRequest.Merge(m, src)
+}
+func (m *QuerySystemHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySystemHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySystemHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySystemHealthRequest proto.InternalMessageInfo
+
+// QuerySystemHealthResponse defines the response type for the Query/SystemHealth RPC method.
+type QuerySystemHealthResponse struct {
+	Collaterals []CollateralHealth `protobuf:"bytes,1,rep,name=collaterals,proto3" json:"collaterals"`
+	// liquidator_debt is the debt held by the liquidator module account that is not covered by any auction
+	LiquidatorDebt types1.Coin `protobuf:"bytes,2,opt,name=liquidator_debt,json=liquidatorDebt,proto3" json:"liquidator_debt"`
+	// surplus_pending_auction is the surplus left after netting with the liquidator debt
+	SurplusPendingAuction types1.Coin `protobuf:"bytes,3,opt,name=surplus_pending_auction,json=surplusPendingAuction,proto3" json:"surplus_pending_auction"`
+	// debt_pending_auction is the liquidator debt left after netting with the surplus
+	DebtPendingAuction types1.Coin `protobuf:"bytes,4,opt,name=debt_pending_auction,json=debtPendingAuction,proto3" json:"debt_pending_auction"`
+	// active_collateral_auctions counts the collateral and dutch auctions selling liquidated collateral
+	ActiveCollateralAuctions uint64 `protobuf:"varint,5,opt,name=active_collateral_auctions,json=activeCollateralAuctions,proto3" json:"active_collateral_auctions,omitempty"`
+	ActiveSurplusAuctions    uint64 `protobuf:"varint,6,opt,name=active_surplus_auctions,json=activeSurplusAuctions,proto3" json:"active_surplus_auctions,omitempty"`
+	ActiveDebtAuctions       uint64 `protobuf:"varint,7,opt,name=active_debt_auctions,json=activeDebtAuctions,proto3" json:"active_debt_auctions,omitempty"`
+	// collateralization_ratio_spot is the value of all cdp collateral at spot prices over all cdp debt, excluding
+	// collateral types with an unavailable price
+	CollateralizationRatioSpot github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=collateralization_ratio_spot,json=collateralizationRatioSpot,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"collateralization_ratio_spot"`
+	// collateralization_ratio_liquidation is the value of all cdp collateral at liquidation prices over all cdp debt,
+	// excluding collateral types with an unavailable price
+	CollateralizationRatioLiquidation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=collateralization_ratio_liquidation,json=collateralizationRatioLiquidation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"collateralization_ratio_liquidation"`
+}
+
+func (m *QuerySystemHealthResponse) Reset()         { *m = QuerySystemHealthResponse{} }
+func (m *QuerySystemHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySystemHealthResponse) ProtoMessage()    {}
+func (*QuerySystemHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd68799328aaf74a, []int{17}
+}
+func (m *QuerySystemHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySystemHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySystemHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySystemHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySystemHealthResponse.Merge(m, src)
+}
+func (m *QuerySystemHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySystemHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySystemHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySystemHealthResponse proto.InternalMessageInfo
+
+func (m *QuerySystemHealthResponse) GetCollaterals() []CollateralHealth {
+	if m != nil {
+		return m.Collaterals
+	}
+	return nil
+}
+
+func (m *QuerySystemHealthResponse) GetLiquidatorDebt() types1.Coin {
+	if m != nil {
+		return m.LiquidatorDebt
+	}
+	return types1.Coin{}
+}
+
+func (m *QuerySystemHealthResponse) GetSurplusPendingAuction() types1.Coin {
+	if m != nil {
+		return m.SurplusPendingAuction
+	}
+	return types1.Coin{}
+}
+
+func (m *QuerySystemHealthResponse) GetDebtPendingAuction() types1.Coin {
+	if m != nil {
+		return m.DebtPendingAuction
+	}
+	return types1.Coin{}
+}
+
+func (m *QuerySystemHealthResponse) GetActiveCollateralAuctions() uint64 {
+	if m != nil {
+		return m.ActiveCollateralAuctions
+	}
+	return 0
+}
+
+func (m *QuerySystemHealthResponse) GetActiveSurplusAuctions() uint64 {
+	if m != nil {
+		return m.ActiveSurplusAuctions
+	}
+	return 0
+}
+
+func (m *QuerySystemHealthResponse) GetActiveDebtAuctions() uint64 {
+	if m != nil {
+		return m.ActiveDebtAuctions
+	}
+	return 0
+}
+
+// QueryRiskiestCdpsRequest defines the request type for the Query/RiskiestCdps RPC method.
+type QueryRiskiestCdpsRequest struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// max_ratio is an optional sdk.Dec as a string, only cdps with a collateralization ratio at or below it are returned. The index is only walked as far as this ratio allows after accrued interest
+	MaxRatio   string             `protobuf:"bytes,2,opt,name=max_ratio,json=maxRatio,proto3" json:"max_ratio,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRiskiestCdpsRequest) Reset()         { *m = QueryRiskiestCdpsRequest{} }
+func (m *QueryRiskiestCdpsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskiestCdpsRequest) ProtoMessage()    {}
+func (*QueryRiskiestCdpsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd68799328aaf74a, []int{18}
+}
+func (m *QueryRiskiestCdpsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskiestCdpsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskiestCdpsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRiskiestCdpsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskiestCdpsRequest.Merge(m, src)
+}
+func (m *QueryRiskiestCdpsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskiestCdpsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskiestCdpsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRiskiestCdpsRequest proto.InternalMessageInfo
+
+func (m *QueryRiskiestCdpsRequest) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
+}
+
+func (m *QueryRiskiestCdpsRequest) GetMaxRatio() string {
+	if m != nil {
+		return m.MaxRatio
+	}
+	return ""
+}
+
+func (m *QueryRiskiestCdpsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRiskiestCdpsResponse defines the response type for the Query/RiskiestCdps RPC method.
+type QueryRiskiestCdpsResponse struct {
+	Cdps       []RiskyCDPResponse  `protobuf:"bytes,1,rep,name=cdps,proto3" json:"cdps"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRiskiestCdpsResponse) Reset()         { *m = QueryRiskiestCdpsResponse{} }
+func (m *QueryRiskiestCdpsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskiestCdpsResponse) ProtoMessage()    {}
+func (*QueryRiskiestCdpsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd68799328aaf74a, []int{19}
+}
+func (m *QueryRiskiestCdpsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskiestCdpsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskiestCdpsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRiskiestCdpsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskiestCdpsResponse.Merge(m, src)
+}
+func (m *QueryRiskiestCdpsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskiestCdpsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskiestCdpsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRiskiestCdpsResponse proto.InternalMessageInfo
+
+func (m *QueryRiskiestCdpsResponse) GetCdps() []RiskyCDPResponse {
+	if m != nil {
+		return m.Cdps
+	}
+	return nil
+}
+
+func (m *QueryRiskiestCdpsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// RiskyCDPResponse defines the state of a collateralized debt position and its distance to liquidation.
+type RiskyCDPResponse struct {
+	Cdp CDPResponse `protobuf:"bytes,1,opt,name=cdp,proto3" json:"cdp"`
+	// liquidation_price is the liquidation market price at which the cdp can be liquidated
+	LiquidationPrice string `protobuf:"bytes,2,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	// distance_to_liquidation is the fraction the liquidation market price can fall before the cdp can be liquidated,
+	// negative if the cdp can already be liquidated
+	DistanceToLiquidation string `protobuf:"bytes,3,opt,name=distance_to_liquidation,json=distanceToLiquidation,proto3" json:"distance_to_liquidation,omitempty"`
+}
+
+func (m *RiskyCDPResponse) Reset()         { *m = RiskyCDPResponse{} }
+func (m *RiskyCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*RiskyCDPResponse) ProtoMessage()    {}
+func (*RiskyCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd68799328aaf74a, []int{20}
+}
+func (m *RiskyCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RiskyCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RiskyCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RiskyCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RiskyCDPResponse.Merge(m, src)
+}
+func (m *RiskyCDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RiskyCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RiskyCDPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RiskyCDPResponse proto.InternalMessageInfo
+
+func (m *RiskyCDPResponse) GetCdp() CDPResponse {
+	if m != nil {
+		return m.Cdp
+	}
+	return CDPResponse{}
+}
+
+func (m *RiskyCDPResponse) GetLiquidationPrice() string {
+	if m != nil {
+		return m.LiquidationPrice
+	}
+	return ""
+}
+
+func (m *RiskyCDPResponse) GetDistanceToLiquidation() string {
+	if m != nil {
+		return m.DistanceToLiquidation
+	}
+	return ""
+}
+
 // CDPResponse defines the state of a single collateralized debt position.
 type CDPResponse struct {
 	ID                     uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -713,13 +1139,14 @@ type CDPResponse struct {
 	InterestFactor         string      `protobuf:"bytes,8,opt,name=interest_factor,json=interestFactor,proto3" json:"interest_factor,omitempty"`
 	CollateralValue        types1.Coin `protobuf:"bytes,9,opt,name=collateral_value,json=collateralValue,proto3" json:"collateral_value"`
 	CollateralizationRatio string      `protobuf:"bytes,10,opt,name=collateralization_ratio,json=collateralizationRatio,proto3" json:"collateralization_ratio,omitempty"`
+	StabilityFee           string      `protobuf:"bytes,11,opt,name=stability_fee,json=stabilityFee,proto3" json:"stability_fee,omitempty"`
 }
 
 func (m *CDPResponse) Reset()         { *m = CDPResponse{} }
 func (m *CDPResponse) String() string { return proto.CompactTextString(m) }
 func (*CDPResponse) ProtoMessage()    {}
 func (*CDPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{14}
+	return fileDescriptor_fd68799328aaf74a, []int{21}
 }
 func (m *CDPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,6 +1245,13 @@ func (m *CDPResponse) GetCollateralizationRatio() string {
 	return ""
 }
 
+func (m *CDPResponse) GetStabilityFee() string {
+	if m != nil {
+		return m.StabilityFee
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.cdp.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.cdp.v1beta1.QueryParamsResponse")
@@ -833,85 +1267,126 @@ func init() {
 	proto.RegisterType((*QueryTotalPrincipalResponse)(nil), "kava.cdp.v1beta1.QueryTotalPrincipalResponse")
 	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "kava.cdp.v1beta1.QueryTotalCollateralRequest")
 	proto.RegisterType((*QueryTotalCollateralResponse)(nil), "kava.cdp.v1beta1.QueryTotalCollateralResponse")
+	proto.RegisterType((*QueryPegStabilityReservesRequest)(nil), "kava.cdp.v1beta1.QueryPegStabilityReservesRequest")
+	proto.RegisterType((*QueryPegStabilityReservesResponse)(nil), "kava.cdp.v1beta1.QueryPegStabilityReservesResponse")
+	proto.RegisterType((*QuerySystemHealthRequest)(nil), "kava.cdp.v1beta1.QuerySystemHealthRequest")
+	proto.RegisterType((*QuerySystemHealthResponse)(nil), "kava.cdp.v1beta1.QuerySystemHealthResponse")
+	proto.RegisterType((*QueryRiskiestCdpsRequest)(nil), "kava.cdp.v1beta1.QueryRiskiestCdpsRequest")
+	proto.RegisterType((*QueryRiskiestCdpsResponse)(nil), "kava.cdp.v1beta1.QueryRiskiestCdpsResponse")
+	proto.RegisterType((*RiskyCDPResponse)(nil), "kava.cdp.v1beta1.RiskyCDPResponse")
 	proto.RegisterType((*CDPResponse)(nil), "kava.cdp.v1beta1.CDPResponse")
 }
 
 func init() { proto.RegisterFile("kava/cdp/v1beta1/query.proto", fileDescriptor_fd68799328aaf74a) }
 
 var fileDescriptor_fd68799328aaf74a = []byte{
-	// 1142 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdb, 0x54,
-	0x1c, 0x8f, 0xd3, 0xb4, 0x4b, 0x5f, 0xa7, 0x26, 0x3c, 0x42, 0xe7, 0x9a, 0x92, 0xa4, 0x1e, 0x5b,
-	0x0b, 0xa2, 0x36, 0x2b, 0xe2, 0xb7, 0xd0, 0xd4, 0xb4, 0x74, 0x1a, 0x12, 0x52, 0x31, 0x05, 0x24,
-	0x24, 0x14, 0x5e, 0xec, 0xd7, 0xcc, 0x90, 0xf8, 0x79, 0x7e, 0x76, 0x47, 0x99, 0x26, 0x04, 0x87,
-	0x89, 0xe3, 0x04, 0x07, 0x0e, 0x48, 0x68, 0x17, 0x2e, 0x9c, 0xf9, 0x23, 0x76, 0x9c, 0x80, 0x03,
-	0xa7, 0x0d, 0x5a, 0x0e, 0xfc, 0x19, 0xd3, 0x7b, 0xfe, 0x3a, 0x76, 0xe2, 0xa4, 0xed, 0x0e, 0xbb,
-	0x44, 0xf1, 0xf7, 0xd7, 0xe7, 0xf3, 0xfd, 0xfa, 0xfb, 0xc3, 0x68, 0xe9, 0x4b, 0xb2, 0x4f, 0x4c,
-	0xdb, 0xf1, 0xcd, 0xfd, 0x4b, 0x1d, 0x1a, 0x92, 0x4b, 0xe6, 0xf5, 0x88, 0x06, 0x07, 0x86, 0x1f,
-	0xb0, 0x90, 0xe1, 0xaa, 0xd0, 0x1a, 0xb6, 0xe3, 0x1b, 0xa0, 0xd5, 0xea, 0x36, 0xe3, 0x7d, 0xc6,
-	0x4d, 0x12, 0x85, 0xd7, 0x06, 0x2e, 0xe2, 0x21, 0xf6, 0xd0, 0x5e, 0x04, 0x7d, 0x87, 0x70, 0x1a,
-	0x87, 0x1a, 0x58, 0xf9, 0xa4, 0xeb, 0x7a, 0x24, 0x74, 0x99, 0x07, 0xb6, 0xf5, 0xac, 0x6d, 0x62,
-	0x65, 0x33, 0x37, 0xd1, 0x2f, 0xc6, 0xfa, 0xb6, 0x7c, 0x32, 0xe3, 0x07, 0x50, 0xd5, 0xba, 0xac,
-	0xcb, 0x62, 0xb9, 0xf8, 0x07, 0xd2, 0xa5, 0x2e, 0x63, 0xdd, 0x1e, 0x35, 0x89, 0xef, 0x9a, 0xc4,
-	0xf3, 0x58, 0x28, 0xd1, 0x12, 0x9f, 0x06, 0x68, 0xe5, 0x53, 0x27, 0xda, 0x33, 0x43, 0xb7, 0x4f,
-	0x79, 0x48, 0xfa, 0x3e, 0x18, 0x68, 0xb9, 0x5a, 0x88, 0xcc, 0x81, 0x6b, 0x4e, 0xd7, 0xa5, 0x1e,
-	0xe5, 0x2e, 0x04, 0xd7, 0x6b, 0x08, 0x7f, 0x20, 0xb2, 0xdd, 0x21, 0x01, 0xe9, 0x73, 0x8b, 0x5e,
-	0x8f, 0x28, 0x0f, 0xf5, 0x4f, 0xd0, 0xd3, 0x43, 0x52, 0xee, 0x33, 0x8f, 0x53, 0xfc, 0x1a, 0x9a,
-	0xf1, 0xa5, 0x44, 0x55, 0x9a, 0xca, 0xea, 0xdc, 0xba, 0x6a, 0x8c, 0xd6, 0xd9, 0x88, 0x3d, 0x5a,
-	0xa5, 0x7b, 0x0f, 0x1a, 0x05, 0x0b, 0xac, 0xdf, 0x2a, 0x7f, 0x7f, 0xb7, 0x51, 0xf8, 0xff, 0x6e,
-	0xa3, 0xa0, 0x2f, 0xa0, 0x9a, 0x0c, 0xbc, 0x61, 0xdb, 0x2c, 0xf2, 0xc2, 0x01, 0xe0, 0x67, 0xe8,
-	0x99, 0x11, 0x39, 0x40, 0x6e, 0xa1, 0x32, 0x01, 0x99, 0xaa, 0x34, 0xa7, 0x56, 0xe7, 0xd6, 0x75,
-	0x03, 0x2a, 0x2a, 0xdf, 0x5e, 0x82, 0xfb, 0x3e, 0x73, 0xa2, 0x1e, 0x05, 0x77, 0x80, 0x1f, 0x78,
-	0xea, 0x5f, 0xa0, 0x8a, 0x0c, 0xbf, 0xe9, 0xf8, 0x80, 0x88, 0x57, 0x50, 0xc5, 0x66, 0xbd, 0x1e,
-	0x09, 0x69, 0x40, 0x7a, 0xed, 0xf0, 0xc0, 0xa7, 0x32, 0xa9, 0x59, 0x6b, 0x3e, 0x15, 0xef, 0x1e,
-	0xf8, 0x14, 0x1b, 0x68, 0x9a, 0xdd, 0xf0, 0x68, 0xa0, 0x16, 0x85, 0xba, 0xa5, 0xfe, 0xf1, 0xfb,
-	0x5a, 0x0d, 0x18, 0x6c, 0x38, 0x4e, 0x40, 0x39, 0xff, 0x30, 0x0c, 0x5c, 0xaf, 0x6b, 0xc5, 0x66,
-	0xfa, 0x55, 0x54, 0x4d, 0xb1, 0x20, 0x8b, 0x57, 0xd1, 0x94, 0xed, 0xf8, 0x50, 0xb5, 0xe7, 0xf2,
-	0x55, 0xdb, 0xdc, 0xda, 0x49, 0x6c, 0x81, 0xbb, 0xb0, 0xd7, 0xff, 0x55, 0xd2, 0x58, 0xfc, 0x49,
-	0x13, 0xc7, 0x0b, 0xa8, 0xe8, 0x3a, 0xea, 0x54, 0x53, 0x59, 0x2d, 0xb5, 0x66, 0x0e, 0x1f, 0x34,
-	0x8a, 0x57, 0xb7, 0xac, 0xa2, 0xeb, 0xe0, 0x1a, 0x9a, 0x0e, 0x44, 0x43, 0xaa, 0x25, 0x09, 0x13,
-	0x3f, 0xe0, 0x6d, 0x84, 0xd2, 0xc1, 0x50, 0xa7, 0x65, 0x66, 0x17, 0x93, 0x57, 0x23, 0x26, 0xc3,
-	0x88, 0x07, 0x32, 0x6d, 0x8c, 0x2e, 0x85, 0x14, 0xac, 0x8c, 0xa7, 0xfe, 0xab, 0x82, 0x9e, 0xca,
-	0xe4, 0x08, 0x05, 0xbb, 0x82, 0x4a, 0xb6, 0xe3, 0x27, 0xaf, 0xfc, 0x84, 0x8a, 0xd5, 0x44, 0xc5,
-	0x7e, 0x7b, 0xd8, 0x38, 0x9b, 0x11, 0x72, 0x4b, 0x06, 0xc0, 0x57, 0x86, 0x68, 0x16, 0x25, 0xcd,
-	0x95, 0x13, 0x69, 0xc6, 0x31, 0x86, 0x78, 0x32, 0xe8, 0xdc, 0x2d, 0xea, 0x33, 0xee, 0x86, 0x4f,
-	0xfc, 0x75, 0xe8, 0x9f, 0xc3, 0x48, 0xa4, 0x80, 0x83, 0xda, 0x94, 0x1d, 0x90, 0x41, 0x7d, 0x16,
-	0xf3, 0xf5, 0x01, 0xaf, 0x56, 0x15, 0x6a, 0x53, 0x1e, 0x84, 0x19, 0x38, 0xeb, 0xef, 0x22, 0x4d,
-	0x22, 0xec, 0xb2, 0x90, 0xf4, 0x76, 0x02, 0xd7, 0xb3, 0x5d, 0x9f, 0xf4, 0x1e, 0x37, 0x31, 0xfd,
-	0x5b, 0x05, 0x3d, 0x3b, 0x36, 0x0e, 0xf0, 0xed, 0xa0, 0x4a, 0x28, 0x34, 0x6d, 0x3f, 0x51, 0x01,
-	0xed, 0x66, 0x9e, 0xf6, 0x70, 0x88, 0xd6, 0x39, 0x60, 0x5f, 0x19, 0x96, 0x73, 0x6b, 0x3e, 0x1c,
-	0x12, 0xe8, 0xdb, 0x59, 0x0a, 0x9b, 0x03, 0x7e, 0x8f, 0x9d, 0xcb, 0x6d, 0x05, 0x2d, 0x8d, 0x0f,
-	0x04, 0xc9, 0xec, 0xa1, 0x6a, 0x9c, 0x4c, 0xea, 0x08, 0xd9, 0x2c, 0x4f, 0xc8, 0x26, 0x0d, 0xd2,
-	0x52, 0x21, 0x9d, 0xea, 0x88, 0x82, 0x5b, 0x71, 0x85, 0x52, 0x89, 0xfe, 0x43, 0x09, 0xcd, 0x65,
-	0xda, 0x19, 0x86, 0x53, 0x19, 0x37, 0x9c, 0x99, 0xae, 0x4a, 0x46, 0x19, 0xa3, 0x92, 0x4c, 0x72,
-	0x4a, 0x0a, 0xe5, 0x7f, 0x7c, 0x19, 0xa1, 0x0c, 0xe7, 0x92, 0x9c, 0x84, 0xc5, 0xa1, 0x49, 0x18,
-	0xcc, 0x16, 0x73, 0x3d, 0x58, 0x43, 0x19, 0x17, 0xfc, 0x0e, 0x9a, 0x4d, 0xdf, 0xe0, 0xf4, 0xe9,
-	0xfc, 0x53, 0x0f, 0xfc, 0x1e, 0xaa, 0x12, 0xdb, 0x8e, 0xfa, 0x91, 0x88, 0xe7, 0xb4, 0xf7, 0x28,
-	0xe5, 0xea, 0xcc, 0xe9, 0xa2, 0x54, 0x32, 0x8e, 0xdb, 0x94, 0x8a, 0xa9, 0x3e, 0x2b, 0xfc, 0xdb,
-	0x91, 0xef, 0x08, 0x99, 0x7a, 0x46, 0xc6, 0xd1, 0x8c, 0xf8, 0x52, 0x1a, 0xc9, 0xa5, 0x34, 0x76,
-	0x93, 0x4b, 0xd9, 0x2a, 0x8b, 0x40, 0x77, 0x1e, 0x36, 0x14, 0x6b, 0x4e, 0x78, 0x7e, 0x14, 0x3b,
-	0x8a, 0xc6, 0x70, 0xbd, 0x90, 0x06, 0x94, 0x87, 0xed, 0x3d, 0x62, 0x87, 0x2c, 0x50, 0xcb, 0x71,
-	0x63, 0x24, 0xe2, 0x6d, 0x29, 0x15, 0xec, 0x33, 0x1d, 0xb4, 0x4f, 0x7a, 0x11, 0x55, 0x67, 0x4f,
-	0xc9, 0x3e, 0x75, 0xfc, 0x58, 0xf8, 0xe1, 0xd7, 0xd1, 0xb9, 0x54, 0xe4, 0x7e, 0x2d, 0xf7, 0x4b,
-	0x3b, 0x5e, 0xb1, 0x48, 0x82, 0x2f, 0xe4, 0xd4, 0x96, 0xf8, 0x5d, 0xff, 0xeb, 0x0c, 0x9a, 0x96,
-	0xdd, 0x89, 0x6f, 0xa0, 0x99, 0xf8, 0xd2, 0xe2, 0xe7, 0xf3, 0x6d, 0x97, 0x3f, 0xe8, 0xda, 0x85,
-	0x13, 0xac, 0xe2, 0x2e, 0xd3, 0x9b, 0xdf, 0xfd, 0xf9, 0xdf, 0x8f, 0x45, 0x0d, 0xab, 0x66, 0xee,
-	0xb3, 0x21, 0x3e, 0xe5, 0xf8, 0x1b, 0x54, 0x4e, 0x6e, 0x34, 0xbe, 0x38, 0x21, 0xe8, 0xc8, 0x71,
-	0xd7, 0x56, 0x4e, 0xb4, 0x03, 0x78, 0x5d, 0xc2, 0x2f, 0x61, 0x2d, 0x0f, 0x9f, 0x9c, 0x72, 0xfc,
-	0x93, 0x82, 0xe6, 0x87, 0xb7, 0x01, 0x7e, 0x69, 0x42, 0xfc, 0xb1, 0x7b, 0x4d, 0x5b, 0x3b, 0xa5,
-	0x35, 0x70, 0x5a, 0x95, 0x9c, 0x74, 0xdc, 0xcc, 0x73, 0x1a, 0xde, 0x41, 0xf8, 0x67, 0x05, 0x55,
-	0x46, 0x06, 0x1b, 0x1f, 0x0b, 0x96, 0xdb, 0x53, 0x9a, 0x71, 0x5a, 0x73, 0x20, 0xf7, 0x82, 0x24,
-	0x77, 0x1e, 0x2f, 0x4f, 0x20, 0x97, 0x61, 0xc2, 0x50, 0x49, 0x5c, 0x58, 0xac, 0x4f, 0x80, 0xc8,
-	0x7c, 0x62, 0x68, 0xe7, 0x8f, 0xb5, 0x01, 0xec, 0xba, 0xc4, 0x56, 0xf1, 0x82, 0x39, 0xee, 0xf3,
-	0x93, 0xe3, 0xdb, 0x0a, 0x9a, 0xda, 0x74, 0x7c, 0xbc, 0x3c, 0x39, 0x58, 0x82, 0xa7, 0x1f, 0x67,
-	0x02, 0x70, 0x6f, 0x48, 0xb8, 0x75, 0xfc, 0xf2, 0x78, 0x38, 0xf3, 0xa6, 0xdc, 0x7c, 0xb7, 0xcc,
-	0x9b, 0x23, 0x8b, 0xfe, 0x16, 0xfe, 0x45, 0x41, 0x83, 0xeb, 0x37, 0xb1, 0x67, 0x47, 0xce, 0xfa,
-	0xc4, 0x9e, 0x1d, 0xbd, 0xc6, 0xfa, 0x86, 0xe4, 0xf5, 0x36, 0x7e, 0x73, 0x02, 0xaf, 0xe4, 0xda,
-	0x4e, 0x26, 0xd8, 0xba, 0x7c, 0xef, 0xb0, 0xae, 0xdc, 0x3f, 0xac, 0x2b, 0xff, 0x1c, 0xd6, 0x95,
-	0x3b, 0x47, 0xf5, 0xc2, 0xfd, 0xa3, 0x7a, 0xe1, 0xef, 0xa3, 0x7a, 0xe1, 0xd3, 0x0b, 0x5d, 0x37,
-	0xbc, 0x16, 0x75, 0x0c, 0x9b, 0xf5, 0x65, 0xf8, 0xb5, 0x1e, 0xe9, 0xf0, 0x18, 0xe8, 0x2b, 0x09,
-	0x25, 0x02, 0xf0, 0xce, 0x8c, 0x5c, 0x78, 0xaf, 0x3c, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x73,
-	0xf8, 0x07, 0x15, 0x0d, 0x00, 0x00,
+	// 1691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5f, 0x6f, 0x23, 0x57,
+	0x15, 0xcf, 0x24, 0x76, 0x70, 0x8e, 0x43, 0xec, 0x5e, 0x9c, 0x64, 0x32, 0x0d, 0xb6, 0x33, 0x61,
+	0x93, 0xd0, 0x25, 0x76, 0x9b, 0x8a, 0x52, 0x60, 0x51, 0x15, 0xc7, 0xa4, 0x4d, 0x05, 0x52, 0x3a,
+	0x09, 0x54, 0x42, 0x42, 0x66, 0x3c, 0x73, 0xe3, 0x1d, 0xad, 0x3d, 0x33, 0x3b, 0xf7, 0x3a, 0xdd,
+	0x50, 0x15, 0x04, 0x0f, 0x15, 0x0f, 0x95, 0x28, 0x02, 0x89, 0x07, 0x24, 0x54, 0x90, 0x90, 0x10,
+	0x0f, 0xf0, 0x52, 0x89, 0x17, 0x3e, 0x40, 0x1f, 0xab, 0xf2, 0x82, 0x78, 0xc8, 0x42, 0x96, 0x07,
+	0x3e, 0x06, 0xba, 0x7f, 0xe6, 0x8f, 0x3d, 0xe3, 0xc4, 0xbb, 0x5a, 0xa4, 0xbe, 0xb4, 0x99, 0x73,
+	0xce, 0xef, 0x9c, 0xdf, 0x39, 0x3e, 0xf7, 0xdc, 0x73, 0x17, 0xd6, 0xef, 0x99, 0xe7, 0x66, 0xd3,
+	0xb2, 0xfd, 0xe6, 0xf9, 0x0b, 0x5d, 0x4c, 0xcd, 0x17, 0x9a, 0xf7, 0x87, 0x38, 0xb8, 0x68, 0xf8,
+	0x81, 0x47, 0x3d, 0x54, 0x66, 0xda, 0x86, 0x65, 0xfb, 0x0d, 0xa9, 0xd5, 0xaa, 0x96, 0x47, 0x06,
+	0x1e, 0x69, 0x9a, 0x43, 0x7a, 0x37, 0x82, 0xb0, 0x0f, 0x81, 0xd0, 0x9e, 0x93, 0xfa, 0xae, 0x49,
+	0xb0, 0x70, 0x15, 0x59, 0xf9, 0x66, 0xcf, 0x71, 0x4d, 0xea, 0x78, 0xae, 0xb4, 0xad, 0x26, 0x6d,
+	0x43, 0x2b, 0xcb, 0x73, 0x42, 0xfd, 0x9a, 0xd0, 0x77, 0xf8, 0x57, 0x53, 0x7c, 0x48, 0x55, 0xa5,
+	0xe7, 0xf5, 0x3c, 0x21, 0x67, 0x7f, 0x49, 0xe9, 0x7a, 0xcf, 0xf3, 0x7a, 0x7d, 0xdc, 0x34, 0x7d,
+	0xa7, 0x69, 0xba, 0xae, 0x47, 0x79, 0xb4, 0x10, 0x53, 0x93, 0x5a, 0xfe, 0xd5, 0x1d, 0x9e, 0x35,
+	0xa9, 0x33, 0xc0, 0x84, 0x9a, 0x03, 0x5f, 0x1a, 0x68, 0xa9, 0x5a, 0x58, 0x76, 0xa8, 0xab, 0xa6,
+	0x74, 0x3d, 0xec, 0x62, 0xe2, 0x48, 0xe7, 0x7a, 0x05, 0xd0, 0x1b, 0x2c, 0xdb, 0x63, 0x33, 0x30,
+	0x07, 0xc4, 0xc0, 0xf7, 0x87, 0x98, 0x50, 0xfd, 0x4d, 0xf8, 0xdc, 0x88, 0x94, 0xf8, 0x9e, 0x4b,
+	0x30, 0x7a, 0x09, 0xe6, 0x7d, 0x2e, 0x51, 0x95, 0xba, 0xb2, 0x53, 0xdc, 0x53, 0x1b, 0xe3, 0x75,
+	0x6e, 0x08, 0x44, 0x2b, 0xf7, 0xd1, 0x65, 0x6d, 0xc6, 0x90, 0xd6, 0x5f, 0x2b, 0xfc, 0xec, 0x83,
+	0xda, 0xcc, 0x7f, 0x3f, 0xa8, 0xcd, 0xe8, 0x2b, 0x50, 0xe1, 0x8e, 0xf7, 0x2d, 0xcb, 0x1b, 0xba,
+	0x34, 0x0a, 0xf8, 0x7d, 0x58, 0x1e, 0x93, 0xcb, 0x90, 0x6d, 0x28, 0x98, 0x52, 0xa6, 0x2a, 0xf5,
+	0xb9, 0x9d, 0xe2, 0x9e, 0xde, 0x90, 0x15, 0xe5, 0xbf, 0x5e, 0x18, 0xf7, 0xdb, 0x9e, 0x3d, 0xec,
+	0x63, 0x09, 0x97, 0xe1, 0x23, 0xa4, 0xfe, 0x9e, 0x02, 0x25, 0xee, 0xff, 0xc0, 0xf6, 0x65, 0x48,
+	0xb4, 0x0d, 0x25, 0xcb, 0xeb, 0xf7, 0x4d, 0x8a, 0x03, 0xb3, 0xdf, 0xa1, 0x17, 0x3e, 0xe6, 0x59,
+	0x2d, 0x18, 0x4b, 0xb1, 0xf8, 0xf4, 0xc2, 0xc7, 0xa8, 0x01, 0x79, 0xef, 0x2d, 0x17, 0x07, 0xea,
+	0x2c, 0x53, 0xb7, 0xd4, 0x4f, 0x3e, 0xdc, 0xad, 0x48, 0x0a, 0xfb, 0xb6, 0x1d, 0x60, 0x42, 0x4e,
+	0x68, 0xe0, 0xb8, 0x3d, 0x43, 0x98, 0xa1, 0x3a, 0xcc, 0x5b, 0xb6, 0xdf, 0x71, 0x6c, 0x75, 0xae,
+	0xae, 0xec, 0xe4, 0x5a, 0x0b, 0x57, 0x97, 0xb5, 0xfc, 0x81, 0xed, 0x1f, 0xb5, 0x8d, 0xbc, 0x65,
+	0xfb, 0x47, 0xb6, 0x7e, 0x04, 0xe5, 0x98, 0x8d, 0x4c, 0xf4, 0xcb, 0x30, 0x67, 0xd9, 0xbe, 0x2c,
+	0xec, 0xe7, 0xd3, 0x85, 0x3d, 0x68, 0x1f, 0x87, 0xb6, 0x32, 0x3d, 0x66, 0xaf, 0xff, 0x5b, 0x89,
+	0x7d, 0x91, 0xff, 0x7b, 0x6a, 0x2b, 0x30, 0x1b, 0xa5, 0x35, 0x7f, 0x75, 0x59, 0x9b, 0x3d, 0x6a,
+	0x1b, 0xb3, 0x8e, 0x8d, 0x2a, 0x90, 0x0f, 0x58, 0xcf, 0xaa, 0x39, 0x1e, 0x46, 0x7c, 0xa0, 0x43,
+	0x80, 0xf8, 0xec, 0xa8, 0x79, 0x9e, 0xd9, 0x56, 0xf8, 0xeb, 0xb1, 0xc3, 0xd3, 0x10, 0x67, 0x36,
+	0xee, 0x9d, 0x1e, 0x96, 0x29, 0x18, 0x09, 0xa4, 0xfe, 0x07, 0x05, 0x9e, 0x49, 0xe4, 0x28, 0x0b,
+	0xf6, 0x2a, 0xe4, 0x2c, 0xdb, 0x0f, 0xbb, 0xe2, 0x86, 0x8a, 0x55, 0x58, 0xc5, 0xfe, 0xf4, 0xb0,
+	0xb6, 0x98, 0x10, 0x12, 0x83, 0x3b, 0x40, 0xaf, 0x8e, 0xd0, 0x9c, 0xe5, 0x34, 0xb7, 0x6f, 0xa4,
+	0x29, 0x7c, 0x8c, 0xf0, 0xfc, 0x85, 0x22, 0xbb, 0xbb, 0x8d, 0x7d, 0x8f, 0x38, 0x94, 0x7c, 0x0a,
+	0x5a, 0xed, 0x07, 0xb0, 0x3c, 0x46, 0x29, 0x2a, 0x5f, 0xc1, 0x96, 0x32, 0x59, 0xc2, 0xb5, 0x74,
+	0x09, 0x25, 0xaa, 0x55, 0x96, 0xe5, 0x2b, 0x44, 0x6e, 0x22, 0xb0, 0xfe, 0x4d, 0xd0, 0x78, 0x84,
+	0x53, 0x8f, 0x9a, 0xfd, 0xe3, 0xc0, 0x71, 0x2d, 0xc7, 0x37, 0xfb, 0x8f, 0x9b, 0xba, 0xfe, 0x13,
+	0x05, 0x9e, 0xcd, 0xf4, 0x23, 0xf9, 0x76, 0xa1, 0x44, 0x99, 0xa6, 0xe3, 0x87, 0x2a, 0x49, 0xbb,
+	0x9e, 0xa6, 0x3d, 0xea, 0xa2, 0xb5, 0x2a, 0xd9, 0x97, 0x46, 0xe5, 0xc4, 0x58, 0xa2, 0x23, 0x02,
+	0xfd, 0x30, 0x49, 0xe1, 0x20, 0xe2, 0xf7, 0xd8, 0xb9, 0xbc, 0xab, 0xc0, 0x7a, 0xb6, 0x23, 0x99,
+	0xcc, 0x19, 0x94, 0x45, 0x32, 0x31, 0x50, 0x66, 0xb3, 0x31, 0x21, 0x9b, 0xd8, 0x49, 0x4b, 0x95,
+	0xe9, 0x94, 0xc7, 0x14, 0xc4, 0x28, 0xd1, 0x51, 0x89, 0xfe, 0x32, 0xd4, 0xc5, 0x1c, 0xc7, 0xbd,
+	0x13, 0x6a, 0x76, 0x9d, 0xbe, 0x43, 0x2f, 0x0c, 0x4c, 0x70, 0x70, 0x8e, 0xa3, 0xe6, 0xac, 0x40,
+	0xde, 0xc6, 0xae, 0x37, 0x90, 0xb9, 0x88, 0x0f, 0xbd, 0x0f, 0x1b, 0xd7, 0x20, 0xe3, 0x1e, 0x0a,
+	0xa4, 0x4c, 0xd2, 0xbf, 0x95, 0x71, 0x23, 0xa4, 0x3d, 0x84, 0xf3, 0x39, 0x04, 0xeb, 0x1a, 0xa8,
+	0x3c, 0xda, 0xc9, 0x05, 0xa1, 0x78, 0xf0, 0x1a, 0x36, 0xfb, 0xf4, 0x6e, 0x78, 0x35, 0xfc, 0x6a,
+	0x1e, 0xd6, 0x32, 0x94, 0x92, 0xc2, 0xeb, 0x50, 0x8c, 0x6b, 0x18, 0x5f, 0x11, 0xe9, 0x61, 0x10,
+	0x19, 0x09, 0x07, 0x92, 0x42, 0x12, 0x8c, 0x5e, 0x83, 0x52, 0xdf, 0xb9, 0x3f, 0x74, 0x6c, 0x93,
+	0x7a, 0x41, 0xc7, 0xc6, 0x5d, 0x2a, 0xa7, 0xc1, 0xda, 0xc8, 0x34, 0x88, 0x5d, 0x3a, 0xae, 0x74,
+	0xb3, 0x14, 0xe3, 0xda, 0xb8, 0x4b, 0xd1, 0x9b, 0xb0, 0x4a, 0x86, 0x81, 0xdf, 0x1f, 0x92, 0x8e,
+	0x8f, 0x5d, 0xdb, 0x71, 0x7b, 0x1d, 0x73, 0x68, 0xf1, 0xf9, 0x32, 0x37, 0x9d, 0xc7, 0x65, 0x89,
+	0x3f, 0x16, 0xf0, 0x7d, 0x81, 0x46, 0x6f, 0x40, 0x85, 0xf1, 0x4a, 0x79, 0xcd, 0x4d, 0xe7, 0x15,
+	0x31, 0xf0, 0x98, 0xcb, 0x3b, 0xa0, 0x99, 0x16, 0x75, 0xce, 0x71, 0xa2, 0x19, 0x43, 0xbf, 0x84,
+	0x4f, 0xed, 0x9c, 0xa1, 0x0a, 0x8b, 0xb8, 0x88, 0x12, 0x4c, 0xd0, 0x4b, 0xb0, 0x2a, 0xd1, 0x61,
+	0xc2, 0x11, 0x74, 0x9e, 0x43, 0x97, 0x85, 0xfa, 0x44, 0x68, 0x23, 0xdc, 0xf3, 0x50, 0x91, 0x38,
+	0x9e, 0x4f, 0x04, 0xfa, 0x0c, 0x07, 0x21, 0xa1, 0x63, 0xb5, 0x8c, 0x10, 0x3f, 0x82, 0xf5, 0x98,
+	0xa0, 0xf3, 0x43, 0x3e, 0x72, 0x3b, 0xfc, 0xa2, 0xe9, 0x10, 0xdf, 0xa3, 0x6a, 0x81, 0x8f, 0xcc,
+	0x3b, 0x2c, 0xcf, 0x7f, 0x5e, 0xd6, 0xb6, 0x7a, 0x0e, 0xbd, 0x3b, 0xec, 0x36, 0x2c, 0x6f, 0x20,
+	0x37, 0x30, 0xf9, 0xbf, 0x5d, 0x62, 0xdf, 0x6b, 0xb2, 0xb3, 0x4b, 0x1a, 0x6d, 0x6c, 0x7d, 0xf2,
+	0xe1, 0x2e, 0xc8, 0x9a, 0xb5, 0xb1, 0x65, 0x68, 0xa9, 0x08, 0x06, 0xfb, 0xef, 0x89, 0xef, 0x51,
+	0xf4, 0x9e, 0x02, 0x9b, 0x93, 0x08, 0x84, 0x3f, 0x3f, 0xfb, 0x29, 0x16, 0x9e, 0x02, 0x8f, 0x8d,
+	0x6c, 0x1e, 0xdf, 0x8a, 0xc3, 0xe8, 0x7f, 0x54, 0xe4, 0x99, 0x31, 0x1c, 0x72, 0xcf, 0xc1, 0x84,
+	0x3e, 0xd1, 0x02, 0xf0, 0x2c, 0x2c, 0x0c, 0xcc, 0x07, 0x22, 0x0b, 0x71, 0xe9, 0x18, 0x85, 0x81,
+	0xf9, 0xc0, 0xc8, 0xb8, 0xbf, 0xe7, 0x9e, 0xf8, 0xfe, 0xfe, 0xbd, 0x02, 0x6b, 0x19, 0x54, 0xe5,
+	0x09, 0xbe, 0x33, 0x72, 0x8f, 0x67, 0x1c, 0x5d, 0x86, 0xba, 0x48, 0xaf, 0x3f, 0x4f, 0xf9, 0xf2,
+	0xfe, 0xb3, 0x02, 0xe5, 0xf1, 0x48, 0x4f, 0xb8, 0x94, 0xa1, 0xdb, 0xf0, 0x4c, 0xa2, 0x23, 0xd8,
+	0x8d, 0x65, 0x61, 0x59, 0xdd, 0x72, 0x42, 0x71, 0xcc, 0xe4, 0xec, 0x04, 0xd9, 0x0e, 0xa1, 0xa6,
+	0x6b, 0xe1, 0x0e, 0x1d, 0x6d, 0xa5, 0x39, 0x0e, 0x59, 0x0e, 0xd5, 0xa7, 0x23, 0x0d, 0xf0, 0xd7,
+	0x1c, 0x14, 0x93, 0x5c, 0xc5, 0x6e, 0xa6, 0x64, 0xed, 0x66, 0x89, 0x9d, 0x22, 0xdc, 0x1c, 0x10,
+	0xe4, 0x78, 0x5b, 0x88, 0x10, 0xfc, 0x6f, 0xf4, 0x0a, 0x40, 0xe2, 0x3e, 0x9a, 0x72, 0xa4, 0x24,
+	0x20, 0xe8, 0x1b, 0xb0, 0x10, 0xdf, 0xce, 0xf9, 0xe9, 0xf0, 0x31, 0x02, 0xbd, 0x0e, 0x65, 0xd3,
+	0xb2, 0x86, 0x83, 0x21, 0xf3, 0x67, 0x77, 0xce, 0x30, 0x16, 0x43, 0x64, 0x0a, 0x2f, 0xa5, 0x04,
+	0xf0, 0x10, 0x63, 0xd6, 0x17, 0x8b, 0x0c, 0xdf, 0x19, 0xfa, 0x36, 0x93, 0xf1, 0xb9, 0x52, 0xdc,
+	0xd3, 0x1a, 0xe2, 0x2d, 0xd5, 0x08, 0xdf, 0x52, 0x8d, 0xd3, 0xf0, 0x2d, 0xd5, 0x2a, 0x30, 0x47,
+	0xef, 0x3f, 0xac, 0x29, 0x46, 0x91, 0x21, 0xbf, 0x23, 0x80, 0xec, 0x28, 0x39, 0x2e, 0xc5, 0x01,
+	0x26, 0xb4, 0x73, 0x66, 0x5a, 0xd4, 0x0b, 0xc4, 0xa4, 0x31, 0x96, 0x42, 0xf1, 0x21, 0x97, 0x32,
+	0xf6, 0x89, 0x33, 0x77, 0x6e, 0xf6, 0x87, 0x58, 0x5d, 0x98, 0x92, 0x7d, 0x0c, 0xfc, 0x2e, 0xc3,
+	0xa1, 0xaf, 0xc0, 0xea, 0x84, 0x51, 0xa3, 0x02, 0x0f, 0xbe, 0x92, 0x3d, 0x20, 0xd0, 0x26, 0x7c,
+	0x96, 0x84, 0x97, 0x2d, 0x2b, 0xa0, 0x5a, 0xe4, 0xe6, 0x8b, 0x91, 0xf0, 0x10, 0xe3, 0xbd, 0xbf,
+	0x15, 0x21, 0xcf, 0xcf, 0x23, 0x7a, 0x0b, 0xe6, 0xc5, 0x83, 0x0d, 0x7d, 0x21, 0xdd, 0xdc, 0xe9,
+	0x77, 0xa1, 0x76, 0xeb, 0x06, 0x2b, 0xd1, 0x8a, 0x7a, 0xfd, 0xa7, 0x7f, 0xff, 0xcf, 0x2f, 0x67,
+	0x35, 0xa4, 0x36, 0x53, 0xaf, 0x4f, 0xf1, 0x22, 0x44, 0x3f, 0x86, 0x42, 0xf8, 0xd4, 0x43, 0x5b,
+	0x13, 0x9c, 0x8e, 0xbd, 0x11, 0xb5, 0xed, 0x1b, 0xed, 0x64, 0x78, 0x9d, 0x87, 0x5f, 0x47, 0x5a,
+	0x3a, 0x7c, 0xf8, 0x22, 0x44, 0xbf, 0x56, 0x60, 0x69, 0x74, 0x1d, 0x44, 0x5f, 0x9a, 0xe0, 0x3f,
+	0x73, 0xb1, 0xd5, 0x76, 0xa7, 0xb4, 0x96, 0x9c, 0x76, 0x38, 0x27, 0x1d, 0xd5, 0xd3, 0x9c, 0x46,
+	0x97, 0x50, 0xf4, 0x1b, 0x05, 0x4a, 0x63, 0x9b, 0x1d, 0xba, 0x36, 0x58, 0x6a, 0x51, 0xd5, 0x1a,
+	0xd3, 0x9a, 0x4b, 0x72, 0x5f, 0xe4, 0xe4, 0x36, 0xd1, 0xc6, 0x04, 0x72, 0x09, 0x26, 0x1e, 0xe4,
+	0xd8, 0xf4, 0x46, 0xfa, 0x84, 0x10, 0x89, 0x5b, 0x48, 0xdb, 0xbc, 0xd6, 0x46, 0xc6, 0xae, 0xf2,
+	0xd8, 0x2a, 0x5a, 0x69, 0x66, 0xfd, 0x2b, 0x06, 0x41, 0xef, 0x2a, 0x30, 0x77, 0x60, 0xfb, 0x68,
+	0x63, 0xb2, 0xb3, 0x30, 0x9e, 0x7e, 0x9d, 0x89, 0x0c, 0xf7, 0x32, 0x0f, 0xb7, 0x87, 0x9e, 0xcf,
+	0x0e, 0xd7, 0x7c, 0x9b, 0x8f, 0xc7, 0x77, 0x9a, 0x6f, 0x8f, 0xdd, 0x9f, 0xef, 0xa0, 0xdf, 0x2a,
+	0x10, 0x3d, 0x7f, 0x26, 0xf6, 0xec, 0xd8, 0xcb, 0x4f, 0xdb, 0xbe, 0xd1, 0x4e, 0xf2, 0xda, 0xe7,
+	0xbc, 0xbe, 0x8e, 0xbe, 0x3a, 0x81, 0x57, 0xf8, 0xdc, 0xba, 0x86, 0xe0, 0x5f, 0x14, 0xa8, 0x64,
+	0xad, 0xeb, 0x68, 0x6f, 0xd2, 0xa9, 0x9d, 0xfc, 0x2a, 0xd0, 0x5e, 0x7c, 0x2c, 0x8c, 0x4c, 0xa2,
+	0xc1, 0x93, 0xd8, 0x41, 0x5b, 0x19, 0xe7, 0x3e, 0x8b, 0xd8, 0xcf, 0x15, 0x58, 0x4c, 0x6e, 0xf5,
+	0xe8, 0xb9, 0x09, 0x51, 0x33, 0xde, 0x05, 0xda, 0xed, 0xa9, 0x6c, 0x25, 0xb3, 0x2d, 0xce, 0xac,
+	0x8e, 0xaa, 0x69, 0x66, 0x24, 0x49, 0xe0, 0x77, 0x0a, 0x2c, 0x26, 0xb7, 0x94, 0x89, 0x8c, 0x32,
+	0xb6, 0x2e, 0xed, 0xf6, 0x54, 0xb6, 0x37, 0x37, 0x62, 0x90, 0xb0, 0x4f, 0xff, 0xce, 0xad, 0x57,
+	0x3e, 0xba, 0xaa, 0x2a, 0x1f, 0x5f, 0x55, 0x95, 0x7f, 0x5d, 0x55, 0x95, 0xf7, 0x1f, 0x55, 0x67,
+	0x3e, 0x7e, 0x54, 0x9d, 0xf9, 0xc7, 0xa3, 0xea, 0xcc, 0xf7, 0x6e, 0x25, 0x96, 0x4d, 0xe6, 0x75,
+	0xb7, 0x6f, 0x76, 0x89, 0xf0, 0xff, 0x80, 0x47, 0x60, 0x0e, 0x48, 0x77, 0x9e, 0xdf, 0x7e, 0x2f,
+	0xfe, 0x6f, 0x00, 0x3a, 0x41, 0x81, 0xa2, 0x44, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -940,6 +1415,12 @@ type QueryClient interface {
 	Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error)
 	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// PegStabilityReserves queries the peg stability module reserves and the debt asset minted against them.
+	PegStabilityReserves(ctx context.Context, in *QueryPegStabilityReservesRequest, opts ...grpc.CallOption) (*QueryPegStabilityReservesResponse, error)
+	// SystemHealth queries the debt, surplus, and collateralization of the cdp system.
+	SystemHealth(ctx context.Context, in *QuerySystemHealthRequest, opts ...grpc.CallOption) (*QuerySystemHealthResponse, error)
+	// RiskiestCdps queries the CDPs of a collateral type closest to liquidation, each page sorted by current collateralization ratio.
+	RiskiestCdps(ctx context.Context, in *QueryRiskiestCdpsRequest, opts ...grpc.CallOption) (*QueryRiskiestCdpsResponse, error)
 }
 
 type queryClient struct {
@@ -1013,11 +1494,38 @@ func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, op
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the cdp module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Accounts queries the CDP module accounts.
+func (c *queryClient) PegStabilityReserves(ctx context.Context, in *QueryPegStabilityReservesRequest, opts ...grpc.CallOption) (*QueryPegStabilityReservesResponse, error) {
+	out := new(QueryPegStabilityReservesResponse)
+	err := c.cc.Invoke(ctx, "/kava.cdp.v1beta1.Query/PegStabilityReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SystemHealth(ctx context.Context, in *QuerySystemHealthRequest, opts ...grpc.CallOption) (*QuerySystemHealthResponse, error) {
+	out := new(QuerySystemHealthResponse)
+	err := c.cc.Invoke(ctx, "/kava.cdp.v1beta1.Query/SystemHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RiskiestCdps(ctx context.Context, in *QueryRiskiestCdpsRequest, opts ...grpc.CallOption) (*QueryRiskiestCdpsResponse, error) {
+	out := new(QueryRiskiestCdpsResponse)
+	err := c.cc.Invoke(ctx, "/kava.cdp.v1beta1.Query/RiskiestCdps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the cdp module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Accounts queries the CDP module accounts.
 	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
 	// TotalPrincipal queries the total principal of a given collateral type.
 	TotalPrincipal(context.Context, *QueryTotalPrincipalRequest) (*QueryTotalPrincipalResponse, error)
@@ -1029,6 +1537,12 @@ type QueryServer interface {
 	Cdp(context.Context, *QueryCdpRequest) (*QueryCdpResponse, error)
 	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// PegStabilityReserves queries the peg stability module reserves and the debt asset minted against them.
+	PegStabilityReserves(context.Context, *QueryPegStabilityReservesRequest) (*QueryPegStabilityReservesResponse, error)
+	// SystemHealth queries the debt, surplus, and collateralization of the cdp system.
+	SystemHealth(context.Context, *QuerySystemHealthRequest) (*QuerySystemHealthResponse, error)
+	// RiskiestCdps queries the CDPs of a collateral type closest to liquidation, each page sorted by current collateralization ratio.
+	RiskiestCdps(context.Context, *QueryRiskiestCdpsRequest) (*QueryRiskiestCdpsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1056,6 +1570,15 @@ func (*UnimplementedQueryServer) Cdp(ctx context.Context, req *QueryCdpRequest)
 func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
 }
+func (*UnimplementedQueryServer) PegStabilityReserves(ctx context.Context, req *QueryPegStabilityReservesRequest) (*QueryPegStabilityReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PegStabilityReserves not implemented")
+}
+func (*UnimplementedQueryServer) SystemHealth(ctx context.Context, req *QuerySystemHealthRequest) (*QuerySystemHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemHealth not implemented")
+}
+func (*UnimplementedQueryServer) RiskiestCdps(ctx context.Context, req *QueryRiskiestCdpsRequest) (*QueryRiskiestCdpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RiskiestCdps not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1187,6 +1710,60 @@ func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PegStabilityReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPegStabilityReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PegStabilityReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.cdp.v1beta1.Query/PegStabilityReserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PegStabilityReserves(ctx, req.(*QueryPegStabilityReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SystemHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySystemHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SystemHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.cdp.v1beta1.Query/SystemHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SystemHealth(ctx, req.(*QuerySystemHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RiskiestCdps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRiskiestCdpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RiskiestCdps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.cdp.v1beta1.Query/RiskiestCdps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RiskiestCdps(ctx, req.(*QueryRiskiestCdpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.cdp.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1219,6 +1796,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deposits",
 			Handler:    _Query_Deposits_Handler,
 		},
+		{
+			MethodName: "PegStabilityReserves",
+			Handler:    _Query_PegStabilityReserves_Handler,
+		},
+		{
+			MethodName: "SystemHealth",
+			Handler:    _Query_SystemHealth_Handler,
+		},
+		{
+			MethodName: "RiskiestCdps",
+			Handler:    _Query_RiskiestCdps_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/cdp/v1beta1/query.proto",
@@ -1360,6 +1949,11 @@ func (m *QueryCdpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1540,6 +2134,11 @@ func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1728,7 +2327,7 @@ func (m *QueryTotalCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *CDPResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPegStabilityReservesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1738,50 +2337,143 @@ func (m *CDPResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CDPResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPegStabilityReservesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPegStabilityReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CollateralizationRatio) > 0 {
-		i -= len(m.CollateralizationRatio)
-		copy(dAtA[i:], m.CollateralizationRatio)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralizationRatio)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPegStabilityReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPegStabilityReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPegStabilityReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySystemHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySystemHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySystemHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySystemHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySystemHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySystemHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size, err := m.CollateralValue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.CollateralizationRatioLiquidation.Size()
+		i -= size
+		if _, err := m.CollateralizationRatioLiquidation.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x4a
-	if len(m.InterestFactor) > 0 {
-		i -= len(m.InterestFactor)
-		copy(dAtA[i:], m.InterestFactor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestFactor)))
+	{
+		size := m.CollateralizationRatioSpot.Size()
+		i -= size
+		if _, err := m.CollateralizationRatioSpot.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.ActiveDebtAuctions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveDebtAuctions))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x38
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
-	if err6 != nil {
-		return 0, err6
+	if m.ActiveSurplusAuctions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveSurplusAuctions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ActiveCollateralAuctions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveCollateralAuctions))
+		i--
+		dAtA[i] = 0x28
 	}
-	i -= n6
-	i = encodeVarintQuery(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x3a
 	{
-		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.DebtPendingAuction.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1789,9 +2481,9 @@ func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x22
 	{
-		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.SurplusPendingAuction.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1799,9 +2491,9 @@ func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x1a
 	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.LiquidatorDebt.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1809,39 +2501,290 @@ func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+	dAtA[i] = 0x12
+	if len(m.Collaterals) > 0 {
+		for iNdEx := len(m.Collaterals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collaterals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRiskiestCdpsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskiestCdpsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskiestCdpsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+	if len(m.MaxRatio) > 0 {
+		i -= len(m.MaxRatio)
+		copy(dAtA[i:], m.MaxRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxRatio)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRiskiestCdpsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskiestCdpsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskiestCdpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cdps) > 0 {
+		for iNdEx := len(m.Cdps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cdps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RiskyCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RiskyCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RiskyCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistanceToLiquidation) > 0 {
+		i -= len(m.DistanceToLiquidation)
+		copy(dAtA[i:], m.DistanceToLiquidation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistanceToLiquidation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LiquidationPrice) > 0 {
+		i -= len(m.LiquidationPrice)
+		copy(dAtA[i:], m.LiquidationPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidationPrice)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Cdp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StabilityFee) > 0 {
+		i -= len(m.StabilityFee)
+		copy(dAtA[i:], m.StabilityFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StabilityFee)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CollateralizationRatio) > 0 {
+		i -= len(m.CollateralizationRatio)
+		copy(dAtA[i:], m.CollateralizationRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralizationRatio)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.CollateralValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.InterestFactor) > 0 {
+		i -= len(m.InterestFactor)
+		copy(dAtA[i:], m.InterestFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestFactor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
@@ -1901,6 +2844,9 @@ func (m *QueryCdpRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.CdpID != 0 {
+		n += 1 + sovQuery(uint64(m.CdpID))
+	}
 	return n
 }
 
@@ -1976,6 +2922,9 @@ func (m *QueryDepositsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.CdpID != 0 {
+		n += 1 + sovQuery(uint64(m.CdpID))
+	}
 	return n
 }
 
@@ -2050,48 +2999,182 @@ func (m *QueryTotalCollateralResponse) Size() (n int) {
 	return n
 }
 
-func (m *CDPResponse) Size() (n int) {
+func (m *QueryPegStabilityReservesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovQuery(uint64(m.ID))
-	}
-	l = len(m.Owner)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPegStabilityReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = m.Collateral.Size()
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySystemHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySystemHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collaterals) > 0 {
+		for _, e := range m.Collaterals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.LiquidatorDebt.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	l = m.Principal.Size()
+	l = m.SurplusPendingAuction.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	l = m.AccumulatedFees.Size()
+	l = m.DebtPendingAuction.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated)
+	if m.ActiveCollateralAuctions != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveCollateralAuctions))
+	}
+	if m.ActiveSurplusAuctions != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveSurplusAuctions))
+	}
+	if m.ActiveDebtAuctions != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveDebtAuctions))
+	}
+	l = m.CollateralizationRatioSpot.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.InterestFactor)
+	l = m.CollateralizationRatioLiquidation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRiskiestCdpsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = m.CollateralValue.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.CollateralizationRatio)
+	l = len(m.MaxRatio)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
+func (m *QueryRiskiestCdpsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cdps) > 0 {
+		for _, e := range m.Cdps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RiskyCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cdp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.LiquidationPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DistanceToLiquidation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccumulatedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.InterestFactor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CollateralValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.CollateralizationRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StabilityFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
@@ -2454,6 +3537,25 @@ func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2972,6 +4074,25 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3024,9 +4145,865 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPrincipalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPrincipalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPrincipalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPrincipal = append(m.TotalPrincipal, TotalPrincipal{})
+			if err := m.TotalPrincipal[len(m.TotalPrincipal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCollateral = append(m.TotalCollateral, TotalCollateral{})
+			if err := m.TotalCollateral[len(m.TotalCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPegStabilityReservesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPegStabilityReservesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPegStabilityReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPegStabilityReservesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPegStabilityReservesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPegStabilityReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, PegStabilityReserve{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySystemHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySystemHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySystemHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySystemHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySystemHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySystemHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaterals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collaterals = append(m.Collaterals, CollateralHealth{})
+			if err := m.Collaterals[len(m.Collaterals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidatorDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusPendingAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SurplusPendingAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtPendingAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtPendingAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveCollateralAuctions", wireType)
+			}
+			m.ActiveCollateralAuctions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveCollateralAuctions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSurplusAuctions", wireType)
+			}
+			m.ActiveSurplusAuctions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSurplusAuctions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDebtAuctions", wireType)
+			}
+			m.ActiveDebtAuctions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveDebtAuctions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatioSpot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralizationRatioSpot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatioLiquidation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3036,23 +5013,23 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralizationRatioLiquidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3077,7 +5054,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRiskiestCdpsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3100,10 +5077,10 @@ func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPrincipalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRiskiestCdpsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRiskiestCdpsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3138,59 +5115,41 @@ func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRatio", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPrincipalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPrincipalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MaxRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3217,8 +5176,10 @@ func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalPrincipal = append(m.TotalPrincipal, TotalPrincipal{})
-			if err := m.TotalPrincipal[len(m.TotalPrincipal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3243,7 +5204,7 @@ func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRiskiestCdpsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3266,17 +5227,17 @@ func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRiskiestCdpsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRiskiestCdpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3286,23 +5247,61 @@ func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.Cdps = append(m.Cdps, RiskyCDPResponse{})
+			if err := m.Cdps[len(m.Cdps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3325,7 +5324,7 @@ func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *RiskyCDPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3348,15 +5347,15 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RiskyCDPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RiskyCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3383,11 +5382,74 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalCollateral = append(m.TotalCollateral, TotalCollateral{})
-			if err := m.TotalCollateral[len(m.TotalCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cdp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistanceToLiquidation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistanceToLiquidation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3750,6 +5812,38 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CollateralizationRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StabilityFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -150,6 +150,7 @@ type MsgDeposit struct {
 	Owner          string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Collateral     types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
 	CollateralType string     `protobuf:"bytes,4,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	CdpID          uint64     `protobuf:"varint,5,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
@@ -213,6 +214,13 @@ func (m *MsgDeposit) GetCollateralType() string {
 	return ""
 }
 
+func (m *MsgDeposit) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // MsgDepositResponse defines the Msg/Deposit response type.
 type MsgDepositResponse struct {
 }
@@ -256,6 +264,7 @@ type MsgWithdraw struct {
 	Owner          string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Collateral     types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
 	CollateralType string     `protobuf:"bytes,4,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	CdpID          uint64     `protobuf:"varint,5,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
@@ -319,6 +328,13 @@ func (m *MsgWithdraw) GetCollateralType() string {
 	return ""
 }
 
+func (m *MsgWithdraw) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // MsgWithdrawResponse defines the Msg/Withdraw response type.
 type MsgWithdrawResponse struct {
 }
@@ -361,6 +377,7 @@ type MsgDrawDebt struct {
 	Sender         string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	CollateralType string     `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 	Principal      types.Coin `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal"`
+	CdpID          uint64     `protobuf:"varint,4,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *MsgDrawDebt) Reset()         { *m = MsgDrawDebt{} }
@@ -417,6 +434,13 @@ func (m *MsgDrawDebt) GetPrincipal() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgDrawDebt) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // MsgDrawDebtResponse defines the Msg/DrawDebt response type.
 type MsgDrawDebtResponse struct {
 }
@@ -459,6 +483,7 @@ type MsgRepayDebt struct {
 	Sender         string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	CollateralType string     `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 	Payment        types.Coin `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment"`
+	CdpID          uint64     `protobuf:"varint,4,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *MsgRepayDebt) Reset()         { *m = MsgRepayDebt{} }
@@ -515,6 +540,13 @@ func (m *MsgRepayDebt) GetPayment() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgRepayDebt) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // MsgRepayDebtResponse defines the Msg/RepayDebt response type.
 type MsgRepayDebtResponse struct {
 }
@@ -558,6 +590,7 @@ type MsgLiquidate struct {
 	Keeper         string `protobuf:"bytes,1,opt,name=keeper,proto3" json:"keeper,omitempty"`
 	Borrower       string `protobuf:"bytes,2,opt,name=borrower,proto3" json:"borrower,omitempty"`
 	CollateralType string `protobuf:"bytes,3,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	CdpID          uint64 `protobuf:"varint,4,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *MsgLiquidate) Reset()         { *m = MsgLiquidate{} }
@@ -614,6 +647,13 @@ func (m *MsgLiquidate) GetCollateralType() string {
 	return ""
 }
 
+func (m *MsgLiquidate) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // MsgLiquidateResponse defines the Msg/Liquidate response type.
 type MsgLiquidateResponse struct {
 }
@@ -651,6 +691,301 @@ func (m *MsgLiquidateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidateResponse proto.InternalMessageInfo
 
+// MsgTransferCDP defines a message to transfer a CDP and the owner's deposit to
+// a new owner.
+type MsgTransferCDP struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	NewOwner       string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	CollateralType string `protobuf:"bytes,3,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	CdpID          uint64 `protobuf:"varint,4,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
+}
+
+func (m *MsgTransferCDP) Reset()         { *m = MsgTransferCDP{} }
+func (m *MsgTransferCDP) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferCDP) ProtoMessage()    {}
+func (*MsgTransferCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b8c9334ad8ab0d3, []int{12}
+}
+func (m *MsgTransferCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferCDP.Merge(m, src)
+}
+func (m *MsgTransferCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferCDP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferCDP proto.InternalMessageInfo
+
+func (m *MsgTransferCDP) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTransferCDP) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *MsgTransferCDP) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
+}
+
+func (m *MsgTransferCDP) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
+// MsgTransferCDPResponse defines the Msg/TransferCDP response type.
+type MsgTransferCDPResponse struct {
+}
+
+func (m *MsgTransferCDPResponse) Reset()         { *m = MsgTransferCDPResponse{} }
+func (m *MsgTransferCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferCDPResponse) ProtoMessage()    {}
+func (*MsgTransferCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b8c9334ad8ab0d3, []int{13}
+}
+func (m *MsgTransferCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferCDPResponse.Merge(m, src)
+}
+func (m *MsgTransferCDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferCDPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferCDPResponse proto.InternalMessageInfo
+
+// MsgPegStabilityMint defines a message to swap a whitelisted stablecoin for newly minted debt asset.
+type MsgPegStabilityMint struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgPegStabilityMint) Reset()         { *m = MsgPegStabilityMint{} }
+func (m *MsgPegStabilityMint) String() string { return proto.CompactTextString(m) }
+func (*MsgPegStabilityMint) ProtoMessage()    {}
+func (*MsgPegStabilityMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b8c9334ad8ab0d3, []int{14}
+}
+func (m *MsgPegStabilityMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPegStabilityMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPegStabilityMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPegStabilityMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPegStabilityMint.Merge(m, src)
+}
+func (m *MsgPegStabilityMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPegStabilityMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPegStabilityMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPegStabilityMint proto.InternalMessageInfo
+
+func (m *MsgPegStabilityMint) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgPegStabilityMint) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgPegStabilityMintResponse defines the Msg/PegStabilityMint response type.
+type MsgPegStabilityMintResponse struct {
+}
+
+func (m *MsgPegStabilityMintResponse) Reset()         { *m = MsgPegStabilityMintResponse{} }
+func (m *MsgPegStabilityMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPegStabilityMintResponse) ProtoMessage()    {}
+func (*MsgPegStabilityMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b8c9334ad8ab0d3, []int{15}
+}
+func (m *MsgPegStabilityMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPegStabilityMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPegStabilityMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPegStabilityMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPegStabilityMintResponse.Merge(m, src)
+}
+func (m *MsgPegStabilityMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPegStabilityMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPegStabilityMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPegStabilityMintResponse proto.InternalMessageInfo
+
+// MsgPegStabilityRedeem defines a message to swap debt asset for a whitelisted stablecoin held in reserve.
+type MsgPegStabilityRedeem struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	Denom  string     `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgPegStabilityRedeem) Reset()         { *m = MsgPegStabilityRedeem{} }
+func (m *MsgPegStabilityRedeem) String() string { return proto.CompactTextString(m) }
+func (*MsgPegStabilityRedeem) ProtoMessage()    {}
+func (*MsgPegStabilityRedeem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b8c9334ad8ab0d3, []int{16}
+}
+func (m *MsgPegStabilityRedeem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPegStabilityRedeem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPegStabilityRedeem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPegStabilityRedeem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPegStabilityRedeem.Merge(m, src)
+}
+func (m *MsgPegStabilityRedeem) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPegStabilityRedeem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPegStabilityRedeem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPegStabilityRedeem proto.InternalMessageInfo
+
+func (m *MsgPegStabilityRedeem) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgPegStabilityRedeem) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgPegStabilityRedeem) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgPegStabilityRedeemResponse defines the Msg/PegStabilityRedeem response type.
+type MsgPegStabilityRedeemResponse struct {
+}
+
+func (m *MsgPegStabilityRedeemResponse) Reset()         { *m = MsgPegStabilityRedeemResponse{} }
+func (m *MsgPegStabilityRedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPegStabilityRedeemResponse) ProtoMessage()    {}
+func (*MsgPegStabilityRedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b8c9334ad8ab0d3, []int{17}
+}
+func (m *MsgPegStabilityRedeemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPegStabilityRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPegStabilityRedeemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPegStabilityRedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPegStabilityRedeemResponse.Merge(m, src)
+}
+func (m *MsgPegStabilityRedeemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPegStabilityRedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPegStabilityRedeemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPegStabilityRedeemResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateCDP)(nil), "kava.cdp.v1beta1.MsgCreateCDP")
 	proto.RegisterType((*MsgCreateCDPResponse)(nil), "kava.cdp.v1beta1.MsgCreateCDPResponse")
@@ -664,52 +999,69 @@ func init() {
 	proto.RegisterType((*MsgRepayDebtResponse)(nil), "kava.cdp.v1beta1.MsgRepayDebtResponse")
 	proto.RegisterType((*MsgLiquidate)(nil), "kava.cdp.v1beta1.MsgLiquidate")
 	proto.RegisterType((*MsgLiquidateResponse)(nil), "kava.cdp.v1beta1.MsgLiquidateResponse")
+	proto.RegisterType((*MsgTransferCDP)(nil), "kava.cdp.v1beta1.MsgTransferCDP")
+	proto.RegisterType((*MsgTransferCDPResponse)(nil), "kava.cdp.v1beta1.MsgTransferCDPResponse")
+	proto.RegisterType((*MsgPegStabilityMint)(nil), "kava.cdp.v1beta1.MsgPegStabilityMint")
+	proto.RegisterType((*MsgPegStabilityMintResponse)(nil), "kava.cdp.v1beta1.MsgPegStabilityMintResponse")
+	proto.RegisterType((*MsgPegStabilityRedeem)(nil), "kava.cdp.v1beta1.MsgPegStabilityRedeem")
+	proto.RegisterType((*MsgPegStabilityRedeemResponse)(nil), "kava.cdp.v1beta1.MsgPegStabilityRedeemResponse")
 }
 
 func init() { proto.RegisterFile("kava/cdp/v1beta1/tx.proto", fileDescriptor_3b8c9334ad8ab0d3) }
 
 var fileDescriptor_3b8c9334ad8ab0d3 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0x8e, 0x9b, 0x26, 0x6d, 0xb6, 0xbf, 0x7e, 0x90, 0x09, 0x28, 0x89, 0xc0, 0x8d, 0x22, 0x0a,
-	0xbd, 0xd4, 0xa6, 0x05, 0x21, 0x38, 0xa0, 0x8a, 0x24, 0x97, 0x4a, 0x44, 0xaa, 0x5c, 0x24, 0x24,
-	0x2e, 0xd1, 0xda, 0xbb, 0x72, 0xad, 0x26, 0xde, 0x65, 0x77, 0xdb, 0x34, 0x6f, 0xc1, 0x1b, 0x70,
-	0x41, 0xe2, 0x05, 0x78, 0x88, 0x1e, 0x2b, 0x4e, 0x9c, 0x02, 0x4a, 0x4e, 0x3c, 0x01, 0x57, 0x64,
-	0xaf, 0xbd, 0x8e, 0x52, 0x27, 0x04, 0x10, 0x17, 0x6e, 0xeb, 0xfd, 0x66, 0x3e, 0x7d, 0xdf, 0x78,
-	0x66, 0x16, 0x54, 0x4f, 0xe0, 0x19, 0xb4, 0x5c, 0x44, 0xad, 0xb3, 0x5d, 0x07, 0x0b, 0xb8, 0x6b,
-	0x89, 0x73, 0x93, 0x32, 0x22, 0x88, 0x7e, 0x3d, 0x84, 0x4c, 0x17, 0x51, 0x33, 0x86, 0x6a, 0x86,
-	0x4b, 0x78, 0x9f, 0x70, 0xcb, 0x81, 0x1c, 0xab, 0x78, 0x97, 0xf8, 0x81, 0xcc, 0xa8, 0x55, 0x25,
-	0xde, 0x8d, 0xbe, 0x2c, 0xf9, 0x11, 0x43, 0x65, 0x8f, 0x78, 0x44, 0xde, 0x87, 0x27, 0x79, 0xdb,
-	0xf8, 0xa6, 0x81, 0xff, 0x3a, 0xdc, 0x6b, 0x31, 0x0c, 0x05, 0x6e, 0xb5, 0x0f, 0xf5, 0x07, 0xa0,
-	0xc8, 0x71, 0x80, 0x30, 0xab, 0x68, 0x75, 0x6d, 0xbb, 0xd4, 0xac, 0x7c, 0xfa, 0xb8, 0x53, 0x8e,
-	0x89, 0x9e, 0x23, 0xc4, 0x30, 0xe7, 0x47, 0x82, 0xf9, 0x81, 0x67, 0xc7, 0x71, 0xfa, 0x3e, 0x00,
-	0x2e, 0xe9, 0xf5, 0xa0, 0xc0, 0x0c, 0xf6, 0x2a, 0x2b, 0x75, 0x6d, 0x7b, 0x63, 0xaf, 0x6a, 0xc6,
-	0x29, 0xa1, 0xd0, 0x44, 0xbd, 0xd9, 0x22, 0x7e, 0xd0, 0x5c, 0xbd, 0x18, 0x6d, 0xe6, 0xec, 0xa9,
-	0x14, 0xfd, 0x19, 0x28, 0x51, 0xe6, 0x07, 0xae, 0x4f, 0x61, 0xaf, 0x92, 0x5f, 0x2e, 0x3f, 0xcd,
-	0xd0, 0xef, 0x83, 0x6b, 0x29, 0x59, 0x57, 0x0c, 0x29, 0xae, 0xac, 0x86, 0xd2, 0xed, 0xff, 0xd3,
-	0xeb, 0x97, 0x43, 0x8a, 0x1b, 0x4f, 0x40, 0x79, 0xda, 0xaa, 0x8d, 0x39, 0x25, 0x01, 0xc7, 0x7a,
-	0x1d, 0x14, 0x5d, 0x44, 0xbb, 0x3e, 0x8a, 0x2c, 0xaf, 0x36, 0x4b, 0xe3, 0xd1, 0x66, 0xa1, 0x85,
-	0xe8, 0x41, 0xdb, 0x2e, 0xb8, 0x88, 0x1e, 0xa0, 0xc6, 0x48, 0x03, 0xa0, 0xc3, 0xbd, 0x36, 0xa6,
-	0x84, 0xfb, 0x42, 0x7f, 0x0c, 0x4a, 0x48, 0x1e, 0xc9, 0xcf, 0xcb, 0x94, 0x86, 0xea, 0x26, 0x28,
-	0x90, 0x41, 0x80, 0x59, 0x54, 0xa4, 0x45, 0x39, 0x32, 0x6c, 0xa6, 0xb2, 0xf9, 0x5f, 0xaf, 0xec,
-	0xd2, 0xa5, 0x29, 0x03, 0x3d, 0xf5, 0x97, 0x14, 0xa6, 0xf1, 0x45, 0x03, 0x1b, 0x1d, 0xee, 0xbd,
-	0xf2, 0xc5, 0x31, 0x62, 0x70, 0xf0, 0x0f, 0xfa, 0xbe, 0x09, 0x6e, 0x4c, 0x19, 0x54, 0xc6, 0x3f,
-	0x48, 0xe3, 0x6d, 0x06, 0x07, 0x6d, 0xec, 0x88, 0xdf, 0x18, 0x8a, 0x0c, 0x05, 0x2b, 0x59, 0x0a,
-	0xfe, 0xb0, 0xf9, 0x63, 0x03, 0x89, 0x50, 0x65, 0xe0, 0xbd, 0x1c, 0x6b, 0x1b, 0x53, 0x38, 0xfc,
-	0xdb, 0x0e, 0x9e, 0x82, 0x35, 0x0a, 0x87, 0x7d, 0x1c, 0x88, 0x65, 0xf5, 0x27, 0xf1, 0x8d, 0x5b,
-	0xd1, 0x44, 0x2a, 0x95, 0x4a, 0xfe, 0x3b, 0x29, 0xff, 0x85, 0xff, 0xe6, 0xd4, 0x47, 0x50, 0xe0,
-	0x50, 0xfe, 0x09, 0xc6, 0x74, 0x19, 0xf9, 0x32, 0x4e, 0x7f, 0x04, 0xd6, 0x1d, 0xc2, 0x18, 0x19,
-	0x2c, 0xd1, 0x76, 0x2a, 0x32, 0xcb, 0x74, 0x3e, 0xb3, 0x71, 0xa4, 0x72, 0x25, 0x30, 0x51, 0xbe,
-	0xf7, 0x3d, 0x0f, 0xf2, 0x1d, 0xee, 0xe9, 0x47, 0xa0, 0x94, 0xee, 0x54, 0xc3, 0x9c, 0x5d, 0xe4,
-	0xe6, 0xf4, 0x22, 0xaa, 0xdd, 0x5b, 0x8c, 0xab, 0x45, 0xd5, 0x01, 0x6b, 0xc9, 0x0a, 0xba, 0x9d,
-	0x99, 0x12, 0xa3, 0xb5, 0xbb, 0x8b, 0x50, 0x45, 0x77, 0x08, 0xd6, 0xd5, 0x68, 0xdf, 0xc9, 0xcc,
-	0x48, 0xe0, 0xda, 0xd6, 0x42, 0x78, 0x9a, 0x51, 0xcd, 0x4c, 0x36, 0x63, 0x02, 0xcf, 0x61, 0x9c,
-	0x6d, 0xe4, 0xb0, 0x8e, 0x69, 0x13, 0x67, 0xd7, 0x51, 0xe1, 0x73, 0xea, 0x78, 0xa5, 0xbd, 0x42,
-	0xd2, 0xb4, 0xb5, 0xb2, 0x49, 0x15, 0x3e, 0x87, 0xf4, 0xca, 0x9f, 0x6f, 0xee, 0x5f, 0x8c, 0x0d,
-	0xed, 0x72, 0x6c, 0x68, 0x5f, 0xc7, 0x86, 0xf6, 0x76, 0x62, 0xe4, 0x2e, 0x27, 0x46, 0xee, 0xf3,
-	0xc4, 0xc8, 0xbd, 0xde, 0xf2, 0x7c, 0x71, 0x7c, 0xea, 0x98, 0x2e, 0xe9, 0x5b, 0x21, 0xd7, 0x4e,
-	0x0f, 0x3a, 0x3c, 0x3a, 0x59, 0xe7, 0xd1, 0xc3, 0x1f, 0xf6, 0x19, 0x77, 0x8a, 0xd1, 0x8b, 0xfc,
-	0xf0, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x75, 0x8a, 0x38, 0x11, 0x08, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcd, 0x6e, 0xeb, 0x44,
+	0x18, 0x8d, 0xf3, 0xd7, 0xe6, 0x2b, 0x2a, 0x95, 0x49, 0xab, 0xd4, 0x50, 0x37, 0x8a, 0xe8, 0xcf,
+	0xa6, 0x36, 0x2d, 0xff, 0x0b, 0x54, 0x91, 0x64, 0x53, 0x89, 0x88, 0x2a, 0xa9, 0x84, 0x60, 0x13,
+	0x8d, 0xed, 0xc1, 0xb5, 0x9a, 0xcc, 0x18, 0xcf, 0xb4, 0x69, 0x76, 0xe5, 0x0d, 0x58, 0xf2, 0x10,
+	0x2c, 0x79, 0x01, 0x24, 0x84, 0xca, 0xae, 0x62, 0xc5, 0xaa, 0x42, 0xe9, 0x8a, 0xb7, 0xb8, 0x4a,
+	0x6c, 0x8f, 0x7d, 0x73, 0x7d, 0x1d, 0xdf, 0xde, 0x9f, 0xcd, 0xdd, 0xd9, 0x3e, 0xe7, 0x3b, 0xfe,
+	0xce, 0xc9, 0xcc, 0x37, 0x0e, 0x6c, 0x5e, 0xa0, 0x2b, 0xa4, 0x9b, 0x96, 0xab, 0x5f, 0x1d, 0x1a,
+	0x98, 0xa3, 0x43, 0x9d, 0x5f, 0x6b, 0xae, 0x47, 0x39, 0x95, 0xd7, 0xa6, 0x90, 0x66, 0x5a, 0xae,
+	0x16, 0x40, 0x8a, 0x6a, 0x52, 0x36, 0xa4, 0x4c, 0x37, 0x10, 0xc3, 0x82, 0x6f, 0x52, 0x87, 0xf8,
+	0x15, 0xca, 0xa6, 0x8f, 0xf7, 0x67, 0x77, 0xba, 0x7f, 0x13, 0x40, 0x55, 0x9b, 0xda, 0xd4, 0x7f,
+	0x3e, 0xbd, 0xf2, 0x9f, 0x36, 0xfe, 0x97, 0xe0, 0x9d, 0x0e, 0xb3, 0x5b, 0x1e, 0x46, 0x1c, 0xb7,
+	0xda, 0xa7, 0xf2, 0x47, 0x50, 0x66, 0x98, 0x58, 0xd8, 0xab, 0x49, 0x75, 0x69, 0xbf, 0xd2, 0xac,
+	0xfd, 0xf3, 0xfb, 0x41, 0x35, 0x10, 0xfa, 0xda, 0xb2, 0x3c, 0xcc, 0x58, 0x8f, 0x7b, 0x0e, 0xb1,
+	0xbb, 0x01, 0x4f, 0x3e, 0x06, 0x30, 0xe9, 0x60, 0x80, 0x38, 0xf6, 0xd0, 0xa0, 0x96, 0xaf, 0x4b,
+	0xfb, 0x2b, 0x47, 0x9b, 0x5a, 0x50, 0x32, 0x6d, 0x34, 0xec, 0x5e, 0x6b, 0x51, 0x87, 0x34, 0x8b,
+	0xb7, 0xf7, 0xdb, 0xb9, 0x6e, 0xac, 0x44, 0xfe, 0x0a, 0x2a, 0xae, 0xe7, 0x10, 0xd3, 0x71, 0xd1,
+	0xa0, 0x56, 0xc8, 0x56, 0x1f, 0x55, 0xc8, 0x7b, 0xf0, 0x6e, 0x24, 0xd6, 0xe7, 0x63, 0x17, 0xd7,
+	0x8a, 0xd3, 0xd6, 0xbb, 0xab, 0xd1, 0xe3, 0xb3, 0xb1, 0x8b, 0x1b, 0x5f, 0x40, 0x35, 0x6e, 0xb5,
+	0x8b, 0x99, 0x4b, 0x09, 0xc3, 0x72, 0x1d, 0xca, 0xa6, 0xe5, 0xf6, 0x1d, 0x6b, 0x66, 0xb9, 0xd8,
+	0xac, 0x4c, 0xee, 0xb7, 0x4b, 0x2d, 0xcb, 0x3d, 0x69, 0x77, 0x4b, 0xa6, 0xe5, 0x9e, 0x58, 0x8d,
+	0x9b, 0x3c, 0x40, 0x87, 0xd9, 0x6d, 0xec, 0x52, 0xe6, 0x70, 0xf9, 0x33, 0xa8, 0x58, 0xfe, 0x25,
+	0x5d, 0x1c, 0x53, 0x44, 0x95, 0x35, 0x28, 0xd1, 0x11, 0xc1, 0x5e, 0x2d, 0xbf, 0xa0, 0xc6, 0xa7,
+	0xcd, 0x25, 0x5b, 0x78, 0xf1, 0x64, 0xb3, 0x46, 0x13, 0x8b, 0xa0, 0xf4, 0x9c, 0x08, 0xaa, 0x20,
+	0x47, 0x09, 0x84, 0xd1, 0x35, 0x7e, 0xce, 0xc3, 0x4a, 0x87, 0xd9, 0xdf, 0x39, 0xfc, 0xdc, 0xf2,
+	0xd0, 0xe8, 0xad, 0x4c, 0x66, 0x1d, 0xde, 0x8b, 0x45, 0x20, 0xa2, 0xf9, 0x5b, 0x9a, 0x45, 0xd3,
+	0xf6, 0xd0, 0xa8, 0x8d, 0x0d, 0xfe, 0x88, 0x8d, 0x95, 0xd0, 0x63, 0x3e, 0xb1, 0xc7, 0x97, 0xdc,
+	0x40, 0x91, 0xc5, 0x62, 0xaa, 0xc5, 0xd0, 0x8a, 0xb0, 0xf8, 0x97, 0x3f, 0x3c, 0xba, 0xd8, 0x45,
+	0xe3, 0xd7, 0xed, 0xf1, 0x4b, 0x58, 0x72, 0xd1, 0x78, 0x88, 0x09, 0xcf, 0xea, 0x30, 0xe4, 0x67,
+	0xf0, 0xb7, 0x01, 0xd5, 0xb8, 0x0f, 0x61, 0xf0, 0x0f, 0xdf, 0xe0, 0x37, 0xce, 0x4f, 0x97, 0x8e,
+	0x85, 0x38, 0x9e, 0x1a, 0xbc, 0xc0, 0xd8, 0xcd, 0x62, 0xd0, 0xe7, 0xc9, 0x9f, 0xc0, 0xb2, 0x41,
+	0x3d, 0x8f, 0x8e, 0x32, 0x2c, 0x6e, 0xc1, 0x4c, 0x8a, 0xa5, 0xb0, 0x60, 0x79, 0xa6, 0x7b, 0x13,
+	0x16, 0x84, 0xb7, 0x3f, 0x25, 0x58, 0xed, 0x30, 0xfb, 0xcc, 0x43, 0x84, 0xfd, 0x88, 0xbd, 0xc7,
+	0xcd, 0xfe, 0x4f, 0xa1, 0x42, 0xf0, 0xa8, 0x9f, 0x6d, 0xef, 0x2e, 0x13, 0x3c, 0xfa, 0x76, 0x44,
+	0x5e, 0xad, 0xbd, 0x1a, 0x6c, 0x3c, 0xed, 0x42, 0x18, 0xbc, 0x91, 0x66, 0xab, 0xf6, 0x14, 0xdb,
+	0x3d, 0x8e, 0x0c, 0x67, 0xe0, 0xf0, 0x71, 0xc7, 0x21, 0x8f, 0x59, 0xa4, 0x9f, 0x43, 0x19, 0x0d,
+	0xe9, 0x25, 0xe1, 0x59, 0x4f, 0xb7, 0x80, 0xde, 0xd8, 0x82, 0xf7, 0x13, 0x3a, 0x10, 0x1d, 0xfe,
+	0x2a, 0xc1, 0xfa, 0x1c, 0xde, 0xc5, 0x16, 0xc6, 0xc3, 0x37, 0xd8, 0xa3, 0x5c, 0x85, 0x92, 0x85,
+	0x09, 0x1d, 0x06, 0xbf, 0x80, 0x7f, 0xd3, 0xd8, 0x86, 0xad, 0xc4, 0xce, 0xc2, 0xde, 0x8f, 0x7e,
+	0x2b, 0x43, 0xa1, 0xc3, 0x6c, 0xb9, 0x07, 0x95, 0xe8, 0xe3, 0x41, 0xd5, 0xe6, 0xbf, 0x58, 0xb4,
+	0xf8, 0x89, 0xab, 0xec, 0xa6, 0xe3, 0xe2, 0x44, 0xee, 0xc0, 0x52, 0x78, 0xd6, 0x7e, 0x90, 0x58,
+	0x12, 0xa0, 0xca, 0x87, 0x69, 0xa8, 0x90, 0x3b, 0x85, 0x65, 0x71, 0x42, 0x6d, 0x25, 0x56, 0x84,
+	0xb0, 0xb2, 0x93, 0x0a, 0xc7, 0x15, 0xc5, 0x60, 0x4f, 0x56, 0x0c, 0x61, 0x65, 0x27, 0x15, 0x16,
+	0x8a, 0x3d, 0xa8, 0x44, 0x73, 0x34, 0x39, 0x47, 0x81, 0x2b, 0xbb, 0xe9, 0x78, 0x5c, 0x34, 0x9a,
+	0x5d, 0xc9, 0xa2, 0x02, 0x57, 0x76, 0xd3, 0x71, 0x21, 0xfa, 0x3d, 0xac, 0xc4, 0x87, 0x46, 0x3d,
+	0xb1, 0x2c, 0xc6, 0x50, 0xf6, 0x17, 0x31, 0x84, 0xf4, 0x39, 0xac, 0x3d, 0xb3, 0x5d, 0x93, 0xf3,
+	0x9b, 0xa7, 0x29, 0x07, 0x99, 0x68, 0xe2, 0x4d, 0x04, 0xe4, 0x84, 0x6d, 0xb7, 0xb7, 0x50, 0xc4,
+	0x27, 0x2a, 0x7a, 0x46, 0x62, 0xf8, 0xbe, 0xe6, 0xf1, 0xed, 0x44, 0x95, 0xee, 0x26, 0xaa, 0xf4,
+	0xdf, 0x44, 0x95, 0x7e, 0x79, 0x50, 0x73, 0x77, 0x0f, 0x6a, 0xee, 0xdf, 0x07, 0x35, 0xf7, 0xc3,
+	0x8e, 0xed, 0xf0, 0xf3, 0x4b, 0x43, 0x33, 0xe9, 0x50, 0x9f, 0x8a, 0x1e, 0x0c, 0x90, 0xc1, 0x66,
+	0x57, 0xfa, 0xf5, 0xec, 0x6f, 0xc1, 0x74, 0x3c, 0x32, 0xa3, 0x3c, 0xfb, 0x5e, 0xff, 0xf8, 0xc9,
+	0x00, 0x8e, 0xb5, 0x79, 0xa6, 0x2f, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -737,6 +1089,12 @@ type MsgClient interface {
 	// Liquidate defines a method to attempt to liquidate a CDP whos
 	// collateralization ratio is under its liquidation ratio.
 	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+	// TransferCDP defines a method to transfer a CDP to a new owner.
+	TransferCDP(ctx context.Context, in *MsgTransferCDP, opts ...grpc.CallOption) (*MsgTransferCDPResponse, error)
+	// PegStabilityMint defines a method to swap a whitelisted stablecoin for newly minted debt asset.
+	PegStabilityMint(ctx context.Context, in *MsgPegStabilityMint, opts ...grpc.CallOption) (*MsgPegStabilityMintResponse, error)
+	// PegStabilityRedeem defines a method to swap debt asset for a whitelisted stablecoin held in reserve.
+	PegStabilityRedeem(ctx context.Context, in *MsgPegStabilityRedeem, opts ...grpc.CallOption) (*MsgPegStabilityRedeemResponse, error)
 }
 
 type msgClient struct {
@@ -801,6 +1159,33 @@ func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) TransferCDP(ctx context.Context, in *MsgTransferCDP, opts ...grpc.CallOption) (*MsgTransferCDPResponse, error) {
+	out := new(MsgTransferCDPResponse)
+	err := c.cc.Invoke(ctx, "/kava.cdp.v1beta1.Msg/TransferCDP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PegStabilityMint(ctx context.Context, in *MsgPegStabilityMint, opts ...grpc.CallOption) (*MsgPegStabilityMintResponse, error) {
+	out := new(MsgPegStabilityMintResponse)
+	err := c.cc.Invoke(ctx, "/kava.cdp.v1beta1.Msg/PegStabilityMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PegStabilityRedeem(ctx context.Context, in *MsgPegStabilityRedeem, opts ...grpc.CallOption) (*MsgPegStabilityRedeemResponse, error) {
+	out := new(MsgPegStabilityRedeemResponse)
+	err := c.cc.Invoke(ctx, "/kava.cdp.v1beta1.Msg/PegStabilityRedeem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateCDP defines a method to create a new CDP.
@@ -816,6 +1201,12 @@ type MsgServer interface {
 	// Liquidate defines a method to attempt to liquidate a CDP whos
 	// collateralization ratio is under its liquidation ratio.
 	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+	// TransferCDP defines a method to transfer a CDP to a new owner.
+	TransferCDP(context.Context, *MsgTransferCDP) (*MsgTransferCDPResponse, error)
+	// PegStabilityMint defines a method to swap a whitelisted stablecoin for newly minted debt asset.
+	PegStabilityMint(context.Context, *MsgPegStabilityMint) (*MsgPegStabilityMintResponse, error)
+	// PegStabilityRedeem defines a method to swap debt asset for a whitelisted stablecoin held in reserve.
+	PegStabilityRedeem(context.Context, *MsgPegStabilityRedeem) (*MsgPegStabilityRedeemResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -840,6 +1231,15 @@ func (*UnimplementedMsgServer) RepayDebt(ctx context.Context, req *MsgRepayDebt)
 func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
 }
+func (*UnimplementedMsgServer) TransferCDP(ctx context.Context, req *MsgTransferCDP) (*MsgTransferCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCDP not implemented")
+}
+func (*UnimplementedMsgServer) PegStabilityMint(ctx context.Context, req *MsgPegStabilityMint) (*MsgPegStabilityMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PegStabilityMint not implemented")
+}
+func (*UnimplementedMsgServer) PegStabilityRedeem(ctx context.Context, req *MsgPegStabilityRedeem) (*MsgPegStabilityRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PegStabilityRedeem not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -953,34 +1353,100 @@ func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kava.cdp.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateCDP",
-			Handler:    _Msg_CreateCDP_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "DrawDebt",
-			Handler:    _Msg_DrawDebt_Handler,
-		},
-		{
-			MethodName: "RepayDebt",
-			Handler:    _Msg_RepayDebt_Handler,
-		},
-		{
-			MethodName: "Liquidate",
-			Handler:    _Msg_Liquidate_Handler,
-		},
+func _Msg_TransferCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferCDP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferCDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.cdp.v1beta1.Msg/TransferCDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferCDP(ctx, req.(*MsgTransferCDP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PegStabilityMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPegStabilityMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PegStabilityMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.cdp.v1beta1.Msg/PegStabilityMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PegStabilityMint(ctx, req.(*MsgPegStabilityMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PegStabilityRedeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPegStabilityRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PegStabilityRedeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.cdp.v1beta1.Msg/PegStabilityRedeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PegStabilityRedeem(ctx, req.(*MsgPegStabilityRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kava.cdp.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCDP",
+			Handler:    _Msg_CreateCDP_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "DrawDebt",
+			Handler:    _Msg_DrawDebt_Handler,
+		},
+		{
+			MethodName: "RepayDebt",
+			Handler:    _Msg_RepayDebt_Handler,
+		},
+		{
+			MethodName: "Liquidate",
+			Handler:    _Msg_Liquidate_Handler,
+		},
+		{
+			MethodName: "TransferCDP",
+			Handler:    _Msg_TransferCDP_Handler,
+		},
+		{
+			MethodName: "PegStabilityMint",
+			Handler:    _Msg_PegStabilityMint_Handler,
+		},
+		{
+			MethodName: "PegStabilityRedeem",
+			Handler:    _Msg_PegStabilityRedeem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/cdp/v1beta1/tx.proto",
@@ -1091,6 +1557,11 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.CollateralType) > 0 {
 		i -= len(m.CollateralType)
 		copy(dAtA[i:], m.CollateralType)
@@ -1168,6 +1639,11 @@ func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.CollateralType) > 0 {
 		i -= len(m.CollateralType)
 		copy(dAtA[i:], m.CollateralType)
@@ -1245,6 +1721,11 @@ func (m *MsgDrawDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1315,6 +1796,11 @@ func (m *MsgRepayDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1385,6 +1871,11 @@ func (m *MsgLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.CollateralType) > 0 {
 		i -= len(m.CollateralType)
 		copy(dAtA[i:], m.CollateralType)
@@ -1432,149 +1923,363 @@ func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTransferCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateCDP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Principal.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgTransferCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateCDPResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTransferCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.CdpID != 0 {
-		n += 1 + sovTx(uint64(m.CdpID))
-	}
-	return n
-}
-
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgTransferCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTransferCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDrawDebt) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgPegStabilityMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Principal.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDrawDebtResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgPegStabilityMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRepayDebt) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPegStabilityMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPegStabilityMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPegStabilityMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPegStabilityMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPegStabilityRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPegStabilityRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPegStabilityRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPegStabilityRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPegStabilityRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPegStabilityRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDrawDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgDrawDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepayDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1584,6 +2289,9 @@ func (m *MsgRepayDebt) Size() (n int) {
 	}
 	l = m.Payment.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
 	return n
 }
 
@@ -1614,6 +2322,9 @@ func (m *MsgLiquidate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
 	return n
 }
 
@@ -1626,13 +2337,595 @@ func (m *MsgLiquidateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgTransferCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPegStabilityMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPegStabilityMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPegStabilityRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPegStabilityRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCDP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCDPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1655,15 +2948,15 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCDP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1691,9 +2984,41 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
@@ -1726,6 +3051,221 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrawDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrawDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
@@ -1760,10 +3300,10 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
 			}
-			var stringLen uint64
+			m.CdpID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1773,24 +3313,11 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CdpID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1812,7 +3339,7 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1835,31 +3362,12 @@ func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCDPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDrawDebtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDrawDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
-			}
-			m.CdpID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CdpID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1881,7 +3389,7 @@ func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1904,15 +3412,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayDebt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayDebt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1940,11 +3448,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1972,11 +3480,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2003,15 +3511,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
 			}
-			var stringLen uint64
+			m.CdpID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2021,24 +3529,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CdpID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2060,7 +3555,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2083,10 +3578,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayDebtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2110,7 +3605,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2133,15 +3628,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2169,11 +3664,11 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Keeper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2201,13 +3696,13 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2217,30 +3712,29 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
 			}
-			var stringLen uint64
+			m.CdpID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2250,24 +3744,11 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CdpID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2289,7 +3770,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2312,10 +3793,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2339,7 +3820,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferCDP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2362,10 +3843,10 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDrawDebt: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferCDP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDrawDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferCDP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2402,7 +3883,7 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2430,13 +3911,13 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2446,25 +3927,43 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2486,7 +3985,7 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferCDPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2509,10 +4008,10 @@ func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDrawDebtResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferCDPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDrawDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2536,7 +4035,7 @@ func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
+func (m *MsgPegStabilityMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2559,10 +4058,10 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayDebt: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPegStabilityMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPegStabilityMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2599,39 +4098,7 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2658,7 +4125,7 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2683,7 +4150,7 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPegStabilityMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,10 +4173,10 @@ func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayDebtResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPegStabilityMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPegStabilityMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2733,7 +4200,7 @@ func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgPegStabilityRedeem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2756,15 +4223,15 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPegStabilityRedeem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPegStabilityRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2792,13 +4259,13 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keeper = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2808,27 +4275,28 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2856,7 +4324,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2879,7 +4347,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPegStabilityRedeemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2902,10 +4370,10 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPegStabilityRedeemResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPegStabilityRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
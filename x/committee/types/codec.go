@@ -12,6 +12,7 @@ import (
 	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 var (
@@ -63,6 +64,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(TextPermission{}, "kava/TextPermission", nil)
 	cdc.RegisterConcrete(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission", nil)
 	cdc.RegisterConcrete(ParamsChangePermission{}, "kava/ParamsChangePermission", nil)
+	cdc.RegisterConcrete(ResetMarketSuspensionPermission{}, "kava/ResetMarketSuspensionPermission", nil)
+	cdc.RegisterConcrete(ReinstateOraclePermission{}, "kava/ReinstateOraclePermission", nil)
 
 	// Msgs
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "kava/MsgSubmitProposal")
@@ -98,6 +101,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&TextPermission{},
 		&SoftwareUpgradePermission{},
 		&ParamsChangePermission{},
+		&ResetMarketSuspensionPermission{},
+		&ReinstateOraclePermission{},
 	)
 
 	// Need to register PubProposal here since we use this as alias for the x/gov Content interface for all the proposal implementations used in this module.
@@ -109,6 +114,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&distrtypes.CommunityPoolSpendProposal{},
 		&govv1beta1.TextProposal{},
 		&kavadisttypes.CommunityPoolMultiSpendProposal{},
+		&pricefeedtypes.ResetMarketSuspensionProposal{},
+		&pricefeedtypes.ReinstateOracleProposal{},
 		&proposaltypes.ParameterChangeProposal{},
 		&upgradetypes.SoftwareUpgradeProposal{},
 		&upgradetypes.CancelSoftwareUpgradeProposal{},
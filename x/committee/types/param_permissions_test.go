@@ -15,6 +15,7 @@ import (
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	types "github.com/kava-labs/kava/x/committee/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 type ParamsChangeTestSuite struct {
@@ -760,6 +761,145 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_SliceSubparamComparis
 	}
 }
 
+func (s *ParamsChangeTestSuite) TestParamsChangePermission_SwapPoolFees() {
+	permission := types.ParamsChangePermission{
+		AllowedParamsChanges: types.AllowedParamsChanges{{
+			Subspace: swaptypes.ModuleName,
+			Key:      string(swaptypes.KeyAllowedPools),
+			MultiSubparamsRequirements: []types.SubparamRequirement{
+				{
+					Key:                        "token_a",
+					Val:                        "ukava",
+					AllowedSubparamAttrChanges: []string{"swap_fee"},
+				},
+				{
+					Key:                        "token_a",
+					Val:                        "hard",
+					AllowedSubparamAttrChanges: []string{},
+				},
+			},
+		}},
+	}
+
+	testcases := []struct {
+		name     string
+		expected bool
+		value    string
+	}{
+		{
+			name:     "success changing the fee of an allowed pool",
+			expected: true,
+			value: `[{
+				"token_a": "ukava",
+				"token_b": "usdx",
+				"swap_fee": "0.005000000000000000"
+			},
+			{
+				"token_a": "hard",
+				"token_b": "usdx",
+				"swap_fee": null
+			}]`,
+		},
+		{
+			name:     "success setting an explicit zero fee on an allowed pool",
+			expected: true,
+			value: `[{
+				"token_a": "ukava",
+				"token_b": "usdx",
+				"swap_fee": "0.000000000000000000"
+			},
+			{
+				"token_a": "hard",
+				"token_b": "usdx",
+				"swap_fee": null
+			}]`,
+		},
+		{
+			name:     "success removing the fee override of an allowed pool",
+			expected: true,
+			value: `[{
+				"token_a": "ukava",
+				"token_b": "usdx",
+				"swap_fee": null
+			},
+			{
+				"token_a": "hard",
+				"token_b": "usdx",
+				"swap_fee": null
+			}]`,
+		},
+		{
+			name:     "fails when setting a zero fee on a pool that is not allowed",
+			expected: false,
+			value: `[{
+				"token_a": "ukava",
+				"token_b": "usdx",
+				"swap_fee": "0.010000000000000000"
+			},
+			{
+				"token_a": "hard",
+				"token_b": "usdx",
+				"swap_fee": "0.000000000000000000"
+			}]`,
+		},
+		{
+			name:     "fails when changing the fee of a pool that is not allowed",
+			expected: false,
+			value: `[{
+				"token_a": "ukava",
+				"token_b": "usdx",
+				"swap_fee": "0.010000000000000000"
+			},
+			{
+				"token_a": "hard",
+				"token_b": "usdx",
+				"swap_fee": "0.010000000000000000"
+			}]`,
+		},
+		{
+			name:     "fails when changing not allowed attr (token_b)",
+			expected: false,
+			value: `[{
+				"token_a": "ukava",
+				"token_b": "busd",
+				"swap_fee": "0.010000000000000000"
+			},
+			{
+				"token_a": "hard",
+				"token_b": "usdx",
+				"swap_fee": null
+			}]`,
+		},
+	}
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			subspace, found := s.pk.GetSubspace(swaptypes.ModuleName)
+			s.Require().True(found)
+			currentPools := swaptypes.NewAllowedPools(
+				swaptypes.NewAllowedPool("ukava", "usdx").WithSwapFee(sdk.MustNewDecFromStr("0.01")),
+				swaptypes.NewAllowedPool("hard", "usdx"),
+			)
+			subspace.Set(s.ctx, swaptypes.KeyAllowedPools, &currentPools)
+
+			proposal := paramsproposal.NewParameterChangeProposal(
+				"A Title",
+				"A description of this proposal.",
+				[]paramsproposal.ParamChange{{
+					Subspace: swaptypes.ModuleName,
+					Key:      string(swaptypes.KeyAllowedPools),
+					Value:    tc.value,
+				}},
+			)
+			s.Require().Equal(
+				tc.expected,
+				permission.Allows(s.ctx, s.pk, proposal),
+			)
+		})
+	}
+}
+
 func (s *ParamsChangeTestSuite) TestParamsChangePermission_NoSubparamRequirements() {
 	permission := types.ParamsChangePermission{
 		AllowedParamsChanges: types.AllowedParamsChanges{{
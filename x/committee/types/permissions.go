@@ -12,6 +12,8 @@ import (
 	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	proto "github.com/gogo/protobuf/proto"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // Permission is anything with a method that validates whether a proposal is allowed by it or not.
@@ -53,6 +55,8 @@ var (
 	_ Permission = TextPermission{}
 	_ Permission = SoftwareUpgradePermission{}
 	_ Permission = ParamsChangePermission{}
+	_ Permission = ResetMarketSuspensionPermission{}
+	_ Permission = ReinstateOraclePermission{}
 )
 
 // Allows implement permission interface for GodPermission.
@@ -70,6 +74,18 @@ func (SoftwareUpgradePermission) Allows(_ sdk.Context, _ ParamKeeper, p PubPropo
 	return ok
 }
 
+// Allows implement permission interface for ResetMarketSuspensionPermission.
+func (ResetMarketSuspensionPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(*pricefeedtypes.ResetMarketSuspensionProposal)
+	return ok
+}
+
+// Allows implement permission interface for ReinstateOraclePermission.
+func (ReinstateOraclePermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(*pricefeedtypes.ReinstateOracleProposal)
+	return ok
+}
+
 // Allows implement permission interface for ParamsChangePermission.
 func (perm ParamsChangePermission) Allows(ctx sdk.Context, pk ParamKeeper, p PubProposal) bool {
 	proposal, ok := p.(*paramsproposal.ParameterChangeProposal)
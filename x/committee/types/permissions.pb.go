@@ -135,6 +135,80 @@ func (m *TextPermission) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TextPermission proto.InternalMessageInfo
 
+// ResetMarketSuspensionPermission allows pricefeed proposals that reset a suspended market.
+type ResetMarketSuspensionPermission struct {
+}
+
+func (m *ResetMarketSuspensionPermission) Reset()         { *m = ResetMarketSuspensionPermission{} }
+func (m *ResetMarketSuspensionPermission) String() string { return proto.CompactTextString(m) }
+func (*ResetMarketSuspensionPermission) ProtoMessage()    {}
+func (*ResetMarketSuspensionPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{3}
+}
+func (m *ResetMarketSuspensionPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetMarketSuspensionPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetMarketSuspensionPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetMarketSuspensionPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetMarketSuspensionPermission.Merge(m, src)
+}
+func (m *ResetMarketSuspensionPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetMarketSuspensionPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetMarketSuspensionPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetMarketSuspensionPermission proto.InternalMessageInfo
+
+// ReinstateOraclePermission allows pricefeed proposals that reinstate a deactivated oracle.
+type ReinstateOraclePermission struct {
+}
+
+func (m *ReinstateOraclePermission) Reset()         { *m = ReinstateOraclePermission{} }
+func (m *ReinstateOraclePermission) String() string { return proto.CompactTextString(m) }
+func (*ReinstateOraclePermission) ProtoMessage()    {}
+func (*ReinstateOraclePermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{4}
+}
+func (m *ReinstateOraclePermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReinstateOraclePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReinstateOraclePermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReinstateOraclePermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReinstateOraclePermission.Merge(m, src)
+}
+func (m *ReinstateOraclePermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReinstateOraclePermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReinstateOraclePermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReinstateOraclePermission proto.InternalMessageInfo
+
 // ParamsChangePermission allows any parameter or sub parameter change proposal.
 type ParamsChangePermission struct {
 	AllowedParamsChanges AllowedParamsChanges `protobuf:"bytes,1,rep,name=allowed_params_changes,json=allowedParamsChanges,proto3,castrepeated=AllowedParamsChanges" json:"allowed_params_changes"`
@@ -144,7 +218,7 @@ func (m *ParamsChangePermission) Reset()         { *m = ParamsChangePermission{}
 func (m *ParamsChangePermission) String() string { return proto.CompactTextString(m) }
 func (*ParamsChangePermission) ProtoMessage()    {}
 func (*ParamsChangePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{3}
+	return fileDescriptor_bdfaf7be16465ae4, []int{5}
 }
 func (m *ParamsChangePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,7 +270,7 @@ func (m *AllowedParamsChange) Reset()         { *m = AllowedParamsChange{} }
 func (m *AllowedParamsChange) String() string { return proto.CompactTextString(m) }
 func (*AllowedParamsChange) ProtoMessage()    {}
 func (*AllowedParamsChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{4}
+	return fileDescriptor_bdfaf7be16465ae4, []int{6}
 }
 func (m *AllowedParamsChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +341,7 @@ func (m *SubparamRequirement) Reset()         { *m = SubparamRequirement{} }
 func (m *SubparamRequirement) String() string { return proto.CompactTextString(m) }
 func (*SubparamRequirement) ProtoMessage()    {}
 func (*SubparamRequirement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{5}
+	return fileDescriptor_bdfaf7be16465ae4, []int{7}
 }
 func (m *SubparamRequirement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,6 +395,8 @@ func init() {
 	proto.RegisterType((*GodPermission)(nil), "kava.committee.v1beta1.GodPermission")
 	proto.RegisterType((*SoftwareUpgradePermission)(nil), "kava.committee.v1beta1.SoftwareUpgradePermission")
 	proto.RegisterType((*TextPermission)(nil), "kava.committee.v1beta1.TextPermission")
+	proto.RegisterType((*ResetMarketSuspensionPermission)(nil), "kava.committee.v1beta1.ResetMarketSuspensionPermission")
+	proto.RegisterType((*ReinstateOraclePermission)(nil), "kava.committee.v1beta1.ReinstateOraclePermission")
 	proto.RegisterType((*ParamsChangePermission)(nil), "kava.committee.v1beta1.ParamsChangePermission")
 	proto.RegisterType((*AllowedParamsChange)(nil), "kava.committee.v1beta1.AllowedParamsChange")
 	proto.RegisterType((*SubparamRequirement)(nil), "kava.committee.v1beta1.SubparamRequirement")
@@ -331,36 +407,37 @@ func init() {
 }
 
 var fileDescriptor_bdfaf7be16465ae4 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
+	// 478 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x80, 0xb3, 0xb8, 0x42, 0x74, 0x11, 0x55, 0xe5, 0x46, 0x91, 0x6b, 0x15, 0x27, 0xca, 0x29,
-	0x52, 0x54, 0x5b, 0x85, 0x1b, 0xb7, 0x04, 0x21, 0xae, 0x95, 0x0b, 0x17, 0x2e, 0xd6, 0x3a, 0x59,
-	0x5c, 0xab, 0x76, 0xd6, 0xec, 0x8c, 0xd3, 0x56, 0x42, 0xe2, 0x15, 0x78, 0x0d, 0x38, 0xf3, 0x10,
-	0x15, 0xa7, 0x1e, 0x39, 0x01, 0x4a, 0x1e, 0x83, 0x0b, 0xda, 0xf5, 0xaf, 0x54, 0x2b, 0xb7, 0x99,
-	0xd9, 0x6f, 0x66, 0xfd, 0xed, 0x7a, 0xe9, 0xe4, 0x8a, 0xad, 0x99, 0xb7, 0x10, 0x69, 0x1a, 0x23,
-	0x72, 0xee, 0xad, 0xcf, 0x42, 0x8e, 0xec, 0xcc, 0xcb, 0xb8, 0x4c, 0x63, 0x80, 0x58, 0xac, 0xc0,
-	0xcd, 0xa4, 0x40, 0x61, 0x0e, 0x14, 0xe9, 0xd6, 0xa4, 0x5b, 0x92, 0xf6, 0xf1, 0x42, 0x40, 0x2a,
-	0x20, 0xd0, 0x94, 0x57, 0x24, 0x45, 0x8b, 0xdd, 0x8f, 0x44, 0x24, 0x8a, 0xba, 0x8a, 0x8a, 0xea,
-	0x78, 0x48, 0x9f, 0xbd, 0x15, 0xcb, 0xf3, 0x7a, 0x83, 0x57, 0x07, 0x3f, 0x7f, 0x9c, 0xd2, 0x26,
-	0x1f, 0x4f, 0xe9, 0xf1, 0x85, 0xf8, 0x88, 0xd7, 0x4c, 0xf2, 0xf7, 0x59, 0x24, 0xd9, 0x92, 0xef,
-	0x80, 0x47, 0xf4, 0xe0, 0x1d, 0xbf, 0xc1, 0x1d, 0xc4, 0x37, 0x42, 0x07, 0xe7, 0x4c, 0xb2, 0x14,
-	0x5e, 0x5f, 0xb2, 0x55, 0xd4, 0x1a, 0x66, 0x7e, 0xa1, 0x03, 0x96, 0x24, 0xe2, 0x9a, 0x2f, 0x83,
-	0x4c, 0x13, 0xc1, 0x42, 0x23, 0x60, 0x91, 0x91, 0x31, 0x79, 0xfa, 0x62, 0xea, 0x76, 0x4b, 0xbb,
-	0xb3, 0xa2, 0xab, 0x3d, 0x76, 0x7e, 0x72, 0xf7, 0x7b, 0xd8, 0xfb, 0xfe, 0x67, 0xd8, 0xef, 0x58,
-	0x04, 0xbf, 0xcf, 0x3a, 0xaa, 0x0f, 0xbe, 0xf5, 0x1f, 0xa1, 0x47, 0x1d, 0xed, 0xa6, 0x4d, 0x9f,
-	0x40, 0x1e, 0x42, 0xc6, 0x16, 0xdc, 0x22, 0x23, 0x32, 0xd9, 0xf7, 0xeb, 0xdc, 0x3c, 0xa4, 0xc6,
-	0x15, 0xbf, 0xb5, 0x1e, 0xe9, 0xb2, 0x0a, 0xcd, 0x19, 0x7d, 0x0e, 0xf1, 0x2a, 0x4a, 0x78, 0x00,
-	0x79, 0xa8, 0xc5, 0x82, 0x4a, 0x93, 0x21, 0x4a, 0xb0, 0x8c, 0x91, 0x31, 0xd9, 0xf7, 0xed, 0x02,
-	0xba, 0x28, 0x99, 0x72, 0xdf, 0x99, 0x22, 0x4c, 0xa0, 0x27, 0x69, 0x9e, 0x60, 0x5c, 0x4f, 0x80,
-	0x40, 0xf2, 0x4f, 0x79, 0x2c, 0x79, 0xca, 0x57, 0x08, 0xd6, 0xde, 0xee, 0xf3, 0xa9, 0x66, 0xfa,
-	0x4d, 0xcf, 0x7c, 0x4f, 0x9d, 0x8f, 0x6f, 0xeb, 0xb1, 0xd5, 0x3a, 0xb4, 0x00, 0x18, 0x7f, 0xa6,
-	0x47, 0x1d, 0x8d, 0x95, 0x20, 0x69, 0x04, 0x0f, 0xa9, 0xb1, 0x66, 0x49, 0xa5, 0xbc, 0x66, 0x89,
-	0x52, 0xae, 0x14, 0x1b, 0x67, 0x44, 0x59, 0x5f, 0x68, 0xa9, 0x5c, 0x42, 0xb5, 0x33, 0xa2, 0x2c,
-	0xef, 0x62, 0xfe, 0xe6, 0x6e, 0xe3, 0x90, 0xfb, 0x8d, 0x43, 0xfe, 0x6e, 0x1c, 0xf2, 0x75, 0xeb,
-	0xf4, 0xee, 0xb7, 0x4e, 0xef, 0xd7, 0xd6, 0xe9, 0x7d, 0x98, 0x46, 0x31, 0x5e, 0xe6, 0xa1, 0xf2,
-	0xf4, 0x94, 0xf0, 0x69, 0xc2, 0x42, 0xd0, 0x91, 0x77, 0xd3, 0x7a, 0x3b, 0x78, 0x9b, 0x71, 0x08,
-	0x1f, 0xeb, 0xbf, 0xfc, 0xe5, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78, 0x09, 0x36, 0x56, 0x5a,
-	0x03, 0x00, 0x00,
+	0x10, 0x80, 0x63, 0x52, 0x21, 0xba, 0x88, 0xaa, 0x72, 0xa3, 0xc8, 0xb5, 0x8a, 0x13, 0xe5, 0x14,
+	0x29, 0xaa, 0xad, 0xc0, 0x8d, 0x5b, 0x82, 0x10, 0x27, 0x44, 0xe5, 0xc0, 0x85, 0x8b, 0xb5, 0x76,
+	0x06, 0xd7, 0x8a, 0xed, 0x35, 0x3b, 0xe3, 0xb4, 0x95, 0x90, 0x78, 0x05, 0x5e, 0x03, 0xce, 0x3c,
+	0x44, 0xc5, 0xa9, 0x47, 0x4e, 0x80, 0x92, 0xc7, 0xe0, 0x82, 0xfc, 0x9b, 0x48, 0xb5, 0x7c, 0xdb,
+	0x9d, 0xfd, 0x66, 0x76, 0xbe, 0x59, 0x2d, 0x1b, 0xaf, 0xf8, 0x9a, 0x5b, 0x9e, 0x88, 0xa2, 0x80,
+	0x08, 0xc0, 0x5a, 0x4f, 0x5d, 0x20, 0x3e, 0xb5, 0x12, 0x90, 0x51, 0x80, 0x18, 0x88, 0x18, 0xcd,
+	0x44, 0x0a, 0x12, 0x6a, 0x3f, 0x23, 0xcd, 0x9a, 0x34, 0x4b, 0x52, 0x3f, 0xf5, 0x04, 0x46, 0x02,
+	0x9d, 0x9c, 0xb2, 0x8a, 0x4d, 0x91, 0xa2, 0xf7, 0x7c, 0xe1, 0x8b, 0x22, 0x9e, 0xad, 0x8a, 0xe8,
+	0x68, 0xc0, 0x9e, 0xbc, 0x16, 0xcb, 0x8b, 0xfa, 0x82, 0x17, 0x47, 0x3f, 0x7f, 0x9c, 0xb3, 0xdd,
+	0x7e, 0x34, 0x61, 0xa7, 0x0b, 0xf1, 0x91, 0xae, 0xb8, 0x84, 0xf7, 0x89, 0x2f, 0xf9, 0x12, 0x5a,
+	0xe0, 0x21, 0x3b, 0x7a, 0x07, 0xd7, 0xd4, 0x42, 0x4c, 0xd9, 0xc0, 0x06, 0x04, 0x7a, 0xc3, 0xe5,
+	0x0a, 0x68, 0x91, 0x62, 0x02, 0x71, 0x76, 0xd0, 0xde, 0x81, 0x0d, 0x41, 0x8c, 0xc4, 0x09, 0xde,
+	0x4a, 0xee, 0x85, 0x6d, 0x1d, 0x7c, 0x53, 0x58, 0xff, 0x82, 0x4b, 0x1e, 0xe1, 0xcb, 0x4b, 0x1e,
+	0xfb, 0x7b, 0xa8, 0xfa, 0x85, 0xf5, 0x79, 0x18, 0x8a, 0x2b, 0x58, 0x3a, 0x49, 0x4e, 0x38, 0x5e,
+	0x8e, 0xa0, 0xa6, 0x0c, 0xbb, 0xe3, 0xc7, 0xcf, 0x26, 0x66, 0xf3, 0x50, 0xcd, 0x59, 0x91, 0xb5,
+	0x5f, 0x76, 0x7e, 0x76, 0xfb, 0x7b, 0xd0, 0xf9, 0xfe, 0x67, 0xd0, 0x6b, 0x38, 0x44, 0xbb, 0xc7,
+	0x1b, 0xa2, 0xf7, 0x7a, 0xfd, 0xa7, 0xb0, 0x93, 0x86, 0x74, 0x55, 0x67, 0x8f, 0x30, 0x75, 0x31,
+	0xe1, 0x1e, 0x68, 0xca, 0x50, 0x19, 0x1f, 0xda, 0xf5, 0x5e, 0x3d, 0x66, 0xdd, 0x15, 0xdc, 0x68,
+	0x0f, 0xf2, 0x70, 0xb6, 0x54, 0x67, 0xec, 0x29, 0x06, 0xb1, 0x1f, 0x82, 0x83, 0xa9, 0x9b, 0x8b,
+	0x39, 0x95, 0x26, 0x27, 0x92, 0xa8, 0x75, 0x87, 0xdd, 0xf1, 0xa1, 0xad, 0x17, 0xd0, 0xa2, 0x64,
+	0xca, 0x7b, 0x67, 0x19, 0xa1, 0x22, 0x3b, 0x8b, 0xd2, 0x90, 0x82, 0xba, 0x02, 0x3a, 0x12, 0x3e,
+	0xa5, 0x81, 0x84, 0x08, 0x62, 0x42, 0xed, 0xa0, 0x7d, 0x3e, 0x55, 0x4d, 0x7b, 0x97, 0x33, 0x3f,
+	0xc8, 0xe6, 0x63, 0xeb, 0x79, 0xd9, 0xea, 0x1c, 0xf7, 0x00, 0x1c, 0x7d, 0x66, 0x27, 0x0d, 0x89,
+	0x95, 0xa0, 0xb2, 0x13, 0x3c, 0x66, 0xdd, 0x35, 0x0f, 0x2b, 0xe5, 0x35, 0x0f, 0x33, 0xe5, 0x4a,
+	0x71, 0xe7, 0x4c, 0x24, 0xeb, 0x07, 0x2d, 0x95, 0x4b, 0xa8, 0x76, 0x26, 0x92, 0xe5, 0x5b, 0xcc,
+	0x5f, 0xdd, 0x6e, 0x0c, 0xe5, 0x6e, 0x63, 0x28, 0x7f, 0x37, 0x86, 0xf2, 0x75, 0x6b, 0x74, 0xee,
+	0xb6, 0x46, 0xe7, 0xd7, 0xd6, 0xe8, 0x7c, 0x98, 0xf8, 0x01, 0x5d, 0xa6, 0x6e, 0xe6, 0x69, 0x65,
+	0xc2, 0xe7, 0x21, 0x77, 0x31, 0x5f, 0x59, 0xd7, 0x7b, 0x7f, 0x93, 0x6e, 0x12, 0x40, 0xf7, 0x61,
+	0xfe, 0x8b, 0x9e, 0xff, 0x1f, 0x00, 0x21, 0xe0, 0x81, 0xff, 0xba, 0x03, 0x00, 0x00,
 }
 
 func (m *GodPermission) Marshal() (dAtA []byte, err error) {
@@ -432,6 +509,52 @@ func (m *TextPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResetMarketSuspensionPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetMarketSuspensionPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetMarketSuspensionPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReinstateOraclePermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReinstateOraclePermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReinstateOraclePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *ParamsChangePermission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -613,6 +736,24 @@ func (m *TextPermission) Size() (n int) {
 	return n
 }
 
+func (m *ResetMarketSuspensionPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReinstateOraclePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *ParamsChangePermission) Size() (n int) {
 	if m == nil {
 		return 0
@@ -836,6 +977,106 @@ func (m *TextPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResetMarketSuspensionPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetMarketSuspensionPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetMarketSuspensionPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReinstateOraclePermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReinstateOraclePermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReinstateOraclePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ParamsChangePermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,7 @@ import (
 	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/kava-labs/kava/x/committee/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 func TestPackPermissions_Success(t *testing.T) {
@@ -40,6 +41,23 @@ func TestUnpackPermissions_Failure(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestResetMarketSuspensionPermission_Allows(t *testing.T) {
+	permission := types.ResetMarketSuspensionPermission{}
+	ctx := sdk.Context{}
+
+	require.True(t, permission.Allows(ctx, nil, pricefeedtypes.NewResetMarketSuspensionProposal("title", "description", "bnb:usd")))
+	require.False(t, permission.Allows(ctx, nil, govv1beta1.NewTextProposal("title", "description")))
+}
+
+func TestReinstateOraclePermission_Allows(t *testing.T) {
+	permission := types.ReinstateOraclePermission{}
+	ctx := sdk.Context{}
+	oracle := sdk.AccAddress("oracle")
+
+	require.True(t, permission.Allows(ctx, nil, pricefeedtypes.NewReinstateOracleProposal("title", "description", "bnb:usd", oracle)))
+	require.False(t, permission.Allows(ctx, nil, pricefeedtypes.NewResetMarketSuspensionProposal("title", "description", "bnb:usd")))
+}
+
 func TestParamsChangePermission_SimpleParamsChange_Allows(t *testing.T) {
 	testPermission := types.ParamsChangePermission{
 		AllowedParamsChanges: types.AllowedParamsChanges{
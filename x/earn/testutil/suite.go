@@ -142,6 +142,8 @@ func (suite *Suite) SetupTest() {
 			),
 		},
 		sdk.NewDec(10),
+		hardtypes.DefaultFlashLoanFee,
+		hardtypes.DefaultEModeCategories,
 	),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -149,6 +151,7 @@ func (suite *Suite) SetupTest() {
 		hardtypes.DefaultTotalSupplied,
 		hardtypes.DefaultTotalBorrowed,
 		hardtypes.DefaultTotalReserves,
+		hardtypes.DefaultAccountEModes,
 	)
 
 	savingsGS := savingstypes.NewGenesisState(
@@ -19,6 +19,11 @@ const (
 	flagName  = "name"
 	flagDenom = "denom"
 	flagOwner = "owner"
+
+	flagDeposit  = "deposit"
+	flagWithdraw = "withdraw"
+	flagBorrow   = "borrow"
+	flagRepay    = "repay"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -43,6 +48,8 @@ func GetQueryCmd() *cobra.Command {
 		queryInterestRateCmd(),
 		queryReserves(),
 		queryInterestFactorsCmd(),
+		queryEModeCmd(),
+		querySimulatePositionCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -532,3 +539,81 @@ func queryInterestFactorsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func queryEModeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "e-mode [owner]",
+		Short:   "get the efficiency mode category of an account",
+		Long:    "get the efficiency mode category an account has opted into",
+		Example: fmt.Sprintf(`%s q %s e-mode kava1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EMode(context.Background(), &types.QueryEModeRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func querySimulatePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-position [owner]",
+		Short: "simulate the position of an account after hypothetical actions",
+		Long: "get the loan-to-value, remaining borrow capacity and health factor of an account after hypothetical deposit, " +
+			"withdraw, borrow and repay actions, and whether any of the actions would be rejected. Deposits and repayments " +
+			"are rejected if the account doesn't hold the coins",
+		Example: fmt.Sprintf(`%s q %s simulate-position kava1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j --deposit 10000000bnb --borrow 1000000usdx`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := types.QuerySimulatePositionRequest{
+				Owner: args[0],
+			}
+			for flag, coins := range map[string]*sdk.Coins{
+				flagDeposit:  &req.Deposit,
+				flagWithdraw: &req.Withdraw,
+				flagBorrow:   &req.Borrow,
+				flagRepay:    &req.Repay,
+			} {
+				coinsStr, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				if *coins, err = sdk.ParseCoinsNormalized(coinsStr); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulatePosition(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "(optional) coins to deposit")
+	cmd.Flags().String(flagWithdraw, "", "(optional) coins to withdraw")
+	cmd.Flags().String(flagBorrow, "", "(optional) coins to borrow")
+	cmd.Flags().String(flagRepay, "", "(optional) coins to repay")
+
+	return cmd
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
@@ -31,6 +32,8 @@ func GetTxCmd() *cobra.Command {
 		getCmdBorrow(),
 		getCmdRepay(),
 		getCmdLiquidate(),
+		getCmdFlashLoan(),
+		getCmdSetEMode(),
 	}
 
 	for _, cmd := range cmds {
@@ -203,3 +206,64 @@ func getCmdLiquidate() *cobra.Command {
 		},
 	}
 }
+
+func getCmdFlashLoan() *cobra.Command {
+	return &cobra.Command{
+		Use:   "flash-loan [amount] [tx-json-file]",
+		Short: "borrow coins from hard for the duration of the messages in a transaction file",
+		Long: `Borrow coins from hard, execute the messages of a transaction file generated with --generate-only and
+repay the loan plus the flash loan fee. The messages must be signed by the borrower.`,
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s flash-loan 10000000bnb tx.json --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgFlashLoan(clientCtx.GetFromAddress(), amount, theTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdSetEMode() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-e-mode [category]",
+		Short: "opt into an efficiency mode category, or out of efficiency mode if no category is given",
+		Long: `Opt into an efficiency mode category to borrow assets in the category against deposits in the category at
+a higher loan-to-value. Omit the category to opt out of efficiency mode.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s tx %[2]s set-e-mode stablecoins --from <key>
+%[1]s tx %[2]s set-e-mode --from <key>`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			category := ""
+			if len(args) > 0 {
+				category = args[0]
+			}
+			msg := types.NewMsgSetEMode(clientCtx.GetFromAddress(), category)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
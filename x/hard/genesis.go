@@ -38,6 +38,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 	k.SetSuppliedCoins(ctx, gs.TotalSupplied)
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
+	k.InitializeIsolatedDebts(ctx)
+
+	for _, accountEMode := range gs.AccountEModes {
+		k.SetAccountEMode(ctx, accountEMode)
+	}
 
 	// check if the module account exists
 	DepositModuleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
@@ -53,6 +58,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	gats := types.GenesisAccumulationTimes{}
 	deposits := types.Deposits{}
 	borrows := types.Borrows{}
+	accountEModes := types.AccountEModes{}
 
 	k.IterateDeposits(ctx, func(d types.Deposit) bool {
 		k.BeforeDepositModified(ctx, d)
@@ -74,6 +80,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		return false
 	})
 
+	k.IterateAccountEModes(ctx, func(accountEMode types.AccountEMode) bool {
+		accountEModes = append(accountEModes, accountEMode)
+		return false
+	})
+
 	totalSupplied, found := k.GetSuppliedCoins(ctx)
 	if !found {
 		totalSupplied = types.DefaultTotalSupplied
@@ -111,6 +122,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	}
 	return types.NewGenesisState(
 		params, gats, deposits, borrows,
-		totalSupplied, totalBorrowed, totalReserves,
+		totalSupplied, totalBorrowed, totalReserves, accountEModes,
 	)
 }
@@ -62,6 +62,10 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			),
 		},
 		sdk.NewDec(10),
+		types.DefaultFlashLoanFee,
+		types.EModeCategories{
+			types.NewEModeCategory("kava", []string{"ukava"}, sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.9")),
+		},
 	)
 
 	deposits := types.Deposits{
@@ -114,6 +118,7 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 		totalSupplied,
 		totalBorrowed,
 		sdk.Coins{},
+		types.AccountEModes{types.NewAccountEMode(suite.addrs[0], "kava")},
 	)
 
 	suite.NotPanics(
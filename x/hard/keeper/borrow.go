@@ -92,6 +92,11 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	// it has already been included in the total borrowed coins by the BeginBlocker.
 	k.IncrementBorrowedCoins(ctx, coins)
 
+	// Update the amount borrowed against the user's isolated collateral
+	if isolatedDenom, isolated := k.getIsolatedCollateralDenom(ctx, borrower); isolated {
+		k.IncrementIsolatedDebt(ctx, isolatedDenom, coins)
+	}
+
 	if !hasExistingBorrow {
 		k.AfterBorrowCreated(ctx, borrow)
 	} else {
@@ -115,19 +120,8 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		return types.ErrBorrowEmptyCoins
 	}
 
-	// The reserve coins aren't available for users to borrow
-	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
-	hardMaccCoins := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
-	reserveCoins, foundReserveCoins := k.GetTotalReserves(ctx)
-	if !foundReserveCoins {
-		reserveCoins = sdk.NewCoins()
-	}
-	fundsAvailableToBorrow, isNegative := hardMaccCoins.SafeSub(reserveCoins...)
-	if isNegative {
-		return sdkerrors.Wrapf(types.ErrReservesExceedCash, "reserves %s > cash %s", reserveCoins, hardMaccCoins)
-	}
-	if amount.IsAnyGT(fundsAvailableToBorrow) {
-		return sdkerrors.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested borrow %s > available to borrow %s", amount, fundsAvailableToBorrow)
+	if err := k.validateProtocolBorrowableBalance(ctx, amount); err != nil {
+		return err
 	}
 
 	// Get the proposed borrow USD value
@@ -169,12 +163,29 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 	if !found {
 		return sdkerrors.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", borrower)
 	}
+
+	// Accounts in efficiency mode can only borrow assets in their category, and borrow against deposits in the
+	// category using the category's loan-to-value
+	eModeCategory, inEMode := k.GetEModeCategory(ctx, borrower)
+	if inEMode {
+		currentBorrow, _ := k.GetBorrow(ctx, borrower)
+		for _, coin := range currentBorrow.Amount.Add(amount...) {
+			if !eModeCategory.Contains(coin.Denom) {
+				return sdkerrors.Wrapf(types.ErrBorrowNotInEModeCategory, "%s is not in e-mode category %s", coin.Denom, eModeCategory.Name)
+			}
+		}
+	}
+
 	totalBorrowableAmount := sdk.ZeroDec()
 	for _, coin := range deposit.Amount {
 		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
 		if !found {
 			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
 		}
+		loanToValue := moneyMarket.BorrowLimit.LoanToValue
+		if inEMode && eModeCategory.Contains(coin.Denom) {
+			loanToValue = eModeCategory.LoanToValue
+		}
 
 		// Calculate the borrowable amount and add it to the user's total borrowable amount
 		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
@@ -182,7 +193,7 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 			return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
 		depositUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
+		borrowableAmountForDeposit := depositUSDValue.Mul(loanToValue)
 		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
 	}
 
@@ -206,6 +217,11 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		}
 	}
 
+	// Validate the borrow against the siloed borrowing and isolated collateral restrictions
+	if err := k.validateBorrowIsolation(ctx, deposit, existingBorrow.Amount, amount); err != nil {
+		return err
+	}
+
 	// Borrow's updated total USD value must be greater than the minimum global USD borrow limit
 	totalBorrowUSDValue := proprosedBorrowUSDValue.Add(existingBorrowUSDValue)
 	if totalBorrowUSDValue.LT(k.GetMinimumBorrowUSDValue(ctx)) {
@@ -219,6 +235,26 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 	return nil
 }
 
+// validateProtocolBorrowableBalance validates that the module account holds enough coins outside of the reserves to
+// lend the input amount
+func (k Keeper) validateProtocolBorrowableBalance(ctx sdk.Context, amount sdk.Coins) error {
+	// The reserve coins aren't available for users to borrow
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	hardMaccCoins := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+	reserveCoins, foundReserveCoins := k.GetTotalReserves(ctx)
+	if !foundReserveCoins {
+		reserveCoins = sdk.NewCoins()
+	}
+	fundsAvailableToBorrow, isNegative := hardMaccCoins.SafeSub(reserveCoins...)
+	if isNegative {
+		return sdkerrors.Wrapf(types.ErrReservesExceedCash, "reserves %s > cash %s", reserveCoins, hardMaccCoins)
+	}
+	if amount.IsAnyGT(fundsAvailableToBorrow) {
+		return sdkerrors.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested borrow %s > available to borrow %s", amount, fundsAvailableToBorrow)
+	}
+	return nil
+}
+
 // IncrementBorrowedCoins increments the total amount of borrowed coins by the newCoins parameter
 func (k Keeper) IncrementBorrowedCoins(ctx sdk.Context, newCoins sdk.Coins) {
 	borrowedCoins, found := k.GetBorrowedCoins(ctx)
@@ -320,8 +320,10 @@ func (suite *KeeperTestSuite) TestBorrow() {
 					types.NewMoneyMarket("xyz", types.NewBorrowLimit(false, sdk.NewDec(1), tc.args.loanToValueBNB), "xyz:usd", sdk.NewInt(1), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				},
 				sdk.NewDec(10),
+				types.DefaultFlashLoanFee,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEModes,
 			)
 
 			// Pricefeed module genesis state
@@ -469,6 +471,8 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 					sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
 			},
 			sdk.NewDec(10),
+			types.DefaultFlashLoanFee,
+			types.DefaultEModeCategories,
 		),
 		types.DefaultAccumulationTimes,
 		types.DefaultDeposits,
@@ -476,6 +480,7 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 		types.DefaultTotalSupplied,
 		types.DefaultTotalBorrowed,
 		types.DefaultTotalReserves,
+		types.DefaultAccountEModes,
 	)
 
 	// Pricefeed module genesis state
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// SetEMode opts an account into an efficiency mode category, or out of efficiency mode if the category is empty.
+// The account's borrows must belong to the new category and its position must remain within a valid LTV range.
+func (k Keeper) SetEMode(ctx sdk.Context, account sdk.AccAddress, category string) error {
+	var eModeCategory types.EModeCategory
+	inEMode := category != ""
+	if inEMode {
+		var found bool
+		eModeCategory, found = k.GetParams(ctx).EModeCategories.Get(category)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrEModeCategoryNotFound, "%s", category)
+		}
+	}
+
+	borrow, foundBorrow := k.GetSyncedBorrow(ctx, account)
+	if foundBorrow {
+		if inEMode {
+			for _, coin := range borrow.Amount {
+				if !eModeCategory.Contains(coin.Denom) {
+					return sdkerrors.Wrapf(types.ErrBorrowNotInEModeCategory, "%s is not in e-mode category %s", coin.Denom, category)
+				}
+			}
+		}
+
+		deposit, found := k.GetSyncedDeposit(ctx, account)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrDepositNotFound, "no deposit found for %s", account)
+		}
+		valid, err := k.isWithinValidLtvRange(ctx, deposit, borrow, eModeCategory, inEMode)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return sdkerrors.Wrapf(types.ErrInsufficientLoanToValue, "position would be outside loan-to-value range in e-mode category %s", category)
+		}
+	}
+
+	if inEMode {
+		k.SetAccountEMode(ctx, types.NewAccountEMode(account, category))
+	} else {
+		k.DeleteAccountEMode(ctx, account)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardSetEMode,
+			sdk.NewAttribute(types.AttributeKeyOwner, account.String()),
+			sdk.NewAttribute(types.AttributeKeyEModeCategory, category),
+		),
+	)
+	return nil
+}
+
+// GetEModeCategory returns the efficiency mode category of an account. Accounts are not in efficiency mode if they
+// haven't opted into a category or their category has been removed from the params.
+func (k Keeper) GetEModeCategory(ctx sdk.Context, account sdk.AccAddress) (types.EModeCategory, bool) {
+	accountEMode, found := k.GetAccountEMode(ctx, account)
+	if !found {
+		return types.EModeCategory{}, false
+	}
+	return k.GetParams(ctx).EModeCategories.Get(accountEMode.Category)
+}
+
+// eModeApplies returns true if all borrowed coins belong to the efficiency mode category
+func eModeApplies(category types.EModeCategory, inEMode bool, borrowed sdk.Coins) bool {
+	if !inEMode {
+		return false
+	}
+	for _, coin := range borrowed {
+		if !category.Contains(coin.Denom) {
+			return false
+		}
+	}
+	return true
+}
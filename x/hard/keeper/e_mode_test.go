@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+type EModeTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *EModeTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	// usdx and busd make up the stablecoins category with a 0.9 loan-to-value, up from busd's 0.5
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(cdc),
+		NewHARDGenState(cdc),
+		app.NewFundedGenStateWithSameCoins(cdc, cs(c("bnb", 10*USDX_CF), c("busd", 1000*BUSD_CF)), addrs),
+	)
+	err := tApp.FundModuleAccount(ctx, types.ModuleAccountName, cs(c("usdx", 10000*USDX_CF), c("bnb", 10*USDX_CF)))
+	suite.Require().NoError(err)
+
+	suite.app = tApp
+	suite.keeper = tApp.GetHardKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func (suite *EModeTestSuite) TestEModeBorrow() {
+	err := suite.keeper.SetEMode(suite.ctx, suite.addrs[0], "eth")
+	suite.ErrorIs(err, types.ErrEModeCategoryNotFound)
+
+	// 1000 busd x $1.00 x 0.5 LTV = $500 borrowable outside of e-mode
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("busd", 1000*BUSD_CF))))
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 800*USDX_CF)))
+	suite.ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	// 1000 busd x $1.00 x 0.9 LTV = $900 borrowable in e-mode
+	suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, suite.addrs[0], "stablecoins"))
+	category, found := suite.keeper.GetEModeCategory(suite.ctx, suite.addrs[0])
+	suite.True(found)
+	suite.Equal("stablecoins", category.Name)
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 800*USDX_CF))))
+
+	// assets outside of the category can't be borrowed in e-mode
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("bnb", 1*USDX_CF)))
+	suite.ErrorIs(err, types.ErrBorrowNotInEModeCategory)
+
+	// the position is healthy at the category's liquidation threshold
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, suite.addrs[0])
+	borrow, _ := suite.keeper.GetBorrow(suite.ctx, suite.addrs[0])
+	valid, err := suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.True(valid)
+
+	// opting out would leave the position outside of its loan-to-value range
+	err = suite.keeper.SetEMode(suite.ctx, suite.addrs[0], "")
+	suite.ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	suite.Require().NoError(suite.keeper.Repay(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("usdx", 500*USDX_CF))))
+	suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, suite.addrs[0], ""))
+	_, found = suite.keeper.GetEModeCategory(suite.ctx, suite.addrs[0])
+	suite.False(found)
+}
+
+func (suite *EModeTestSuite) TestSetEModeWithBorrowOutsideCategory() {
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("busd", 1000*BUSD_CF))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, suite.addrs[1], cs(c("bnb", USDX_CF/2))))
+
+	err := suite.keeper.SetEMode(suite.ctx, suite.addrs[1], "stablecoins")
+	suite.ErrorIs(err, types.ErrBorrowNotInEModeCategory)
+}
+
+func TestEModeTestSuite(t *testing.T) {
+	suite.Run(t, new(EModeTestSuite))
+}
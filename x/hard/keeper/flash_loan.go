@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// FlashLoan sends coins to the borrower and executes the input messages on their behalf. Once the messages complete,
+// the coins plus the flash loan fee are taken back from the borrower and the fee is added to the reserves. An error
+// is returned if the loan cannot be repaid, which reverts all state changes of the transaction.
+func (k Keeper) FlashLoan(ctx sdk.Context, borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) ([][]byte, error) {
+	if err := k.ValidateFlashLoan(ctx, amount); err != nil {
+		return nil, err
+	}
+
+	// The messages are executed without their own signatures, so they can only act on behalf of the borrower
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(borrower) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "flash loan message %d must be signed by the borrower only", i)
+		}
+	}
+
+	// Accrue interest before the loan leaves the module account, so interest accrued while it is outstanding isn't
+	// calculated from the reduced cash
+	for _, coin := range amount {
+		if err := k.AccrueInterest(ctx, coin.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.dispatchFlashLoanMsgs(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	fees := k.CalculateFlashLoanFees(ctx, amount)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, borrower, types.ModuleAccountName, amount.Add(fees...))
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
+			return nil, sdkerrors.Wrapf(types.ErrFlashLoanNotRepaid, "loan %s plus fees %s, balance %s",
+				amount, fees, k.bankKeeper.GetAllBalances(ctx, borrower),
+			)
+		}
+		return nil, err
+	}
+
+	if !fees.IsZero() {
+		reserves, found := k.GetTotalReserves(ctx)
+		if !found {
+			reserves = sdk.NewCoins()
+		}
+		k.SetTotalReserves(ctx, reserves.Add(fees...))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardFlashLoan,
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyFlashLoanCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFlashLoanFee, fees.String()),
+		),
+	)
+
+	return results, nil
+}
+
+// ValidateFlashLoan validates that a flash loan is for money market assets available to borrow
+func (k Keeper) ValidateFlashLoan(ctx sdk.Context, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return types.ErrBorrowEmptyCoins
+	}
+
+	for _, coin := range amount {
+		if _, found := k.GetMoneyMarket(ctx, coin.Denom); !found {
+			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+	}
+
+	return k.validateProtocolBorrowableBalance(ctx, amount)
+}
+
+// CalculateFlashLoanFees returns the fees owed on a flash loan, rounded up
+func (k Keeper) CalculateFlashLoanFees(ctx sdk.Context, amount sdk.Coins) sdk.Coins {
+	fee := k.GetParams(ctx).FlashLoanFee
+
+	fees := sdk.NewCoins()
+	for _, coin := range amount {
+		fees = fees.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(fee).Ceil().TruncateInt()))
+	}
+	return fees
+}
+
+// dispatchFlashLoanMsgs executes the messages of a flash loan and emits their events
+func (k Keeper) dispatchFlashLoanMsgs(ctx sdk.Context, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute flash loan message %d", i)
+		}
+		results[i] = res.Data
+
+		events := make([]sdk.Event, 0, len(res.Events))
+		for _, event := range res.Events {
+			e := event
+			e.Attributes = append(e.Attributes, abci.EventAttribute{Key: []byte("flash_loan_msg_index"), Value: []byte(strconv.Itoa(i))})
+			events = append(events, sdk.Event(e))
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+	return results, nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+type FlashLoanTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *FlashLoanTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(tApp.AppCodec()),
+		NewHARDGenState(tApp.AppCodec()),
+		app.NewFundedGenStateWithSameCoins(tApp.AppCodec(), cs(c("usdx", 1000000)), addrs[:1]),
+	)
+	err := tApp.FundModuleAccount(ctx, types.ModuleAccountName, cs(c("usdx", 1000000000)))
+	suite.Require().NoError(err)
+
+	suite.app = tApp
+	suite.keeper = tApp.GetHardKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func (suite *FlashLoanTestSuite) checkBalance(addr sdk.AccAddress, expected sdk.Coins) {
+	suite.Equal(expected, suite.app.GetBankKeeper().GetAllBalances(suite.ctx, addr))
+}
+
+func (suite *FlashLoanTestSuite) TestFlashLoan() {
+	// the loan is deposited and withdrawn again, leaving only the fee to be paid from the borrower's balance
+	loan := cs(c("usdx", 100000000))
+	depositMsg := types.NewMsgDeposit(suite.addrs[0], loan)
+	withdrawMsg := types.NewMsgWithdraw(suite.addrs[0], loan)
+
+	results, err := suite.keeper.FlashLoan(suite.ctx, suite.addrs[0], loan, []sdk.Msg{&depositMsg, &withdrawMsg})
+	suite.Require().NoError(err)
+	suite.Len(results, 2)
+
+	// the borrower pays the fee and the fee is added to the reserves
+	suite.checkBalance(suite.addrs[0], cs(c("usdx", 910000)))
+	suite.checkBalance(suite.app.GetAccountKeeper().GetModuleAddress(types.ModuleAccountName), cs(c("usdx", 1000090000)))
+	reserves, found := suite.keeper.GetTotalReserves(suite.ctx)
+	suite.True(found)
+	suite.Equal(cs(c("usdx", 90000)), reserves)
+
+	_, found = suite.keeper.GetDeposit(suite.ctx, suite.addrs[0])
+	suite.False(found)
+
+	var emitted bool
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeHardFlashLoan {
+			emitted = true
+		}
+	}
+	suite.True(emitted)
+}
+
+func (suite *FlashLoanTestSuite) TestFlashLoanNotRepaid() {
+	loan := cs(c("usdx", 100000000))
+	sendMsg := banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], loan)
+
+	_, err := suite.keeper.FlashLoan(suite.ctx, suite.addrs[0], loan, []sdk.Msg{sendMsg})
+	suite.ErrorIs(err, types.ErrFlashLoanNotRepaid)
+}
+
+func (suite *FlashLoanTestSuite) TestFlashLoanErrors() {
+	depositMsg := types.NewMsgDeposit(suite.addrs[0], cs(c("usdx", 1)))
+
+	_, err := suite.keeper.FlashLoan(suite.ctx, suite.addrs[0], cs(c("usdx", 1000000001)), []sdk.Msg{&depositMsg})
+	suite.ErrorIs(err, types.ErrExceedsProtocolBorrowableBalance)
+
+	_, err = suite.keeper.FlashLoan(suite.ctx, suite.addrs[0], cs(c("xrp", 1)), []sdk.Msg{&depositMsg})
+	suite.ErrorIs(err, types.ErrMarketNotFound)
+}
+
+func (suite *FlashLoanTestSuite) TestFlashLoanInterestAccrual() {
+	// usdx is half borrowed, and interest hasn't been accrued for a day
+	suite.keeper.IncrementBorrowedCoins(suite.ctx, cs(c("usdx", 500000000)))
+	suite.keeper.SetPreviousAccrualTime(suite.ctx, "usdx", suite.ctx.BlockTime())
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+
+	// interest accrued at the utilization before the loan
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(suite.keeper.AccrueInterest(cacheCtx, "usdx"))
+	expectedBorrowFactor, _ := suite.keeper.GetBorrowInterestFactor(cacheCtx, "usdx")
+	expectedSupplyFactor, _ := suite.keeper.GetSupplyInterestFactor(cacheCtx, "usdx")
+
+	loan := cs(c("usdx", 400000000))
+	depositMsg := types.NewMsgDeposit(suite.addrs[0], loan)
+	withdrawMsg := types.NewMsgWithdraw(suite.addrs[0], loan)
+	_, err := suite.keeper.FlashLoan(suite.ctx, suite.addrs[0], loan, []sdk.Msg{&depositMsg, &withdrawMsg})
+	suite.Require().NoError(err)
+
+	// interest is accrued before the loan is sent, so the deposit inside the loan doesn't change the interest factors
+	borrowFactor, _ := suite.keeper.GetBorrowInterestFactor(suite.ctx, "usdx")
+	supplyFactor, _ := suite.keeper.GetSupplyInterestFactor(suite.ctx, "usdx")
+	suite.Equal(expectedBorrowFactor, borrowFactor)
+	suite.Equal(expectedSupplyFactor, supplyFactor)
+}
+
+func (suite *FlashLoanTestSuite) TestFlashLoanSigners() {
+	// messages signed by other accounts are rejected even without ValidateBasic
+	sendMsg := banktypes.NewMsgSend(suite.addrs[1], suite.addrs[0], cs(c("usdx", 1)))
+
+	_, err := suite.keeper.FlashLoan(suite.ctx, suite.addrs[0], cs(c("usdx", 1000000)), []sdk.Msg{sendMsg})
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func TestFlashLoanTestSuite(t *testing.T) {
+	suite.Run(t, new(FlashLoanTestSuite))
+}
@@ -268,7 +268,7 @@ func (s queryServer) Borrows(ctx context.Context, req *types.QueryBorrowsRequest
 	// If owner param was specified then borrows array already contains the user's synced borrow
 	if hasOwner {
 		return &types.QueryBorrowsResponse{
-			Borrows:    borrows.ToResponse(),
+			Borrows:    s.borrowResponsesWithHealthFactors(sdkCtx, borrows),
 			Pagination: nil,
 		}, nil
 	}
@@ -293,10 +293,26 @@ func (s queryServer) Borrows(ctx context.Context, req *types.QueryBorrowsRequest
 	}
 
 	return &types.QueryBorrowsResponse{
-		Borrows: syncedBorrows.ToResponse(),
+		Borrows: s.borrowResponsesWithHealthFactors(sdkCtx, syncedBorrows),
 	}, nil
 }
 
+// borrowResponsesWithHealthFactors converts synced borrows to responses that include the health factor of each position.
+// The health factor of a position is left unset when one of its prices is unavailable, so one market with a tripped
+// price circuit breaker does not fail the whole response.
+func (s queryServer) borrowResponsesWithHealthFactors(ctx sdk.Context, borrows types.Borrows) types.BorrowResponses {
+	borrowResponses := borrows.ToResponse()
+	for i, borrow := range borrows {
+		deposit, _ := s.keeper.GetSyncedDeposit(ctx, borrow.Borrower)
+		healthFactor, err := s.keeper.GetHealthFactor(ctx, deposit, borrow)
+		if err != nil {
+			continue
+		}
+		borrowResponses[i].HealthFactor = &healthFactor
+	}
+	return borrowResponses
+}
+
 func (s queryServer) UnsyncedBorrows(ctx context.Context, req *types.QueryUnsyncedBorrowsRequest) (*types.QueryUnsyncedBorrowsResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -543,3 +559,107 @@ func (s queryServer) InterestFactors(ctx context.Context, req *types.QueryIntere
 		InterestFactors: interestFactors,
 	}, nil
 }
+
+func (s queryServer) EMode(ctx context.Context, req *types.QueryEModeRequest) (*types.QueryEModeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	category, _ := s.keeper.GetEModeCategory(sdkCtx, owner)
+
+	return &types.QueryEModeResponse{
+		Category: category,
+	}, nil
+}
+
+func (s queryServer) SimulatePosition(ctx context.Context, req *types.QuerySimulatePositionRequest) (*types.QuerySimulatePositionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	// Apply the actions to a branch of the state that is never written, so they go through the same validation as
+	// messages without taking effect. Each action runs in its own nested branch that is only written on success, so a
+	// rejected action leaves no partial writes behind.
+	cacheCtx, _ := sdkCtx.CacheContext()
+	actions := []struct {
+		coins  sdk.Coins
+		action func(sdk.Context, sdk.AccAddress, sdk.Coins) error
+	}{
+		{req.Deposit, s.keeper.Deposit},
+		{req.Repay, func(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins) error {
+			return s.keeper.Repay(ctx, owner, owner, coins)
+		}},
+		{req.Withdraw, s.keeper.Withdraw},
+		{req.Borrow, s.keeper.Borrow},
+	}
+	var rejection error
+	for _, a := range actions {
+		if a.coins.Empty() {
+			continue
+		}
+		actionCtx, write := cacheCtx.CacheContext()
+		if rejection = a.action(actionCtx, owner, a.coins); rejection != nil {
+			break
+		}
+		write()
+	}
+
+	deposit, found := s.keeper.GetSyncedDeposit(cacheCtx, owner)
+	if !found {
+		deposit = types.NewDeposit(owner, sdk.NewCoins(), types.SupplyInterestFactors{})
+	}
+	borrow, found := s.keeper.GetSyncedBorrow(cacheCtx, owner)
+	if !found {
+		borrow = types.NewBorrow(owner, sdk.NewCoins(), types.BorrowInterestFactors{})
+	}
+
+	depositValues, err := s.keeper.GetUSDValues(cacheCtx, deposit.Amount)
+	if err != nil {
+		return nil, err
+	}
+	borrowValues, err := s.keeper.GetUSDValues(cacheCtx, borrow.Amount)
+	if err != nil {
+		return nil, err
+	}
+	ltv, err := s.keeper.CalculateLtv(cacheCtx, deposit, borrow)
+	if err != nil {
+		return nil, err
+	}
+	borrowCapacity, err := s.keeper.GetBorrowCapacity(cacheCtx, deposit, borrow)
+	if err != nil {
+		return nil, err
+	}
+	healthFactor, err := s.keeper.GetHealthFactor(cacheCtx, deposit, borrow)
+	if err != nil {
+		return nil, err
+	}
+
+	res := types.QuerySimulatePositionResponse{
+		Deposit:          deposit.Amount,
+		Borrow:           borrow.Amount,
+		DepositUsdValues: depositValues,
+		BorrowUsdValues:  borrowValues,
+		LoanToValue:      ltv,
+		BorrowCapacity:   borrowCapacity,
+		HealthFactor:     healthFactor,
+	}
+	if rejection != nil {
+		res.Rejected = true
+		res.RejectionReason = rejection.Error()
+	}
+	return &res, nil
+}
@@ -5,9 +5,11 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/hard/keeper"
 	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 	"github.com/stretchr/testify/suite"
 	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -350,6 +352,118 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryBorrows() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryBorrows_HealthFactor() {
+	suite.addDeposits()
+	suite.addBorrows()
+
+	res, err := suite.queryServer.Borrows(sdk.WrapSDKContext(suite.ctx), &types.QueryBorrowsRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Borrows, 1)
+
+	deposit, _ := suite.keeper.GetSyncedDeposit(suite.ctx, suite.addrs[0])
+	borrow, _ := suite.keeper.GetSyncedBorrow(suite.ctx, suite.addrs[0])
+	healthFactor, err := suite.keeper.GetHealthFactor(suite.ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.True(healthFactor.GT(sdk.OneDec()))
+	suite.Require().NotNil(res.Borrows[0].HealthFactor)
+	suite.Equal(healthFactor, *res.Borrows[0].HealthFactor)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryBorrows_HealthFactorPriceUnavailable() {
+	suite.addDeposits()
+	suite.addBorrows()
+
+	// only the first address holds busd, so only its health factor depends on the suspended market
+	suite.tApp.GetPriceFeedKeeper().SetMarketSuspension(suite.ctx, pricefeedtypes.NewMarketSuspension(
+		"busd:usd", sdk.OneDec(), sdk.NewDec(2), suite.ctx.BlockTime(),
+	))
+
+	res, err := suite.queryServer.Borrows(sdk.WrapSDKContext(suite.ctx), &types.QueryBorrowsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Borrows, 2)
+
+	for _, borrow := range res.Borrows {
+		if borrow.Borrower == suite.addrs[0].String() {
+			suite.Nil(borrow.HealthFactor)
+		} else {
+			suite.NotNil(borrow.HealthFactor)
+		}
+	}
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySimulatePosition() {
+	suite.addDeposits()
+	suite.addBorrows()
+
+	// 20 bnb x $618.13 x 0.5 LTV = $6181.3 borrowable
+	res, err := suite.queryServer.SimulatePosition(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulatePositionRequest{
+		Owner:  suite.addrs[1].String(),
+		Borrow: cs(c("usdx", 100000000)),
+	})
+	suite.Require().NoError(err)
+	suite.False(res.Rejected)
+	suite.Equal(cs(c("bnb", 20000000)), res.Deposit)
+	suite.Equal(cs(c("usdx", 120000000)), res.Borrow)
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("bnb", sdk.MustNewDecFromStr("12362.6"))), res.DepositUsdValues)
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("usdx", sdk.NewDec(120))), res.BorrowUsdValues)
+	suite.Equal(sdk.NewDec(120).Quo(sdk.MustNewDecFromStr("12362.6")), res.LoanToValue)
+	suite.Equal(sdk.MustNewDecFromStr("6061.3"), res.BorrowCapacity)
+
+	// the position is the one before the rejected action
+	res, err = suite.queryServer.SimulatePosition(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulatePositionRequest{
+		Owner:  suite.addrs[1].String(),
+		Repay:  cs(c("usdx", 10000000)),
+		Borrow: cs(c("usdx", 7000000000)),
+	})
+	suite.Require().NoError(err)
+	suite.True(res.Rejected)
+	suite.Contains(res.RejectionReason, types.ErrInsufficientLoanToValue.Error())
+	suite.Equal(cs(c("usdx", 10000000)), res.Borrow)
+	suite.Equal(sdk.MustNewDecFromStr("6171.3"), res.BorrowCapacity)
+
+	res, err = suite.queryServer.SimulatePosition(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulatePositionRequest{
+		Owner:    suite.addrs[1].String(),
+		Withdraw: cs(c("bnb", 20000000)),
+	})
+	suite.Require().NoError(err)
+	suite.True(res.Rejected)
+	suite.Contains(res.RejectionReason, types.ErrInvalidWithdrawAmount.Error())
+
+	// deposits must be covered by the account balance
+	res, err = suite.queryServer.SimulatePosition(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulatePositionRequest{
+		Owner:   suite.addrs[1].String(),
+		Deposit: cs(c("bnb", 1000000000000)),
+	})
+	suite.Require().NoError(err)
+	suite.True(res.Rejected)
+	suite.Contains(res.RejectionReason, sdkerrors.ErrInsufficientFunds.Error())
+	suite.Equal(cs(c("bnb", 20000000)), res.Deposit)
+
+	// simulated actions are not persisted
+	borrow, _ := suite.keeper.GetBorrow(suite.ctx, suite.addrs[1])
+	suite.Equal(cs(c("usdx", 20000000)), borrow.Amount)
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, suite.addrs[1])
+	suite.Equal(cs(c("bnb", 20000000)), deposit.Amount)
+
+	// accounts without a position can simulate opening one
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	res, err = suite.queryServer.SimulatePosition(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulatePositionRequest{
+		Owner: addrs[2].String(),
+	})
+	suite.Require().NoError(err)
+	suite.False(res.Rejected)
+	suite.Empty(res.Deposit)
+	suite.True(res.LoanToValue.IsZero())
+	suite.True(res.BorrowCapacity.IsZero())
+
+	_, err = suite.queryServer.SimulatePosition(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulatePositionRequest{
+		Owner: "invalid address",
+	})
+	suite.Error(err)
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryTotalDeposited() {
 	suite.addDeposits()
 
@@ -525,6 +639,23 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryReserves() {
 	}, res)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryEMode() {
+	res, err := suite.queryServer.EMode(sdk.WrapSDKContext(suite.ctx), &types.QueryEModeRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(types.EModeCategory{}, res.Category)
+
+	suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, suite.addrs[0], "stablecoins"))
+
+	res, err = suite.queryServer.EMode(sdk.WrapSDKContext(suite.ctx), &types.QueryEModeRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal("stablecoins", res.Category.Name)
+	suite.Equal([]string{"usdx", "busd"}, res.Category.Denoms)
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
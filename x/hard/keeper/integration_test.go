@@ -71,6 +71,10 @@ func NewHARDGenState(cdc codec.JSONCodec) app.GenesisState {
 				},
 			},
 			sdk.MustNewDecFromStr("10"),
+			types.DefaultFlashLoanFee,
+			types.EModeCategories{
+				types.NewEModeCategory("stablecoins", []string{"usdx", "busd"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.95")),
+			},
 		),
 		PreviousAccumulationTimes: types.GenesisAccumulationTimes{
 			types.NewGenesisAccumulationTime(
@@ -850,8 +850,10 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 						sdk.ZeroDec()),            // Keeper Reward Percentage
 				},
 				sdk.NewDec(10),
+				types.DefaultFlashLoanFee,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEModes,
 			)
 
 			// Pricefeed module genesis state
@@ -1262,8 +1264,10 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 						sdk.ZeroDec()),            // Keeper Reward Percentage
 				},
 				sdk.NewDec(10),
+				types.DefaultFlashLoanFee,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEModes,
 			)
 
 			// Pricefeed module genesis state
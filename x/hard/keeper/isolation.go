@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetIsolatedCollateral returns the money market of the isolated denom held in a deposit. Deposits holding more than
+// one isolated denom cannot be borrowed against.
+func (k Keeper) GetIsolatedCollateral(ctx sdk.Context, deposit types.Deposit) (types.MoneyMarket, bool, error) {
+	var isolatedMarket types.MoneyMarket
+	found := false
+	for _, coin := range deposit.Amount {
+		moneyMarket, foundMm := k.GetMoneyMarket(ctx, coin.Denom)
+		if !foundMm || !moneyMarket.Isolated {
+			continue
+		}
+		if found {
+			return types.MoneyMarket{}, false, sdkerrors.Wrapf(types.ErrMultipleIsolatedCollateral,
+				"deposit holds isolated collateral %s and %s", isolatedMarket.Denom, moneyMarket.Denom)
+		}
+		isolatedMarket = moneyMarket
+		found = true
+	}
+	return isolatedMarket, found, nil
+}
+
+// IncrementIsolatedDebt increments the amount borrowed against an isolated collateral denom by coins valued at the
+// current borrow interest factors
+func (k Keeper) IncrementIsolatedDebt(ctx sdk.Context, denom string, coins sdk.Coins) {
+	isolatedDebt, _ := k.GetIsolatedDebt(ctx, denom)
+	k.SetIsolatedDebt(ctx, denom, isolatedDebt.Add(k.scaleByBorrowInterestFactors(ctx, coins)...))
+}
+
+// DecrementIsolatedDebt decrements the amount borrowed against an isolated collateral denom by coins valued at the
+// current borrow interest factors. Coins exceeding the tracked debt only reduce the debt to zero.
+func (k Keeper) DecrementIsolatedDebt(ctx sdk.Context, denom string, coins sdk.Coins) {
+	isolatedDebt, found := k.GetIsolatedDebt(ctx, denom)
+	if !found {
+		return
+	}
+	scaledCoins := k.scaleByBorrowInterestFactors(ctx, coins)
+	remainingDebt := sdk.NewDecCoins()
+	for _, coin := range isolatedDebt {
+		remaining := coin.Amount.Sub(scaledCoins.AmountOf(coin.Denom))
+		if remaining.IsPositive() {
+			remainingDebt = remainingDebt.Add(sdk.NewDecCoinFromDec(coin.Denom, remaining))
+		}
+	}
+	k.SetIsolatedDebt(ctx, denom, remainingDebt)
+}
+
+// GetIsolatedDebtAmount returns the amount borrowed against an isolated collateral denom, including the interest
+// accrued since it was borrowed
+func (k Keeper) GetIsolatedDebtAmount(ctx sdk.Context, denom string) sdk.DecCoins {
+	isolatedDebt, _ := k.GetIsolatedDebt(ctx, denom)
+	amount := sdk.NewDecCoins()
+	for _, coin := range isolatedDebt {
+		amount = amount.Add(sdk.NewDecCoinFromDec(coin.Denom, coin.Amount.Mul(k.getBorrowInterestFactorOrOne(ctx, coin.Denom))))
+	}
+	return amount
+}
+
+// scaleByBorrowInterestFactors divides coins by the current borrow interest factor of each denom. Isolated debt is
+// stored in these terms so it accrues interest along with the borrows it tracks, and repaying interest reduces it by
+// no more than the interest added to it.
+func (k Keeper) scaleByBorrowInterestFactors(ctx sdk.Context, coins sdk.Coins) sdk.DecCoins {
+	scaled := sdk.NewDecCoins()
+	for _, coin := range coins {
+		amount := sdk.NewDecFromInt(coin.Amount).Quo(k.getBorrowInterestFactorOrOne(ctx, coin.Denom))
+		scaled = scaled.Add(sdk.NewDecCoinFromDec(coin.Denom, amount))
+	}
+	return scaled
+}
+
+// getBorrowInterestFactorOrOne returns the borrow interest factor of a denom, which is one before any interest accrues
+func (k Keeper) getBorrowInterestFactorOrOne(ctx sdk.Context, denom string) sdk.Dec {
+	interestFactor, found := k.GetBorrowInterestFactor(ctx, denom)
+	if !found {
+		return sdk.OneDec()
+	}
+	return interestFactor
+}
+
+// InitializeIsolatedDebts sets the amounts borrowed against isolated collateral from the deposits and borrows in the store
+func (k Keeper) InitializeIsolatedDebts(ctx sdk.Context) {
+	k.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+		if denom, isolated := k.getIsolatedCollateralDenom(ctx, borrow.Borrower); isolated {
+			// include the interest accrued since the borrow was last synced
+			syncedBorrow := k.loadSyncedBorrow(ctx, borrow)
+			k.IncrementIsolatedDebt(ctx, denom, syncedBorrow.Amount)
+		}
+		return false
+	})
+}
+
+// getIsolatedCollateralDenom returns the isolated denom an account's borrows count toward, if any
+func (k Keeper) getIsolatedCollateralDenom(ctx sdk.Context, addr sdk.AccAddress) (string, bool) {
+	deposit, found := k.GetDeposit(ctx, addr)
+	if !found {
+		return "", false
+	}
+	// Borrowing is rejected while a deposit holds multiple isolated denoms, so there is no debt to attribute
+	isolatedMarket, isolated, err := k.GetIsolatedCollateral(ctx, deposit)
+	if err != nil || !isolated {
+		return "", false
+	}
+	return isolatedMarket.Denom, true
+}
+
+// isBorrowableInIsolation returns true if every coin can be borrowed against isolated collateral
+func (k Keeper) isBorrowableInIsolation(ctx sdk.Context, coins sdk.Coins) bool {
+	for _, coin := range coins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found || !moneyMarket.BorrowableInIsolation {
+			return false
+		}
+	}
+	return true
+}
+
+// validateBorrowIsolation validates a proposed borrow against the siloed borrowing and isolated collateral restrictions
+// of the money markets
+func (k Keeper) validateBorrowIsolation(ctx sdk.Context, deposit types.Deposit, existingBorrow, amount sdk.Coins) error {
+	proposedBorrow := existingBorrow.Add(amount...)
+
+	// Siloed assets can only be borrowed on their own
+	if len(proposedBorrow) > 1 {
+		for _, coin := range proposedBorrow {
+			moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+			if found && moneyMarket.Siloed {
+				return sdkerrors.Wrapf(types.ErrSiloedBorrow, "%s cannot be borrowed alongside %s", coin.Denom, proposedBorrow)
+			}
+		}
+	}
+
+	isolatedMarket, isolated, err := k.GetIsolatedCollateral(ctx, deposit)
+	if err != nil {
+		return err
+	}
+	if !isolated {
+		return nil
+	}
+
+	if !k.isBorrowableInIsolation(ctx, proposedBorrow) {
+		return sdkerrors.Wrapf(types.ErrNotBorrowableInIsolation, "%s cannot be borrowed against isolated collateral %s",
+			proposedBorrow, isolatedMarket.Denom)
+	}
+
+	// Validate the debt borrowed against the isolated collateral by all accounts against its debt ceiling
+	isolatedDebt := k.GetIsolatedDebtAmount(ctx, isolatedMarket.Denom)
+	proposedDebtUSDValue := sdk.ZeroDec()
+	for _, coin := range isolatedDebt.Add(sdk.NewDecCoinsFromCoins(amount...)...) {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
+		}
+		coinUSDValue := coin.Amount.Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
+		proposedDebtUSDValue = proposedDebtUSDValue.Add(coinUSDValue)
+	}
+	if proposedDebtUSDValue.GT(isolatedMarket.IsolationDebtCeiling) {
+		return sdkerrors.Wrapf(types.ErrExceedsIsolationDebtCeiling,
+			"proposed borrow would result in $%s borrowed against %s, but the isolation debt ceiling is $%s",
+			proposedDebtUSDValue, isolatedMarket.Denom, isolatedMarket.IsolationDebtCeiling)
+	}
+	return nil
+}
+
+// validateDepositIsolation validates that a deposit doesn't add isolated collateral to an account that is already
+// borrowing, as its existing borrows were not validated against the isolation restrictions
+func (k Keeper) validateDepositIsolation(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	if _, found := k.GetBorrow(ctx, depositor); !found {
+		return nil
+	}
+	existingDeposit, _ := k.GetDeposit(ctx, depositor)
+	for _, coin := range coins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if found && moneyMarket.Isolated && existingDeposit.Amount.AmountOf(coin.Denom).IsZero() {
+			return sdkerrors.Wrapf(types.ErrIsolatedDepositWithBorrow, "%s is isolated collateral", coin.Denom)
+		}
+	}
+	return nil
+}
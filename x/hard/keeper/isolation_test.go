@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+type IsolationTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *IsolationTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+
+	// bnb is isolated collateral that can only back usdx borrows, busd is siloed
+	hardGS := NewHARDGenState(cdc)
+	var hardGenesis types.GenesisState
+	cdc.MustUnmarshalJSON(hardGS[types.ModuleName], &hardGenesis)
+	hardGenesis.Params.MoneyMarkets[0].BorrowableInIsolation = true
+	hardGenesis.Params.MoneyMarkets[1].Isolated = true
+	hardGenesis.Params.MoneyMarkets[1].IsolationDebtCeiling = sdk.NewDec(1000)
+	hardGenesis.Params.MoneyMarkets[2].Siloed = true
+	hardGS[types.ModuleName] = cdc.MustMarshalJSON(&hardGenesis)
+
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(cdc),
+		hardGS,
+		app.NewFundedGenStateWithSameCoins(cdc, cs(c("bnb", 10*USDX_CF), c("usdx", 1000*USDX_CF)), addrs),
+	)
+	err := tApp.FundModuleAccount(ctx, types.ModuleAccountName, cs(c("usdx", 10000*USDX_CF), c("busd", 10000*BNB_CF)))
+	suite.Require().NoError(err)
+
+	suite.app = tApp
+	suite.keeper = tApp.GetHardKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func (suite *IsolationTestSuite) checkIsolatedDebt(expected sdk.Coins) {
+	suite.Equal(sdk.NewDecCoinsFromCoins(expected...), suite.keeper.GetIsolatedDebtAmount(suite.ctx, "bnb"))
+}
+
+func (suite *IsolationTestSuite) TestIsolatedBorrow() {
+	// 10 bnb x $618.13 x 0.5 LTV = $3090 borrowable, limited by the $1000 debt ceiling shared by all borrowers
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("bnb", 10*USDX_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("bnb", 10*USDX_CF))))
+
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 600*USDX_CF))))
+	suite.checkIsolatedDebt(cs(c("usdx", 600*USDX_CF)))
+
+	err := suite.keeper.Borrow(suite.ctx, suite.addrs[1], cs(c("usdx", 500*USDX_CF)))
+	suite.ErrorIs(err, types.ErrExceedsIsolationDebtCeiling)
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, suite.addrs[1], cs(c("usdx", 400*USDX_CF))))
+	suite.checkIsolatedDebt(cs(c("usdx", 1000*USDX_CF)))
+
+	// only assets that are borrowable in isolation can be borrowed against isolated collateral
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[1], cs(c("bnb", 1*USDX_CF)))
+	suite.ErrorIs(err, types.ErrNotBorrowableInIsolation)
+
+	// repaying frees up the debt ceiling
+	suite.Require().NoError(suite.keeper.Repay(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("usdx", 100*USDX_CF))))
+	suite.checkIsolatedDebt(cs(c("usdx", 900*USDX_CF)))
+
+	// borrows no longer count toward the debt ceiling once the isolated collateral is withdrawn
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("usdx", 1000*USDX_CF))))
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, suite.addrs[0], cs(c("bnb", 10*USDX_CF))))
+	suite.checkIsolatedDebt(cs(c("usdx", 400*USDX_CF)))
+
+	// the debt is rebuilt from the stored borrows
+	suite.keeper.SetIsolatedDebt(suite.ctx, "bnb", sdk.NewDecCoins())
+	suite.keeper.InitializeIsolatedDebts(suite.ctx)
+	suite.checkIsolatedDebt(cs(c("usdx", 400*USDX_CF)))
+}
+
+func (suite *IsolationTestSuite) TestIsolatedBorrow_AccruedInterest() {
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("bnb", 10*USDX_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("bnb", 10*USDX_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[2], cs(c("usdx", 1000*USDX_CF))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 600*USDX_CF))))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * 24 * time.Hour))
+	suite.Require().NoError(suite.keeper.AccrueInterest(suite.ctx, "usdx"))
+
+	// the isolated debt grows with the interest accrued on the borrows it tracks
+	borrow, found := suite.keeper.GetSyncedBorrow(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	interest := borrow.Amount.Sub(cs(c("usdx", 600*USDX_CF))...)
+	suite.Require().False(interest.IsZero())
+	debt := suite.keeper.GetIsolatedDebtAmount(suite.ctx, "bnb").AmountOf("usdx")
+	suite.InDelta(borrow.Amount.AmountOf("usdx").Int64(), debt.RoundInt64(), 1)
+
+	// so the interest counts towards the debt ceiling
+	err := suite.keeper.Borrow(suite.ctx, suite.addrs[1], cs(c("usdx", 400*USDX_CF)))
+	suite.ErrorIs(err, types.ErrExceedsIsolationDebtCeiling)
+
+	// repaying the interest frees up no more of the debt ceiling than the interest added to it
+	suite.Require().NoError(suite.keeper.Repay(suite.ctx, suite.addrs[0], suite.addrs[0], interest))
+	debt = suite.keeper.GetIsolatedDebtAmount(suite.ctx, "bnb").AmountOf("usdx")
+	suite.InDelta(600*USDX_CF, debt.RoundInt64(), 1)
+
+	suite.Require().NoError(suite.keeper.Repay(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("usdx", 600*USDX_CF))))
+	debt = suite.keeper.GetIsolatedDebtAmount(suite.ctx, "bnb").AmountOf("usdx")
+	suite.InDelta(0, debt.RoundInt64(), 1)
+}
+
+func (suite *IsolationTestSuite) TestSiloedBorrow() {
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[2], cs(c("usdx", 500*USDX_CF))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, suite.addrs[2], cs(c("busd", 100*BNB_CF))))
+
+	err := suite.keeper.Borrow(suite.ctx, suite.addrs[2], cs(c("usdx", 100*USDX_CF)))
+	suite.ErrorIs(err, types.ErrSiloedBorrow)
+
+	// isolated collateral can't be added by an account that is already borrowing
+	err = suite.keeper.Deposit(suite.ctx, suite.addrs[2], cs(c("bnb", 1*USDX_CF)))
+	suite.ErrorIs(err, types.ErrIsolatedDepositWithBorrow)
+}
+
+func (suite *IsolationTestSuite) TestIsWithinValidLtvRange() {
+	deposit := types.NewDeposit(suite.addrs[0], cs(c("bnb", 10*USDX_CF)), types.SupplyInterestFactors{})
+
+	borrow := types.NewBorrow(suite.addrs[0], cs(c("usdx", 100*USDX_CF)), types.BorrowInterestFactors{})
+	valid, err := suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.True(valid)
+
+	// isolated collateral doesn't back borrows of assets that aren't borrowable in isolation
+	borrow = types.NewBorrow(suite.addrs[0], cs(c("busd", 100*BNB_CF)), types.BorrowInterestFactors{})
+	valid, err = suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.False(valid)
+}
+
+func TestIsolationTestSuite(t *testing.T) {
+	suite.Run(t, new(IsolationTestSuite))
+}
@@ -21,13 +21,14 @@ type Keeper struct {
 	bankKeeper      types.BankKeeper
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
+	router          types.MessageRouter
 	hooks           types.HARDHooks
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper,
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, router types.MessageRouter,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -41,6 +42,7 @@ func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.S
 		bankKeeper:      bk,
 		pricefeedKeeper: pfk,
 		auctionKeeper:   auk,
+		router:          router,
 		hooks:           nil,
 	}
 }
@@ -296,6 +298,74 @@ func (k Keeper) GetTotalReserves(ctx sdk.Context) (sdk.Coins, bool) {
 	return totalReserves.Coins, true
 }
 
+// SetIsolatedDebt sets the amount borrowed against an isolated collateral denom, divided by the borrow interest factor
+// of each borrowed denom
+func (k Keeper) SetIsolatedDebt(ctx sdk.Context, denom string, coins sdk.DecCoins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	if coins.Empty() {
+		store.Delete([]byte(denom))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&types.DecCoinsProto{
+		Coins: coins,
+	})
+	store.Set([]byte(denom), bz)
+}
+
+// GetIsolatedDebt returns the amount borrowed against an isolated collateral denom, divided by the borrow interest
+// factor of each borrowed denom
+func (k Keeper) GetIsolatedDebt(ctx sdk.Context, denom string) (sdk.DecCoins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.DecCoins{}, false
+	}
+
+	var isolatedDebt types.DecCoinsProto
+	k.cdc.MustUnmarshal(bz, &isolatedDebt)
+	return isolatedDebt.Coins, true
+}
+
+// GetAccountEMode returns the efficiency mode an account has opted into
+func (k Keeper) GetAccountEMode(ctx sdk.Context, account sdk.AccAddress) (types.AccountEMode, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModesPrefix)
+	bz := store.Get(account.Bytes())
+	if len(bz) == 0 {
+		return types.AccountEMode{}, false
+	}
+	var accountEMode types.AccountEMode
+	k.cdc.MustUnmarshal(bz, &accountEMode)
+	return accountEMode, true
+}
+
+// SetAccountEMode sets the efficiency mode of an account in the store
+func (k Keeper) SetAccountEMode(ctx sdk.Context, accountEMode types.AccountEMode) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModesPrefix)
+	bz := k.cdc.MustMarshal(&accountEMode)
+	store.Set(accountEMode.Account.Bytes(), bz)
+}
+
+// DeleteAccountEMode deletes the efficiency mode of an account from the store
+func (k Keeper) DeleteAccountEMode(ctx sdk.Context, account sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModesPrefix)
+	store.Delete(account.Bytes())
+}
+
+// IterateAccountEModes iterates over all account efficiency modes in the store and performs a callback function
+func (k Keeper) IterateAccountEModes(ctx sdk.Context, cb func(accountEMode types.AccountEMode) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var accountEMode types.AccountEMode
+		k.cdc.MustUnmarshal(iterator.Value(), &accountEMode)
+		if cb(accountEMode) {
+			break
+		}
+	}
+}
+
 // GetBorrowInterestFactor returns the current borrow interest factor for an individual market
 func (k Keeper) GetBorrowInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowInterestFactorPrefix)
@@ -11,9 +11,11 @@ import (
 
 // LiqData holds liquidation-related data
 type LiqData struct {
-	price            sdk.Dec
-	ltv              sdk.Dec
-	conversionFactor sdk.Int
+	price                sdk.Dec
+	ltv                  sdk.Dec
+	liquidationThreshold sdk.Dec
+	liquidationBonus     sdk.Dec
+	conversionFactor     sdk.Int
 }
 
 // AttemptKeeperLiquidation enables a keeper to liquidate an individual borrower's position
@@ -45,12 +47,17 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 		return types.ErrBorrowNotFound
 	}
 
-	isWithinRange, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
+	liquidatable, err := k.IsLiquidatable(ctx, deposit, borrow)
 	if err != nil {
 		return err
 	}
-	if isWithinRange {
-		return sdkerrors.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
+	if !liquidatable {
+		return sdkerrors.Wrapf(types.ErrBorrowNotLiquidatable, "position is within its liquidation threshold")
+	}
+
+	// Liquidated borrows no longer count toward the isolation debt ceiling
+	if isolatedDenom, isolated := k.getIsolatedCollateralDenom(ctx, borrower); isolated {
+		k.DecrementIsolatedDebt(ctx, isolatedDenom, borrow.Amount)
 	}
 
 	// Sending coins to auction module with keeper address getting % of the profits
@@ -145,6 +152,28 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 	return err
 }
 
+// startAuction starts an auction selling the lot using the auction type of the lot's money market
+func (k Keeper) startAuction(ctx sdk.Context, lot, bid sdk.Coin, returnAddrs []sdk.AccAddress, weights []sdk.Int,
+	debt sdk.Coin, liqMap map[string]LiqData,
+) error {
+	moneyMarket, found := k.GetMoneyMarket(ctx, lot.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", lot.Denom)
+	}
+
+	if moneyMarket.AuctionType != types.AuctionTypeDutch {
+		_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+		return err
+	}
+
+	// price of one unit of lot in units of the bid denom
+	lData, bData := liqMap[lot.Denom], liqMap[bid.Denom]
+	referencePrice := lData.price.MulInt(bData.conversionFactor).Quo(bData.price.MulInt(lData.conversionFactor))
+
+	_, err := k.auctionKeeper.StartDutchAuction(ctx, types.ModuleAccountName, lot, bid, referencePrice, returnAddrs, weights, debt)
+	return err
+}
+
 // StartAuctions attempts to start auctions for seized assets
 func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows, deposits sdk.Coins,
 	depositCoinValues, borrowCoinValues types.ValuationMap, ltv sdk.Dec, liqMap map[string]LiqData,
@@ -163,14 +192,21 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 
 	var liquidatedCoins sdk.Coins
 	for _, bKey := range bKeys {
-		bValue := borrowCoinValues.Get(bKey)
-		maxLotSize := bValue.Quo(ltv)
-
 		for _, dKey := range dKeys {
-			dValue := depositCoinValues.Get(dKey)
-			if maxLotSize.Equal(sdk.ZeroDec()) {
+			bValue := borrowCoinValues.Get(bKey)
+			if bValue.Equal(sdk.ZeroDec()) {
 				break // exit out of the loop if we have cleared the full amount
 			}
+			dValue := depositCoinValues.Get(dKey)
+
+			// Deposits are auctioned in proportion to the position's LTV, limited to the borrowed value plus the
+			// deposit's liquidation bonus when set
+			bonusMultiplier := sdk.OneDec().Add(liqMap[dKey].liquidationBonus)
+			limitLot := liqMap[dKey].liquidationBonus.IsPositive() && bonusMultiplier.Mul(ltv).LT(sdk.OneDec())
+			maxLotSize := bValue.Quo(ltv)
+			if limitLot {
+				maxLotSize = bValue.Mul(bonusMultiplier)
+			}
 
 			if dValue.GTE(maxLotSize) { // We can start an auction for the whole borrow amount]
 				bid := sdk.NewCoin(bKey, borrows.AmountOf(bKey))
@@ -193,7 +229,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = full borrow amount, lot = maxLotSize
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				err := k.startAuction(ctx, lot, bid, returnAddrs, weights, debt, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -220,10 +256,11 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				} else {
 					deposits = deposits.Sub(lot)
 				}
-				// Update max lot size
-				maxLotSize = sdk.ZeroDec()
 			} else { // We can only start an auction for the partial borrow amount
 				maxBid := dValue.Mul(ltv)
+				if limitLot {
+					maxBid = dValue.Quo(bonusMultiplier)
+				}
 				bidSize := maxBid.MulInt(liqMap[bKey].conversionFactor).Quo(liqMap[bKey].price)
 				bid := sdk.NewCoin(bKey, bidSize.TruncateInt())
 				lot := sdk.NewCoin(dKey, deposits.AmountOf(dKey))
@@ -244,7 +281,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = maxBid, lot = whole deposit amount
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				err := k.startAuction(ctx, lot, bid, returnAddrs, weights, debt, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -271,9 +308,6 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				} else {
 					deposits = deposits.Sub(lot)
 				}
-
-				// Update max lot size
-				maxLotSize = borrowCoinValues.Get(bKey).Quo(ltv)
 			}
 		}
 	}
@@ -283,7 +317,11 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 		remaining := deposits.AmountOf(dKey)
 		if remaining.GT(sdk.ZeroInt()) {
 			returnCoin := sdk.NewCoins(sdk.NewCoin(dKey, remaining))
-			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, returnCoin)
+			err := k.DecrementSuppliedCoins(ctx, returnCoin)
+			if err != nil {
+				return liquidatedCoins, err
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, returnCoin)
 			if err != nil {
 				return liquidatedCoins, err
 			}
@@ -295,32 +333,128 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 
 // IsWithinValidLtvRange compares a borrow and deposit to see if it's within a valid LTV range at current prices
 func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
-	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	eModeCategory, inEMode := k.GetEModeCategory(ctx, deposit.Depositor)
+	return k.isWithinValidLtvRange(ctx, deposit, borrow, eModeCategory, inEMode)
+}
+
+// isWithinValidLtvRange compares a borrow and deposit to see if it's within a valid LTV range at current prices for the
+// input efficiency mode category
+func (k Keeper) isWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow,
+	eModeCategory types.EModeCategory, inEMode bool,
+) (bool, error) {
+	values, err := k.getPositionValues(ctx, deposit, borrow, eModeCategory, inEMode)
+	if err != nil {
+		return false, err
+	}
+
+	// Check if the user's has borrowed more than they're allowed to
+	return values.borrowed.LTE(values.borrowLimit), nil
+}
+
+// IsLiquidatable compares a borrow and deposit to see if the borrow exceeds the liquidation threshold at current prices
+func (k Keeper) IsLiquidatable(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
+	eModeCategory, inEMode := k.GetEModeCategory(ctx, deposit.Depositor)
+	values, err := k.getPositionValues(ctx, deposit, borrow, eModeCategory, inEMode)
 	if err != nil {
 		return false, err
 	}
+	return values.borrowed.GT(values.liquidationLimit), nil
+}
 
-	totalBorrowableUSDAmount := sdk.ZeroDec()
+// GetHealthFactor returns the ratio of a deposit weighted by its liquidation thresholds to a borrow at current prices.
+// Positions with a health factor below one can be liquidated. Borrows without value have a health factor of zero.
+func (k Keeper) GetHealthFactor(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, error) {
+	eModeCategory, inEMode := k.GetEModeCategory(ctx, deposit.Depositor)
+	values, err := k.getPositionValues(ctx, deposit, borrow, eModeCategory, inEMode)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	if values.borrowed.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+	return values.liquidationLimit.Quo(values.borrowed), nil
+}
+
+// GetBorrowCapacity returns the USD value that can still be borrowed against a deposit at its loan-to-values, given
+// a borrow at current prices
+func (k Keeper) GetBorrowCapacity(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, error) {
+	eModeCategory, inEMode := k.GetEModeCategory(ctx, deposit.Depositor)
+	values, err := k.getPositionValues(ctx, deposit, borrow, eModeCategory, inEMode)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	if values.borrowed.GTE(values.borrowLimit) {
+		return sdk.ZeroDec(), nil
+	}
+	return values.borrowLimit.Sub(values.borrowed), nil
+}
+
+// GetUSDValues returns the USD value of each denom of the input coins at current prices
+func (k Keeper) GetUSDValues(ctx sdk.Context, coins sdk.Coins) (sdk.DecCoins, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, types.Deposit{Amount: coins}, types.Borrow{})
+	if err != nil {
+		return nil, err
+	}
+
+	values := sdk.NewDecCoins()
+	for _, coin := range coins {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		values = values.Add(sdk.NewDecCoinFromDec(coin.Denom, usdValue))
+	}
+	return values, nil
+}
+
+// positionValues holds the USD values of a position at current prices
+type positionValues struct {
+	borrowed         sdk.Dec // the value of the borrow
+	borrowLimit      sdk.Dec // the value that can be borrowed against the deposit at its loan-to-values
+	liquidationLimit sdk.Dec // the value that can be borrowed against the deposit before the position can be liquidated
+}
+
+// getPositionValues returns the USD values of a borrow and deposit for the input efficiency mode category
+func (k Keeper) getPositionValues(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow,
+	eModeCategory types.EModeCategory, inEMode bool,
+) (positionValues, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return positionValues{}, err
+	}
+
+	// Isolated collateral can only back borrows of assets that are borrowable in isolation
+	borrowableInIsolation := k.isBorrowableInIsolation(ctx, borrow.Amount)
+
+	// Deposits in the efficiency mode category use the category's loan-to-value and liquidation threshold
+	applyEMode := eModeApplies(eModeCategory, inEMode, borrow.Amount)
+
+	values := positionValues{
+		borrowed:         sdk.ZeroDec(),
+		borrowLimit:      sdk.ZeroDec(),
+		liquidationLimit: sdk.ZeroDec(),
+	}
 	for _, depCoin := range deposit.Amount {
+		if !borrowableInIsolation {
+			if mm, _ := k.GetMoneyMarket(ctx, depCoin.Denom); mm.Isolated {
+				continue
+			}
+		}
 		lData := liqMap[depCoin.Denom]
+		ltv, liquidationThreshold := lData.ltv, lData.liquidationThreshold
+		if applyEMode && eModeCategory.Contains(depCoin.Denom) {
+			ltv, liquidationThreshold = eModeCategory.LoanToValue, eModeCategory.LiquidationThreshold
+		}
 		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
-		borrowableUSDAmountForDeposit := usdValue.Mul(lData.ltv)
-		totalBorrowableUSDAmount = totalBorrowableUSDAmount.Add(borrowableUSDAmountForDeposit)
+		values.borrowLimit = values.borrowLimit.Add(usdValue.Mul(ltv))
+		values.liquidationLimit = values.liquidationLimit.Add(usdValue.Mul(liquidationThreshold))
 	}
 
-	totalBorrowedUSDAmount := sdk.ZeroDec()
 	for _, coin := range borrow.Amount {
 		lData := liqMap[coin.Denom]
 		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
-		totalBorrowedUSDAmount = totalBorrowedUSDAmount.Add(usdValue)
+		values.borrowed = values.borrowed.Add(usdValue)
 	}
 
-	// Check if the user's has borrowed more than they're allowed to
-	if totalBorrowedUSDAmount.GT(totalBorrowableUSDAmount) {
-		return false, nil
-	}
-
-	return true, nil
+	return values, nil
 }
 
 // GetStoreLTV calculates the user's current LTV based on their deposits/borrows in the store
@@ -396,7 +530,9 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		liqMap[denom] = LiqData{
+			priceData.Price, mm.BorrowLimit.LoanToValue, mm.GetLiquidationThreshold(), mm.GetLiquidationBonus(), mm.ConversionFactor,
+		}
 	}
 
 	return liqMap, nil
@@ -71,7 +71,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				depositCoins:               sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))),
 				borrowCoins:                sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(8*KAVA_CF))),
 				liquidateAfter:             oneMonthDur,
-				expectedTotalSuppliedCoins: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100004118)),
+				expectedTotalSuppliedCoins: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100004117)),
 				expectedTotalBorrowedCoins: nil,
 				expectedKeeperCoins:        sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100500020))),
 				expectedBorrowerCoins:      sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(98000001))), // initial - deposit + borrow + liquidation leftovers
@@ -173,7 +173,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				borrowCoins:          sdk.NewCoins(sdk.NewCoin("usdc", sdk.NewInt(20*KAVA_CF)), sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)), sdk.NewCoin("bnb", sdk.NewInt(2*BNB_CF)), sdk.NewCoin("btc", sdk.NewInt(0.2*BTCB_CF))), // $20+$20+$20 = $80 borrowed
 				liquidateAfter:       oneMonthDur,
 				expectedTotalSuppliedCoins: sdk.NewCoins(
-					sdk.NewInt64Coin("ukava", 1000000710),
+					sdk.NewInt64Coin("ukava", 1000000708),
 					sdk.NewInt64Coin("usdc", 1000003120),
 					sdk.NewInt64Coin("bnb", 100000003123),
 					sdk.NewInt64Coin("btc", 100000000031),
@@ -262,7 +262,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				borrowCoins:          sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(120*KAVA_CF))),                                                                                      // $240 borrowed
 				liquidateAfter:       oneMonthDur,
 				expectedTotalSuppliedCoins: sdk.NewCoins(
-					sdk.NewInt64Coin("ukava", 1000101456),
+					sdk.NewInt64Coin("ukava", 1000101455),
 				),
 				expectedTotalBorrowedCoins: nil,
 				expectedKeeperCoins:        sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(102500253)), sdk.NewCoin("bnb", sdk.NewInt(0.5*BNB_CF)), sdk.NewCoin("btc", sdk.NewInt(0.05*BTCB_CF))), // 5% of each seized coin + initial balances
@@ -337,7 +337,6 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 					sdk.NewInt64Coin("bnb", 100000078047),
 					sdk.NewInt64Coin("btc", 100000000780),
 					sdk.NewInt64Coin("ukava", 1000009550),
-					sdk.NewInt64Coin("usdx", 1),
 				),
 				expectedTotalBorrowedCoins: nil,
 				expectedKeeperCoins:        sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)), sdk.NewCoin("usdc", sdk.NewInt(5*KAVA_CF)), sdk.NewCoin("usdt", sdk.NewInt(5*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(5*KAVA_CF))), // 5% of each seized coin + initial balances
@@ -439,7 +438,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				liquidateAfter:       oneMonthDur,
 				expectedTotalSuppliedCoins: sdk.NewCoins(
 					sdk.NewInt64Coin("dai", 1000000000),
-					sdk.NewInt64Coin("usdc", 1000000001),
+					sdk.NewInt64Coin("usdc", 1000000000),
 					sdk.NewInt64Coin("usdt", 1000482503),
 					sdk.NewInt64Coin("usdx", 1000463500),
 				),
@@ -602,8 +601,10 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						tc.args.keeperRewardPercent), // Keeper Reward Percent
 				},
 				sdk.NewDec(10),
+				types.DefaultFlashLoanFee,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEModes,
 			)
 
 			// Pricefeed module genesis state
@@ -762,3 +763,218 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeperLiquidationDutchAuction() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100*BNB_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*KAVA_CF))),
+		},
+		[]sdk.AccAddress{borrower, depositor},
+	)
+
+	bnbMarket := types.NewMoneyMarket("bnb",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")),
+		"bnb:usd", sdk.NewInt(BNB_CF), model, reserveFactor, sdk.MustNewDecFromStr("0.05"))
+	bnbMarket.AuctionType = types.AuctionTypeDutch
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.9")),
+				"usdx:usd", sdk.NewInt(KAVA_CF), model, reserveFactor, sdk.MustNewDecFromStr("0.05")),
+			bnbMarket,
+		},
+		sdk.NewDec(10),
+		types.DefaultFlashLoanFee,
+		types.DefaultEModeCategories,
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEModes,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+			{
+				MarketID:      "bnb:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("10.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(80*KAVA_CF)))))
+
+	// Accrue interest so the borrow is above the borrow limit
+	liqCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 30 * 24 * 3600))
+	hard.BeginBlocker(liqCtx, suite.keeper)
+
+	err := suite.keeper.AttemptKeeperLiquidation(liqCtx, keeper, borrower)
+	suite.Require().NoError(err)
+
+	auctions := suite.auctionKeeper.GetAllAuctions(liqCtx)
+	suite.Require().Len(auctions, 1)
+	auction, ok := auctions[0].(*auctiontypes.DutchAuction)
+	suite.Require().True(ok)
+	suite.Require().Equal("bnb", auction.Lot.Denom)
+	suite.Require().Equal("usdx", auction.MaxBid.Denom)
+	// reference price is 10 usd per bnb, converted from 8 to 6 decimal places
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.1").Mul(auctiontypes.DefaultDutchStartPriceMultiplier), auction.StartPrice)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.1").Mul(auctiontypes.DefaultDutchEndPriceMultiplier), auction.EndPrice)
+	suite.Require().Equal(liqCtx.BlockTime(), auction.StartTime)
+}
+
+func (suite *KeeperTestSuite) TestKeeperLiquidationThreshold() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100*BNB_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*KAVA_CF))),
+		},
+		[]sdk.AccAddress{borrower, depositor},
+	)
+
+	// bnb can be borrowed against up to 80% of its value, but is only liquidated above 85%
+	bnbMarket := types.NewMoneyMarket("bnb",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")),
+		"bnb:usd", sdk.NewInt(BNB_CF), model, reserveFactor, sdk.MustNewDecFromStr("0.05"))
+	bnbMarket.LiquidationThreshold = sdk.MustNewDecFromStr("0.85")
+	bnbMarket.LiquidationBonus = sdk.MustNewDecFromStr("0.05")
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.9")),
+				"usdx:usd", sdk.NewInt(KAVA_CF), model, reserveFactor, sdk.MustNewDecFromStr("0.05")),
+			bnbMarket,
+		},
+		sdk.NewDec(10),
+		types.DefaultFlashLoanFee,
+		types.DefaultEModeCategories,
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEModes,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+			{
+				MarketID:      "bnb:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("10.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(80*KAVA_CF)))))
+
+	// Accrue interest so the borrow is above the borrow limit, but below the liquidation threshold
+	liqCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 30 * 24 * 3600))
+	hard.BeginBlocker(liqCtx, suite.keeper)
+
+	deposit, _ := suite.keeper.GetSyncedDeposit(liqCtx, borrower)
+	borrow, _ := suite.keeper.GetSyncedBorrow(liqCtx, borrower)
+	withinLtv, err := suite.keeper.IsWithinValidLtvRange(liqCtx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.False(withinLtv)
+	healthFactor, err := suite.keeper.GetHealthFactor(liqCtx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.True(healthFactor.GT(sdk.OneDec()))
+
+	err = suite.keeper.AttemptKeeperLiquidation(liqCtx, keeper, borrower)
+	suite.ErrorIs(err, types.ErrBorrowNotLiquidatable)
+
+	// Accrue interest so the borrow is above the liquidation threshold
+	liqCtx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 365 * 24 * 3600))
+	hard.BeginBlocker(liqCtx, suite.keeper)
+
+	deposit, _ = suite.keeper.GetSyncedDeposit(liqCtx, borrower)
+	borrow, _ = suite.keeper.GetSyncedBorrow(liqCtx, borrower)
+	healthFactor, err = suite.keeper.GetHealthFactor(liqCtx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.True(healthFactor.LT(sdk.OneDec()))
+
+	suite.Require().NoError(suite.keeper.AttemptKeeperLiquidation(liqCtx, keeper, borrower))
+
+	// Only the borrowed value plus the liquidation bonus is auctioned, the rest of the deposit is returned
+	auctions := suite.auctionKeeper.GetAllAuctions(liqCtx)
+	suite.Require().Len(auctions, 1)
+	lot := auctions[0].GetLot()
+	borrowUSDValue := sdk.NewDecFromInt(borrow.Amount.AmountOf("usdx")).QuoInt64(KAVA_CF)
+	expectedLot := borrowUSDValue.Mul(sdk.MustNewDecFromStr("1.05")).QuoInt64(10).MulInt64(BNB_CF)
+	suite.InDelta(expectedLot.MustFloat64(), float64(lot.Amount.Int64()), 1)
+
+	keeperReward := sdk.NewInt(BNB_CF / 2)
+	returned := deposit.Amount.AmountOf("bnb").Sub(keeperReward).Sub(lot.Amount)
+	suite.True(returned.IsPositive())
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(sdk.NewInt(90*BNB_CF).Add(returned), bk.GetBalance(liqCtx, borrower, "bnb").Amount)
+
+	suppliedCoins, _ := suite.keeper.GetSuppliedCoins(liqCtx)
+	suite.True(suppliedCoins.AmountOf("bnb").IsZero())
+}
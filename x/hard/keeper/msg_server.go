@@ -144,3 +144,54 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) FlashLoan(goCtx context.Context, msg *types.MsgFlashLoan) (*types.MsgFlashLoanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.keeper.FlashLoan(ctx, borrower, msg.Amount, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Borrower),
+		),
+	)
+	return &types.MsgFlashLoanResponse{Results: results}, nil
+}
+
+func (k msgServer) SetEMode(goCtx context.Context, msg *types.MsgSetEMode) (*types.MsgSetEModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.SetEMode(ctx, sender, msg.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgSetEModeResponse{}, nil
+}
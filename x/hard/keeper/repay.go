@@ -66,6 +66,11 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 		return err
 	}
 
+	// Update the amount borrowed against the owner's isolated collateral
+	if isolatedDenom, isolated := k.getIsolatedCollateralDenom(ctx, owner); isolated {
+		k.DecrementIsolatedDebt(ctx, isolatedDenom, payment)
+	}
+
 	// Call incentive hook
 	k.AfterBorrowModified(ctx, borrow)
 
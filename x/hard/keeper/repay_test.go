@@ -241,8 +241,10 @@ func (suite *KeeperTestSuite) TestRepay() {
 						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
 				},
 				sdk.NewDec(10),
+				types.DefaultFlashLoanFee,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEModes,
 			)
 
 			// Pricefeed module genesis state
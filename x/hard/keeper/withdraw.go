@@ -52,6 +52,13 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 		return err
 	}
 
+	// Borrows no longer count toward the isolation debt ceiling once the isolated collateral is fully withdrawn
+	if isolatedDenom, isolated := k.getIsolatedCollateralDenom(ctx, depositor); isolated {
+		if proposedDeposit.Amount.AmountOf(isolatedDenom).IsZero() {
+			k.DecrementIsolatedDebt(ctx, isolatedDenom, borrow.Amount)
+		}
+	}
+
 	// If any coin denoms have been completely withdrawn reset the denom's supply index factor
 	for _, coin := range deposit.Amount {
 		if !sdk.NewCoins(coin).DenomsSubsetOf(proposedDeposit.Amount) {
@@ -30,6 +30,9 @@ func migrateParams(params v015hard.Params) v016hard.Params {
 			},
 			ReserveFactor:          mm.ReserveFactor,
 			KeeperRewardPercentage: mm.KeeperRewardPercentage,
+			IsolationDebtCeiling:   sdk.ZeroDec(),
+			LiquidationThreshold:   sdk.ZeroDec(),
+			LiquidationBonus:       sdk.ZeroDec(),
 		}
 		moneyMarkets = append(moneyMarkets, moneyMarket)
 	}
@@ -51,12 +54,17 @@ func migrateParams(params v015hard.Params) v016hard.Params {
 		},
 		ReserveFactor:          sdk.MustNewDecFromStr("0.025"),
 		KeeperRewardPercentage: sdk.MustNewDecFromStr("0.02"),
+		IsolationDebtCeiling:   sdk.ZeroDec(),
+		LiquidationThreshold:   sdk.ZeroDec(),
+		LiquidationBonus:       sdk.ZeroDec(),
 	}
 	moneyMarkets = append(moneyMarkets, atomMoneyMarket)
 
 	return v016hard.Params{
 		MoneyMarkets:          moneyMarkets,
 		MinimumBorrowUSDValue: params.MinimumBorrowUSDValue,
+		FlashLoanFee:          v016hard.DefaultFlashLoanFee,
+		EModeCategories:       v016hard.DefaultEModeCategories,
 	}
 }
 
@@ -123,5 +131,6 @@ func Migrate(oldState v015hard.GenesisState) *v016hard.GenesisState {
 		TotalSupplied:             oldState.TotalSupplied,
 		TotalBorrowed:             oldState.TotalBorrowed,
 		TotalReserves:             oldState.TotalReserves,
+		AccountEModes:             v016hard.DefaultAccountEModes,
 	}
 }
@@ -131,6 +131,9 @@ func (s *migrateTestSuite) TestMigrate_GenState() {
 					},
 					ReserveFactor:          sdk.MustNewDecFromStr("0.5"),
 					KeeperRewardPercentage: sdk.MustNewDecFromStr("0.6"),
+					IsolationDebtCeiling:   sdk.ZeroDec(),
+					LiquidationThreshold:   sdk.ZeroDec(),
+					LiquidationBonus:       sdk.ZeroDec(),
 				},
 				{
 					Denom: UATOM_IBC_DENOM,
@@ -149,8 +152,13 @@ func (s *migrateTestSuite) TestMigrate_GenState() {
 					},
 					ReserveFactor:          sdk.MustNewDecFromStr("0.025"),
 					KeeperRewardPercentage: sdk.MustNewDecFromStr("0.02"),
+					IsolationDebtCeiling:   sdk.ZeroDec(),
+					LiquidationThreshold:   sdk.ZeroDec(),
+					LiquidationBonus:       sdk.ZeroDec(),
 				},
 			},
+			FlashLoanFee:    v016hard.DefaultFlashLoanFee,
+			EModeCategories: v016hard.DefaultEModeCategories,
 		},
 		PreviousAccumulationTimes: v016hard.GenesisAccumulationTimes{
 			{
@@ -187,6 +195,7 @@ func (s *migrateTestSuite) TestMigrate_GenState() {
 		TotalSupplied: sdk.NewCoins(sdk.NewCoin("kava", sdk.NewInt(100))),
 		TotalBorrowed: sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(200))),
 		TotalReserves: sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(300))),
+		AccountEModes: v016hard.DefaultAccountEModes,
 	}
 	genState := Migrate(v15genstate)
 	s.Require().Equal(expected, *genState)
@@ -15,6 +15,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBorrow{}, "hard/MsgBorrow", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "hard/MsgLiquidate", nil)
 	cdc.RegisterConcrete(&MsgRepay{}, "hard/MsgRepay", nil)
+	cdc.RegisterConcrete(&MsgFlashLoan{}, "hard/MsgFlashLoan", nil)
+	cdc.RegisterConcrete(&MsgSetEMode{}, "hard/MsgSetEMode", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +26,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBorrow{},
 		&MsgLiquidate{},
 		&MsgRepay{},
+		&MsgFlashLoan{},
+		&MsgSetEMode{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAccountEMode returns a new AccountEMode instance
+func NewAccountEMode(account sdk.AccAddress, category string) AccountEMode {
+	return AccountEMode{
+		Account:  account,
+		Category: category,
+	}
+}
+
+// Validate account e-mode validation
+func (e AccountEMode) Validate() error {
+	if e.Account.Empty() {
+		return fmt.Errorf("account cannot be empty")
+	}
+	if strings.TrimSpace(e.Category) == "" {
+		return fmt.Errorf("e-mode category cannot be blank")
+	}
+	return nil
+}
+
+// AccountEModes is a slice of AccountEMode
+type AccountEModes []AccountEMode
+
+// Validate validates AccountEModes
+func (es AccountEModes) Validate() error {
+	seenAccounts := make(map[string]bool)
+	for _, e := range es {
+		if seenAccounts[e.Account.String()] {
+			return fmt.Errorf("duplicate e-mode for account %s", e.Account)
+		}
+		seenAccounts[e.Account.String()] = true
+
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -69,4 +69,20 @@ var (
 	ErrExceedsProtocolBorrowableBalance = sdkerrors.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = sdkerrors.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrFlashLoanNotRepaid error for when a flash loan and its fee are not repaid after its messages are executed
+	ErrFlashLoanNotRepaid = sdkerrors.Register(ModuleName, 33, "flash loan not repaid")
+	// ErrMultipleIsolatedCollateral error for when a borrower holds deposits of more than one isolated denom
+	ErrMultipleIsolatedCollateral = sdkerrors.Register(ModuleName, 34, "cannot borrow against more than one isolated collateral")
+	// ErrNotBorrowableInIsolation error for when an asset that isn't borrowable in isolation is borrowed against isolated collateral
+	ErrNotBorrowableInIsolation = sdkerrors.Register(ModuleName, 35, "asset not borrowable against isolated collateral")
+	// ErrExceedsIsolationDebtCeiling error for when a borrow exceeds the debt ceiling of an isolated collateral
+	ErrExceedsIsolationDebtCeiling = sdkerrors.Register(ModuleName, 36, "proposed borrow exceeds isolation debt ceiling")
+	// ErrSiloedBorrow error for when a siloed asset would be borrowed alongside other assets
+	ErrSiloedBorrow = sdkerrors.Register(ModuleName, 37, "siloed asset cannot be borrowed with other assets")
+	// ErrIsolatedDepositWithBorrow error for when isolated collateral is deposited by an account with an outstanding borrow
+	ErrIsolatedDepositWithBorrow = sdkerrors.Register(ModuleName, 38, "cannot deposit isolated collateral with an outstanding borrow")
+	// ErrEModeCategoryNotFound error for when an efficiency mode category is not found in the params
+	ErrEModeCategoryNotFound = sdkerrors.Register(ModuleName, 39, "e-mode category not found")
+	// ErrBorrowNotInEModeCategory error for when an account in efficiency mode borrows an asset outside of its category
+	ErrBorrowNotInEModeCategory = sdkerrors.Register(ModuleName, 40, "borrowed asset not in e-mode category")
 )
@@ -7,6 +7,8 @@ const (
 	EventTypeHardBorrow           = "hard_borrow"
 	EventTypeHardLiquidation      = "hard_liquidation"
 	EventTypeHardRepay            = "hard_repay"
+	EventTypeHardFlashLoan        = "hard_flash_loan"
+	EventTypeHardSetEMode         = "hard_set_e_mode"
 	AttributeValueCategory        = ModuleName
 	AttributeKeyDeposit           = "deposit"
 	AttributeKeyDepositDenom      = "deposit_denom"
@@ -22,4 +24,7 @@ const (
 	AttributeKeyKeeper            = "keeper"
 	AttributeKeyKeeperRewardCoins = "keeper_reward_coins"
 	AttributeKeyOwner             = "owner"
+	AttributeKeyFlashLoanCoins    = "flash_loan_coins"
+	AttributeKeyFlashLoanFee      = "flash_loan_fee"
+	AttributeKeyEModeCategory     = "e_mode_category"
 )
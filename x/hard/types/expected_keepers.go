@@ -1,6 +1,7 @@
 package types // noalias
 
 import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -46,6 +47,7 @@ type PricefeedKeeper interface {
 // AuctionKeeper expected interface for the auction keeper (noalias)
 type AuctionKeeper interface {
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, error)
+	StartDutchAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, referencePrice sdk.Dec, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, error)
 }
 
 // HARDHooks event hooks for other keepers to run code in response to HARD modifications
@@ -57,3 +59,8 @@ type HARDHooks interface {
 	BeforeBorrowModified(ctx sdk.Context, borrow Borrow)
 	AfterBorrowModified(ctx sdk.Context, borrow Borrow)
 }
+
+// MessageRouter defines the expected message router used to execute the messages of a flash loan
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
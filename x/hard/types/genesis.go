@@ -10,7 +10,7 @@ import (
 // NewGenesisState returns a new genesis state
 func NewGenesisState(
 	params Params, prevAccumulationTimes GenesisAccumulationTimes, deposits Deposits,
-	borrows Borrows, totalSupplied, totalBorrowed, totalReserves sdk.Coins,
+	borrows Borrows, totalSupplied, totalBorrowed, totalReserves sdk.Coins, accountEModes AccountEModes,
 ) GenesisState {
 	return GenesisState{
 		Params:                    params,
@@ -20,6 +20,7 @@ func NewGenesisState(
 		TotalSupplied:             totalSupplied,
 		TotalBorrowed:             totalBorrowed,
 		TotalReserves:             totalReserves,
+		AccountEModes:             accountEModes,
 	}
 }
 
@@ -33,6 +34,7 @@ func DefaultGenesisState() GenesisState {
 		TotalSupplied:             DefaultTotalSupplied,
 		TotalBorrowed:             DefaultTotalBorrowed,
 		TotalReserves:             DefaultTotalReserves,
+		AccountEModes:             DefaultAccountEModes,
 	}
 }
 
@@ -61,6 +63,9 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
+	if err := gs.AccountEModes.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -39,6 +39,7 @@ type GenesisState struct {
 	TotalSupplied             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_supplied,json=totalSupplied,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_supplied"`
 	TotalBorrowed             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_borrowed,json=totalBorrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_borrowed"`
 	TotalReserves             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_reserves,json=totalReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reserves"`
+	AccountEModes             AccountEModes                            `protobuf:"bytes,8,rep,name=account_e_modes,json=accountEModes,proto3,castrepeated=AccountEModes" json:"account_e_modes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +124,13 @@ func (m *GenesisState) GetTotalReserves() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetAccountEModes() AccountEModes {
+	if m != nil {
+		return m.AccountEModes
+	}
+	return nil
+}
+
 // GenesisAccumulationTime stores the previous distribution time and its corresponding denom.
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -186,44 +194,47 @@ func init() {
 func init() { proto.RegisterFile("kava/hard/v1beta1/genesis.proto", fileDescriptor_20a1f6c2cf728e74) }
 
 var fileDescriptor_20a1f6c2cf728e74 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa6, 0x6d, 0xc2, 0x16, 0x5a, 0xb0, 0x2a, 0x70, 0x02, 0xb2, 0xa3, 0x1e, 0x20,
-	0x42, 0x8a, 0x4d, 0xcb, 0x81, 0x0b, 0x07, 0x30, 0x11, 0x1f, 0x37, 0xe4, 0xe6, 0xc4, 0xc5, 0x5a,
-	0x3b, 0x5b, 0xd7, 0xaa, 0x9d, 0xb5, 0x76, 0xd6, 0x81, 0xbc, 0x03, 0x42, 0x7d, 0x0e, 0xce, 0x3c,
-	0x01, 0xa7, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x8b, 0x92, 0x17, 0x41, 0xfb, 0x91, 0xa4, 0x28, 0x89,
-	0xc4, 0x81, 0x9e, 0xe2, 0xdd, 0xfd, 0xcf, 0xff, 0x37, 0x3b, 0x3b, 0x13, 0xe4, 0x9c, 0xe0, 0x21,
-	0xf6, 0x8e, 0x31, 0xeb, 0x7b, 0xc3, 0xfd, 0x88, 0x70, 0xbc, 0xef, 0x25, 0x64, 0x40, 0x20, 0x05,
-	0xb7, 0x60, 0x94, 0x53, 0xf3, 0x8e, 0x10, 0xb8, 0x42, 0xe0, 0x6a, 0x41, 0xd3, 0x8e, 0x29, 0xe4,
-	0x14, 0xbc, 0x08, 0x03, 0x99, 0x45, 0xc5, 0x34, 0x1d, 0xa8, 0x90, 0x66, 0x43, 0x9d, 0x87, 0x72,
-	0xe5, 0xa9, 0x85, 0x3e, 0xda, 0x4d, 0x68, 0x42, 0xd5, 0xbe, 0xf8, 0xd2, 0xbb, 0x4e, 0x42, 0x69,
-	0x92, 0x11, 0x4f, 0xae, 0xa2, 0xf2, 0xc8, 0xe3, 0x69, 0x4e, 0x80, 0xe3, 0xbc, 0xd0, 0x82, 0x07,
-	0x8b, 0x59, 0xca, 0x8c, 0xe4, 0xe9, 0xde, 0xf7, 0x0d, 0x74, 0xf3, 0x8d, 0x4a, 0xfa, 0x90, 0x63,
-	0x4e, 0xcc, 0x67, 0x68, 0xb3, 0xc0, 0x0c, 0xe7, 0x60, 0x19, 0x2d, 0xa3, 0xbd, 0x75, 0xd0, 0x70,
-	0x17, 0x2e, 0xe1, 0xbe, 0x97, 0x02, 0x7f, 0xfd, 0xec, 0xc2, 0xa9, 0x04, 0x5a, 0x6e, 0x7e, 0x36,
-	0xd0, 0xfd, 0x82, 0x91, 0x61, 0x4a, 0x4b, 0x08, 0x71, 0x1c, 0x97, 0x79, 0x99, 0x61, 0x9e, 0xd2,
-	0x41, 0x28, 0x33, 0xb2, 0xd6, 0x5a, 0xd5, 0xf6, 0xd6, 0xc1, 0xe3, 0x25, 0x76, 0x9a, 0xff, 0xf2,
-	0x4a, 0x4c, 0x2f, 0xcd, 0x89, 0xdf, 0x12, 0xfe, 0x5f, 0x2f, 0x1d, 0x6b, 0x85, 0x00, 0x82, 0xc6,
-	0x14, 0xb8, 0x70, 0x64, 0xbe, 0x45, 0xf5, 0x3e, 0x29, 0x28, 0xa4, 0x1c, 0xac, 0xaa, 0x44, 0x37,
-	0x97, 0xa0, 0xbb, 0x4a, 0xe2, 0xdf, 0xd6, 0xa8, 0xba, 0xde, 0x80, 0x60, 0x16, 0x6d, 0x76, 0x51,
-	0x2d, 0xa2, 0x8c, 0xd1, 0x8f, 0x60, 0xad, 0x4b, 0xa3, 0x65, 0x25, 0xf1, 0xa5, 0xc2, 0xdf, 0xd1,
-	0x3e, 0x35, 0xb5, 0x86, 0x60, 0x1a, 0x6a, 0x32, 0xb4, 0xcd, 0x29, 0xc7, 0x59, 0x08, 0x65, 0x51,
-	0x64, 0x29, 0xe9, 0x5b, 0x1b, 0xda, 0x4c, 0x3f, 0xb2, 0xe8, 0x88, 0x99, 0xdd, 0x2b, 0x9a, 0x0e,
-	0xfc, 0x27, 0xda, 0xac, 0x9d, 0xa4, 0xfc, 0xb8, 0x8c, 0xdc, 0x98, 0xe6, 0xba, 0x23, 0xf4, 0x4f,
-	0x07, 0xfa, 0x27, 0x1e, 0x1f, 0x15, 0x04, 0x64, 0x00, 0x04, 0xb7, 0x24, 0xe2, 0x50, 0x13, 0xe6,
-	0x4c, 0x95, 0x04, 0xe9, 0x5b, 0x9b, 0xd7, 0xc5, 0xf4, 0x35, 0x61, 0xce, 0x64, 0x04, 0x08, 0x1b,
-	0x12, 0xb0, 0x6a, 0xd7, 0xc5, 0x0c, 0x34, 0x61, 0xef, 0x4b, 0x15, 0xdd, 0x5b, 0xd1, 0x23, 0xe6,
-	0x23, 0xb4, 0x13, 0xd3, 0x2c, 0xc3, 0x9c, 0x30, 0x9c, 0x85, 0xc2, 0x44, 0x36, 0xf6, 0x8d, 0x60,
-	0x7b, 0xbe, 0xdd, 0x1b, 0x15, 0xc4, 0x8c, 0x50, 0x73, 0x75, 0xfb, 0x5a, 0x6b, 0x72, 0x18, 0x9a,
-	0xae, 0x9a, 0x36, 0x77, 0x3a, 0x6d, 0x6e, 0x6f, 0x3a, 0x6d, 0x7e, 0x5d, 0xdc, 0xe2, 0xf4, 0xd2,
-	0x31, 0x02, 0x6b, 0x55, 0x57, 0x9a, 0x0c, 0xdd, 0x95, 0xcf, 0x3f, 0x0a, 0xd3, 0x01, 0x27, 0x8c,
-	0x00, 0x0f, 0x8f, 0x70, 0xcc, 0x29, 0xb3, 0xaa, 0x22, 0x27, 0xff, 0xb9, 0xf0, 0xf8, 0x75, 0xe1,
-	0x3c, 0xfc, 0x87, 0x4a, 0x74, 0x49, 0xfc, 0xe3, 0x5b, 0x07, 0xe9, 0xaa, 0x76, 0x49, 0x1c, 0xec,
-	0x2a, 0xef, 0x77, 0xda, 0xfa, 0xb5, 0x74, 0x16, 0x4c, 0xf5, 0xfc, 0x0b, 0xcc, 0xf5, 0xff, 0xc1,
-	0x54, 0xde, 0x7f, 0x33, 0xfd, 0x17, 0x67, 0x63, 0xdb, 0x38, 0x1f, 0xdb, 0xc6, 0xef, 0xb1, 0x6d,
-	0x9c, 0x4e, 0xec, 0xca, 0xf9, 0xc4, 0xae, 0xfc, 0x9c, 0xd8, 0x95, 0x0f, 0x57, 0x29, 0x62, 0x8a,
-	0x3a, 0x19, 0x8e, 0x40, 0x7e, 0x79, 0x9f, 0xd4, 0x9f, 0x94, 0x24, 0x45, 0x9b, 0xb2, 0xc2, 0x4f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x16, 0xde, 0x3f, 0x0d, 0x64, 0x05, 0x00, 0x00,
+	// 628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xa6, 0xbf, 0x34, 0xbf, 0x2d, 0x6d, 0xc1, 0x2a, 0xe0, 0x06, 0x64, 0x47, 0x3d,
+	0x40, 0x85, 0x54, 0x9b, 0x96, 0x03, 0x17, 0x0e, 0xd4, 0x84, 0x7f, 0x07, 0x24, 0xe4, 0xf6, 0xc4,
+	0x01, 0x6b, 0x6d, 0x4f, 0x53, 0xab, 0xb6, 0xd7, 0xda, 0x59, 0x07, 0xfa, 0x0e, 0x08, 0xf5, 0x19,
+	0x38, 0x72, 0xe6, 0x21, 0x7a, 0xac, 0x38, 0x21, 0x0e, 0x2d, 0x6a, 0x5f, 0x04, 0x79, 0x77, 0xd3,
+	0x06, 0x25, 0x91, 0x38, 0xd0, 0x53, 0x3d, 0xb3, 0xdf, 0xf9, 0x7e, 0xa6, 0xbb, 0x33, 0x21, 0xce,
+	0x3e, 0x1d, 0x50, 0x6f, 0x8f, 0xf2, 0xc4, 0x1b, 0x6c, 0x44, 0x20, 0xe8, 0x86, 0xd7, 0x87, 0x02,
+	0x30, 0x45, 0xb7, 0xe4, 0x4c, 0x30, 0xf3, 0x46, 0x2d, 0x70, 0x6b, 0x81, 0xab, 0x05, 0x1d, 0x3b,
+	0x66, 0x98, 0x33, 0xf4, 0x22, 0x8a, 0x70, 0x51, 0x15, 0xb3, 0xb4, 0x50, 0x25, 0x9d, 0x15, 0x75,
+	0x1e, 0xca, 0xc8, 0x53, 0x81, 0x3e, 0x5a, 0xee, 0xb3, 0x3e, 0x53, 0xf9, 0xfa, 0x4b, 0x67, 0x9d,
+	0x3e, 0x63, 0xfd, 0x0c, 0x3c, 0x19, 0x45, 0xd5, 0xae, 0x27, 0xd2, 0x1c, 0x50, 0xd0, 0xbc, 0xd4,
+	0x82, 0xbb, 0xe3, 0x5d, 0xca, 0x8e, 0xe4, 0xe9, 0xea, 0x97, 0x16, 0xb9, 0xf6, 0x52, 0x35, 0xbd,
+	0x2d, 0xa8, 0x00, 0xf3, 0x31, 0x69, 0x95, 0x94, 0xd3, 0x1c, 0x2d, 0xa3, 0x6b, 0xac, 0xcd, 0x6f,
+	0xae, 0xb8, 0x63, 0xff, 0x84, 0xfb, 0x56, 0x0a, 0xfc, 0xd9, 0xa3, 0x13, 0xa7, 0x11, 0x68, 0xb9,
+	0xf9, 0xc9, 0x20, 0x77, 0x4a, 0x0e, 0x83, 0x94, 0x55, 0x18, 0xd2, 0x38, 0xae, 0xf2, 0x2a, 0xa3,
+	0x22, 0x65, 0x45, 0x28, 0x3b, 0xb2, 0x66, 0xba, 0xcd, 0xb5, 0xf9, 0xcd, 0x07, 0x13, 0xec, 0x34,
+	0x7f, 0x6b, 0xa4, 0x66, 0x27, 0xcd, 0xc1, 0xef, 0xd6, 0xfe, 0x5f, 0x4f, 0x1d, 0x6b, 0x8a, 0x00,
+	0x83, 0x95, 0x21, 0x70, 0xec, 0xc8, 0x7c, 0x45, 0xda, 0x09, 0x94, 0x0c, 0x53, 0x81, 0x56, 0x53,
+	0xa2, 0x3b, 0x13, 0xd0, 0x3d, 0x25, 0xf1, 0xaf, 0x6b, 0x54, 0x5b, 0x27, 0x30, 0xb8, 0xa8, 0x36,
+	0x7b, 0x64, 0x2e, 0x62, 0x9c, 0xb3, 0x0f, 0x68, 0xcd, 0x76, 0x9b, 0x53, 0xae, 0xc4, 0x97, 0x0a,
+	0x7f, 0x49, 0xfb, 0xcc, 0xa9, 0x18, 0x83, 0x61, 0xa9, 0xc9, 0xc9, 0xa2, 0x60, 0x82, 0x66, 0x21,
+	0x56, 0x65, 0x99, 0xa5, 0x90, 0x58, 0xff, 0x69, 0x33, 0xfd, 0xc8, 0xf5, 0x44, 0x5c, 0xd8, 0x3d,
+	0x63, 0x69, 0xe1, 0x3f, 0xd4, 0x66, 0x6b, 0xfd, 0x54, 0xec, 0x55, 0x91, 0x1b, 0xb3, 0x5c, 0x4f,
+	0x84, 0xfe, 0xb3, 0x8e, 0xc9, 0xbe, 0x27, 0x0e, 0x4a, 0x40, 0x59, 0x80, 0xc1, 0x82, 0x44, 0x6c,
+	0x6b, 0xc2, 0x25, 0x53, 0x35, 0x01, 0x89, 0xd5, 0xba, 0x2a, 0xa6, 0xaf, 0x09, 0x97, 0x4c, 0x0e,
+	0x08, 0x7c, 0x00, 0x68, 0xcd, 0x5d, 0x15, 0x33, 0xd0, 0x04, 0xf3, 0x3d, 0x59, 0xa2, 0x71, 0xcc,
+	0xaa, 0x42, 0x84, 0x10, 0xe6, 0x2c, 0x01, 0xb4, 0xda, 0x12, 0xea, 0x4c, 0x78, 0xa9, 0x2d, 0xa5,
+	0x7c, 0xfe, 0x86, 0x25, 0xe0, 0xdf, 0xd4, 0xe8, 0x85, 0xd1, 0x2c, 0x06, 0x0b, 0x74, 0x34, 0x5c,
+	0xfd, 0xdc, 0x24, 0xb7, 0xa7, 0xcc, 0xa0, 0x79, 0x9f, 0x2c, 0xc5, 0x2c, 0xcb, 0xa8, 0x00, 0x4e,
+	0xb3, 0xb0, 0x6e, 0x52, 0x2e, 0xce, 0xff, 0xc1, 0xe2, 0x65, 0x7a, 0xe7, 0xa0, 0x04, 0x33, 0x22,
+	0x9d, 0xe9, 0xeb, 0x61, 0xcd, 0xc8, 0x65, 0xeb, 0xb8, 0x6a, 0x9b, 0xdd, 0xe1, 0x36, 0xbb, 0x3b,
+	0xc3, 0x6d, 0xf6, 0xdb, 0x75, 0xab, 0x87, 0xa7, 0x8e, 0x11, 0x58, 0xd3, 0xa6, 0xde, 0xe4, 0xe4,
+	0x96, 0x1c, 0xaf, 0x83, 0x30, 0x2d, 0x04, 0x70, 0x40, 0x11, 0xee, 0xd2, 0x58, 0x30, 0x6e, 0x35,
+	0xeb, 0x9e, 0xfc, 0x27, 0xb5, 0xc7, 0xcf, 0x13, 0xe7, 0xde, 0x5f, 0xdc, 0x74, 0x0f, 0xe2, 0xef,
+	0xdf, 0xd6, 0x89, 0x7e, 0xb5, 0x1e, 0xc4, 0xc1, 0xb2, 0xf2, 0x7e, 0xad, 0xad, 0x5f, 0x48, 0xe7,
+	0x9a, 0xa9, 0xc6, 0x6b, 0x8c, 0x39, 0xfb, 0x2f, 0x98, 0xca, 0xfb, 0x4f, 0xa6, 0xff, 0xf4, 0xe8,
+	0xcc, 0x36, 0x8e, 0xcf, 0x6c, 0xe3, 0xd7, 0x99, 0x6d, 0x1c, 0x9e, 0xdb, 0x8d, 0xe3, 0x73, 0xbb,
+	0xf1, 0xe3, 0xdc, 0x6e, 0xbc, 0x1b, 0xa5, 0xd4, 0x6f, 0xbf, 0x9e, 0xd1, 0x08, 0xe5, 0x97, 0xf7,
+	0x51, 0xfd, 0x08, 0x4a, 0x52, 0xd4, 0x92, 0x37, 0xfc, 0xe8, 0xf7, 0x00, 0xd3, 0xe4, 0xba, 0x52,
+	0xc4, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +257,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountEModes) > 0 {
+		for iNdEx := len(m.AccountEModes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountEModes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.TotalReserves) > 0 {
 		for iNdEx := len(m.TotalReserves) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -456,6 +481,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccountEModes) > 0 {
+		for _, e := range m.AccountEModes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -750,6 +781,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountEModes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountEModes = append(m.AccountEModes, AccountEMode{})
+			if err := m.AccountEModes[len(m.AccountEModes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
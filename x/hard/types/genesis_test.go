@@ -62,6 +62,8 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 						types.NewMoneyMarket("usdx", types.NewBorrowLimit(true, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("1")), "usdx:usd", sdk.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 					},
 					sdk.MustNewDecFromStr("10"),
+					types.DefaultFlashLoanFee,
+					types.DefaultEModeCategories,
 				),
 				gats: types.GenesisAccumulationTimes{
 					types.NewGenesisAccumulationTime("usdx", time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC), sdk.OneDec(), sdk.OneDec()),
@@ -78,7 +80,7 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			gs := types.NewGenesisState(tc.args.params, tc.args.gats, tc.args.deps, tc.args.brws, tc.args.ts, tc.args.tb, tc.args.tr)
+			gs := types.NewGenesisState(tc.args.params, tc.args.gats, tc.args.deps, tc.args.brws, tc.args.ts, tc.args.tb, tc.args.tr, types.DefaultAccountEModes)
 			err := gs.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -30,6 +30,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	MoneyMarkets          MoneyMarkets                           `protobuf:"bytes,1,rep,name=money_markets,json=moneyMarkets,proto3,castrepeated=MoneyMarkets" json:"money_markets"`
 	MinimumBorrowUSDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=minimum_borrow_usd_value,json=minimumBorrowUsdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_borrow_usd_value"`
+	// flash_loan_fee is the fraction of a flash loan that must be repaid on top of the loan and is added to the reserves
+	FlashLoanFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=flash_loan_fee,json=flashLoanFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"flash_loan_fee"`
+	// e_mode_categories are the categories of correlated assets that accounts can opt into for efficiency mode
+	EModeCategories EModeCategories `protobuf:"bytes,4,rep,name=e_mode_categories,json=eModeCategories,proto3,castrepeated=EModeCategories" json:"e_mode_categories"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -74,6 +78,25 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel                      `protobuf:"bytes,5,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model"`
 	ReserveFactor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor"`
 	KeeperRewardPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
+	// auction_type selects the style of auction used to sell liquidated deposits of this denom, either "collateral" or "dutch".
+	// When unset, collateral auctions are used.
+	AuctionType string `protobuf:"bytes,8,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	// isolated restricts deposits of this denom to only back borrows of money markets that are borrowable in isolation,
+	// up to the isolation debt ceiling.
+	Isolated bool `protobuf:"varint,9,opt,name=isolated,proto3" json:"isolated,omitempty"`
+	// isolation_debt_ceiling is the maximum USD value that can be borrowed by accounts using this denom as isolated
+	// collateral.
+	IsolationDebtCeiling github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=isolation_debt_ceiling,json=isolationDebtCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"isolation_debt_ceiling"`
+	// borrowable_in_isolation allows this denom to be borrowed against isolated collateral.
+	BorrowableInIsolation bool `protobuf:"varint,11,opt,name=borrowable_in_isolation,json=borrowableInIsolation,proto3" json:"borrowable_in_isolation,omitempty"`
+	// siloed prevents this denom from being borrowed alongside any other denom.
+	Siloed bool `protobuf:"varint,12,opt,name=siloed,proto3" json:"siloed,omitempty"`
+	// liquidation_threshold is the percentage amount of each unit of deposit of this denom that can be borrowed before
+	// the position can be liquidated. When unset, the loan-to-value of the borrow limit is used.
+	LiquidationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=liquidation_threshold,json=liquidationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_threshold"`
+	// liquidation_bonus is the maximum premium over the value of the liquidated borrow at which deposits of this denom
+	// are sold at auction, with the rest returned to the borrower. When unset, the full deposit is auctioned.
+	LiquidationBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=liquidation_bonus,json=liquidationBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_bonus"`
 }
 
 func (m *MoneyMarket) Reset()         { *m = MoneyMarket{} }
@@ -109,6 +132,91 @@ func (m *MoneyMarket) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MoneyMarket proto.InternalMessageInfo
 
+// EModeCategory is a category of correlated assets that can be borrowed against each other with a higher
+// loan-to-value by accounts that opt into it.
+type EModeCategory struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// denoms are the money market denoms that belong to the category
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// loan_to_value is the percentage amount of borrow power each unit of deposit in the category accounts for
+	LoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=loan_to_value,json=loanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"loan_to_value"`
+	// liquidation_threshold is the percentage amount of each unit of deposit in the category that can be borrowed before
+	// the position can be liquidated
+	LiquidationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidation_threshold,json=liquidationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_threshold"`
+}
+
+func (m *EModeCategory) Reset()         { *m = EModeCategory{} }
+func (m *EModeCategory) String() string { return proto.CompactTextString(m) }
+func (*EModeCategory) ProtoMessage()    {}
+func (*EModeCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{2}
+}
+func (m *EModeCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EModeCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EModeCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EModeCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EModeCategory.Merge(m, src)
+}
+func (m *EModeCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EModeCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EModeCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EModeCategory proto.InternalMessageInfo
+
+// AccountEMode defines the efficiency mode category an account has opted into.
+type AccountEMode struct {
+	Account  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=account,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"account,omitempty"`
+	Category string                                        `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (m *AccountEMode) Reset()         { *m = AccountEMode{} }
+func (m *AccountEMode) String() string { return proto.CompactTextString(m) }
+func (*AccountEMode) ProtoMessage()    {}
+func (*AccountEMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{3}
+}
+func (m *AccountEMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountEMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountEMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountEMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountEMode.Merge(m, src)
+}
+func (m *AccountEMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountEMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountEMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountEMode proto.InternalMessageInfo
+
 // BorrowLimit enforces restrictions on a money market.
 type BorrowLimit struct {
 	HasMaxLimit  bool                                   `protobuf:"varint,1,opt,name=has_max_limit,json=hasMaxLimit,proto3" json:"has_max_limit"`
@@ -120,7 +228,7 @@ func (m *BorrowLimit) Reset()         { *m = BorrowLimit{} }
 func (m *BorrowLimit) String() string { return proto.CompactTextString(m) }
 func (*BorrowLimit) ProtoMessage()    {}
 func (*BorrowLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{2}
+	return fileDescriptor_23a5de800263a2ff, []int{4}
 }
 func (m *BorrowLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +269,7 @@ func (m *InterestRateModel) Reset()         { *m = InterestRateModel{} }
 func (m *InterestRateModel) String() string { return proto.CompactTextString(m) }
 func (*InterestRateModel) ProtoMessage()    {}
 func (*InterestRateModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{3}
+	return fileDescriptor_23a5de800263a2ff, []int{5}
 }
 func (m *InterestRateModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +309,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{4}
+	return fileDescriptor_23a5de800263a2ff, []int{6}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +349,7 @@ func (m *Borrow) Reset()         { *m = Borrow{} }
 func (m *Borrow) String() string { return proto.CompactTextString(m) }
 func (*Borrow) ProtoMessage()    {}
 func (*Borrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{5}
+	return fileDescriptor_23a5de800263a2ff, []int{7}
 }
 func (m *Borrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +388,7 @@ func (m *SupplyInterestFactor) Reset()         { *m = SupplyInterestFactor{} }
 func (m *SupplyInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactor) ProtoMessage()    {}
 func (*SupplyInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{6}
+	return fileDescriptor_23a5de800263a2ff, []int{8}
 }
 func (m *SupplyInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +427,7 @@ func (m *BorrowInterestFactor) Reset()         { *m = BorrowInterestFactor{} }
 func (m *BorrowInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactor) ProtoMessage()    {}
 func (*BorrowInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{7}
+	return fileDescriptor_23a5de800263a2ff, []int{9}
 }
 func (m *BorrowInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +465,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{8}
+	return fileDescriptor_23a5de800263a2ff, []int{10}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,9 +494,49 @@ func (m *CoinsProto) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CoinsProto proto.InternalMessageInfo
 
+// DecCoinsProto defines a Protobuf wrapper around a DecCoins slice
+type DecCoinsProto struct {
+	Coins github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"coins"`
+}
+
+func (m *DecCoinsProto) Reset()         { *m = DecCoinsProto{} }
+func (m *DecCoinsProto) String() string { return proto.CompactTextString(m) }
+func (*DecCoinsProto) ProtoMessage()    {}
+func (*DecCoinsProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{11}
+}
+func (m *DecCoinsProto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecCoinsProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecCoinsProto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecCoinsProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecCoinsProto.Merge(m, src)
+}
+func (m *DecCoinsProto) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecCoinsProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecCoinsProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecCoinsProto proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.hard.v1beta1.Params")
 	proto.RegisterType((*MoneyMarket)(nil), "kava.hard.v1beta1.MoneyMarket")
+	proto.RegisterType((*EModeCategory)(nil), "kava.hard.v1beta1.EModeCategory")
+	proto.RegisterType((*AccountEMode)(nil), "kava.hard.v1beta1.AccountEMode")
 	proto.RegisterType((*BorrowLimit)(nil), "kava.hard.v1beta1.BorrowLimit")
 	proto.RegisterType((*InterestRateModel)(nil), "kava.hard.v1beta1.InterestRateModel")
 	proto.RegisterType((*Deposit)(nil), "kava.hard.v1beta1.Deposit")
@@ -396,69 +544,89 @@ func init() {
 	proto.RegisterType((*SupplyInterestFactor)(nil), "kava.hard.v1beta1.SupplyInterestFactor")
 	proto.RegisterType((*BorrowInterestFactor)(nil), "kava.hard.v1beta1.BorrowInterestFactor")
 	proto.RegisterType((*CoinsProto)(nil), "kava.hard.v1beta1.CoinsProto")
+	proto.RegisterType((*DecCoinsProto)(nil), "kava.hard.v1beta1.DecCoinsProto")
 }
 
 func init() { proto.RegisterFile("kava/hard/v1beta1/hard.proto", fileDescriptor_23a5de800263a2ff) }
 
 var fileDescriptor_23a5de800263a2ff = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xf7, 0x9e, 0x3f, 0x92, 0x8c, 0xed, 0x23, 0xde, 0xdc, 0xa1, 0x4d, 0x04, 0xeb, 0xc8, 0x42,
-	0x70, 0x8d, 0x6d, 0x02, 0x82, 0x8a, 0xe6, 0x16, 0x0b, 0x38, 0x81, 0x25, 0x6b, 0x8f, 0x20, 0x25,
-	0x42, 0x5a, 0xc6, 0xbb, 0x2f, 0x77, 0x83, 0x3d, 0x3b, 0xab, 0x99, 0xb1, 0x63, 0x77, 0xb4, 0x34,
-	0x88, 0x3f, 0x82, 0x8a, 0x0e, 0xe9, 0xfe, 0x88, 0x2b, 0xa3, 0x54, 0x88, 0xc2, 0x80, 0xaf, 0xa3,
-	0xa6, 0xa2, 0x42, 0xf3, 0xe1, 0x8f, 0x5c, 0x1c, 0x29, 0xa7, 0x58, 0x88, 0x6a, 0x77, 0xe6, 0xbd,
-	0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x9b, 0x99, 0x87, 0xde, 0x18, 0xe0, 0x31, 0x6e, 0x9f, 0x62, 0x9e,
-	0xb4, 0xc7, 0xf7, 0xfa, 0x20, 0xf1, 0x3d, 0x3d, 0x68, 0x65, 0x9c, 0x49, 0xe6, 0xd6, 0x94, 0xb5,
-	0xa5, 0x27, 0xac, 0xf5, 0x8e, 0x1f, 0x33, 0x41, 0x99, 0x68, 0xf7, 0xb1, 0x80, 0xe5, 0x92, 0x98,
-	0x91, 0xd4, 0x2c, 0xb9, 0x73, 0xdb, 0xd8, 0x23, 0x3d, 0x6a, 0x9b, 0x81, 0x35, 0xed, 0x9d, 0xb0,
-	0x13, 0x66, 0xe6, 0xd5, 0x9f, 0x99, 0x6d, 0xfc, 0xed, 0xa0, 0x52, 0x0f, 0x73, 0x4c, 0x85, 0xfb,
-	0x00, 0x55, 0x29, 0x4b, 0x61, 0x1a, 0x51, 0xcc, 0x07, 0x20, 0x85, 0xe7, 0xdc, 0xcd, 0x1f, 0x94,
-	0xdf, 0xf3, 0x5b, 0xcf, 0xd1, 0x68, 0x75, 0x95, 0x5f, 0x57, 0xbb, 0x05, 0x7b, 0xe7, 0xb3, 0x7a,
-	0xee, 0xe7, 0xdf, 0xeb, 0x95, 0xb5, 0x49, 0x11, 0x56, 0xe8, 0xda, 0xc8, 0xfd, 0xc1, 0x41, 0x1e,
-	0x25, 0x29, 0xa1, 0x23, 0x1a, 0xf5, 0x19, 0xe7, 0xec, 0x71, 0x34, 0x12, 0x49, 0x34, 0xc6, 0xc3,
-	0x11, 0x78, 0x3b, 0x77, 0x9d, 0x83, 0x1b, 0xc1, 0x7d, 0x05, 0xf3, 0xdb, 0xac, 0xfe, 0xf6, 0x09,
-	0x91, 0xa7, 0xa3, 0x7e, 0x2b, 0x66, 0xd4, 0xf2, 0xb7, 0x9f, 0xa6, 0x48, 0x06, 0x6d, 0x39, 0xcd,
-	0x40, 0xb4, 0x3a, 0x10, 0xcf, 0x67, 0xf5, 0xfd, 0xae, 0x41, 0x0c, 0x34, 0xe0, 0xfd, 0xe3, 0xce,
-	0x57, 0x0a, 0xee, 0xe9, 0x59, 0x13, 0xd9, 0xbc, 0x3b, 0x10, 0x87, 0xfb, 0xf4, 0x19, 0x27, 0x91,
-	0x68, 0xa7, 0xc6, 0x79, 0x01, 0x95, 0xd7, 0xf8, 0xba, 0x7b, 0xa8, 0x98, 0x40, 0xca, 0xa8, 0xe7,
-	0x28, 0x32, 0xa1, 0x19, 0xb8, 0x9f, 0xa2, 0x8a, 0x65, 0x3b, 0x24, 0x94, 0x48, 0xcd, 0x74, 0xb3,
-	0x20, 0x06, 0xfe, 0x0b, 0xe5, 0x15, 0x14, 0x54, 0x26, 0x61, 0xb9, 0xbf, 0x9a, 0x72, 0x3f, 0x44,
-	0xbb, 0x22, 0x63, 0xd2, 0x2a, 0x1b, 0x91, 0xc4, 0xcb, 0xeb, 0xa4, 0x6f, 0xce, 0x67, 0xf5, 0xca,
-	0x71, 0xc6, 0xa4, 0xa1, 0x71, 0xd4, 0x09, 0x2b, 0x62, 0x35, 0x4a, 0x5c, 0x82, 0x6a, 0x31, 0x4b,
-	0xc7, 0xc0, 0x05, 0x61, 0x69, 0xf4, 0x08, 0xc7, 0x92, 0x71, 0xaf, 0xa0, 0x97, 0x7e, 0x74, 0x05,
-	0xbd, 0x8e, 0x52, 0xb9, 0x26, 0xcb, 0x51, 0x2a, 0xc3, 0x9b, 0x2b, 0xd8, 0x4f, 0x34, 0xaa, 0xfb,
-	0x10, 0xdd, 0x22, 0xa9, 0x04, 0x0e, 0x42, 0x46, 0x1c, 0x4b, 0x88, 0x28, 0x4b, 0x60, 0xe8, 0x15,
-	0x75, 0xca, 0x6f, 0x6d, 0x48, 0xf9, 0xc8, 0x7a, 0x87, 0x58, 0x42, 0x57, 0xf9, 0xda, 0xc4, 0x6b,
-	0xe4, 0xb2, 0xc1, 0x8d, 0xd1, 0x2e, 0x07, 0x01, 0x7c, 0x0c, 0x8b, 0x1c, 0x4a, 0x57, 0xce, 0xa1,
-	0x03, 0xf1, 0xa5, 0xad, 0xad, 0x5a, 0x4c, 0x9b, 0xc0, 0x18, 0x79, 0x03, 0x80, 0x0c, 0x78, 0xc4,
-	0xe1, 0x31, 0xe6, 0x49, 0x94, 0x01, 0x8f, 0x21, 0x95, 0xf8, 0x04, 0xbc, 0x6b, 0x5b, 0x08, 0xf7,
-	0xba, 0x41, 0x0f, 0x35, 0x78, 0x6f, 0x89, 0xdd, 0xf8, 0x7e, 0x07, 0x95, 0xd7, 0xb6, 0xdf, 0xfd,
-	0x00, 0x55, 0x4f, 0xb1, 0x88, 0x28, 0x9e, 0xd8, 0xaa, 0x51, 0x25, 0x75, 0x3d, 0xa8, 0xfd, 0x35,
-	0xab, 0x3f, 0x6b, 0x08, 0xcb, 0xa7, 0x58, 0x74, 0xf1, 0xc4, 0x2c, 0xc3, 0xa8, 0x4a, 0xf1, 0x44,
-	0x9f, 0x90, 0x55, 0xb1, 0xbd, 0x2a, 0xe7, 0x8a, 0x85, 0x34, 0x21, 0xbe, 0x41, 0xd5, 0x21, 0xc3,
-	0x69, 0x24, 0x99, 0x3d, 0x79, 0xf9, 0x2d, 0x84, 0x28, 0x2b, 0xc8, 0x2f, 0x99, 0x39, 0x56, 0x3f,
-	0xe5, 0x51, 0xed, 0xb9, 0xba, 0x70, 0x19, 0xaa, 0xaa, 0xfb, 0xca, 0x94, 0x15, 0xce, 0xa6, 0xe6,
-	0x90, 0x05, 0x9f, 0x5f, 0xf9, 0xc4, 0x97, 0x03, 0x2c, 0x40, 0xe1, 0x1e, 0xf6, 0x1e, 0x5c, 0xa6,
-	0xd1, 0x5f, 0x98, 0xb2, 0xa9, 0x0b, 0xe8, 0x35, 0x1d, 0x90, 0x8e, 0x86, 0x92, 0x64, 0x43, 0x02,
-	0x7c, 0x2b, 0x6a, 0xee, 0x2a, 0xd0, 0xee, 0x12, 0xd3, 0xed, 0xa1, 0xc2, 0x80, 0xa4, 0x83, 0xad,
-	0xc8, 0xa8, 0x91, 0x14, 0xf1, 0x6f, 0x47, 0x34, 0x5b, 0x27, 0x5e, 0xd8, 0x06, 0x71, 0x05, 0xba,
-	0x22, 0xde, 0x38, 0xdb, 0x41, 0xd7, 0x3a, 0x90, 0x31, 0x41, 0xa4, 0xfb, 0x08, 0xdd, 0x48, 0xcc,
-	0x2f, 0xe3, 0x76, 0x63, 0x3e, 0xfb, 0x67, 0x56, 0x6f, 0xbe, 0x44, 0xa0, 0xc3, 0x38, 0x3e, 0x4c,
-	0x12, 0x0e, 0x42, 0x3c, 0x3d, 0x6b, 0xde, 0xb2, 0xf1, 0xec, 0x4c, 0x30, 0x95, 0x20, 0xc2, 0x15,
-	0xb4, 0x1b, 0xa3, 0x12, 0xa6, 0x6c, 0x94, 0xaa, 0xc2, 0x56, 0xcf, 0xca, 0xed, 0x96, 0x5d, 0xa0,
-	0x44, 0x5d, 0x5e, 0x2a, 0x1f, 0x33, 0x92, 0x06, 0xef, 0xda, 0x17, 0xe5, 0xe0, 0x25, 0x38, 0xa8,
-	0x05, 0x22, 0xb4, 0xd0, 0xee, 0xd7, 0xa8, 0x48, 0xd2, 0x04, 0x26, 0x5e, 0x5e, 0xc7, 0x78, 0x67,
-	0xc3, 0xb5, 0x75, 0x3c, 0xca, 0xb2, 0xe1, 0x74, 0x51, 0xa4, 0xe6, 0xee, 0x08, 0xde, 0xb4, 0x11,
-	0xf7, 0x37, 0x59, 0x45, 0x68, 0x40, 0x1b, 0xbf, 0xec, 0xa0, 0x92, 0x39, 0xe9, 0x6e, 0x82, 0xae,
-	0x9b, 0xfb, 0x1d, 0xb6, 0x2f, 0xda, 0x12, 0xf9, 0x7f, 0xa3, 0x99, 0x49, 0xfa, 0x45, 0x9a, 0x6d,
-	0xb2, 0x2e, 0x35, 0xfb, 0xce, 0x41, 0x7b, 0x9b, 0x44, 0x7d, 0xc1, 0x8b, 0x1b, 0xa2, 0xe2, 0x7a,
-	0x53, 0xf0, 0x6a, 0x65, 0x6f, 0xa0, 0x34, 0x85, 0x4d, 0x1c, 0xff, 0x43, 0x0a, 0x0c, 0x21, 0x2d,
-	0x7a, 0x4f, 0xf7, 0x75, 0x18, 0x15, 0x55, 0xcb, 0xb6, 0x68, 0xb0, 0xb6, 0xba, 0xab, 0x06, 0x39,
-	0xe8, 0x9c, 0xff, 0xe9, 0xe7, 0xce, 0xe7, 0xbe, 0xf3, 0x64, 0xee, 0x3b, 0x7f, 0xcc, 0x7d, 0xe7,
-	0xc7, 0x0b, 0x3f, 0xf7, 0xe4, 0xc2, 0xcf, 0xfd, 0x7a, 0xe1, 0xe7, 0x1e, 0xae, 0xe7, 0xa2, 0x76,
-	0xbb, 0x39, 0xc4, 0x7d, 0xa1, 0xff, 0xda, 0x13, 0xd3, 0x8d, 0x6a, 0xc8, 0x7e, 0x49, 0xf7, 0x88,
-	0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xda, 0xa7, 0xd8, 0xa7, 0x0a, 0x00, 0x00,
+	// 1216 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0x8f, 0x9d, 0x1f, 0x24, 0x63, 0x3b, 0x90, 0x21, 0x81, 0x05, 0xf1, 0xb5, 0xf3, 0xb5, 0xaa,
+	0x36, 0x52, 0x15, 0xbb, 0x14, 0x95, 0x53, 0x2f, 0x59, 0x5c, 0x5a, 0xab, 0x58, 0x8a, 0x16, 0xa8,
+	0x04, 0xaa, 0xb4, 0x9d, 0xdd, 0x7d, 0xb1, 0xa7, 0xde, 0xdd, 0x59, 0x76, 0xc6, 0xc6, 0xbe, 0xf5,
+	0xda, 0x0b, 0xe2, 0x8f, 0xe8, 0xa5, 0xbd, 0x55, 0xe2, 0xde, 0x2b, 0x47, 0xc4, 0xa9, 0xea, 0xc1,
+	0x6d, 0xc3, 0xad, 0x7f, 0x42, 0x4f, 0xd5, 0xfc, 0xb0, 0xbd, 0x01, 0x23, 0x11, 0xb1, 0x54, 0x3d,
+	0xed, 0xbe, 0x1f, 0xf3, 0x79, 0xef, 0x7d, 0x66, 0xe6, 0xcd, 0x0c, 0xba, 0xd2, 0x27, 0x43, 0xd2,
+	0xec, 0x91, 0x34, 0x68, 0x0e, 0xaf, 0x7a, 0x20, 0xc8, 0x55, 0x25, 0x34, 0x92, 0x94, 0x09, 0x86,
+	0xb7, 0xa4, 0xb5, 0xa1, 0x14, 0xc6, 0x7a, 0xb9, 0xea, 0x33, 0x1e, 0x31, 0xde, 0xf4, 0x08, 0x87,
+	0xd9, 0x10, 0x9f, 0xd1, 0x58, 0x0f, 0xb9, 0x7c, 0x49, 0xdb, 0x5d, 0x25, 0x35, 0xb5, 0x60, 0x4c,
+	0xdb, 0x5d, 0xd6, 0x65, 0x5a, 0x2f, 0xff, 0xb4, 0xb6, 0xfe, 0xcb, 0x32, 0x5a, 0x3b, 0x24, 0x29,
+	0x89, 0x38, 0xbe, 0x87, 0x2a, 0x11, 0x8b, 0x61, 0xec, 0x46, 0x24, 0xed, 0x83, 0xe0, 0x56, 0x61,
+	0x77, 0x79, 0xaf, 0xf4, 0x71, 0xb5, 0xf1, 0x4a, 0x1a, 0x8d, 0x8e, 0xf4, 0xeb, 0x28, 0x37, 0x7b,
+	0xfb, 0xe9, 0xa4, 0xb6, 0xf4, 0xd3, 0xef, 0xb5, 0x72, 0x46, 0xc9, 0x9d, 0x72, 0x94, 0x91, 0xf0,
+	0xa3, 0x02, 0xb2, 0x22, 0x1a, 0xd3, 0x68, 0x10, 0xb9, 0x1e, 0x4b, 0x53, 0xf6, 0xd0, 0x1d, 0xf0,
+	0xc0, 0x1d, 0x92, 0x70, 0x00, 0x56, 0x71, 0xb7, 0xb0, 0xb7, 0x61, 0xdf, 0x95, 0x30, 0xbf, 0x4d,
+	0x6a, 0xef, 0x77, 0xa9, 0xe8, 0x0d, 0xbc, 0x86, 0xcf, 0x22, 0x93, 0xbf, 0xf9, 0xec, 0xf3, 0xa0,
+	0xdf, 0x14, 0xe3, 0x04, 0x78, 0xa3, 0x05, 0xfe, 0xf1, 0xa4, 0xb6, 0xd3, 0xd1, 0x88, 0xb6, 0x02,
+	0xbc, 0x7b, 0xbb, 0xf5, 0x95, 0x84, 0x7b, 0xfe, 0x64, 0x1f, 0x99, 0xba, 0x5b, 0xe0, 0x3b, 0x3b,
+	0xd1, 0x09, 0x27, 0x1e, 0x28, 0x27, 0xec, 0xa1, 0xcd, 0xa3, 0x90, 0xf0, 0x9e, 0x1b, 0x32, 0x12,
+	0xbb, 0x47, 0x00, 0xd6, 0xb2, 0xca, 0xe2, 0xd3, 0xd3, 0x65, 0xf1, 0x52, 0xb0, 0xb2, 0xc2, 0xbc,
+	0xc5, 0x48, 0x7c, 0x13, 0x00, 0x03, 0xda, 0x02, 0x37, 0x62, 0x01, 0xb8, 0x3e, 0x11, 0xd0, 0x65,
+	0x29, 0x05, 0x6e, 0xad, 0x28, 0x4e, 0x77, 0x17, 0x70, 0xfa, 0x59, 0x87, 0x05, 0x70, 0x43, 0x7b,
+	0x8e, 0xed, 0x8b, 0x86, 0xd5, 0xb3, 0x59, 0x35, 0x05, 0xee, 0x9c, 0x85, 0x93, 0x8a, 0xfa, 0x8f,
+	0xeb, 0xa8, 0x94, 0xa1, 0x1e, 0x6f, 0xa3, 0xd5, 0x00, 0x62, 0x16, 0x59, 0x05, 0x59, 0x91, 0xa3,
+	0x05, 0xfc, 0x39, 0x2a, 0x1b, 0xe2, 0x43, 0x1a, 0x51, 0xa1, 0x48, 0x5f, 0x3c, 0xb7, 0x9a, 0xa9,
+	0x5b, 0xd2, 0xcb, 0x5e, 0x91, 0x59, 0x38, 0x25, 0x6f, 0xae, 0xc2, 0xd7, 0xd1, 0x26, 0x4f, 0x98,
+	0x30, 0x8b, 0xc4, 0xa5, 0x81, 0x61, 0xee, 0xdc, 0xf1, 0xa4, 0x56, 0xbe, 0x9d, 0x30, 0xa1, 0xd3,
+	0x68, 0xb7, 0x9c, 0x32, 0x9f, 0x4b, 0x01, 0xa6, 0x68, 0xcb, 0x67, 0xf1, 0x10, 0x52, 0x4e, 0x59,
+	0xec, 0x1e, 0x11, 0x5f, 0xb0, 0xd4, 0x5a, 0x39, 0x35, 0xe9, 0xed, 0x58, 0x64, 0x48, 0x6f, 0xc7,
+	0xc2, 0x39, 0x37, 0x87, 0xbd, 0xa9, 0x50, 0xf1, 0x7d, 0x74, 0x9e, 0xc6, 0x02, 0x52, 0xe0, 0xc2,
+	0x4d, 0x89, 0xd0, 0x93, 0x10, 0x5a, 0xab, 0xaa, 0xe4, 0xf7, 0x16, 0x94, 0xdc, 0x36, 0xde, 0x0e,
+	0x11, 0x8a, 0xdd, 0xd0, 0x14, 0xbe, 0x45, 0x5f, 0x36, 0x60, 0x1f, 0x6d, 0xa6, 0xc0, 0x21, 0x1d,
+	0xc2, 0xb4, 0x86, 0xb5, 0x1c, 0x16, 0x4e, 0xc5, 0x60, 0x9a, 0x02, 0x86, 0xc8, 0xea, 0x03, 0x24,
+	0x90, 0xba, 0x29, 0x3c, 0x24, 0x69, 0xe0, 0x26, 0x90, 0xfa, 0x10, 0x0b, 0xd2, 0x05, 0xeb, 0x4c,
+	0x0e, 0xe1, 0x2e, 0x68, 0x74, 0x47, 0x81, 0x1f, 0xce, 0xb0, 0xf1, 0xff, 0x51, 0x99, 0x0c, 0x7c,
+	0x21, 0x27, 0x48, 0x0e, 0xb5, 0xd6, 0xd5, 0x0a, 0x2a, 0x19, 0xdd, 0x9d, 0x71, 0x02, 0xf8, 0x32,
+	0x5a, 0xa7, 0x9c, 0x85, 0x44, 0x40, 0x60, 0x6d, 0xec, 0x16, 0xf6, 0xd6, 0x9d, 0x99, 0x8c, 0x53,
+	0x74, 0x41, 0xff, 0x4b, 0x80, 0x00, 0x3c, 0xe1, 0xfa, 0x40, 0x43, 0x1a, 0x77, 0x2d, 0x94, 0x43,
+	0xd2, 0xdb, 0x33, 0xec, 0x16, 0x78, 0xe2, 0x86, 0x46, 0xc6, 0xd7, 0xd1, 0x45, 0xbd, 0x3a, 0x89,
+	0x17, 0x82, 0x4b, 0x63, 0x77, 0xe6, 0x65, 0x95, 0x54, 0x7a, 0x3b, 0x73, 0x73, 0x3b, 0x6e, 0x4f,
+	0x8d, 0xf8, 0x02, 0x5a, 0xe3, 0x34, 0x64, 0x10, 0x58, 0x65, 0xe5, 0x66, 0x24, 0xfc, 0x00, 0xed,
+	0x84, 0xf4, 0xc1, 0x80, 0x06, 0xba, 0x0a, 0xd1, 0x4b, 0x81, 0xf7, 0x58, 0x18, 0x58, 0x95, 0x3c,
+	0x4a, 0xc8, 0x40, 0xdf, 0x99, 0x22, 0xcb, 0x9d, 0x91, 0x0d, 0xe9, 0xb1, 0x78, 0xc0, 0xad, 0xcd,
+	0x1c, 0xc2, 0x9d, 0xcb, 0xc0, 0xda, 0x12, 0xb5, 0xfe, 0xb8, 0x88, 0x2a, 0x27, 0xfa, 0x0c, 0xc6,
+	0x68, 0x25, 0x26, 0x11, 0x98, 0x66, 0xa1, 0xfe, 0x25, 0x37, 0xaa, 0x69, 0x70, 0xab, 0xb8, 0xbb,
+	0xbc, 0xb7, 0xe1, 0x18, 0x09, 0x7f, 0x83, 0x2a, 0xaa, 0x5d, 0x0a, 0x66, 0x3a, 0x77, 0x1e, 0x3d,
+	0xb3, 0x24, 0x21, 0xef, 0x30, 0xdd, 0x96, 0x5f, 0xcb, 0xfe, 0xca, 0xbb, 0x62, 0xbf, 0xfe, 0xa8,
+	0x80, 0xca, 0x07, 0xbe, 0xcf, 0x06, 0xb1, 0x50, 0xcc, 0x60, 0x0f, 0x9d, 0x21, 0x5a, 0xd6, 0xa4,
+	0xd8, 0x5f, 0xfc, 0x3d, 0xa9, 0xed, 0xbf, 0x41, 0xc4, 0x03, 0xdf, 0x3f, 0x08, 0x82, 0x14, 0x38,
+	0x7f, 0xfe, 0x64, 0xff, 0xbc, 0x09, 0x6c, 0x34, 0xf6, 0x58, 0x00, 0x77, 0xa6, 0xc0, 0x72, 0x17,
+	0x99, 0x33, 0x61, 0xac, 0x8f, 0x3f, 0x67, 0x26, 0xd7, 0xbf, 0x2f, 0xa2, 0x52, 0xa6, 0x07, 0xe3,
+	0x4f, 0x50, 0xa5, 0x47, 0xb8, 0x1b, 0x91, 0x91, 0x69, 0xdd, 0x32, 0xab, 0x75, 0x7b, 0xeb, 0xaf,
+	0x49, 0xed, 0xa4, 0xc1, 0x29, 0xf5, 0x08, 0xef, 0x90, 0x91, 0x1e, 0x46, 0x50, 0x25, 0x22, 0x23,
+	0x75, 0xe2, 0xce, 0x3b, 0xfe, 0x5b, 0x1f, 0x70, 0x06, 0x52, 0x87, 0x78, 0xe7, 0xeb, 0xa1, 0xfe,
+	0xc3, 0x32, 0xda, 0x7a, 0xa5, 0x39, 0x63, 0x86, 0x2a, 0xf2, 0xfe, 0xa3, 0x7b, 0x3b, 0x49, 0xc6,
+	0x66, 0x9e, 0xbe, 0x3c, 0xf5, 0x0d, 0xa2, 0x64, 0x13, 0x0e, 0x12, 0xf7, 0xe0, 0xf0, 0xde, 0xcb,
+	0x69, 0x78, 0x53, 0x53, 0x32, 0xc6, 0x80, 0xce, 0xaa, 0x80, 0xd1, 0x20, 0x14, 0x34, 0x09, 0x29,
+	0xa4, 0xb9, 0xb0, 0xb9, 0x29, 0x41, 0x3b, 0x33, 0x4c, 0x7c, 0x88, 0x56, 0xfa, 0x34, 0xee, 0xe7,
+	0x42, 0xa3, 0x42, 0x92, 0x89, 0x7f, 0x3b, 0x88, 0x92, 0x6c, 0xe2, 0x79, 0xec, 0xa4, 0x4d, 0x09,
+	0x3a, 0x4f, 0xbc, 0xfe, 0xa4, 0x88, 0xce, 0xb4, 0x20, 0x61, 0x9c, 0x0a, 0x7c, 0x84, 0x36, 0x02,
+	0xfd, 0xcb, 0xd2, 0xdc, 0x37, 0xd0, 0x1c, 0x1a, 0xfb, 0x68, 0x8d, 0x44, 0x6a, 0x97, 0x16, 0xd5,
+	0x95, 0xea, 0x52, 0xc3, 0x0c, 0x90, 0xa4, 0xce, 0x4e, 0xf6, 0x1b, 0x8c, 0xc6, 0xf6, 0x47, 0xe6,
+	0x2e, 0xb5, 0xf7, 0x06, 0x39, 0xc8, 0x01, 0xdc, 0x31, 0xd0, 0xf8, 0x6b, 0xb4, 0x4a, 0xe3, 0x00,
+	0x46, 0xd6, 0xb2, 0x8a, 0xf1, 0xc1, 0x82, 0xbb, 0xc3, 0xed, 0x41, 0x92, 0x84, 0xe3, 0xe9, 0x22,
+	0xd5, 0x07, 0xb8, 0xfd, 0x3f, 0x13, 0x71, 0x67, 0x91, 0x95, 0x3b, 0x1a, 0xb4, 0xfe, 0x73, 0x11,
+	0xad, 0xe9, 0x9d, 0x8e, 0x03, 0xb4, 0xae, 0xcf, 0x29, 0xc8, 0x9f, 0xb4, 0x19, 0xf2, 0x7f, 0x86,
+	0x33, 0x5d, 0xf4, 0xeb, 0x38, 0x5b, 0x64, 0x9d, 0x71, 0xf6, 0x5d, 0x01, 0x6d, 0x2f, 0x22, 0xf5,
+	0x35, 0xd7, 0x5e, 0x07, 0xad, 0x66, 0x1f, 0x19, 0x6f, 0xb7, 0xec, 0x35, 0x94, 0x4a, 0x61, 0x51,
+	0x8e, 0xff, 0x62, 0x0a, 0x0c, 0x21, 0x45, 0xfa, 0xa1, 0x7a, 0x27, 0x12, 0xb4, 0x2a, 0x9f, 0x80,
+	0xd3, 0x07, 0x5b, 0xae, 0xb3, 0xaa, 0x91, 0xeb, 0x23, 0x54, 0x69, 0x81, 0x9f, 0x89, 0xd9, 0x3d,
+	0x19, 0xf3, 0xca, 0xc2, 0x98, 0x66, 0x88, 0x7d, 0xcd, 0x84, 0xfd, 0xf0, 0xcd, 0x6a, 0xce, 0x46,
+	0xb6, 0x5b, 0x4f, 0xff, 0xac, 0x2e, 0x3d, 0x3d, 0xae, 0x16, 0x9e, 0x1d, 0x57, 0x0b, 0x7f, 0x1c,
+	0x57, 0x0b, 0x8f, 0x5f, 0x54, 0x97, 0x9e, 0xbd, 0xa8, 0x2e, 0xfd, 0xfa, 0xa2, 0xba, 0x74, 0x3f,
+	0xcb, 0xa2, 0x5c, 0x67, 0xfb, 0x21, 0xf1, 0xb8, 0xfa, 0x6b, 0x8e, 0xf4, 0xbb, 0x5a, 0xa1, 0x7a,
+	0x6b, 0xea, 0xb5, 0x7b, 0xed, 0x9f, 0x01, 0x00, 0x91, 0x1b, 0xdc, 0x90, 0x71, 0x0f, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -481,6 +649,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EModeCategories) > 0 {
+		for iNdEx := len(m.EModeCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EModeCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.FlashLoanFee.Size()
+		i -= size
+		if _, err := m.FlashLoanFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.MinimumBorrowUSDValue.Size()
 		i -= size
@@ -528,6 +720,73 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidationBonus.Size()
+		i -= size
+		if _, err := m.LiquidationBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.LiquidationThreshold.Size()
+		i -= size
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.Siloed {
+		i--
+		if m.Siloed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BorrowableInIsolation {
+		i--
+		if m.BorrowableInIsolation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.IsolationDebtCeiling.Size()
+		i -= size
+		if _, err := m.IsolationDebtCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Isolated {
+		i--
+		if m.Isolated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.KeeperRewardPercentage.Size()
 		i -= size
@@ -595,6 +854,102 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EModeCategory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EModeCategory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EModeCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidationThreshold.Size()
+		i -= size
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LoanToValue.Size()
+		i -= size
+		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintHard(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountEMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountEMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountEMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BorrowLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -944,6 +1299,43 @@ func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DecCoinsProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecCoinsProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecCoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHard(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHard(v)
 	base := offset
@@ -969,6 +1361,14 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinimumBorrowUSDValue.Size()
 	n += 1 + l + sovHard(uint64(l))
+	l = m.FlashLoanFee.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if len(m.EModeCategories) > 0 {
+		for _, e := range m.EModeCategories {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -996,15 +1396,74 @@ func (m *MoneyMarket) Size() (n int) {
 	n += 1 + l + sovHard(uint64(l))
 	l = m.KeeperRewardPercentage.Size()
 	n += 1 + l + sovHard(uint64(l))
-	return n
-}
-
-func (m *BorrowLimit) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
 	}
-	var l int
-	_ = l
+	if m.Isolated {
+		n += 2
+	}
+	l = m.IsolationDebtCeiling.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if m.BorrowableInIsolation {
+		n += 2
+	}
+	if m.Siloed {
+		n += 2
+	}
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.LiquidationBonus.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *EModeCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *AccountEMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	return n
+}
+
+func (m *BorrowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.HasMaxLimit {
 		n += 2
 	}
@@ -1127,6 +1586,21 @@ func (m *CoinsProto) Size() (n int) {
 	return n
 }
 
+func (m *DecCoinsProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovHard(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1143,30 +1617,574 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowHard
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MoneyMarkets = append(m.MoneyMarkets, MoneyMarket{})
+			if err := m.MoneyMarkets[len(m.MoneyMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashLoanFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashLoanFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EModeCategories = append(m.EModeCategories, EModeCategory{})
+			if err := m.EModeCategories[len(m.EModeCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoneyMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoneyMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isolated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Isolated = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationDebtCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.IsolationDebtCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowableInIsolation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BorrowableInIsolation = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siloed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Siloed = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1176,29 +2194,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MoneyMarkets = append(m.MoneyMarkets, MoneyMarket{})
-			if err := m.MoneyMarkets[len(m.MoneyMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBonus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1226,7 +2244,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1251,7 +2269,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
+func (m *EModeCategory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,15 +2292,15 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoneyMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EModeCategory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoneyMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1310,13 +2328,13 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1326,28 +2344,27 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1375,11 +2392,13 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1407,46 +2426,63 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHard
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthHard
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountEMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountEMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountEMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1474,13 +2510,11 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1508,9 +2542,7 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2473,6 +3505,90 @@ func (m *CoinsProto) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DecCoinsProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecCoinsProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecCoinsProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.DecCoin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHard(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
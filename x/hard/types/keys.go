@@ -31,6 +31,8 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	IsolatedDebtPrefix            = []byte{0x11} // denom -> sdk.DecCoins
+	AccountEModesPrefix           = []byte{0x12} // address -> AccountEMode
 )
 
 // DepositTypeIteratorKey returns an interator prefix for interating over deposits by deposit denom
@@ -1,8 +1,12 @@
 package types
 
 import (
+	"strings"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // ensure Msg interface compliance at compile time
@@ -12,6 +16,10 @@ var (
 	_ sdk.Msg = &MsgBorrow{}
 	_ sdk.Msg = &MsgRepay{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgFlashLoan{}
+	_ sdk.Msg = &MsgSetEMode{}
+
+	_ cdctypes.UnpackInterfacesMessage = MsgFlashLoan{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -225,3 +233,129 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgFlashLoan returns a new MsgFlashLoan
+func NewMsgFlashLoan(borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) MsgFlashLoan {
+	msgsAny := make([]*cdctypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := cdctypes.NewAnyWithValue(msg)
+		if err != nil {
+			panic(err)
+		}
+		msgsAny[i] = any
+	}
+
+	return MsgFlashLoan{
+		Borrower: borrower.String(),
+		Amount:   amount,
+		Msgs:     msgsAny,
+	}
+}
+
+// GetMessages returns the cached messages executed with the flash loan.
+func (msg MsgFlashLoan) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgFlashLoan")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgFlashLoan) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, x := range msg.Msgs {
+		var innerMsg sdk.Msg
+		if err := unpacker.UnpackAny(x, &innerMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgFlashLoan) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgFlashLoan) Type() string { return "hard_flash_loan" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFlashLoan) ValidateBasic() error {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "flash loan amount %s", msg.Amount)
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "flash loan must execute at least one message")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for i, innerMsg := range msgs {
+		if err := innerMsg.ValidateBasic(); err != nil {
+			return err
+		}
+		// the messages are executed without their own signatures, so they can only act on behalf of the borrower
+		signers := innerMsg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(borrower) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %d must be signed by the borrower only", i)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgFlashLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgFlashLoan) GetSigners() []sdk.AccAddress {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{borrower}
+}
+
+// NewMsgSetEMode returns a new MsgSetEMode
+func NewMsgSetEMode(sender sdk.AccAddress, category string) MsgSetEMode {
+	return MsgSetEMode{
+		Sender:   sender.String(),
+		Category: category,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetEMode) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetEMode) Type() string { return "hard_set_e_mode" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetEMode) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Category != strings.TrimSpace(msg.Category) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "e-mode category cannot have leading or trailing whitespace: %q", msg.Category)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetEMode) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetEMode) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -189,6 +189,123 @@ func (suite *MsgTestSuite) TestMsgRepay() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgFlashLoan() {
+	type args struct {
+		borrower sdk.AccAddress
+		amount   sdk.Coins
+		msgs     []sdk.Msg
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	amount := sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10000000)))
+	depositMsg := types.NewMsgDeposit(addrs[0], amount)
+	otherDepositMsg := types.NewMsgDeposit(addrs[1], amount)
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				borrower: addrs[0],
+				amount:   amount,
+				msgs:     []sdk.Msg{&depositMsg},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: no messages",
+			args: args{
+				borrower: addrs[0],
+				amount:   amount,
+				msgs:     []sdk.Msg{},
+			},
+			expectPass:  false,
+			expectedErr: "flash loan must execute at least one message",
+		},
+		{
+			name: "invalid: message signed by another account",
+			args: args{
+				borrower: addrs[0],
+				amount:   amount,
+				msgs:     []sdk.Msg{&otherDepositMsg},
+			},
+			expectPass:  false,
+			expectedErr: "message 0 must be signed by the borrower only",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgFlashLoan(tc.args.borrower, tc.args.amount, tc.args.msgs)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgSetEMode() {
+	type args struct {
+		sender   sdk.AccAddress
+		category string
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				sender:   sdk.AccAddress("test1"),
+				category: "stablecoins",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "valid: opt out",
+			args: args{
+				sender:   sdk.AccAddress("test1"),
+				category: "",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: category with whitespace",
+			args: args{
+				sender:   sdk.AccAddress("test1"),
+				category: " stablecoins",
+			},
+			expectPass:  false,
+			expectedErr: "e-mode category cannot have leading or trailing whitespace",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgSetEMode(tc.args.sender, tc.args.category)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,14 +13,25 @@ import (
 var (
 	KeyMoneyMarkets              = []byte("MoneyMarkets")
 	KeyMinimumBorrowUSDValue     = []byte("MinimumBorrowUSDValue")
+	KeyFlashLoanFee              = []byte("FlashLoanFee")
+	KeyEModeCategories           = []byte("EModeCategories")
 	DefaultMoneyMarkets          = MoneyMarkets{}
-	DefaultMinimumBorrowUSDValue = sdk.NewDec(10) // $10 USD minimum borrow value
+	DefaultMinimumBorrowUSDValue = sdk.NewDec(10)                  // $10 USD minimum borrow value
+	DefaultFlashLoanFee          = sdk.MustNewDecFromStr("0.0009") // 0.09% of each flash loan
+	DefaultEModeCategories       = EModeCategories{}
 	DefaultAccumulationTimes     = GenesisAccumulationTimes{}
 	DefaultTotalSupplied         = sdk.Coins{}
 	DefaultTotalBorrowed         = sdk.Coins{}
 	DefaultTotalReserves         = sdk.Coins{}
 	DefaultDeposits              = Deposits{}
 	DefaultBorrows               = Borrows{}
+	DefaultAccountEModes         = AccountEModes{}
+)
+
+// Auction types that can be used to sell liquidated deposits
+const (
+	AuctionTypeCollateral = "collateral"
+	AuctionTypeDutch      = "dutch"
 )
 
 // NewBorrowLimit returns a new BorrowLimit
@@ -71,6 +83,9 @@ func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string,
 		InterestRateModel:      interestRateModel,
 		ReserveFactor:          reserveFactor,
 		KeeperRewardPercentage: keeperRewardPercentage,
+		IsolationDebtCeiling:   sdk.ZeroDec(),
+		LiquidationThreshold:   sdk.ZeroDec(),
+		LiquidationBonus:       sdk.ZeroDec(),
 	}
 }
 
@@ -100,9 +115,51 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("keeper reward percentage must be between 0.0-1.0")
 	}
 
+	switch mm.AuctionType {
+	case "", AuctionTypeCollateral, AuctionTypeDutch:
+	default:
+		return fmt.Errorf("auction type must be %s or %s: %s", AuctionTypeCollateral, AuctionTypeDutch, mm.AuctionType)
+	}
+
+	if mm.Isolated {
+		if mm.IsolationDebtCeiling.IsNil() || mm.IsolationDebtCeiling.IsNegative() {
+			return fmt.Errorf("isolation debt ceiling must be non-negative: %s", mm.IsolationDebtCeiling)
+		}
+		if mm.BorrowableInIsolation {
+			return fmt.Errorf("isolated money market %s cannot be borrowable in isolation", mm.Denom)
+		}
+	}
+
+	liquidationThreshold := mm.GetLiquidationThreshold()
+	if liquidationThreshold.LT(mm.BorrowLimit.LoanToValue) || liquidationThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquidation threshold must be between the loan-to-value and 1.0: %s", liquidationThreshold)
+	}
+
+	liquidationBonus := mm.GetLiquidationBonus()
+	if liquidationBonus.IsNegative() || liquidationBonus.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquidation bonus must be between 0.0-1.0: %s", liquidationBonus)
+	}
+
 	return nil
 }
 
+// GetLiquidationThreshold returns the liquidation threshold of the money market, which defaults to the loan-to-value
+// of its borrow limit when unset
+func (mm MoneyMarket) GetLiquidationThreshold() sdk.Dec {
+	if mm.LiquidationThreshold.IsNil() || mm.LiquidationThreshold.IsZero() {
+		return mm.BorrowLimit.LoanToValue
+	}
+	return mm.LiquidationThreshold
+}
+
+// GetLiquidationBonus returns the liquidation bonus of the money market, zero if unset
+func (mm MoneyMarket) GetLiquidationBonus() sdk.Dec {
+	if mm.LiquidationBonus.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return mm.LiquidationBonus
+}
+
 // Equal returns a boolean indicating if a MoneyMarket is equal to another MoneyMarket
 func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if mm.Denom != mmCompareTo.Denom {
@@ -126,6 +183,28 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if mm.AuctionType != mmCompareTo.AuctionType {
+		return false
+	}
+	if mm.Isolated != mmCompareTo.Isolated {
+		return false
+	}
+	// the debt ceiling is only set on isolated money markets
+	if mm.Isolated && !mm.IsolationDebtCeiling.Equal(mmCompareTo.IsolationDebtCeiling) {
+		return false
+	}
+	if mm.BorrowableInIsolation != mmCompareTo.BorrowableInIsolation {
+		return false
+	}
+	if mm.Siloed != mmCompareTo.Siloed {
+		return false
+	}
+	if !mm.GetLiquidationThreshold().Equal(mmCompareTo.GetLiquidationThreshold()) {
+		return false
+	}
+	if !mm.GetLiquidationBonus().Equal(mmCompareTo.GetLiquidationBonus()) {
+		return false
+	}
 	return true
 }
 
@@ -193,17 +272,101 @@ func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
 // InterestRateModels slice of InterestRateModel
 type InterestRateModels []InterestRateModel
 
+// NewEModeCategory returns a new EModeCategory
+func NewEModeCategory(name string, denoms []string, loanToValue, liquidationThreshold sdk.Dec) EModeCategory {
+	return EModeCategory{
+		Name:                 name,
+		Denoms:               denoms,
+		LoanToValue:          loanToValue,
+		LiquidationThreshold: liquidationThreshold,
+	}
+}
+
+// Validate EModeCategory param
+func (c EModeCategory) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return fmt.Errorf("e-mode category name cannot be blank")
+	}
+
+	if len(c.Denoms) == 0 {
+		return fmt.Errorf("e-mode category %s must contain at least one denom", c.Name)
+	}
+	seenDenoms := make(map[string]bool)
+	for _, denom := range c.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate denom %s in e-mode category %s", denom, c.Name)
+		}
+		seenDenoms[denom] = true
+	}
+
+	if c.LoanToValue.IsNil() || c.LoanToValue.IsNegative() || c.LoanToValue.GT(sdk.OneDec()) {
+		return fmt.Errorf("e-mode loan-to-value must be between 0.0-1.0: %s", c.LoanToValue)
+	}
+
+	if c.LiquidationThreshold.IsNil() || c.LiquidationThreshold.LT(c.LoanToValue) || c.LiquidationThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("e-mode liquidation threshold must be between the loan-to-value and 1.0: %s", c.LiquidationThreshold)
+	}
+
+	return nil
+}
+
+// Contains returns true if the denom belongs to the category
+func (c EModeCategory) Contains(denom string) bool {
+	for _, d := range c.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// EModeCategories slice of EModeCategory
+type EModeCategories []EModeCategory
+
+// Validate e-mode categories
+func (cs EModeCategories) Validate() error {
+	seenNames := make(map[string]bool)
+	for _, c := range cs {
+		if seenNames[c.Name] {
+			return fmt.Errorf("duplicate e-mode category name %s", c.Name)
+		}
+		seenNames[c.Name] = true
+
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the category with the input name
+func (cs EModeCategories) Get(name string) (EModeCategory, bool) {
+	for _, c := range cs {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return EModeCategory{}, false
+}
+
 // NewParams returns a new params object
-func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec) Params {
+func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec, flashLoanFee sdk.Dec,
+	eModeCategories EModeCategories,
+) Params {
 	return Params{
 		MoneyMarkets:          moneyMarkets,
 		MinimumBorrowUSDValue: minimumBorrowUSDValue,
+		FlashLoanFee:          flashLoanFee,
+		EModeCategories:       eModeCategories,
 	}
 }
 
 // DefaultParams returns default params for hard module
 func DefaultParams() Params {
-	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue)
+	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue, DefaultFlashLoanFee, DefaultEModeCategories)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -216,6 +379,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMoneyMarkets, &p.MoneyMarkets, validateMoneyMarketParams),
 		paramtypes.NewParamSetPair(KeyMinimumBorrowUSDValue, &p.MinimumBorrowUSDValue, validateMinimumBorrowUSDValue),
+		paramtypes.NewParamSetPair(KeyFlashLoanFee, &p.FlashLoanFee, validateFlashLoanFee),
+		paramtypes.NewParamSetPair(KeyEModeCategories, &p.EModeCategories, validateEModeCategoriesParams),
 	}
 }
 
@@ -225,6 +390,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFlashLoanFee(p.FlashLoanFee); err != nil {
+		return err
+	}
+
+	if err := validateEModeCategoriesParams(p.EModeCategories); err != nil {
+		return err
+	}
+
 	return validateMoneyMarketParams(p.MoneyMarkets)
 }
 
@@ -241,6 +414,19 @@ func validateMinimumBorrowUSDValue(i interface{}) error {
 	return nil
 }
 
+func validateFlashLoanFee(i interface{}) error {
+	fee, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fee.IsNil() || fee.IsNegative() || fee.GT(sdk.OneDec()) {
+		return fmt.Errorf("flash loan fee must be between 0.0-1.0: %s", fee)
+	}
+
+	return nil
+}
+
 func validateMoneyMarketParams(i interface{}) error {
 	mm, ok := i.(MoneyMarkets)
 	if !ok {
@@ -249,3 +435,12 @@ func validateMoneyMarketParams(i interface{}) error {
 
 	return mm.Validate()
 }
+
+func validateEModeCategoriesParams(i interface{}) error {
+	categories, ok := i.(EModeCategories)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return categories.Validate()
+}
@@ -15,8 +15,10 @@ type ParamTestSuite struct {
 
 func (suite *ParamTestSuite) TestParamValidation() {
 	type args struct {
-		minBorrowVal sdk.Dec
-		mms          types.MoneyMarkets
+		minBorrowVal    sdk.Dec
+		mms             types.MoneyMarkets
+		flashLoanFee    sdk.Dec
+		eModeCategories types.EModeCategories
 	}
 	testCases := []struct {
 		name        string
@@ -29,10 +31,21 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			args: args{
 				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
 				mms:          types.DefaultMoneyMarkets,
+				flashLoanFee: types.DefaultFlashLoanFee,
 			},
 			expectPass:  true,
 			expectedErr: "",
 		},
+		{
+			name: "invalid: flash loan fee > one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms:          types.DefaultMoneyMarkets,
+				flashLoanFee: sdk.MustNewDecFromStr("1.01"),
+			},
+			expectPass:  false,
+			expectedErr: "flash loan fee must be between 0.0-1.0",
+		},
 		{
 			name: "invalid: conversion factor < one",
 			args: args{
@@ -52,14 +65,145 @@ func (suite *ParamTestSuite) TestParamValidation() {
 						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
 					},
 				},
+				flashLoanFee: types.DefaultFlashLoanFee,
 			},
 			expectPass:  false,
 			expectedErr: "conversion '0' factor must be ≥ one",
 		},
+		{
+			name: "invalid: unknown auction type",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:           "btc:usd",
+						ConversionFactor:       sdk.NewInt(100000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						AuctionType:            "english",
+					},
+				},
+				flashLoanFee: types.DefaultFlashLoanFee,
+			},
+			expectPass:  false,
+			expectedErr: "auction type must be collateral or dutch",
+		},
+		{
+			name: "invalid: isolated without debt ceiling",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:           "btc:usd",
+						ConversionFactor:       sdk.NewInt(100000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						Isolated:               true,
+					},
+				},
+				flashLoanFee: types.DefaultFlashLoanFee,
+			},
+			expectPass:  false,
+			expectedErr: "isolation debt ceiling must be non-negative",
+		},
+		{
+			name: "invalid: liquidation threshold < loan-to-value",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:           "btc:usd",
+						ConversionFactor:       sdk.NewInt(100000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						IsolationDebtCeiling:   sdk.ZeroDec(),
+						LiquidationThreshold:   sdk.MustNewDecFromStr("0.4"),
+					},
+				},
+				flashLoanFee: types.DefaultFlashLoanFee,
+			},
+			expectPass:  false,
+			expectedErr: "liquidation threshold must be between the loan-to-value and 1.0",
+		},
+		{
+			name: "invalid: liquidation bonus > one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:           "btc:usd",
+						ConversionFactor:       sdk.NewInt(100000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						IsolationDebtCeiling:   sdk.ZeroDec(),
+						LiquidationThreshold:   sdk.MustNewDecFromStr("0.6"),
+						LiquidationBonus:       sdk.MustNewDecFromStr("1.1"),
+					},
+				},
+				flashLoanFee: types.DefaultFlashLoanFee,
+			},
+			expectPass:  false,
+			expectedErr: "liquidation bonus must be between 0.0-1.0",
+		},
+		{
+			name: "invalid: e-mode liquidation threshold < loan-to-value",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms:          types.DefaultMoneyMarkets,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				eModeCategories: types.EModeCategories{
+					types.NewEModeCategory("stablecoins", []string{"usdx", "busd"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.85")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "e-mode liquidation threshold must be between the loan-to-value and 1.0",
+		},
+		{
+			name: "invalid: duplicate e-mode category",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms:          types.DefaultMoneyMarkets,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				eModeCategories: types.EModeCategories{
+					types.NewEModeCategory("stablecoins", []string{"usdx", "busd"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.95")),
+					types.NewEModeCategory("stablecoins", []string{"usdx"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.95")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate e-mode category name stablecoins",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal)
+			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal, tc.args.flashLoanFee, tc.args.eModeCategories)
 			err := params.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -1104,6 +1104,275 @@ func (m *QueryInterestFactorsResponse) GetInterestFactors() InterestFactors {
 	return nil
 }
 
+// QueryEModeRequest is the request type for the Query/EMode RPC method.
+type QueryEModeRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryEModeRequest) Reset()         { *m = QueryEModeRequest{} }
+func (m *QueryEModeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEModeRequest) ProtoMessage()    {}
+func (*QueryEModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1eedf429c9bff7da, []int{22}
+}
+func (m *QueryEModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEModeRequest.Merge(m, src)
+}
+func (m *QueryEModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEModeRequest proto.InternalMessageInfo
+
+func (m *QueryEModeRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryEModeResponse is the response type for the Query/EMode RPC method.
+type QueryEModeResponse struct {
+	// category is the efficiency mode category of the account, empty if the account hasn't opted into one
+	Category EModeCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category"`
+}
+
+func (m *QueryEModeResponse) Reset()         { *m = QueryEModeResponse{} }
+func (m *QueryEModeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEModeResponse) ProtoMessage()    {}
+func (*QueryEModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1eedf429c9bff7da, []int{23}
+}
+func (m *QueryEModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEModeResponse.Merge(m, src)
+}
+func (m *QueryEModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEModeResponse proto.InternalMessageInfo
+
+func (m *QueryEModeResponse) GetCategory() EModeCategory {
+	if m != nil {
+		return m.Category
+	}
+	return EModeCategory{}
+}
+
+// QuerySimulatePositionRequest is the request type for the Query/SimulatePosition RPC method.
+type QuerySimulatePositionRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// deposit is transferred from the account as a real deposit would be, so the account must hold the coins
+	Deposit  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	Withdraw github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdraw,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdraw"`
+	Borrow   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=borrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"borrow"`
+	// repay is transferred from the account as a real repayment would be, so the account must hold the coins
+	Repay github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=repay,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"repay"`
+}
+
+func (m *QuerySimulatePositionRequest) Reset()         { *m = QuerySimulatePositionRequest{} }
+func (m *QuerySimulatePositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePositionRequest) ProtoMessage()    {}
+func (*QuerySimulatePositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1eedf429c9bff7da, []int{24}
+}
+func (m *QuerySimulatePositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulatePositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePositionRequest.Merge(m, src)
+}
+func (m *QuerySimulatePositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePositionRequest proto.InternalMessageInfo
+
+func (m *QuerySimulatePositionRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QuerySimulatePositionRequest) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *QuerySimulatePositionRequest) GetWithdraw() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdraw
+	}
+	return nil
+}
+
+func (m *QuerySimulatePositionRequest) GetBorrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Borrow
+	}
+	return nil
+}
+
+func (m *QuerySimulatePositionRequest) GetRepay() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Repay
+	}
+	return nil
+}
+
+// QuerySimulatePositionResponse is the response type for the Query/SimulatePosition RPC method. The actions are applied
+// in the order deposit, repay, withdraw and borrow, after syncing interest. If an action would be rejected, the position
+// is the one before that action. Deposits and repayments are rejected if the account doesn't hold the coins.
+type QuerySimulatePositionResponse struct {
+	// deposit is the resulting deposit of the account
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// borrow is the resulting borrow of the account
+	Borrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=borrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"borrow"`
+	// deposit_usd_values are the USD values of each denom of the deposit at current prices
+	DepositUsdValues github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=deposit_usd_values,json=depositUsdValues,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"deposit_usd_values"`
+	// borrow_usd_values are the USD values of each denom of the borrow at current prices
+	BorrowUsdValues github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=borrow_usd_values,json=borrowUsdValues,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"borrow_usd_values"`
+	// loan_to_value is the ratio of the value of the borrow to the value of the deposit
+	LoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=loan_to_value,json=loanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"loan_to_value"`
+	// borrow_capacity is the USD value that can still be borrowed against the deposit
+	BorrowCapacity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=borrow_capacity,json=borrowCapacity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_capacity"`
+	// health_factor is the ratio of the deposit weighted by its liquidation thresholds to the borrow
+	HealthFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=health_factor,json=healthFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"health_factor"`
+	// rejected is true if one of the actions would be rejected
+	Rejected bool `protobuf:"varint,8,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// rejection_reason is the error of the rejected action
+	RejectionReason string `protobuf:"bytes,9,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+}
+
+func (m *QuerySimulatePositionResponse) Reset()         { *m = QuerySimulatePositionResponse{} }
+func (m *QuerySimulatePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePositionResponse) ProtoMessage()    {}
+func (*QuerySimulatePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1eedf429c9bff7da, []int{25}
+}
+func (m *QuerySimulatePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulatePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePositionResponse.Merge(m, src)
+}
+func (m *QuerySimulatePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePositionResponse proto.InternalMessageInfo
+
+func (m *QuerySimulatePositionResponse) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *QuerySimulatePositionResponse) GetBorrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Borrow
+	}
+	return nil
+}
+
+func (m *QuerySimulatePositionResponse) GetDepositUsdValues() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DepositUsdValues
+	}
+	return nil
+}
+
+func (m *QuerySimulatePositionResponse) GetBorrowUsdValues() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BorrowUsdValues
+	}
+	return nil
+}
+
+func (m *QuerySimulatePositionResponse) GetRejected() bool {
+	if m != nil {
+		return m.Rejected
+	}
+	return false
+}
+
+func (m *QuerySimulatePositionResponse) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
 // DepositResponse defines an amount of coins deposited into a hard module account.
 type DepositResponse struct {
 	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
@@ -1115,7 +1384,7 @@ func (m *DepositResponse) Reset()         { *m = DepositResponse{} }
 func (m *DepositResponse) String() string { return proto.CompactTextString(m) }
 func (*DepositResponse) ProtoMessage()    {}
 func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{22}
+	return fileDescriptor_1eedf429c9bff7da, []int{26}
 }
 func (m *DepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1445,7 @@ func (m *SupplyInterestFactorResponse) Reset()         { *m = SupplyInterestFact
 func (m *SupplyInterestFactorResponse) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactorResponse) ProtoMessage()    {}
 func (*SupplyInterestFactorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{23}
+	return fileDescriptor_1eedf429c9bff7da, []int{27}
 }
 func (m *SupplyInterestFactorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1224,13 +1493,17 @@ type BorrowResponse struct {
 	Borrower string                                   `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
 	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 	Index    BorrowInterestFactorResponses            `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=BorrowInterestFactorResponses" json:"index"`
+	// health_factor is the ratio of the borrower's deposits weighted by their liquidation thresholds to its borrows, at
+	// current prices. Positions with a health factor below one can be liquidated. Only set by the Borrows query, and
+	// left unset when a price of the position is unavailable.
+	HealthFactor *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=health_factor,json=healthFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"health_factor,omitempty"`
 }
 
 func (m *BorrowResponse) Reset()         { *m = BorrowResponse{} }
 func (m *BorrowResponse) String() string { return proto.CompactTextString(m) }
 func (*BorrowResponse) ProtoMessage()    {}
 func (*BorrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{24}
+	return fileDescriptor_1eedf429c9bff7da, []int{28}
 }
 func (m *BorrowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1291,7 +1564,7 @@ func (m *BorrowInterestFactorResponse) Reset()         { *m = BorrowInterestFact
 func (m *BorrowInterestFactorResponse) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactorResponse) ProtoMessage()    {}
 func (*BorrowInterestFactorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{25}
+	return fileDescriptor_1eedf429c9bff7da, []int{29}
 }
 func (m *BorrowInterestFactorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1347,7 +1620,7 @@ func (m *MoneyMarketInterestRate) Reset()         { *m = MoneyMarketInterestRate
 func (m *MoneyMarketInterestRate) String() string { return proto.CompactTextString(m) }
 func (*MoneyMarketInterestRate) ProtoMessage()    {}
 func (*MoneyMarketInterestRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{26}
+	return fileDescriptor_1eedf429c9bff7da, []int{30}
 }
 func (m *MoneyMarketInterestRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1410,7 +1683,7 @@ func (m *InterestFactor) Reset()         { *m = InterestFactor{} }
 func (m *InterestFactor) String() string { return proto.CompactTextString(m) }
 func (*InterestFactor) ProtoMessage()    {}
 func (*InterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{27}
+	return fileDescriptor_1eedf429c9bff7da, []int{31}
 }
 func (m *InterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1483,6 +1756,10 @@ func init() {
 	proto.RegisterType((*QueryReservesResponse)(nil), "kava.hard.v1beta1.QueryReservesResponse")
 	proto.RegisterType((*QueryInterestFactorsRequest)(nil), "kava.hard.v1beta1.QueryInterestFactorsRequest")
 	proto.RegisterType((*QueryInterestFactorsResponse)(nil), "kava.hard.v1beta1.QueryInterestFactorsResponse")
+	proto.RegisterType((*QueryEModeRequest)(nil), "kava.hard.v1beta1.QueryEModeRequest")
+	proto.RegisterType((*QueryEModeResponse)(nil), "kava.hard.v1beta1.QueryEModeResponse")
+	proto.RegisterType((*QuerySimulatePositionRequest)(nil), "kava.hard.v1beta1.QuerySimulatePositionRequest")
+	proto.RegisterType((*QuerySimulatePositionResponse)(nil), "kava.hard.v1beta1.QuerySimulatePositionResponse")
 	proto.RegisterType((*DepositResponse)(nil), "kava.hard.v1beta1.DepositResponse")
 	proto.RegisterType((*SupplyInterestFactorResponse)(nil), "kava.hard.v1beta1.SupplyInterestFactorResponse")
 	proto.RegisterType((*BorrowResponse)(nil), "kava.hard.v1beta1.BorrowResponse")
@@ -1494,90 +1771,114 @@ func init() {
 func init() { proto.RegisterFile("kava/hard/v1beta1/query.proto", fileDescriptor_1eedf429c9bff7da) }
 
 var fileDescriptor_1eedf429c9bff7da = []byte{
-	// 1318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0x24, 0x4d, 0x5f, 0x9b, 0x0f, 0x06, 0xd3, 0xda, 0x4e, 0xe2, 0x26, 0x9b, 0xe6,
-	0x83, 0x34, 0xf6, 0x26, 0x69, 0x05, 0x57, 0x6a, 0xaa, 0x22, 0x90, 0x82, 0x60, 0x5b, 0x24, 0x84,
-	0x84, 0xa2, 0xb5, 0x77, 0x70, 0x56, 0x71, 0x76, 0xdc, 0x9d, 0x75, 0xda, 0x20, 0xc4, 0xa1, 0x12,
-	0xf7, 0x42, 0x0e, 0x08, 0x81, 0xc4, 0xa1, 0x9c, 0x80, 0x23, 0x5c, 0x90, 0xb8, 0x70, 0xaa, 0x38,
-	0x55, 0x70, 0xe1, 0x04, 0x28, 0xe1, 0x0f, 0x41, 0x3b, 0xf3, 0x66, 0xed, 0x5d, 0xef, 0xae, 0x83,
-	0x44, 0x51, 0x7a, 0x4a, 0x66, 0xe6, 0x7d, 0xfc, 0xde, 0x6f, 0xde, 0xbc, 0x7d, 0xcf, 0x30, 0xbb,
-	0x6b, 0xed, 0x5b, 0xc6, 0x8e, 0xe5, 0xd9, 0xc6, 0xfe, 0x46, 0x9d, 0xfa, 0xd6, 0x86, 0x71, 0xa7,
-	0x43, 0xbd, 0x83, 0x6a, 0xdb, 0x63, 0x3e, 0x23, 0xcf, 0x06, 0xc7, 0xd5, 0xe0, 0xb8, 0x8a, 0xc7,
-	0xa5, 0x72, 0x83, 0xf1, 0x3d, 0xc6, 0x0d, 0xab, 0xe3, 0xef, 0x84, 0x3a, 0xc1, 0x42, 0xaa, 0x94,
-	0x56, 0xf1, 0xbc, 0x6e, 0x71, 0x2a, 0x6d, 0x85, 0x52, 0x6d, 0xab, 0xe9, 0xb8, 0x96, 0xef, 0x30,
-	0x17, 0x65, 0xcb, 0xbd, 0xb2, 0x4a, 0xaa, 0xc1, 0x1c, 0x75, 0x5e, 0x94, 0xe7, 0xdb, 0x62, 0x65,
-	0xc8, 0x05, 0x1e, 0xe5, 0x9b, 0xac, 0xc9, 0xe4, 0x7e, 0xf0, 0x1f, 0xee, 0xce, 0x34, 0x19, 0x6b,
-	0xb6, 0xa8, 0x61, 0xb5, 0x1d, 0xc3, 0x72, 0x5d, 0xe6, 0x0b, 0x6f, 0x4a, 0x67, 0xa6, 0x3f, 0x58,
-	0x11, 0x9a, 0x38, 0xd5, 0xf3, 0x40, 0xde, 0x0a, 0xe0, 0xbe, 0x69, 0x79, 0xd6, 0x1e, 0x37, 0xe9,
-	0x9d, 0x0e, 0xe5, 0xbe, 0xfe, 0x06, 0x3c, 0x17, 0xd9, 0xe5, 0x6d, 0xe6, 0x72, 0x4a, 0x5e, 0x82,
-	0xd1, 0xb6, 0xd8, 0x29, 0x68, 0x73, 0xda, 0xca, 0xb9, 0xcd, 0x62, 0xb5, 0x8f, 0xa9, 0xaa, 0x54,
-	0xa9, 0x3d, 0xf3, 0xe8, 0x8f, 0x4b, 0x43, 0x26, 0x8a, 0xeb, 0x17, 0x20, 0x2f, 0xec, 0x5d, 0x6f,
-	0x34, 0x58, 0xc7, 0xf5, 0x43, 0x3f, 0xef, 0xc1, 0xf3, 0xb1, 0x7d, 0xf4, 0x74, 0x03, 0xc6, 0x2c,
-	0xdc, 0x2b, 0x68, 0x73, 0xc3, 0x2b, 0xe7, 0x36, 0xf5, 0x2a, 0x32, 0x21, 0x58, 0x57, 0xde, 0xb6,
-	0x98, 0xdd, 0x69, 0x51, 0x54, 0x47, 0xa7, 0xa1, 0xa6, 0xfe, 0xb5, 0x86, 0x7e, 0x6f, 0xd0, 0x36,
-	0xe3, 0x4e, 0xe8, 0x97, 0xe4, 0x61, 0xc4, 0xa6, 0x2e, 0xdb, 0x13, 0x71, 0x9c, 0x35, 0xe5, 0x82,
-	0x54, 0x61, 0x84, 0xdd, 0x75, 0xa9, 0x57, 0xc8, 0x05, 0xbb, 0xb5, 0xc2, 0xaf, 0xdf, 0x57, 0xf2,
-	0xe8, 0xf4, 0xba, 0x6d, 0x7b, 0x94, 0xf3, 0x5b, 0xbe, 0xe7, 0xb8, 0x4d, 0x53, 0x8a, 0x91, 0x9b,
-	0x00, 0xdd, 0xcb, 0x2d, 0x0c, 0x0b, 0x4a, 0x96, 0x14, 0xcc, 0xe0, 0x76, 0xab, 0x32, 0xab, 0xba,
-	0xd4, 0x34, 0x29, 0x22, 0x30, 0x7b, 0x34, 0xf5, 0x1f, 0x35, 0xa4, 0xa1, 0x0b, 0x13, 0x69, 0x78,
-	0x07, 0xc6, 0x6c, 0xdc, 0x0b, 0x69, 0xe8, 0xa7, 0x1c, 0xd5, 0x94, 0x56, 0xad, 0x10, 0xd0, 0xf0,
-	0xcd, 0x9f, 0x97, 0xa6, 0x62, 0x07, 0xdc, 0x0c, 0xad, 0x91, 0x57, 0x23, 0xd8, 0x73, 0x02, 0xfb,
-	0xf2, 0x40, 0xec, 0xd2, 0x4e, 0x04, 0xfc, 0x77, 0x1a, 0xcc, 0x08, 0xf0, 0x6f, 0xbb, 0xfc, 0xc0,
-	0x6d, 0x50, 0xfb, 0x74, 0x73, 0xfd, 0xb3, 0x06, 0xb3, 0x29, 0x70, 0x9f, 0x1e, 0xce, 0x37, 0xa1,
-	0x24, 0x62, 0xb8, 0xcd, 0x7c, 0xab, 0x85, 0x0e, 0xa9, 0x9d, 0x49, 0xb8, 0xfe, 0x89, 0x06, 0xd3,
-	0x89, 0x4a, 0x18, 0xb6, 0x07, 0x13, 0xbc, 0xd3, 0x6e, 0xb7, 0x1c, 0x6a, 0x6f, 0x07, 0xc5, 0x88,
-	0x17, 0x72, 0x22, 0xf8, 0x62, 0x04, 0xa0, 0x82, 0xf6, 0x0a, 0x73, 0xdc, 0xda, 0x3a, 0xc6, 0xbc,
-	0xd2, 0x74, 0xfc, 0x9d, 0x4e, 0xbd, 0xda, 0x60, 0x7b, 0x58, 0xae, 0xf0, 0x4f, 0x85, 0xdb, 0xbb,
-	0x86, 0x7f, 0xd0, 0xa6, 0x5c, 0x28, 0x70, 0x73, 0x5c, 0xb9, 0x10, 0x4b, 0xfd, 0xa1, 0x86, 0x75,
-	0xa6, 0xc6, 0x3c, 0x8f, 0xdd, 0x3d, 0xa5, 0x29, 0xf3, 0x83, 0xaa, 0x22, 0x21, 0x4a, 0xa4, 0xec,
-	0x36, 0x9c, 0xa9, 0xcb, 0x2d, 0x4c, 0x94, 0xf9, 0x84, 0x44, 0x91, 0x4a, 0x61, 0x9e, 0x5c, 0x44,
-	0xce, 0x26, 0xa3, 0xfb, 0xdc, 0x54, 0xa6, 0xfe, 0xbb, 0x2c, 0xf9, 0x56, 0xdd, 0xb8, 0x4a, 0xf5,
-	0x53, 0xcd, 0xf2, 0x4f, 0xf1, 0x3a, 0xf2, 0x94, 0xb1, 0xbd, 0x01, 0xc5, 0xee, 0xf3, 0x92, 0xee,
-	0x06, 0x3d, 0xc9, 0x07, 0x5a, 0xef, 0x3b, 0xee, 0xea, 0x74, 0x5f, 0x64, 0x1d, 0xf7, 0x9e, 0xe0,
-	0x8b, 0x54, 0x2e, 0xe4, 0x8b, 0x5c, 0x87, 0x82, 0x40, 0xf4, 0x9a, 0xeb, 0x53, 0x2f, 0xb8, 0x22,
-	0xcb, 0xa7, 0x03, 0x83, 0x28, 0x26, 0xa8, 0x60, 0x0c, 0x1c, 0x26, 0x1c, 0xdc, 0xdf, 0xf6, 0x2c,
-	0x9f, 0xaa, 0xbb, 0x5b, 0x4d, 0xb8, 0xbb, 0x2d, 0xe6, 0xd2, 0x83, 0x2d, 0xcb, 0xdb, 0xa5, 0x7e,
-	0xaf, 0xad, 0xda, 0x1c, 0x06, 0x55, 0x48, 0x11, 0xe0, 0xe6, 0xb8, 0xd3, 0xbb, 0xd4, 0xd7, 0xf0,
-	0xbd, 0x9a, 0x94, 0x53, 0x6f, 0x9f, 0x66, 0x27, 0xbc, 0xfe, 0x21, 0x7e, 0x7c, 0xbb, 0xd2, 0x88,
-	0xbd, 0x01, 0xa3, 0xd6, 0x5e, 0xd0, 0x48, 0x3c, 0x09, 0xde, 0xd1, 0xb4, 0x7e, 0x15, 0xdf, 0xa8,
-	0x0a, 0xe8, 0xa6, 0xd5, 0xf0, 0x99, 0x37, 0x00, 0xf2, 0xc7, 0xea, 0xad, 0xf4, 0x69, 0x21, 0x74,
-	0x0a, 0x53, 0x21, 0xed, 0xef, 0xcb, 0xb3, 0x8c, 0x47, 0x13, 0xb5, 0xd2, 0x7d, 0x34, 0x71, 0xeb,
-	0x93, 0x4e, 0x74, 0x43, 0xff, 0x32, 0x07, 0x93, 0xb1, 0xef, 0x1d, 0x79, 0x11, 0xce, 0xe2, 0x07,
-	0x8f, 0x79, 0x12, 0x75, 0x46, 0x0d, 0xe9, 0x8a, 0xfe, 0x2f, 0x6c, 0x93, 0x16, 0x8c, 0x38, 0xae,
-	0x4d, 0xef, 0x15, 0x86, 0x85, 0x0f, 0x23, 0x81, 0x8c, 0x5b, 0xc1, 0x17, 0x2a, 0x46, 0x6c, 0x58,
-	0x4f, 0x16, 0xd1, 0xf3, 0x6c, 0x96, 0x14, 0x37, 0xa5, 0x13, 0xfd, 0x75, 0x98, 0xc9, 0x92, 0x4b,
-	0x29, 0xc0, 0x79, 0x18, 0xd9, 0xb7, 0x5a, 0x1d, 0x2a, 0x0b, 0xb0, 0x29, 0x17, 0xfa, 0xe7, 0x39,
-	0x98, 0x88, 0x16, 0x31, 0x72, 0x0d, 0xc6, 0xf0, 0xf1, 0x0e, 0x26, 0x3a, 0x94, 0x3c, 0x35, 0x3c,
-	0xcb, 0x60, 0x06, 0xf1, 0x9c, 0x25, 0xd5, 0xcb, 0x73, 0x96, 0xdc, 0xbf, 0xe2, 0xf9, 0x50, 0x83,
-	0x8b, 0x29, 0x75, 0x26, 0xc5, 0xce, 0x3a, 0xe4, 0x45, 0x57, 0x73, 0xb0, 0x1d, 0xa9, 0x74, 0x68,
-	0x96, 0xf0, 0x48, 0x06, 0x08, 0x3b, 0xeb, 0x90, 0x97, 0xd7, 0x11, 0xd3, 0x18, 0x96, 0x1a, 0xf5,
-	0x48, 0x2c, 0x81, 0x86, 0xfe, 0xa9, 0x06, 0x13, 0xd1, 0xe0, 0x52, 0xc0, 0x5c, 0x83, 0x0b, 0x71,
-	0xd3, 0xf2, 0xfd, 0x23, 0x9c, 0x7c, 0x3d, 0x81, 0xa8, 0x40, 0x2b, 0x1e, 0x02, 0x6a, 0x49, 0x48,
-	0x79, 0x9e, 0x90, 0xc6, 0x9b, 0xbf, 0x9c, 0x87, 0x11, 0x51, 0x85, 0xc8, 0x07, 0x30, 0x2a, 0xc7,
-	0x3e, 0xb2, 0x98, 0x70, 0xd3, 0xfd, 0xf3, 0x65, 0x69, 0x69, 0x90, 0x98, 0xbc, 0x39, 0x7d, 0xfe,
-	0xfe, 0x6f, 0x7f, 0x1f, 0xe6, 0xa6, 0x49, 0xd1, 0xe8, 0x1f, 0x62, 0xe5, 0x68, 0x49, 0xee, 0x6b,
-	0x30, 0xa6, 0xc6, 0x47, 0xb2, 0x9c, 0x66, 0x37, 0x36, 0x78, 0x96, 0x56, 0x06, 0x0b, 0x22, 0x84,
-	0x05, 0x01, 0x61, 0x96, 0x4c, 0x27, 0x40, 0x50, 0x83, 0xa6, 0x00, 0xa1, 0x06, 0x89, 0x74, 0x10,
-	0xb1, 0xc9, 0x28, 0x1d, 0x44, 0x7c, 0x26, 0xc9, 0x04, 0x11, 0x8e, 0x17, 0x0f, 0x35, 0x98, 0x8a,
-	0x4f, 0x35, 0xc4, 0x48, 0xf3, 0x91, 0x32, 0xae, 0x95, 0xd6, 0x4f, 0xae, 0x80, 0xe0, 0xd6, 0x04,
-	0xb8, 0x25, 0x72, 0x39, 0x01, 0x5c, 0x07, 0x95, 0x2a, 0x21, 0xca, 0x2f, 0x34, 0x98, 0x88, 0x8e,
-	0x20, 0xa4, 0x92, 0xe6, 0x32, 0x71, 0xbe, 0x29, 0x55, 0x4f, 0x2a, 0x8e, 0xf8, 0x56, 0x05, 0xbe,
-	0xcb, 0x44, 0x4f, 0xc0, 0xe7, 0x07, 0x2a, 0x0a, 0x1c, 0xb5, 0xc9, 0x47, 0x70, 0x06, 0xfb, 0x4e,
-	0x92, 0x9a, 0xa3, 0xd1, 0x36, 0xba, 0xb4, 0x3c, 0x50, 0x0e, 0x71, 0xe8, 0x02, 0xc7, 0x0c, 0x29,
-	0x25, 0xe0, 0x50, 0xed, 0xe8, 0x57, 0x1a, 0x4c, 0xc6, 0x1a, 0x60, 0x52, 0x1d, 0x74, 0x23, 0x31,
-	0x40, 0xc6, 0x89, 0xe5, 0x11, 0xd8, 0x15, 0x01, 0x6c, 0x91, 0x2c, 0x64, 0x5d, 0xa0, 0x42, 0xf8,
-	0x99, 0x06, 0xe3, 0x91, 0x7e, 0x95, 0xac, 0x65, 0xde, 0x47, 0xac, 0x15, 0x2e, 0x55, 0x4e, 0x28,
-	0x8d, 0xd8, 0x5e, 0x10, 0xd8, 0x16, 0xc8, 0x7c, 0xea, 0xe5, 0xa9, 0x06, 0x96, 0x1c, 0x6a, 0x70,
-	0x3e, 0x52, 0x67, 0xaf, 0xa4, 0xb9, 0x4a, 0xe8, 0x6e, 0x4b, 0x6b, 0x27, 0x13, 0x46, 0x58, 0x2b,
-	0x02, 0x96, 0x4e, 0xe6, 0x12, 0x60, 0xa9, 0x1a, 0x5a, 0x09, 0x8a, 0xba, 0x28, 0x0d, 0xaa, 0xb5,
-	0x4c, 0x2f, 0x0d, 0xb1, 0x56, 0x35, 0xbd, 0x34, 0xc4, 0xbb, 0xd4, 0xcc, 0xd2, 0xe0, 0x29, 0xbf,
-	0x41, 0x5a, 0xc5, 0xba, 0xb9, 0xf4, 0xb4, 0x4a, 0x6e, 0x45, 0xd3, 0xd3, 0x2a, 0xa5, 0x09, 0xcd,
-	0x4c, 0xab, 0x90, 0x23, 0xec, 0x4e, 0x6b, 0x2f, 0x3f, 0x3a, 0x2a, 0x6b, 0x8f, 0x8f, 0xca, 0xda,
-	0x5f, 0x47, 0x65, 0xed, 0xc1, 0x71, 0x79, 0xe8, 0xf1, 0x71, 0x79, 0xe8, 0xf7, 0xe3, 0xf2, 0xd0,
-	0xbb, 0x4b, 0x3d, 0xdd, 0x47, 0x60, 0xa8, 0xd2, 0xb2, 0xea, 0x5c, 0x9a, 0xbc, 0x27, 0x8d, 0x8a,
-	0x0e, 0xa4, 0x3e, 0x2a, 0x7e, 0xd0, 0xbc, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0x6b,
-	0x16, 0xfb, 0xdd, 0x15, 0x00, 0x00,
+	// 1712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x14, 0xc9,
+	0x15, 0x77, 0x8f, 0x3d, 0xf6, 0xf0, 0xc0, 0x1f, 0x54, 0x06, 0x68, 0x37, 0xf6, 0x60, 0x1a, 0x6c,
+	0x06, 0xe3, 0x99, 0x31, 0x06, 0x25, 0x51, 0x94, 0x43, 0x18, 0x3b, 0x44, 0x89, 0xe4, 0x88, 0x0c,
+	0x10, 0xa1, 0x48, 0x68, 0x52, 0x33, 0x5d, 0x19, 0x77, 0x18, 0x77, 0x0d, 0xdd, 0x3d, 0x36, 0x93,
+	0x84, 0x20, 0x21, 0xe5, 0x4e, 0xc2, 0x21, 0x87, 0x44, 0xca, 0x81, 0x28, 0x87, 0x24, 0x47, 0xf6,
+	0xb2, 0xd2, 0x5e, 0xf6, 0xc4, 0x11, 0xb1, 0x97, 0xd5, 0x1e, 0xd8, 0x15, 0xac, 0xf6, 0x2f, 0xd8,
+	0xc3, 0x1e, 0x57, 0x5d, 0xf5, 0xba, 0x67, 0xba, 0xdd, 0x3d, 0x33, 0xbb, 0xb2, 0x57, 0xe6, 0x84,
+	0xbb, 0xea, 0xbd, 0xf7, 0xfb, 0xd5, 0xfb, 0xaa, 0x9a, 0x07, 0xcc, 0xdf, 0xa3, 0x3b, 0xb4, 0xb4,
+	0x45, 0x6d, 0xa3, 0xb4, 0x73, 0xb9, 0xc6, 0x5c, 0x7a, 0xb9, 0x74, 0xbf, 0xcd, 0xec, 0x4e, 0xb1,
+	0x65, 0x73, 0x97, 0x93, 0xe3, 0xde, 0x76, 0xd1, 0xdb, 0x2e, 0xe2, 0xb6, 0x96, 0xab, 0x73, 0x67,
+	0x9b, 0x3b, 0x25, 0xda, 0x76, 0xb7, 0x02, 0x1d, 0xef, 0x43, 0xaa, 0x68, 0xcb, 0xb8, 0x5f, 0xa3,
+	0x0e, 0x93, 0xb6, 0x02, 0xa9, 0x16, 0x6d, 0x98, 0x16, 0x75, 0x4d, 0x6e, 0xa1, 0x6c, 0xae, 0x57,
+	0xd6, 0x97, 0xaa, 0x73, 0xd3, 0xdf, 0x9f, 0x95, 0xfb, 0x55, 0xf1, 0x55, 0x92, 0x1f, 0xb8, 0x95,
+	0x6d, 0xf0, 0x06, 0x97, 0xeb, 0xde, 0x5f, 0xb8, 0x3a, 0xd7, 0xe0, 0xbc, 0xd1, 0x64, 0x25, 0xda,
+	0x32, 0x4b, 0xd4, 0xb2, 0xb8, 0x2b, 0xd0, 0x7c, 0x9d, 0xb9, 0xbd, 0x87, 0x15, 0x47, 0x13, 0xbb,
+	0x7a, 0x16, 0xc8, 0xaf, 0x3c, 0xba, 0x37, 0xa8, 0x4d, 0xb7, 0x9d, 0x0a, 0xbb, 0xdf, 0x66, 0x8e,
+	0xab, 0xff, 0x12, 0xbe, 0x17, 0x5a, 0x75, 0x5a, 0xdc, 0x72, 0x18, 0xf9, 0x01, 0x8c, 0xb7, 0xc4,
+	0x8a, 0xaa, 0x2c, 0x28, 0xf9, 0xa3, 0x6b, 0xb3, 0xc5, 0x3d, 0x9e, 0x2a, 0x4a, 0x95, 0xf2, 0xd8,
+	0x8b, 0xd7, 0x67, 0x46, 0x2a, 0x28, 0xae, 0x9f, 0x84, 0xac, 0xb0, 0x77, 0xad, 0x5e, 0xe7, 0x6d,
+	0xcb, 0x0d, 0x70, 0xee, 0xc2, 0x89, 0xc8, 0x3a, 0x22, 0x6d, 0x40, 0x86, 0xe2, 0x9a, 0xaa, 0x2c,
+	0x8c, 0xe6, 0x8f, 0xae, 0xe9, 0x45, 0xf4, 0x84, 0xf0, 0xba, 0x8f, 0xb6, 0xc9, 0x8d, 0x76, 0x93,
+	0xa1, 0x3a, 0x82, 0x06, 0x9a, 0xfa, 0xbf, 0x15, 0xc4, 0xdd, 0x60, 0x2d, 0xee, 0x98, 0x01, 0x2e,
+	0xc9, 0x42, 0xda, 0x60, 0x16, 0xdf, 0x16, 0xe7, 0x38, 0x52, 0x91, 0x1f, 0xa4, 0x08, 0x69, 0xbe,
+	0x6b, 0x31, 0x5b, 0x4d, 0x79, 0xab, 0x65, 0xf5, 0xd5, 0xf3, 0x42, 0x16, 0x41, 0xaf, 0x19, 0x86,
+	0xcd, 0x1c, 0xe7, 0xa6, 0x6b, 0x9b, 0x56, 0xa3, 0x22, 0xc5, 0xc8, 0x75, 0x80, 0x6e, 0x70, 0xd5,
+	0x51, 0xe1, 0x92, 0x25, 0x9f, 0xa6, 0x17, 0xdd, 0xa2, 0xcc, 0xaa, 0xae, 0x6b, 0x1a, 0x0c, 0x19,
+	0x54, 0x7a, 0x34, 0xf5, 0xf7, 0x15, 0x38, 0x11, 0xa1, 0x89, 0x6e, 0xb8, 0x03, 0x19, 0x03, 0xd7,
+	0x02, 0x37, 0xec, 0x75, 0x39, 0xaa, 0xf9, 0x5a, 0x65, 0xd5, 0x73, 0xc3, 0x7f, 0x3f, 0x3d, 0x33,
+	0x13, 0xd9, 0x70, 0x2a, 0x81, 0x35, 0xf2, 0xb3, 0x10, 0xf7, 0x94, 0xe0, 0x7e, 0x61, 0x20, 0x77,
+	0x69, 0x27, 0x44, 0xfe, 0xff, 0x0a, 0xcc, 0x09, 0xf2, 0xb7, 0x2d, 0xa7, 0x63, 0xd5, 0x99, 0x71,
+	0xb8, 0x7d, 0xfd, 0xa1, 0x02, 0xf3, 0x09, 0x74, 0xdf, 0x1d, 0x9f, 0xaf, 0x81, 0x26, 0xce, 0x70,
+	0x8b, 0xbb, 0xb4, 0x89, 0x80, 0xcc, 0xe8, 0xeb, 0x70, 0xfd, 0xaf, 0x0a, 0x9c, 0x8e, 0x55, 0xc2,
+	0x63, 0xdb, 0x30, 0xe5, 0xb4, 0x5b, 0xad, 0xa6, 0xc9, 0x8c, 0xaa, 0xd7, 0x8c, 0x1c, 0x35, 0x25,
+	0x0e, 0x3f, 0x1b, 0x22, 0xe8, 0x53, 0x5b, 0xe7, 0xa6, 0x55, 0x5e, 0xc5, 0x33, 0xe7, 0x1b, 0xa6,
+	0xbb, 0xd5, 0xae, 0x15, 0xeb, 0x7c, 0x1b, 0xdb, 0x15, 0xfe, 0x53, 0x70, 0x8c, 0x7b, 0x25, 0xb7,
+	0xd3, 0x62, 0x8e, 0x50, 0x70, 0x2a, 0x93, 0x3e, 0x84, 0xf8, 0xd4, 0x9f, 0x29, 0xd8, 0x67, 0xca,
+	0xdc, 0xb6, 0xf9, 0xee, 0x21, 0x4d, 0x99, 0xf7, 0xfc, 0x2e, 0x12, 0xb0, 0x44, 0x97, 0xdd, 0x82,
+	0x89, 0x9a, 0x5c, 0xc2, 0x44, 0x39, 0x1b, 0x93, 0x28, 0x52, 0x29, 0xc8, 0x93, 0x53, 0xe8, 0xb3,
+	0xe9, 0xf0, 0xba, 0x53, 0xf1, 0x4d, 0xed, 0x5f, 0x96, 0xfc, 0xcf, 0x8f, 0xb8, 0x9f, 0xea, 0x87,
+	0xda, 0xcb, 0x1f, 0x44, 0xfb, 0xc8, 0x3b, 0xe6, 0xed, 0xcb, 0x30, 0xdb, 0x2d, 0x2f, 0x09, 0x37,
+	0xa8, 0x24, 0x9f, 0x28, 0xa0, 0xc5, 0xe9, 0x74, 0x2b, 0xb2, 0x86, 0x6b, 0x07, 0x58, 0x91, 0x3e,
+	0x84, 0xac, 0xc8, 0x55, 0x50, 0x05, 0xa3, 0x9f, 0x5b, 0x2e, 0xb3, 0xbd, 0x10, 0x51, 0x97, 0x0d,
+	0x3c, 0xc4, 0x6c, 0x8c, 0x0a, 0x9e, 0xc1, 0x81, 0x29, 0x13, 0xd7, 0xab, 0x36, 0x75, 0x99, 0x1f,
+	0xbb, 0xe5, 0x98, 0xd8, 0x6d, 0x72, 0x8b, 0x75, 0x36, 0xa9, 0x7d, 0x8f, 0xb9, 0xbd, 0xb6, 0xca,
+	0x0b, 0x78, 0x28, 0x35, 0x41, 0xc0, 0xa9, 0x4c, 0x9a, 0xbd, 0x9f, 0xfa, 0x0a, 0xd6, 0x6b, 0x85,
+	0x39, 0xcc, 0xde, 0x61, 0xfd, 0x13, 0x5e, 0xff, 0x13, 0x9c, 0x88, 0x48, 0x23, 0xf7, 0x3a, 0x8c,
+	0xd3, 0x6d, 0xef, 0x21, 0x71, 0x10, 0x7e, 0x47, 0xd3, 0xfa, 0x15, 0xac, 0x51, 0xff, 0x40, 0xd7,
+	0x69, 0xdd, 0xe5, 0xf6, 0x00, 0xca, 0x7f, 0xf1, 0x6b, 0x65, 0x8f, 0x16, 0x52, 0x67, 0x30, 0x13,
+	0xb8, 0xfd, 0x77, 0x72, 0xaf, 0x4f, 0xd1, 0x84, 0xad, 0x74, 0x8b, 0x26, 0x6a, 0x7d, 0xda, 0x0c,
+	0x2f, 0xe8, 0xeb, 0x70, 0x5c, 0xd0, 0xf8, 0xe9, 0x26, 0x37, 0x82, 0x34, 0x09, 0x1a, 0x88, 0x32,
+	0x54, 0x03, 0xd1, 0xef, 0x00, 0xe9, 0x35, 0x82, 0x27, 0x28, 0x43, 0xa6, 0x4e, 0x5d, 0xd6, 0xe0,
+	0x76, 0x07, 0x1f, 0x9b, 0x0b, 0x31, 0xcc, 0x85, 0xce, 0x3a, 0xca, 0xf9, 0xcf, 0x3f, 0x5f, 0x4f,
+	0xff, 0x62, 0x14, 0xdd, 0x74, 0xd3, 0xdc, 0x6e, 0x37, 0xa9, 0xcb, 0x6e, 0x78, 0x77, 0x9e, 0xc9,
+	0xad, 0x6f, 0x49, 0x95, 0x30, 0x98, 0xc0, 0xcb, 0xfc, 0x20, 0x52, 0xc2, 0xb7, 0x4d, 0x1a, 0x90,
+	0xd9, 0x35, 0xdd, 0x2d, 0xc3, 0xa6, 0xbb, 0xea, 0xe8, 0xfe, 0xe3, 0x04, 0xc6, 0xbd, 0x0c, 0x97,
+	0xe5, 0xaf, 0x8e, 0x1d, 0x40, 0x86, 0x4b, 0xd3, 0x84, 0x42, 0xda, 0x66, 0x2d, 0xda, 0x51, 0xd3,
+	0xfb, 0x8f, 0x21, 0x2d, 0xeb, 0xaf, 0xc7, 0x61, 0x3e, 0x21, 0xd0, 0x41, 0x41, 0x04, 0x91, 0x53,
+	0x0e, 0x30, 0x72, 0x5d, 0x87, 0xa6, 0x0e, 0xce, 0xa1, 0x8f, 0x80, 0x20, 0x5e, 0xb5, 0xed, 0x18,
+	0xd5, 0x1d, 0xda, 0x6c, 0x33, 0x07, 0x13, 0x65, 0x2e, 0x16, 0x70, 0x83, 0xd5, 0x05, 0xe6, 0x15,
+	0xc4, 0xbc, 0x34, 0x04, 0x26, 0xea, 0x38, 0x95, 0x19, 0x04, 0xbb, 0xed, 0x18, 0xbf, 0x16, 0x50,
+	0xe4, 0x21, 0x1c, 0x97, 0x54, 0x7a, 0xf1, 0xc7, 0x0e, 0x0a, 0x7f, 0x5a, 0x62, 0x75, 0xe1, 0x7f,
+	0x0b, 0x93, 0x4d, 0x4e, 0xad, 0xaa, 0xcb, 0x25, 0xb6, 0x9a, 0x16, 0xd5, 0xfb, 0x63, 0xcf, 0xf8,
+	0x27, 0xaf, 0xcf, 0x2c, 0x0d, 0x67, 0xfc, 0xd5, 0xf3, 0x02, 0x20, 0xd7, 0x0d, 0x56, 0xaf, 0x1c,
+	0xf5, 0x4c, 0xde, 0xe2, 0x02, 0x82, 0x30, 0x40, 0xd0, 0x6a, 0x9d, 0xb6, 0x68, 0xdd, 0x74, 0x3b,
+	0xea, 0xf8, 0x3e, 0x60, 0xe0, 0x75, 0xbe, 0x8e, 0x36, 0x09, 0x85, 0xc9, 0x2d, 0x46, 0x9b, 0xee,
+	0x16, 0xf6, 0x68, 0x75, 0x62, 0x1f, 0x40, 0x8e, 0x49, 0x93, 0xb2, 0x45, 0x13, 0x0d, 0x32, 0x36,
+	0xfb, 0x3d, 0xab, 0xbb, 0xcc, 0x50, 0x33, 0x0b, 0x4a, 0x3e, 0x53, 0x09, 0xbe, 0xc9, 0x45, 0x98,
+	0x91, 0x7f, 0x9b, 0xdc, 0xaa, 0xda, 0x8c, 0x3a, 0xdc, 0x52, 0x8f, 0x88, 0x6b, 0x66, 0x3a, 0x58,
+	0xaf, 0x88, 0x65, 0xfd, 0x9f, 0x29, 0x98, 0x8e, 0xfc, 0xb0, 0x21, 0xdf, 0x87, 0x23, 0x98, 0x19,
+	0x7c, 0x70, 0x03, 0xed, 0x8a, 0x7e, 0x27, 0xd7, 0x2a, 0x69, 0x42, 0xda, 0xb4, 0x0c, 0xf6, 0x00,
+	0xcb, 0xa2, 0x14, 0x73, 0x77, 0xdc, 0xf4, 0x7e, 0x8a, 0x44, 0x6e, 0xd0, 0xe0, 0xe1, 0xb8, 0x88,
+	0xc8, 0xf3, 0xfd, 0xa4, 0x9c, 0x8a, 0x04, 0xd1, 0x7f, 0x01, 0x73, 0xfd, 0xe4, 0x12, 0x5e, 0xda,
+	0x59, 0x48, 0xcb, 0xfc, 0x4d, 0xc9, 0x55, 0xf1, 0xa1, 0x7f, 0x95, 0x82, 0xa9, 0xf0, 0x6b, 0x95,
+	0x5c, 0x85, 0x0c, 0xbe, 0xd2, 0x06, 0x3b, 0x3a, 0x90, 0x3c, 0x34, 0x7e, 0x96, 0x87, 0x19, 0xe4,
+	0xe7, 0x7e, 0x52, 0xbe, 0x9f, 0xc9, 0xdd, 0x68, 0xc1, 0x8c, 0x09, 0x6f, 0xfc, 0x70, 0x7f, 0x8a,
+	0xc5, 0x0b, 0x63, 0x3f, 0x1a, 0xdf, 0x28, 0x8c, 0x4f, 0x15, 0x38, 0x95, 0xf0, 0x5e, 0x4d, 0xb0,
+	0xb3, 0x0a, 0x59, 0xf1, 0xeb, 0xb8, 0x53, 0x0d, 0xbd, 0x98, 0xd1, 0x2c, 0x71, 0x42, 0x09, 0x26,
+	0xec, 0xac, 0x42, 0x16, 0xdb, 0x54, 0x58, 0x63, 0x54, 0x6a, 0xd4, 0x42, 0x67, 0xf1, 0x34, 0xf4,
+	0xbf, 0x29, 0x30, 0x15, 0x3e, 0x5c, 0x02, 0x99, 0xab, 0x70, 0x32, 0x6a, 0x1a, 0x5d, 0x2e, 0xe9,
+	0x64, 0x6b, 0x31, 0x8e, 0xf2, 0xb4, 0xa2, 0x47, 0x40, 0x2d, 0x49, 0x29, 0xeb, 0xc4, 0x54, 0xc9,
+	0xda, 0x97, 0x53, 0x90, 0x16, 0xb7, 0x37, 0xf9, 0x03, 0x8c, 0xcb, 0xf1, 0x21, 0x59, 0x8c, 0x49,
+	0xa4, 0xbd, 0x73, 0x4a, 0x6d, 0x69, 0x90, 0x98, 0x8c, 0x9c, 0x7e, 0xf6, 0xf1, 0x47, 0x9f, 0x3f,
+	0x4d, 0x9d, 0x26, 0xb3, 0xa5, 0xbd, 0xc3, 0x50, 0x39, 0xa2, 0x24, 0x8f, 0x15, 0xc8, 0xf8, 0x63,
+	0x48, 0x72, 0x21, 0xc9, 0x6e, 0x64, 0x80, 0xa9, 0xe5, 0x07, 0x0b, 0x22, 0x85, 0x73, 0x82, 0xc2,
+	0x3c, 0x39, 0x1d, 0x43, 0xc1, 0x1f, 0x58, 0x0a, 0x12, 0xfe, 0x40, 0x2a, 0x99, 0x44, 0x64, 0xc2,
+	0xa6, 0xe5, 0x07, 0x0b, 0x0e, 0x41, 0x22, 0x18, 0x53, 0x3d, 0x53, 0x60, 0x26, 0x3a, 0x1d, 0x23,
+	0xa5, 0x24, 0x8c, 0x84, 0xb1, 0x9f, 0xb6, 0x3a, 0xbc, 0x02, 0x92, 0x5b, 0x11, 0xe4, 0x96, 0xc8,
+	0xf9, 0x18, 0x72, 0x6d, 0x54, 0x2a, 0x04, 0x2c, 0xff, 0xa1, 0xc0, 0x54, 0x78, 0x94, 0x45, 0x0a,
+	0x49, 0x90, 0xb1, 0x73, 0x32, 0xad, 0x38, 0xac, 0x38, 0xf2, 0x5b, 0x16, 0xfc, 0xce, 0x13, 0x3d,
+	0x86, 0x9f, 0xeb, 0xa9, 0xf8, 0xe4, 0x98, 0x41, 0xfe, 0x0c, 0x13, 0x38, 0xbf, 0x20, 0x89, 0x39,
+	0x1a, 0x1e, 0xc7, 0x68, 0x17, 0x06, 0xca, 0x21, 0x0f, 0x5d, 0xf0, 0x98, 0x23, 0x5a, 0x0c, 0x0f,
+	0x7f, 0xac, 0xf1, 0x2f, 0x05, 0xa6, 0x23, 0x83, 0x14, 0x52, 0x1c, 0x14, 0x91, 0x08, 0xa1, 0xd2,
+	0xd0, 0xf2, 0x48, 0xec, 0x92, 0x20, 0xb6, 0x48, 0xce, 0xf5, 0x0b, 0xa0, 0xcf, 0xf0, 0xef, 0x0a,
+	0x4c, 0x86, 0xe6, 0x1e, 0x64, 0xa5, 0x6f, 0x3c, 0x22, 0x23, 0x15, 0xad, 0x30, 0xa4, 0x34, 0x72,
+	0xbb, 0x28, 0xb8, 0x9d, 0x23, 0x67, 0x13, 0x83, 0xe7, 0x0f, 0x42, 0xc8, 0x53, 0x05, 0x8e, 0x85,
+	0xfa, 0xec, 0xa5, 0x24, 0xa8, 0x98, 0x29, 0x89, 0xb6, 0x32, 0x9c, 0x30, 0xd2, 0xca, 0x0b, 0x5a,
+	0x3a, 0x59, 0x88, 0xa1, 0xe5, 0xf7, 0xd0, 0x82, 0xed, 0x91, 0xf0, 0x5a, 0x83, 0x3f, 0xa2, 0x48,
+	0x6e, 0x0d, 0x91, 0x91, 0x87, 0x96, 0x1f, 0x2c, 0x38, 0x44, 0x6b, 0xb0, 0x7d, 0x5c, 0x2f, 0xad,
+	0x22, 0x53, 0x81, 0xe4, 0xb4, 0x8a, 0x1f, 0x69, 0x68, 0xa5, 0xa1, 0xe5, 0x87, 0x48, 0xab, 0xc0,
+	0x47, 0x38, 0xe5, 0x20, 0x8f, 0x20, 0x2d, 0x86, 0x02, 0xe4, 0x7c, 0x12, 0x4c, 0xef, 0xb0, 0x42,
+	0x5b, 0x1c, 0x20, 0x35, 0x44, 0xf6, 0xb0, 0xc2, 0x36, 0x37, 0x58, 0xe9, 0x8f, 0x62, 0x44, 0xf0,
+	0x90, 0xfc, 0x47, 0x81, 0x99, 0xe8, 0xcf, 0xd0, 0xe4, 0xee, 0x99, 0x30, 0x99, 0xd0, 0x56, 0x87,
+	0x57, 0x40, 0x8a, 0x25, 0x41, 0xf1, 0xa2, 0x1e, 0xd7, 0x3d, 0x1d, 0x54, 0x2a, 0xb4, 0x50, 0xeb,
+	0x47, 0xca, 0x72, 0xf9, 0x27, 0x2f, 0xde, 0xe4, 0x94, 0x97, 0x6f, 0x72, 0xca, 0x67, 0x6f, 0x72,
+	0xca, 0x93, 0xb7, 0xb9, 0x91, 0x97, 0x6f, 0x73, 0x23, 0x1f, 0xbf, 0xcd, 0x8d, 0xfc, 0xa6, 0xf7,
+	0x2d, 0xe5, 0x19, 0x2b, 0x34, 0x69, 0xcd, 0x91, 0x66, 0x1f, 0x48, 0xc3, 0xe2, 0x3d, 0x55, 0x1b,
+	0x17, 0xff, 0x85, 0x78, 0xe5, 0xeb, 0x01, 0x00, 0xad, 0x95, 0xa6, 0x0e, 0x4f, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1614,6 +1915,10 @@ type QueryClient interface {
 	Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error)
 	// InterestFactors queries hard module interest factors.
 	InterestFactors(ctx context.Context, in *QueryInterestFactorsRequest, opts ...grpc.CallOption) (*QueryInterestFactorsResponse, error)
+	// EMode queries the efficiency mode category of an account.
+	EMode(ctx context.Context, in *QueryEModeRequest, opts ...grpc.CallOption) (*QueryEModeResponse, error)
+	// SimulatePosition queries the position of an account after hypothetical deposit, withdraw, borrow and repay actions.
+	SimulatePosition(ctx context.Context, in *QuerySimulatePositionRequest, opts ...grpc.CallOption) (*QuerySimulatePositionResponse, error)
 }
 
 type queryClient struct {
@@ -1723,6 +2028,24 @@ func (c *queryClient) InterestFactors(ctx context.Context, in *QueryInterestFact
 	return out, nil
 }
 
+func (c *queryClient) EMode(ctx context.Context, in *QueryEModeRequest, opts ...grpc.CallOption) (*QueryEModeResponse, error) {
+	out := new(QueryEModeResponse)
+	err := c.cc.Invoke(ctx, "/kava.hard.v1beta1.Query/EMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulatePosition(ctx context.Context, in *QuerySimulatePositionRequest, opts ...grpc.CallOption) (*QuerySimulatePositionResponse, error) {
+	out := new(QuerySimulatePositionResponse)
+	err := c.cc.Invoke(ctx, "/kava.hard.v1beta1.Query/SimulatePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries module params.
@@ -1747,6 +2070,10 @@ type QueryServer interface {
 	Reserves(context.Context, *QueryReservesRequest) (*QueryReservesResponse, error)
 	// InterestFactors queries hard module interest factors.
 	InterestFactors(context.Context, *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error)
+	// EMode queries the efficiency mode category of an account.
+	EMode(context.Context, *QueryEModeRequest) (*QueryEModeResponse, error)
+	// SimulatePosition queries the position of an account after hypothetical deposit, withdraw, borrow and repay actions.
+	SimulatePosition(context.Context, *QuerySimulatePositionRequest) (*QuerySimulatePositionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1786,6 +2113,12 @@ func (*UnimplementedQueryServer) Reserves(ctx context.Context, req *QueryReserve
 func (*UnimplementedQueryServer) InterestFactors(ctx context.Context, req *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InterestFactors not implemented")
 }
+func (*UnimplementedQueryServer) EMode(ctx context.Context, req *QueryEModeRequest) (*QueryEModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EMode not implemented")
+}
+func (*UnimplementedQueryServer) SimulatePosition(ctx context.Context, req *QuerySimulatePositionRequest) (*QuerySimulatePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePosition not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1989,6 +2322,42 @@ func _Query_InterestFactors_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.hard.v1beta1.Query/EMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EMode(ctx, req.(*QueryEModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.hard.v1beta1.Query/SimulatePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePosition(ctx, req.(*QuerySimulatePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.hard.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2037,6 +2406,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InterestFactors",
 			Handler:    _Query_InterestFactors_Handler,
 		},
+		{
+			MethodName: "EMode",
+			Handler:    _Query_EMode_Handler,
+		},
+		{
+			MethodName: "SimulatePosition",
+			Handler:    _Query_SimulatePosition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/hard/v1beta1/query.proto",
@@ -2885,7 +3262,7 @@ func (m *QueryInterestFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *DepositResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryEModeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2895,55 +3272,27 @@ func (m *DepositResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DepositResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEModeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SupplyInterestFactorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryEModeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2953,34 +3302,30 @@ func (m *SupplyInterestFactorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SupplyInterestFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEModeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SupplyInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Category.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *BorrowResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySimulatePositionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2990,20 +3335,48 @@ func (m *BorrowResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySimulatePositionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySimulatePositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Repay) > 0 {
+		for iNdEx := len(m.Repay) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Repay[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Borrow) > 0 {
+		for iNdEx := len(m.Borrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Borrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Withdraw) > 0 {
+		for iNdEx := len(m.Withdraw) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdraw[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3014,10 +3387,10 @@ func (m *BorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3028,17 +3401,17 @@ func (m *BorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrower)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BorrowInterestFactorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySimulatePositionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3048,34 +3421,123 @@ func (m *BorrowInterestFactorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BorrowInterestFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySimulatePositionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BorrowInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySimulatePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+	if len(m.RejectionReason) > 0 {
+		i -= len(m.RejectionReason)
+		copy(dAtA[i:], m.RejectionReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RejectionReason)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if m.Rejected {
 		i--
-		dAtA[i] = 0xa
+		if m.Rejected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.HealthFactor.Size()
+		i -= size
+		if _, err := m.HealthFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.BorrowCapacity.Size()
+		i -= size
+		if _, err := m.BorrowCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LoanToValue.Size()
+		i -= size
+		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BorrowUsdValues) > 0 {
+		for iNdEx := len(m.BorrowUsdValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BorrowUsdValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DepositUsdValues) > 0 {
+		for iNdEx := len(m.DepositUsdValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositUsdValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Borrow) > 0 {
+		for iNdEx := len(m.Borrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Borrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MoneyMarketInterestRate) Marshal() (dAtA []byte, err error) {
+func (m *DepositResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3085,41 +3547,55 @@ func (m *MoneyMarketInterestRate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MoneyMarketInterestRate) MarshalTo(dAtA []byte) (int, error) {
+func (m *DepositResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MoneyMarketInterestRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BorrowInterestRate) > 0 {
-		i -= len(m.BorrowInterestRate)
-		copy(dAtA[i:], m.BorrowInterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowInterestRate)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.SupplyInterestRate) > 0 {
-		i -= len(m.SupplyInterestRate)
-		copy(dAtA[i:], m.SupplyInterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyInterestRate)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InterestFactor) Marshal() (dAtA []byte, err error) {
+func (m *SupplyInterestFactorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3129,27 +3605,20 @@ func (m *InterestFactor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InterestFactor) MarshalTo(dAtA []byte) (int, error) {
+func (m *SupplyInterestFactorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SupplyInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SupplyInterestFactor) > 0 {
-		i -= len(m.SupplyInterestFactor)
-		copy(dAtA[i:], m.SupplyInterestFactor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyInterestFactor)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BorrowInterestFactor) > 0 {
-		i -= len(m.BorrowInterestFactor)
-		copy(dAtA[i:], m.BorrowInterestFactor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowInterestFactor)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3163,51 +3632,246 @@ func (m *InterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BorrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *BorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.HealthFactor != nil {
+		{
+			size := m.HealthFactor.Size()
+			i -= size
+			if _, err := m.HealthFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BorrowInterestFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BorrowInterestFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BorrowInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoneyMarketInterestRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoneyMarketInterestRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoneyMarketInterestRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BorrowInterestRate) > 0 {
+		i -= len(m.BorrowInterestRate)
+		copy(dAtA[i:], m.BorrowInterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowInterestRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SupplyInterestRate) > 0 {
+		i -= len(m.SupplyInterestRate)
+		copy(dAtA[i:], m.SupplyInterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyInterestRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterestFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SupplyInterestFactor) > 0 {
+		i -= len(m.SupplyInterestFactor)
+		copy(dAtA[i:], m.SupplyInterestFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyInterestFactor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BorrowInterestFactor) > 0 {
+		i -= len(m.BorrowInterestFactor)
+		copy(dAtA[i:], m.BorrowInterestFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowInterestFactor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if len(m.Accounts) > 0 {
 		for _, e := range m.Accounts {
@@ -3518,66 +4182,60 @@ func (m *QueryInterestFactorsResponse) Size() (n int) {
 	return n
 }
 
-func (m *DepositResponse) Size() (n int) {
+func (m *QueryEModeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Depositor)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *SupplyInterestFactorResponse) Size() (n int) {
+func (m *QueryEModeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.Category.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *BorrowResponse) Size() (n int) {
+func (m *QuerySimulatePositionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Borrower)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
+	if len(m.Withdraw) > 0 {
+		for _, e := range m.Withdraw {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Borrow) > 0 {
+		for _, e := range m.Borrow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Repay) > 0 {
+		for _, e := range m.Repay {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3585,17 +4243,134 @@ func (m *BorrowResponse) Size() (n int) {
 	return n
 }
 
-func (m *BorrowInterestFactorResponse) Size() (n int) {
+func (m *QuerySimulatePositionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
-	l = len(m.Value)
+	if len(m.Borrow) > 0 {
+		for _, e := range m.Borrow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DepositUsdValues) > 0 {
+		for _, e := range m.DepositUsdValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BorrowUsdValues) > 0 {
+		for _, e := range m.BorrowUsdValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BorrowCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.HealthFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Rejected {
+		n += 2
+	}
+	l = len(m.RejectionReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyInterestFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.HealthFactor != nil {
+		l = m.HealthFactor.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BorrowInterestFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -3783,7 +4558,681 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, types.ModuleAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, DepositResponse{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnsyncedDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnsyncedDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnsyncedDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnsyncedDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnsyncedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, DepositResponse{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalDepositedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3806,12 +5255,44 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalDepositedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalDepositedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3833,7 +5314,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3856,15 +5337,15 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalDepositedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalDepositedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuppliedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3891,8 +5372,8 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, types.ModuleAccount{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SuppliedCoins = append(m.SuppliedCoins, types1.Coin{})
+			if err := m.SuppliedCoins[len(m.SuppliedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3917,7 +5398,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3940,10 +5421,10 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBorrowsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4067,7 +5548,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4090,15 +5571,15 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBorrowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4125,8 +5606,8 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, DepositResponse{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Borrows = append(m.Borrows, BorrowResponse{})
+			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4187,7 +5668,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnsyncedDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4210,10 +5691,10 @@ func (m *QueryUnsyncedDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnsyncedDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnsyncedBorrowsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnsyncedDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnsyncedBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4337,7 +5818,7 @@ func (m *QueryUnsyncedDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4360,15 +5841,15 @@ func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnsyncedDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnsyncedBorrowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnsyncedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnsyncedBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4395,8 +5876,8 @@ func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, DepositResponse{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Borrows = append(m.Borrows, BorrowResponse{})
+			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4457,7 +5938,173 @@ func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalDepositedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBorrowedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBorrowedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBorrowedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBorrowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowedCoins = append(m.BorrowedCoins, types1.Coin{})
+			if err := m.BorrowedCoins[len(m.BorrowedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterestRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4480,10 +6127,10 @@ func (m *QueryTotalDepositedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalDepositedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalDepositedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4539,7 +6186,7 @@ func (m *QueryTotalDepositedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4562,15 +6209,15 @@ func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalDepositedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalDepositedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuppliedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4597,8 +6244,8 @@ func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuppliedCoins = append(m.SuppliedCoins, types1.Coin{})
-			if err := m.SuppliedCoins[len(m.SuppliedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InterestRates = append(m.InterestRates, MoneyMarketInterestRate{})
+			if err := m.InterestRates[len(m.InterestRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4623,7 +6270,7 @@ func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4646,10 +6293,10 @@ func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReservesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4684,74 +6331,6 @@ func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4773,7 +6352,7 @@ func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4796,49 +6375,15 @@ func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReservesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Borrows = append(m.Borrows, BorrowResponse{})
-			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4865,10 +6410,8 @@ func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4893,7 +6436,7 @@ func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestFactorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4916,10 +6459,10 @@ func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnsyncedBorrowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestFactorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnsyncedBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestFactorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4954,74 +6497,6 @@ func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5043,7 +6518,7 @@ func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestFactorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5066,49 +6541,15 @@ func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnsyncedBorrowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestFactorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnsyncedBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Borrows = append(m.Borrows, BorrowResponse{})
-			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5135,10 +6576,8 @@ func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InterestFactors = append(m.InterestFactors, InterestFactor{})
+			if err := m.InterestFactors[len(m.InterestFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5163,7 +6602,7 @@ func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEModeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5186,15 +6625,15 @@ func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBorrowedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEModeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBorrowedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5222,7 +6661,7 @@ func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5245,7 +6684,7 @@ func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5268,15 +6707,15 @@ func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBorrowedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBorrowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5303,8 +6742,7 @@ func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorrowedCoins = append(m.BorrowedCoins, types1.Coin{})
-			if err := m.BorrowedCoins[len(m.BorrowedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Category.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5329,7 +6767,7 @@ func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterestRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePositionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5352,15 +6790,15 @@ func (m *QueryInterestRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterestRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePositionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5388,7 +6826,143 @@ func (m *QueryInterestRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdraw = append(m.Withdraw, types1.Coin{})
+			if err := m.Withdraw[len(m.Withdraw)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrow = append(m.Borrow, types1.Coin{})
+			if err := m.Borrow[len(m.Borrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repay = append(m.Repay, types1.Coin{})
+			if err := m.Repay[len(m.Repay)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5411,7 +6985,7 @@ func (m *QueryInterestRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterestRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5434,15 +7008,15 @@ func (m *QueryInterestRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterestRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5469,64 +7043,116 @@ func (m *QueryInterestRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestRates = append(m.InterestRates, MoneyMarketInterestRate{})
-			if err := m.InterestRates[len(m.InterestRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrow = append(m.Borrow, types1.Coin{})
+			if err := m.Borrow[len(m.Borrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositUsdValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.DepositUsdValues = append(m.DepositUsdValues, types1.DecCoin{})
+			if err := m.DepositUsdValues[len(m.DepositUsdValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowUsdValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BorrowUsdValues = append(m.BorrowUsdValues, types1.DecCoin{})
+			if err := m.BorrowUsdValues[len(m.BorrowUsdValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReservesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5554,63 +7180,15 @@ func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReservesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowCapacity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5620,79 +7198,29 @@ func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BorrowCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInterestFactorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterestFactorsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterestFactorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5720,63 +7248,35 @@ func (m *QueryInterestFactorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.HealthFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInterestFactorsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterestFactorsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterestFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Rejected = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5786,25 +7286,23 @@ func (m *QueryInterestFactorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestFactors = append(m.InterestFactors, InterestFactor{})
-			if err := m.InterestFactors[len(m.InterestFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6220,6 +7718,42 @@ func (m *BorrowResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.HealthFactor = &v
+			if err := m.HealthFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -489,6 +490,206 @@ func (m *MsgLiquidateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidateResponse proto.InternalMessageInfo
 
+// MsgFlashLoan defines the Msg/FlashLoan request type.
+type MsgFlashLoan struct {
+	Borrower string                                   `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// msgs are executed on behalf of the borrower after the loan is sent, the loan plus the flash loan fee must be
+	// repaid from the borrower's balance once they complete
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgFlashLoan) Reset()         { *m = MsgFlashLoan{} }
+func (m *MsgFlashLoan) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashLoan) ProtoMessage()    {}
+func (*MsgFlashLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72cf8eb667c23b8a, []int{10}
+}
+func (m *MsgFlashLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashLoan.Merge(m, src)
+}
+func (m *MsgFlashLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashLoan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashLoan proto.InternalMessageInfo
+
+func (m *MsgFlashLoan) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *MsgFlashLoan) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgFlashLoan) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// MsgFlashLoanResponse defines the Msg/FlashLoan response type.
+type MsgFlashLoanResponse struct {
+	// results are the data returned by each executed message
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgFlashLoanResponse) Reset()         { *m = MsgFlashLoanResponse{} }
+func (m *MsgFlashLoanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashLoanResponse) ProtoMessage()    {}
+func (*MsgFlashLoanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72cf8eb667c23b8a, []int{11}
+}
+func (m *MsgFlashLoanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashLoanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashLoanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashLoanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashLoanResponse.Merge(m, src)
+}
+func (m *MsgFlashLoanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashLoanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashLoanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashLoanResponse proto.InternalMessageInfo
+
+func (m *MsgFlashLoanResponse) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// MsgSetEMode defines the Msg/SetEMode request type.
+type MsgSetEMode struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// category is the name of the efficiency mode category to opt into, or empty to opt out
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (m *MsgSetEMode) Reset()         { *m = MsgSetEMode{} }
+func (m *MsgSetEMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEMode) ProtoMessage()    {}
+func (*MsgSetEMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72cf8eb667c23b8a, []int{12}
+}
+func (m *MsgSetEMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEMode.Merge(m, src)
+}
+func (m *MsgSetEMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEMode proto.InternalMessageInfo
+
+func (m *MsgSetEMode) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetEMode) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+// MsgSetEModeResponse defines the Msg/SetEMode response type.
+type MsgSetEModeResponse struct {
+}
+
+func (m *MsgSetEModeResponse) Reset()         { *m = MsgSetEModeResponse{} }
+func (m *MsgSetEModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEModeResponse) ProtoMessage()    {}
+func (*MsgSetEModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72cf8eb667c23b8a, []int{13}
+}
+func (m *MsgSetEModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEModeResponse.Merge(m, src)
+}
+func (m *MsgSetEModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEModeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeposit)(nil), "kava.hard.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "kava.hard.v1beta1.MsgDepositResponse")
@@ -500,46 +701,59 @@ func init() {
 	proto.RegisterType((*MsgRepayResponse)(nil), "kava.hard.v1beta1.MsgRepayResponse")
 	proto.RegisterType((*MsgLiquidate)(nil), "kava.hard.v1beta1.MsgLiquidate")
 	proto.RegisterType((*MsgLiquidateResponse)(nil), "kava.hard.v1beta1.MsgLiquidateResponse")
+	proto.RegisterType((*MsgFlashLoan)(nil), "kava.hard.v1beta1.MsgFlashLoan")
+	proto.RegisterType((*MsgFlashLoanResponse)(nil), "kava.hard.v1beta1.MsgFlashLoanResponse")
+	proto.RegisterType((*MsgSetEMode)(nil), "kava.hard.v1beta1.MsgSetEMode")
+	proto.RegisterType((*MsgSetEModeResponse)(nil), "kava.hard.v1beta1.MsgSetEModeResponse")
 }
 
 func init() { proto.RegisterFile("kava/hard/v1beta1/tx.proto", fileDescriptor_72cf8eb667c23b8a) }
 
 var fileDescriptor_72cf8eb667c23b8a = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x8f, 0xd2, 0x4e,
-	0x18, 0xa6, 0x90, 0xe5, 0x07, 0xef, 0xfe, 0x0e, 0xee, 0x2c, 0x1a, 0xb6, 0x6a, 0xd9, 0xa0, 0xae,
-	0x5c, 0x68, 0x77, 0x57, 0xe3, 0x59, 0xd1, 0x8b, 0xc9, 0x36, 0x26, 0x18, 0x63, 0xe2, 0xc5, 0x4c,
-	0xe9, 0x64, 0x68, 0x90, 0x4e, 0xed, 0x3b, 0xc0, 0xee, 0xb7, 0xf0, 0xec, 0x07, 0x30, 0x71, 0xcf,
-	0x7e, 0x88, 0x3d, 0xae, 0x9e, 0x3c, 0xa9, 0x81, 0x2f, 0x62, 0xda, 0x69, 0x07, 0x8c, 0x04, 0xb8,
-	0x68, 0x3c, 0x31, 0xed, 0xf3, 0x87, 0xe7, 0xc9, 0xbc, 0x33, 0x05, 0x73, 0x40, 0xc7, 0xd4, 0xe9,
-	0xd3, 0xd8, 0x77, 0xc6, 0x47, 0x1e, 0x93, 0xf4, 0xc8, 0x91, 0xa7, 0x76, 0x14, 0x0b, 0x29, 0xc8,
-	0x4e, 0x82, 0xd9, 0x09, 0x66, 0x67, 0x98, 0x69, 0xf5, 0x04, 0x0e, 0x05, 0x3a, 0x1e, 0x45, 0xa6,
-	0x05, 0x3d, 0x11, 0x84, 0x4a, 0x62, 0xee, 0x29, 0xfc, 0x75, 0xfa, 0xe4, 0xa8, 0x87, 0x0c, 0xaa,
-	0x71, 0xc1, 0x85, 0x7a, 0x9f, 0xac, 0xd4, 0xdb, 0xe6, 0x47, 0x03, 0xc0, 0x45, 0xfe, 0x84, 0x45,
-	0x02, 0x03, 0x49, 0x1e, 0x40, 0xd5, 0x57, 0x4b, 0x11, 0xd7, 0x8d, 0x7d, 0xa3, 0x55, 0xed, 0xd4,
-	0xbf, 0x7c, 0x6a, 0xd7, 0x32, 0xa7, 0x47, 0xbe, 0x1f, 0x33, 0xc4, 0xe7, 0x32, 0x0e, 0x42, 0xde,
-	0x9d, 0x53, 0x49, 0x0f, 0xca, 0x74, 0x28, 0x46, 0xa1, 0xac, 0x17, 0xf7, 0x4b, 0xad, 0xed, 0xe3,
-	0x3d, 0x3b, 0x53, 0x24, 0x41, 0xf3, 0xf4, 0xf6, 0x63, 0x11, 0x84, 0x9d, 0xc3, 0x8b, 0x6f, 0x8d,
-	0xc2, 0xf9, 0xf7, 0x46, 0x8b, 0x07, 0xb2, 0x3f, 0xf2, 0xec, 0x9e, 0x18, 0x66, 0x41, 0xb3, 0x9f,
-	0x36, 0xfa, 0x03, 0x47, 0x9e, 0x45, 0x0c, 0x53, 0x01, 0x76, 0x33, 0xeb, 0x66, 0x0d, 0xc8, 0x3c,
-	0x6a, 0x97, 0x61, 0x24, 0x42, 0x64, 0xcd, 0x73, 0x03, 0xb6, 0x5d, 0xe4, 0x2f, 0x03, 0xd9, 0xf7,
-	0x63, 0x3a, 0xf9, 0xb7, 0x2b, 0x5c, 0x85, 0xdd, 0x85, 0xac, 0xba, 0xc3, 0x07, 0x03, 0xaa, 0x2e,
-	0xf2, 0x8e, 0x88, 0x63, 0x31, 0x21, 0xf7, 0xa1, 0xe2, 0xa5, 0x2b, 0xb6, 0xbe, 0x80, 0x66, 0xfe,
-	0x9d, 0xfc, 0xbb, 0xb0, 0xa3, 0x73, 0xea, 0xf4, 0x9f, 0x0d, 0xa8, 0xb8, 0xc8, 0xbb, 0x2c, 0xa2,
-	0x67, 0xe4, 0x10, 0xca, 0xc8, 0x42, 0x7f, 0x83, 0xe8, 0x19, 0x8f, 0xd8, 0xb0, 0x25, 0x26, 0x21,
-	0x8b, 0xeb, 0xc5, 0x35, 0x02, 0x45, 0x5b, 0x28, 0x5a, 0xfa, 0x73, 0x45, 0x09, 0x5c, 0xc9, 0x2b,
-	0xe9, 0x9e, 0x63, 0xf8, 0xdf, 0x45, 0x7e, 0x12, 0xbc, 0x1d, 0x05, 0x3e, 0x95, 0x2c, 0xa9, 0x3a,
-	0x60, 0x2c, 0xda, 0xa4, 0xaa, 0xe2, 0xfd, 0xb2, 0xb3, 0xc5, 0x4d, 0x77, 0xb6, 0x79, 0x0d, 0x6a,
-	0x8b, 0xff, 0x9b, 0xe7, 0x39, 0x7e, 0x5f, 0x82, 0x92, 0x8b, 0x9c, 0x3c, 0x83, 0xff, 0xf2, 0xf3,
-	0x7b, 0xd3, 0xfe, 0xed, 0xce, 0xb0, 0xe7, 0x67, 0xc6, 0xbc, 0xb3, 0x12, 0xce, 0x8d, 0x49, 0x17,
-	0x2a, 0xfa, 0x38, 0x59, 0xcb, 0x25, 0x39, 0x6e, 0x1e, 0xac, 0xc6, 0xb5, 0xe7, 0x09, 0x94, 0xb3,
-	0xf1, 0xbe, 0xb1, 0x5c, 0xa1, 0x50, 0xf3, 0xf6, 0x2a, 0x54, 0xbb, 0x3d, 0x85, 0x2d, 0x35, 0x6e,
-	0xd7, 0x97, 0xd3, 0x53, 0xd0, 0xbc, 0xb5, 0x02, 0xd4, 0x56, 0x2f, 0xa0, 0x3a, 0xdf, 0xd2, 0xc6,
-	0x72, 0x85, 0x26, 0x98, 0x77, 0xd7, 0x10, 0x72, 0xdb, 0xce, 0xc3, 0x8b, 0xa9, 0x65, 0x5c, 0x4e,
-	0x2d, 0xe3, 0xc7, 0xd4, 0x32, 0xde, 0xcd, 0xac, 0xc2, 0xe5, 0xcc, 0x2a, 0x7c, 0x9d, 0x59, 0x85,
-	0x57, 0x07, 0x0b, 0xc3, 0x98, 0x98, 0xb5, 0xdf, 0x50, 0x0f, 0xd3, 0x95, 0x73, 0xaa, 0xbe, 0x04,
-	0xe9, 0x40, 0x7a, 0xe5, 0xf4, 0x86, 0xbe, 0xf7, 0x33, 0x00, 0x00, 0xff, 0xff, 0x91, 0x63, 0x35,
-	0x65, 0x23, 0x06, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0x36, 0x4d, 0x6e, 0xbb, 0xf8, 0xea, 0xe6, 0x43, 0xa9, 0x01, 0xb7, 0x0a, 0xd0,
+	0x66, 0x93, 0x71, 0x5b, 0x7e, 0xd6, 0x34, 0xfc, 0x48, 0x48, 0xb5, 0x90, 0x5c, 0x21, 0x24, 0x58,
+	0xa0, 0x71, 0x3c, 0x4c, 0xac, 0x24, 0x9e, 0xe0, 0x99, 0xb4, 0xcd, 0x5b, 0xf0, 0x14, 0x48, 0x74,
+	0xdd, 0x57, 0x40, 0xaa, 0x58, 0x15, 0x56, 0xac, 0xa0, 0x6a, 0x5f, 0x04, 0xd9, 0x63, 0x4f, 0x8c,
+	0x48, 0x93, 0x08, 0x09, 0xd4, 0x55, 0xe6, 0xe6, 0x9c, 0x7b, 0xe7, 0x9c, 0xdc, 0xb9, 0x57, 0x01,
+	0xa3, 0x8d, 0xf7, 0xb1, 0xd5, 0xc2, 0xa1, 0x67, 0xed, 0x6f, 0xb9, 0x44, 0xe0, 0x2d, 0x4b, 0x1c,
+	0xa2, 0x5e, 0xc8, 0x04, 0xd3, 0x97, 0x22, 0x0c, 0x45, 0x18, 0x4a, 0x30, 0xc3, 0x6c, 0x32, 0xde,
+	0x65, 0xdc, 0x72, 0x31, 0x27, 0x2a, 0xa1, 0xc9, 0xfc, 0x40, 0xa6, 0x18, 0x2b, 0x12, 0x7f, 0x13,
+	0x47, 0x96, 0x0c, 0x12, 0xa8, 0x4c, 0x19, 0x65, 0xf2, 0xfb, 0xe8, 0x94, 0x26, 0x50, 0xc6, 0x68,
+	0x87, 0x58, 0x71, 0xe4, 0xf6, 0xdf, 0x5a, 0x38, 0x18, 0x48, 0xa8, 0xfa, 0x51, 0x03, 0xb0, 0x39,
+	0x7d, 0x4c, 0x7a, 0x8c, 0xfb, 0x42, 0x7f, 0x00, 0x25, 0x4f, 0x1e, 0x59, 0x58, 0xd1, 0xd6, 0xb4,
+	0x5a, 0xa9, 0x51, 0xf9, 0x7a, 0x5c, 0x2f, 0x27, 0x97, 0xec, 0x78, 0x5e, 0x48, 0x38, 0xdf, 0x13,
+	0xa1, 0x1f, 0x50, 0x67, 0x48, 0xd5, 0x9b, 0x50, 0xc0, 0x5d, 0xd6, 0x0f, 0x44, 0x65, 0x66, 0x2d,
+	0x5f, 0x5b, 0xd8, 0x5e, 0x41, 0x49, 0x46, 0xe4, 0x21, 0x35, 0x86, 0x1e, 0x31, 0x3f, 0x68, 0x6c,
+	0x9e, 0x7c, 0x5f, 0xcd, 0x1d, 0xfd, 0x58, 0xad, 0x51, 0x5f, 0xb4, 0xfa, 0x2e, 0x6a, 0xb2, 0x6e,
+	0xe2, 0x21, 0xf9, 0xa8, 0x73, 0xaf, 0x6d, 0x89, 0x41, 0x8f, 0xf0, 0x38, 0x81, 0x3b, 0x49, 0xe9,
+	0x6a, 0x19, 0xf4, 0xa1, 0x54, 0x87, 0xf0, 0x1e, 0x0b, 0x38, 0xa9, 0x1e, 0x69, 0xb0, 0x60, 0x73,
+	0xfa, 0xd2, 0x17, 0x2d, 0x2f, 0xc4, 0x07, 0x57, 0xdb, 0xc2, 0xff, 0xb0, 0x9c, 0xd1, 0xaa, 0x3c,
+	0x7c, 0xd0, 0xa0, 0x64, 0x73, 0xda, 0x60, 0x61, 0xc8, 0x0e, 0xf4, 0x7b, 0x50, 0x74, 0xe3, 0x13,
+	0x99, 0x6c, 0x40, 0x31, 0xff, 0x8d, 0xfe, 0x65, 0x58, 0x52, 0x3a, 0x95, 0xfa, 0x2f, 0x1a, 0x14,
+	0x6d, 0x4e, 0x1d, 0xd2, 0xc3, 0x03, 0x7d, 0x13, 0x0a, 0x9c, 0x04, 0xde, 0x14, 0xd2, 0x13, 0x9e,
+	0x8e, 0x60, 0x8e, 0x1d, 0x04, 0x24, 0xac, 0xcc, 0x4c, 0x48, 0x90, 0xb4, 0x8c, 0xd1, 0xfc, 0xdf,
+	0x33, 0xaa, 0xc3, 0x7f, 0xa9, 0x25, 0xe5, 0x73, 0x1f, 0x16, 0x6d, 0x4e, 0x77, 0xfd, 0x77, 0x7d,
+	0xdf, 0xc3, 0x82, 0x44, 0x56, 0xdb, 0x84, 0xf4, 0xa6, 0xb1, 0x2a, 0x79, 0xbf, 0x74, 0x76, 0x66,
+	0xda, 0xce, 0x56, 0xaf, 0x41, 0x39, 0x7b, 0xaf, 0xd2, 0x73, 0xa6, 0xc5, 0x82, 0x9e, 0x76, 0x30,
+	0x6f, 0xed, 0x32, 0x1c, 0x5c, 0xe1, 0x87, 0xa3, 0xdf, 0x87, 0xd9, 0x2e, 0xa7, 0x3c, 0x69, 0x59,
+	0x19, 0xc9, 0x8d, 0x84, 0xd2, 0x8d, 0x84, 0x76, 0x82, 0x41, 0x63, 0xe1, 0xf3, 0x71, 0x7d, 0x9e,
+	0x7b, 0x6d, 0x14, 0xfd, 0xf2, 0x31, 0xbd, 0xba, 0x09, 0xe5, 0xac, 0xc3, 0xd4, 0xba, 0x5e, 0x81,
+	0xf9, 0x90, 0xf0, 0x7e, 0x47, 0xf0, 0x8a, 0xb6, 0x96, 0xaf, 0x2d, 0x3a, 0x69, 0x58, 0x7d, 0x1d,
+	0x6f, 0x83, 0x3d, 0x22, 0x9e, 0xd8, 0xcc, 0x23, 0x7f, 0xf0, 0x1c, 0x0d, 0x28, 0x36, 0xb1, 0x20,
+	0x94, 0x85, 0x03, 0xd9, 0x23, 0x47, 0xc5, 0xc9, 0xf8, 0xa6, 0xc5, 0x53, 0x35, 0xdb, 0x9f, 0x66,
+	0x21, 0x6f, 0x73, 0xaa, 0x3f, 0x87, 0xf9, 0x74, 0x91, 0xde, 0x44, 0xbf, 0xed, 0x75, 0x34, 0x5c,
+	0x5e, 0xc6, 0x9d, 0xb1, 0xb0, 0xb2, 0xe9, 0x40, 0x51, 0xed, 0x35, 0x73, 0x74, 0x4a, 0x8a, 0x1b,
+	0xeb, 0xe3, 0x71, 0x55, 0x73, 0x17, 0x0a, 0xc9, 0x9e, 0xb9, 0x31, 0x3a, 0x43, 0xa2, 0xc6, 0xed,
+	0x71, 0xa8, 0xaa, 0xf6, 0x0c, 0xe6, 0xe4, 0xdc, 0x5f, 0x1f, 0x4d, 0x8f, 0x41, 0xe3, 0xd6, 0x18,
+	0x50, 0x95, 0x7a, 0x01, 0xa5, 0xe1, 0x6c, 0xad, 0x8e, 0xce, 0x50, 0x04, 0x63, 0x63, 0x02, 0x21,
+	0x5b, 0x76, 0x38, 0x21, 0x97, 0x94, 0x55, 0x04, 0x63, 0x63, 0x02, 0x21, 0xdb, 0x1a, 0xf5, 0xc8,
+	0x2e, 0x69, 0x4d, 0x8a, 0x1b, 0xeb, 0xe3, 0xf1, 0xb4, 0x66, 0xe3, 0xe1, 0xc9, 0xb9, 0xa9, 0x9d,
+	0x9e, 0x9b, 0xda, 0xd9, 0xb9, 0xa9, 0xbd, 0xbf, 0x30, 0x73, 0xa7, 0x17, 0x66, 0xee, 0xdb, 0x85,
+	0x99, 0x7b, 0xb5, 0x9e, 0x19, 0xb8, 0xa8, 0x56, 0xbd, 0x83, 0x5d, 0x1e, 0x9f, 0xac, 0x43, 0xf9,
+	0xc7, 0x22, 0x1e, 0x3a, 0xb7, 0x10, 0x0f, 0xd4, 0xdd, 0x9f, 0x03, 0x00, 0x72, 0x6a, 0xba, 0x39,
+	0x72, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -564,6 +778,10 @@ type MsgClient interface {
 	Repay(ctx context.Context, in *MsgRepay, opts ...grpc.CallOption) (*MsgRepayResponse, error)
 	// Liquidate defines a method for attempting to liquidate a borrower that is over their loan-to-value.
 	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+	// FlashLoan defines a method for borrowing funds from hard liquidity pool for the duration of a set of messages.
+	FlashLoan(ctx context.Context, in *MsgFlashLoan, opts ...grpc.CallOption) (*MsgFlashLoanResponse, error)
+	// SetEMode defines a method for opting into or out of an efficiency mode category.
+	SetEMode(ctx context.Context, in *MsgSetEMode, opts ...grpc.CallOption) (*MsgSetEModeResponse, error)
 }
 
 type msgClient struct {
@@ -619,6 +837,24 @@ func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) FlashLoan(ctx context.Context, in *MsgFlashLoan, opts ...grpc.CallOption) (*MsgFlashLoanResponse, error) {
+	out := new(MsgFlashLoanResponse)
+	err := c.cc.Invoke(ctx, "/kava.hard.v1beta1.Msg/FlashLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEMode(ctx context.Context, in *MsgSetEMode, opts ...grpc.CallOption) (*MsgSetEModeResponse, error) {
+	out := new(MsgSetEModeResponse)
+	err := c.cc.Invoke(ctx, "/kava.hard.v1beta1.Msg/SetEMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Deposit defines a method for depositing funds to hard liquidity pool.
@@ -631,6 +867,10 @@ type MsgServer interface {
 	Repay(context.Context, *MsgRepay) (*MsgRepayResponse, error)
 	// Liquidate defines a method for attempting to liquidate a borrower that is over their loan-to-value.
 	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+	// FlashLoan defines a method for borrowing funds from hard liquidity pool for the duration of a set of messages.
+	FlashLoan(context.Context, *MsgFlashLoan) (*MsgFlashLoanResponse, error)
+	// SetEMode defines a method for opting into or out of an efficiency mode category.
+	SetEMode(context.Context, *MsgSetEMode) (*MsgSetEModeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -652,6 +892,12 @@ func (*UnimplementedMsgServer) Repay(ctx context.Context, req *MsgRepay) (*MsgRe
 func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
 }
+func (*UnimplementedMsgServer) FlashLoan(ctx context.Context, req *MsgFlashLoan) (*MsgFlashLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashLoan not implemented")
+}
+func (*UnimplementedMsgServer) SetEMode(ctx context.Context, req *MsgSetEMode) (*MsgSetEModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEMode not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -747,6 +993,42 @@ func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FlashLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.hard.v1beta1.Msg/FlashLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashLoan(ctx, req.(*MsgFlashLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.hard.v1beta1.Msg/SetEMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEMode(ctx, req.(*MsgSetEMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.hard.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -771,6 +1053,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Liquidate",
 			Handler:    _Msg_Liquidate_Handler,
 		},
+		{
+			MethodName: "FlashLoan",
+			Handler:    _Msg_FlashLoan_Handler,
+		},
+		{
+			MethodName: "SetEMode",
+			Handler:    _Msg_SetEMode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/hard/v1beta1/tx.proto",
@@ -1111,59 +1401,209 @@ func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgFlashLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgFlashLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFlashLoanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFlashLoanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashLoanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
 		}
 	}
 	return n
@@ -1226,51 +1666,449 @@ func (m *MsgRepay) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRepayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keeper)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFlashLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFlashLoanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetEMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetEModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRepayResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgLiquidate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Keeper)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgLiquidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1293,15 +2131,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1329,7 +2167,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1386,7 +2224,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1409,10 +2247,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBorrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1436,7 +2274,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1459,15 +2297,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1495,9 +2333,41 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1552,7 +2422,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1575,10 +2445,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1602,7 +2472,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1625,15 +2495,15 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1661,13 +2531,13 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Keeper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1677,25 +2547,23 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1718,7 +2586,7 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1741,10 +2609,10 @@ func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1768,7 +2636,7 @@ func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepay) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashLoan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,15 +2659,15 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepay: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashLoan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepay: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashLoan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1827,13 +2695,13 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1843,27 +2711,29 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1890,8 +2760,8 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1916,7 +2786,7 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashLoanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1939,12 +2809,44 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashLoanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashLoanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1966,7 +2868,7 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1989,15 +2891,15 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2025,11 +2927,11 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keeper = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2057,7 +2959,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2080,7 +2982,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2103,10 +3005,10 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
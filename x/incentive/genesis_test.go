@@ -54,6 +54,8 @@ func (suite *GenesisTestSuite) SetupTest() {
 				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdk.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 			},
 			sdk.NewDec(10),
+			hardtypes.DefaultFlashLoanFee,
+			hardtypes.DefaultEModeCategories,
 		),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -61,6 +63,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 		hardtypes.DefaultTotalSupplied,
 		hardtypes.DefaultTotalBorrowed,
 		hardtypes.DefaultTotalReserves,
+		hardtypes.DefaultAccountEModes,
 	)
 	incentiveGS := types.NewGenesisState(
 		types.NewParams(
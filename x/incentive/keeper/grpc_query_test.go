@@ -58,6 +58,8 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdk.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 			},
 			sdk.NewDec(10),
+			hardtypes.DefaultFlashLoanFee,
+			hardtypes.DefaultEModeCategories,
 		),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -65,6 +67,7 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 		hardtypes.DefaultTotalSupplied,
 		hardtypes.DefaultTotalBorrowed,
 		hardtypes.DefaultTotalReserves,
+		hardtypes.DefaultAccountEModes,
 	)
 
 	suite.genesisState = types.NewGenesisState(
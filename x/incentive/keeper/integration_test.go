@@ -230,6 +230,9 @@ func NewSwapGenesisState(cdc codec.JSONCodec) app.GenesisState {
 		),
 		swaptypes.DefaultPoolRecords,
 		swaptypes.DefaultShareRecords,
+		swaptypes.DefaultPriceObservations,
+		swaptypes.DefaultLimitOrders,
+		swaptypes.DefaultNextLimitOrderID,
 	)
 	return app.GenesisState{
 		swaptypes.ModuleName: cdc.MustMarshalJSON(&genesis),
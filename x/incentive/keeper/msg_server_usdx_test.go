@@ -44,3 +44,37 @@ func (suite *HandlerTestSuite) TestPayoutUSDXClaim() {
 	// Check that claimed coins have been removed from a claim's reward
 	suite.USDXRewardEquals(userAddr, c(types.USDXMintingRewardDenom, 0))
 }
+
+func (suite *HandlerTestSuite) TestTransferCDPSettlesUSDXClaims() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12))).
+		WithSimpleAccount(receiverAddr, nil)
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleUSDXRewardPeriod("bnb-a", c(types.USDXMintingRewardDenom, 1e6))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	err := suite.DeliverMsgCreateCDP(userAddr, c("bnb", 1e9), c("usdx", 1e7), "bnb-a")
+	suite.NoError(err)
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	err = suite.DeliverCDPMsgTransfer(userAddr, receiverAddr, "bnb-a")
+	suite.NoError(err)
+
+	// the previous owner keeps the rewards accumulated before the transfer
+	suite.USDXRewardEquals(userAddr, c(types.USDXMintingRewardDenom, 7*1e6))
+	suite.USDXRewardEquals(receiverAddr, c(types.USDXMintingRewardDenom, 0))
+
+	suite.NextBlockAfter(5 * time.Second)
+
+	// sync the new owner's rewards
+	err = suite.DeliverCDPMsgBorrow(receiverAddr, "bnb-a", c("usdx", 1))
+	suite.NoError(err)
+
+	suite.USDXRewardEquals(userAddr, c(types.USDXMintingRewardDenom, 7*1e6))
+	suite.USDXRewardEquals(receiverAddr, c(types.USDXMintingRewardDenom, 5*1e6))
+}
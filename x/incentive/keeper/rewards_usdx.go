@@ -75,6 +75,7 @@ func (k Keeper) InitializeUSDXMintingClaim(ctx sdk.Context, cdp cdptypes.CDP) {
 
 // SynchronizeUSDXMintingReward updates the claim object by adding any accumulated rewards and updating the reward index value.
 // this should be called before a cdp is modified.
+// Claims are indexed per collateral type, so the rewards of all the owner's cdps of the cdp's collateral type are synchronized.
 func (k Keeper) SynchronizeUSDXMintingReward(ctx sdk.Context, cdp cdptypes.CDP) {
 	claim, found := k.GetUSDXMintingClaim(ctx, cdp.Owner)
 	if !found {
@@ -85,6 +86,16 @@ func (k Keeper) SynchronizeUSDXMintingReward(ctx sdk.Context, cdp cdptypes.CDP)
 	if err != nil {
 		panic(fmt.Sprintf("during usdx reward sync, could not get normalized principal for %s: %s", cdp.Owner, err.Error()))
 	}
+	for _, ownerCdp := range k.cdpKeeper.GetCdpsByOwnerAndCollateralType(ctx, cdp.Owner, cdp.Type) {
+		if ownerCdp.ID == cdp.ID {
+			continue
+		}
+		shares, err := ownerCdp.GetNormalizedPrincipal()
+		if err != nil {
+			panic(fmt.Sprintf("during usdx reward sync, could not get normalized principal for %s: %s", cdp.Owner, err.Error()))
+		}
+		sourceShares = sourceShares.Add(shares)
+	}
 
 	claim = k.synchronizeSingleUSDXMintingReward(ctx, claim, cdp.Type, sourceShares)
 
@@ -154,11 +165,11 @@ func (k Keeper) SimulateUSDXMintingSynchronization(ctx sdk.Context, claim types.
 
 		claim.RewardIndexes[index].RewardFactor = globalRewardFactor
 
-		cdp, found := k.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, claim.GetOwner(), ri.CollateralType)
-		if !found {
-			continue
+		totalPrincipal := sdk.ZeroInt()
+		for _, cdp := range k.cdpKeeper.GetCdpsByOwnerAndCollateralType(ctx, claim.GetOwner(), ri.CollateralType) {
+			totalPrincipal = totalPrincipal.Add(cdp.GetTotalPrincipal().Amount)
 		}
-		newRewardsAmount := rewardsAccumulatedFactor.Mul(sdk.NewDecFromInt(cdp.GetTotalPrincipal().Amount)).RoundInt()
+		newRewardsAmount := rewardsAccumulatedFactor.Mul(sdk.NewDecFromInt(totalPrincipal)).RoundInt()
 		if newRewardsAmount.IsZero() {
 			continue
 		}
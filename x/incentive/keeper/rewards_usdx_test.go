@@ -149,6 +149,60 @@ func (suite *USDXIntegrationTests) TestSingleUserAccumulatesRewardsWithoutSyncin
 	suite.BalanceInEpsilon(user, cs(c(cdptypes.DefaultStableDenom, 1e8), c(types.USDXMintingRewardDenom, 3*1e6*1e6)), accuracy)
 }
 
+func (suite *USDXIntegrationTests) TestSingleUserAccumulatesRewardsFromMultipleCdps() {
+	userA := suite.addrs[0]
+
+	authBulder := app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(kavadisttypes.ModuleName, cs(c(types.USDXMintingRewardDenom, 1e18))). // Fill kavadist with enough coins to pay out any reward
+		WithSimpleAccount(userA, cs(c("bnb", 1e12)))                                                  // give the user some coins
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithMultipliers(types.MultipliersPerDenoms{{
+			Denom:       types.USDXMintingRewardDenom,
+			Multipliers: types.Multipliers{types.NewMultiplier("large", 12, d("1.0"))}, // keep payout at 1.0 to make maths easier
+		}}).
+		WithSimpleUSDXRewardPeriod("bnb-a", c(types.USDXMintingRewardDenom, 1e6))
+
+	suite.SetApp()
+	suite.WithGenesisTime(suite.genesisTime)
+	suite.StartChain(
+		NewPricefeedGenStateMultiFromTime(suite.App.AppCodec(), suite.genesisTime),
+		NewCDPGenStateMulti(suite.App.AppCodec()),
+		authBulder.BuildMarshalled(suite.App.AppCodec()),
+		incentBuilder.BuildMarshalled(suite.App.AppCodec()),
+	)
+
+	// User creates two CDPs of the same collateral type.
+	suite.NoError(
+		suite.DeliverMsgCreateCDP(userA, c("bnb", 1e10), c(cdptypes.DefaultStableDenom, 1e9), "bnb-a"),
+	)
+	suite.NoError(
+		suite.DeliverMsgCreateCDP(userA, c("bnb", 1e10), c(cdptypes.DefaultStableDenom, 1e9), "bnb-a"),
+	)
+
+	suite.NextBlockAfter(1e6 * time.Second) // about 12 days
+
+	// User syncs only the second CDP, which must sync the rewards of both
+	msgServer := cdpkeeper.NewMsgServerImpl(suite.App.GetCDPKeeper())
+	repay := cdptypes.NewMsgRepayDebt(userA, "bnb-a", c(cdptypes.DefaultStableDenom, 1), 2)
+	_, err := msgServer.RepayDebt(sdk.WrapSDKContext(suite.Ctx), &repay)
+	suite.NoError(err)
+	draw := cdptypes.NewMsgDrawDebt(userA, "bnb-a", c(cdptypes.DefaultStableDenom, 1), 2)
+	_, err = msgServer.DrawDebt(sdk.WrapSDKContext(suite.Ctx), &draw)
+	suite.NoError(err)
+
+	suite.NextBlockAfter(1e6 * time.Second) // about 12 days
+
+	msg := types.NewMsgClaimUSDXMintingReward(userA.String(), "large")
+	suite.NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// The user has always had 100% of cdp debt across their two CDPs, so they should receive all rewards for both blocks.
+	// Interest is rounded per CDP but accumulated on the total principal, so the user's share is very slightly under 100%.
+	accuracy := 1e-9
+	suite.BalanceInEpsilon(userA, cs(c("bnb", 1e12-2*1e10), c(cdptypes.DefaultStableDenom, 2*1e9), c(types.USDXMintingRewardDenom, 2*1e6*1e6)), accuracy)
+}
+
 func (suite *USDXIntegrationTests) TestReinstatingRewardParamsDoesNotTriggerOverPayments() {
 	userA := suite.addrs[0]
 	userB := suite.addrs[1]
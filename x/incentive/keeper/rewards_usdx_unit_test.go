@@ -15,6 +15,11 @@ type usdxRewardsUnitTester struct {
 	unitTester
 }
 
+func (suite *usdxRewardsUnitTester) SetupTest() {
+	suite.unitTester.SetupTest()
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, newFakeCDPKeeper(), nil, nil, nil, nil, nil, nil, nil)
+}
+
 func (suite *usdxRewardsUnitTester) storeGlobalUSDXIndexes(indexes types.RewardIndexes) {
 	for _, ri := range indexes {
 		suite.keeper.SetUSDXMintingRewardFactor(suite.ctx, ri.CollateralType, ri.RewardFactor)
@@ -461,6 +461,10 @@ func (k *fakeCDPKeeper) GetCdpByOwnerAndCollateralType(_ sdk.Context, owner sdk.
 	return cdptypes.CDP{}, false
 }
 
+func (k *fakeCDPKeeper) GetCdpsByOwnerAndCollateralType(_ sdk.Context, owner sdk.AccAddress, collateralType string) cdptypes.CDPs {
+	return nil
+}
+
 func (k *fakeCDPKeeper) GetCollateral(_ sdk.Context, collateralType string) (cdptypes.CollateralParam, bool) {
 	return cdptypes.CollateralParam{}, false
 }
@@ -285,7 +285,7 @@ func (suite *IntegrationTester) DeliverMsgCreateCDP(owner sdk.AccAddress, collat
 }
 
 func (suite *IntegrationTester) DeliverCDPMsgRepay(owner sdk.AccAddress, collateralType string, payment sdk.Coin) error {
-	msg := cdptypes.NewMsgRepayDebt(owner, collateralType, payment)
+	msg := cdptypes.NewMsgRepayDebt(owner, collateralType, payment, 0)
 	msgServer := cdpkeeper.NewMsgServerImpl(suite.App.GetCDPKeeper())
 
 	_, err := msgServer.RepayDebt(sdk.WrapSDKContext(suite.Ctx), &msg)
@@ -293,13 +293,21 @@ func (suite *IntegrationTester) DeliverCDPMsgRepay(owner sdk.AccAddress, collate
 }
 
 func (suite *IntegrationTester) DeliverCDPMsgBorrow(owner sdk.AccAddress, collateralType string, draw sdk.Coin) error {
-	msg := cdptypes.NewMsgDrawDebt(owner, collateralType, draw)
+	msg := cdptypes.NewMsgDrawDebt(owner, collateralType, draw, 0)
 	msgServer := cdpkeeper.NewMsgServerImpl(suite.App.GetCDPKeeper())
 
 	_, err := msgServer.DrawDebt(sdk.WrapSDKContext(suite.Ctx), &msg)
 	return err
 }
 
+func (suite *IntegrationTester) DeliverCDPMsgTransfer(owner, newOwner sdk.AccAddress, collateralType string) error {
+	msg := cdptypes.NewMsgTransferCDP(owner, newOwner, collateralType, 0)
+	msgServer := cdpkeeper.NewMsgServerImpl(suite.App.GetCDPKeeper())
+
+	_, err := msgServer.TransferCDP(sdk.WrapSDKContext(suite.Ctx), &msg)
+	return err
+}
+
 func (suite *IntegrationTester) DeliverMsgMintDerivative(
 	sender sdk.AccAddress,
 	validator sdk.ValAddress,
@@ -17,7 +17,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 
 		err := k.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
+		if err != nil && !errors.Is(err, types.ErrNoValidPrice) && !errors.Is(err, types.ErrInsufficientPrices) {
 			panic(err)
 		}
 	}
@@ -2,6 +2,9 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -11,6 +14,12 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
+const (
+	flagFrom   = "from"
+	flagTo     = "to"
+	flagOracle = "oracle"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group nameservice queries under a subcommand
@@ -25,8 +34,10 @@ func GetQueryCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		GetCmdPrice(),
 		GetCmdQueryPrices(),
+		GetCmdPriceHistory(),
 		GetCmdRawPrices(),
 		GetCmdOracles(),
+		GetCmdOracleStats(),
 		GetCmdMarkets(),
 		GetCmdQueryParams(),
 	}
@@ -70,6 +81,50 @@ func GetCmdOracles() *cobra.Command {
 	}
 }
 
+// GetCmdOracleStats queries the performance of the oracles of a market
+func GetCmdOracleStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-stats [marketID]",
+		Short: "get the missed price updates and price deviations of the oracles of a market",
+		Long: strings.TrimSpace(`get the missed price updates and price deviations of the oracles of a market:
+		Example:
+		$ kvcli q pricefeed oracle-stats bnb:usd
+		$ kvcli q pricefeed oracle-stats bnb:usd --oracle kava1...
+		`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oracle, err := cmd.Flags().GetString(flagOracle)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryOracleStatsRequest{
+				MarketId:      args[0],
+				OracleAddress: oracle,
+			}
+
+			res, err := queryClient.OracleStats(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOracle, "", "(optional) oracle address to return the stats of")
+
+	return cmd
+}
+
 // GetCmdPrice queries the current price of an asset
 func GetCmdPrice() *cobra.Command {
 	return &cobra.Command{
@@ -125,6 +180,79 @@ func GetCmdQueryPrices() *cobra.Command {
 	}
 }
 
+// GetCmdPriceHistory queries the recorded current prices of a market
+func GetCmdPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [marketID]",
+		Short: "get the recorded current prices of the input market",
+		Long: strings.TrimSpace(`get the current prices of a market recorded between two block times, inclusive:
+		Example:
+		$ kvcli q pricefeed price-history bnb:usd
+		$ kvcli q pricefeed price-history bnb:usd --from 2022-07-01T00:00:00Z --to 2022-07-02T00:00:00Z
+		$ kvcli q pricefeed price-history bnb:usd --page=2 --limit=100
+		`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := readTimeFlag(cmd, flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := readTimeFlag(cmd, flagTo)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryPriceHistoryRequest{
+				MarketId:   args[0],
+				From:       from,
+				To:         to,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PriceHistory(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "price-history")
+
+	cmd.Flags().String(flagFrom, "", "earliest block time to return (RFC3339)")
+	cmd.Flags().String(flagTo, "", "latest block time to return (RFC3339)")
+
+	return cmd
+}
+
+// readTimeFlag parses an optional RFC3339 time flag, returning the zero time if the flag is not set
+func readTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s time %s: %w", flag, value, err)
+	}
+	return t, nil
+}
+
 // GetCmdRawPrices queries the current price of an asset
 func GetCmdRawPrices() *cobra.Command {
 	return &cobra.Command{
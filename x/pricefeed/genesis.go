@@ -12,9 +12,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	// Set the markets and oracles from params
 	k.SetParams(ctx, gs.Params)
 
+	for _, stats := range gs.OracleStats {
+		k.SetOracleStats(ctx, stats)
+	}
+
 	// Iterate through the posted prices and set them in the store if they are not expired
+	// and their oracle is not deactivated
 	for _, pp := range gs.PostedPrices {
-		if pp.Expiry.After(ctx.BlockTime()) {
+		if pp.Expiry.After(ctx.BlockTime()) && !k.IsOracleDeactivated(ctx, pp.MarketID, pp.OracleAddress) {
 			_, err := k.SetPrice(ctx, pp.OracleAddress, pp.MarketID, pp.Price, pp.Expiry)
 			if err != nil {
 				panic(err)
@@ -23,6 +28,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	}
 	params := k.GetParams(ctx)
 
+	for _, ms := range gs.MarketSuspensions {
+		k.SetMarketSuspension(ctx, ms)
+	}
+	for _, entry := range gs.PriceHistory {
+		k.SetPriceHistoryEntry(ctx, entry)
+	}
+
 	// Set the current price (if any) based on what's now in the store
 	for _, market := range params.Markets {
 		if !market.Active {
@@ -51,5 +63,5 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return types.NewGenesisState(params, postedPrices)
+	return types.NewGenesisState(params, postedPrices, k.GetAllMarketSuspensions(ctx), k.GetAllPriceHistory(ctx), k.GetAllOracleStats(ctx))
 }
@@ -0,0 +1,24 @@
+package pricefeed
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// NewProposalHandler handles x/pricefeed proposals.
+func NewProposalHandler(k keeper.Keeper) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.ResetMarketSuspensionProposal:
+			return keeper.HandleResetMarketSuspensionProposal(ctx, k, c)
+		case *types.ReinstateOracleProposal:
+			return keeper.HandleReinstateOracleProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized pricefeed proposal content type: %T", c)
+		}
+	}
+}
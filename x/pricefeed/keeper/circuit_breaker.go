@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// checkPriceDeviation returns true if a new price may be set as the current price of a market with a circuit
+// breaker. A price that deviates too far from the reference price of the current interval suspends the market.
+// While suspended, a price is accepted once it reverts to within the deviation of the reference price, or once
+// it has held within the deviation of the suspended price for a full interval.
+func (k Keeper) checkPriceDeviation(ctx sdk.Context, market types.Market, price sdk.Dec, prevPrice types.CurrentPrice, validPrevPrice bool) bool {
+	now := ctx.BlockTime()
+
+	if suspension, found := k.GetMarketSuspension(ctx, market.MarketID); found {
+		switch {
+		case market.WithinDeviation(suspension.ReferencePrice, price):
+			k.resumeMarket(ctx, market.MarketID, price, types.AttributeValueReverted)
+			return true
+		case !market.WithinDeviation(suspension.SuspendedPrice, price):
+			// the price moved again, so it must hold at its new level for a full interval
+			suspension.SuspendedPrice = price
+			suspension.SuspendedAt = now
+			k.SetMarketSuspension(ctx, suspension)
+			return false
+		case !now.Before(suspension.SuspendedAt.Add(market.DeviationInterval)):
+			k.resumeMarket(ctx, market.MarketID, price, types.AttributeValueConfirmed)
+			return true
+		default:
+			return false
+		}
+	}
+
+	reference, found := k.GetPriceReference(ctx, market.MarketID)
+	if !found || !now.Before(reference.StartTime.Add(market.DeviationInterval)) {
+		if !validPrevPrice {
+			// without a previous price there is nothing to compare to, so the new price starts the interval
+			k.SetPriceReference(ctx, types.NewPriceReference(market.MarketID, price, now))
+			return true
+		}
+		reference = types.NewPriceReference(market.MarketID, prevPrice.Price, now)
+		k.SetPriceReference(ctx, reference)
+	}
+
+	if market.WithinDeviation(reference.Price, price) {
+		return true
+	}
+
+	k.SetMarketSuspension(ctx, types.NewMarketSuspension(market.MarketID, reference.Price, price, now))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketSuspended,
+			sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+			sdk.NewAttribute(types.AttributeReferencePrice, reference.Price.String()),
+			sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+		),
+	)
+
+	return false
+}
+
+// resumeMarket lifts the suspension of a market and starts a new interval at the resumed price
+func (k Keeper) resumeMarket(ctx sdk.Context, marketID string, price sdk.Dec, reason string) {
+	k.DeleteMarketSuspension(ctx, marketID)
+	k.SetPriceReference(ctx, types.NewPriceReference(marketID, price, ctx.BlockTime()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketResumed,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+			sdk.NewAttribute(types.AttributeResumeReason, reason),
+		),
+	)
+}
+
+// ResetMarketSuspension lifts the suspension of a market by accepting its suspended price as the current price
+func (k Keeper) ResetMarketSuspension(ctx sdk.Context, marketID string) error {
+	suspension, found := k.GetMarketSuspension(ctx, marketID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrMarketNotSuspended, marketID)
+	}
+
+	k.resumeMarket(ctx, marketID, suspension.SuspendedPrice, types.AttributeValueReset)
+	k.setCurrentPrice(ctx, marketID, types.NewCurrentPrice(marketID, suspension.SuspendedPrice))
+	return nil
+}
+
+// IsMarketSuspended returns true if the current price of a market is suspended
+func (k Keeper) IsMarketSuspended(ctx sdk.Context, marketID string) bool {
+	return ctx.KVStore(k.key).Has(types.MarketSuspensionKey(marketID))
+}
+
+// GetMarketSuspension returns the suspension of a market from the store
+func (k Keeper) GetMarketSuspension(ctx sdk.Context, marketID string) (types.MarketSuspension, bool) {
+	bz := ctx.KVStore(k.key).Get(types.MarketSuspensionKey(marketID))
+	if bz == nil {
+		return types.MarketSuspension{}, false
+	}
+
+	var suspension types.MarketSuspension
+	k.cdc.MustUnmarshal(bz, &suspension)
+	return suspension, true
+}
+
+// SetMarketSuspension saves the suspension of a market to the store
+func (k Keeper) SetMarketSuspension(ctx sdk.Context, suspension types.MarketSuspension) {
+	ctx.KVStore(k.key).Set(types.MarketSuspensionKey(suspension.MarketID), k.cdc.MustMarshal(&suspension))
+}
+
+// DeleteMarketSuspension deletes the suspension of a market from the store
+func (k Keeper) DeleteMarketSuspension(ctx sdk.Context, marketID string) {
+	ctx.KVStore(k.key).Delete(types.MarketSuspensionKey(marketID))
+}
+
+// IterateMarketSuspensions iterates over all market suspensions in the store and performs a callback function
+func (k Keeper) IterateMarketSuspensions(ctx sdk.Context, cb func(suspension types.MarketSuspension) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.MarketSuspensionPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var suspension types.MarketSuspension
+		k.cdc.MustUnmarshal(iterator.Value(), &suspension)
+		if cb(suspension) {
+			break
+		}
+	}
+}
+
+// GetAllMarketSuspensions returns all market suspensions from the store
+func (k Keeper) GetAllMarketSuspensions(ctx sdk.Context) types.MarketSuspensions {
+	var suspensions types.MarketSuspensions
+	k.IterateMarketSuspensions(ctx, func(suspension types.MarketSuspension) bool {
+		suspensions = append(suspensions, suspension)
+		return false
+	})
+	return suspensions
+}
+
+// GetPriceReference returns the reference price of a market's current interval from the store
+func (k Keeper) GetPriceReference(ctx sdk.Context, marketID string) (types.PriceReference, bool) {
+	bz := ctx.KVStore(k.key).Get(types.PriceReferenceKey(marketID))
+	if bz == nil {
+		return types.PriceReference{}, false
+	}
+
+	var reference types.PriceReference
+	k.cdc.MustUnmarshal(bz, &reference)
+	return reference, true
+}
+
+// SetPriceReference saves the reference price of a market's current interval to the store
+func (k Keeper) SetPriceReference(ctx sdk.Context, reference types.PriceReference) {
+	ctx.KVStore(k.key).Set(types.PriceReferenceKey(reference.MarketID), k.cdc.MustMarshal(&reference))
+}
+
+// DeletePriceReference deletes the reference price of a market from the store
+func (k Keeper) DeletePriceReference(ctx sdk.Context, marketID string) {
+	ctx.KVStore(k.key).Delete(types.PriceReferenceKey(marketID))
+}
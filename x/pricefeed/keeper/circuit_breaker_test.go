@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+var circuitBreakerStartTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// setupCircuitBreaker returns a context with a market that is suspended by moves of more than 10% per hour
+func setupCircuitBreaker(t *testing.T) (sdk.Context, keeper.Keeper, sdk.AccAddress) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(circuitBreakerStartTime)
+	k := tApp.GetPriceFeedKeeper()
+
+	k.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			types.NewMarketWithCircuitBreaker("tstusd", "tst", "usd", addrs, true, 1000, time.Hour),
+		},
+	})
+
+	return ctx, k, addrs[0]
+}
+
+// postAndUpdate posts a price from the oracle and updates the current price of the market
+func postAndUpdate(t *testing.T, ctx sdk.Context, k keeper.Keeper, oracle sdk.AccAddress, price string) {
+	_, err := k.SetPrice(ctx, oracle, "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(24*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+}
+
+func TestKeeper_CircuitBreaker_Suspend(t *testing.T) {
+	ctx, k, oracle := setupCircuitBreaker(t)
+
+	postAndUpdate(t, ctx, k, oracle, "10.00")
+	price, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.00"), price.Price)
+
+	// a move within the bound updates the price
+	ctx = ctx.WithBlockTime(circuitBreakerStartTime.Add(time.Minute))
+	postAndUpdate(t, ctx, k, oracle, "10.90")
+	price, err = k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.90"), price.Price)
+
+	// moves are measured from the price at the start of the interval
+	ctx = ctx.WithBlockTime(circuitBreakerStartTime.Add(2 * time.Minute))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	postAndUpdate(t, ctx, k, oracle, "11.50")
+
+	_, err = k.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrMarketSuspended)
+	require.True(t, k.IsMarketSuspended(ctx, "tstusd"))
+
+	suspension, found := k.GetMarketSuspension(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, types.NewMarketSuspension("tstusd", sdk.MustNewDecFromStr("10.00"), sdk.MustNewDecFromStr("11.50"), ctx.BlockTime()), suspension)
+
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMarketSuspended,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeReferencePrice, sdk.MustNewDecFromStr("10.00").String()),
+		sdk.NewAttribute(types.AttributeMarketPrice, sdk.MustNewDecFromStr("11.50").String()),
+	))
+
+	// the last accepted price is kept while suspended
+	prices := k.GetCurrentPrices(ctx)
+	require.Equal(t, types.CurrentPrices{types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("10.90"))}, prices)
+}
+
+func TestKeeper_CircuitBreaker_IntervalResetsReference(t *testing.T) {
+	ctx, k, oracle := setupCircuitBreaker(t)
+
+	postAndUpdate(t, ctx, k, oracle, "10.00")
+
+	ctx = ctx.WithBlockTime(circuitBreakerStartTime.Add(30 * time.Minute))
+	postAndUpdate(t, ctx, k, oracle, "10.90")
+
+	// the next interval measures moves from the price at its start
+	ctx = ctx.WithBlockTime(circuitBreakerStartTime.Add(time.Hour))
+	postAndUpdate(t, ctx, k, oracle, "11.50")
+
+	price, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("11.50"), price.Price)
+}
+
+func TestKeeper_CircuitBreaker_Revert(t *testing.T) {
+	ctx, k, oracle := setupCircuitBreaker(t)
+
+	postAndUpdate(t, ctx, k, oracle, "10.00")
+	postAndUpdate(t, ctx, k, oracle, "5.00")
+	require.True(t, k.IsMarketSuspended(ctx, "tstusd"))
+
+	ctx = ctx.WithBlockTime(circuitBreakerStartTime.Add(time.Minute))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	postAndUpdate(t, ctx, k, oracle, "9.50")
+
+	require.False(t, k.IsMarketSuspended(ctx, "tstusd"))
+	price, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("9.50"), price.Price)
+
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMarketResumed,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeMarketPrice, sdk.MustNewDecFromStr("9.50").String()),
+		sdk.NewAttribute(types.AttributeResumeReason, types.AttributeValueReverted),
+	))
+}
+
+func TestKeeper_CircuitBreaker_Confirm(t *testing.T) {
+	ctx, k, oracle := setupCircuitBreaker(t)
+
+	postAndUpdate(t, ctx, k, oracle, "10.00")
+	postAndUpdate(t, ctx, k, oracle, "5.00")
+
+	// a price that moves beyond the bound of the suspended price restarts the confirmation interval
+	ctx = ctx.WithBlockTime(circuitBreakerStartTime.Add(30 * time.Minute))
+	postAndUpdate(t, ctx, k, oracle, "4.00")
+
+	ctx = ctx.WithBlockTime(circuitBreakerStartTime.Add(time.Hour))
+	postAndUpdate(t, ctx, k, oracle, "4.10")
+	_, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrMarketSuspended)
+
+	// the price held within the bound of the suspended price for a full interval
+	ctx = ctx.WithBlockTime(circuitBreakerStartTime.Add(90 * time.Minute))
+	postAndUpdate(t, ctx, k, oracle, "4.20")
+
+	price, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("4.20"), price.Price)
+
+	reference, found := k.GetPriceReference(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, types.NewPriceReference("tstusd", sdk.MustNewDecFromStr("4.20"), ctx.BlockTime()), reference)
+}
+
+func TestKeeper_CircuitBreaker_ResetProposal(t *testing.T) {
+	ctx, k, oracle := setupCircuitBreaker(t)
+
+	proposal := types.NewResetMarketSuspensionProposal("title", "description", "tstusd")
+
+	err := keeper.HandleResetMarketSuspensionProposal(ctx, k, proposal)
+	require.ErrorIs(t, err, types.ErrMarketNotSuspended)
+
+	postAndUpdate(t, ctx, k, oracle, "10.00")
+	postAndUpdate(t, ctx, k, oracle, "5.00")
+
+	err = keeper.HandleResetMarketSuspensionProposal(ctx, k, proposal)
+	require.NoError(t, err)
+
+	require.False(t, k.IsMarketSuspended(ctx, "tstusd"))
+	price, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("5.00"), price.Price)
+
+	// later prices are measured from the reset price
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	require.False(t, k.IsMarketSuspended(ctx, "tstusd"))
+}
+
+func TestKeeper_CircuitBreaker_Disabled(t *testing.T) {
+	ctx, k, oracle := setupCircuitBreaker(t)
+
+	postAndUpdate(t, ctx, k, oracle, "10.00")
+	postAndUpdate(t, ctx, k, oracle, "5.00")
+	require.True(t, k.IsMarketSuspended(ctx, "tstusd"))
+
+	// removing the circuit breaker from the market lifts its suspension
+	k.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{oracle}, true),
+		},
+	})
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+
+	require.False(t, k.IsMarketSuspended(ctx, "tstusd"))
+	price, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("5.00"), price.Price)
+}
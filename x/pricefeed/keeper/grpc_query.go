@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -52,6 +54,50 @@ func (s queryServer) Price(c context.Context, req *types.QueryPriceRequest) (*ty
 	}, nil
 }
 
+// PriceHistory implements the gRPC service handler for querying the price history of a market.
+func (s queryServer) PriceHistory(c context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	if !req.To.IsZero() && req.To.Before(req.From) {
+		return nil, status.Errorf(codes.InvalidArgument, "to %s is before from %s", req.To, req.From)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.PriceHistoryIteratorKey(req.MarketId))
+
+	var entries types.PriceHistoryEntries
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var entry types.PriceHistoryEntry
+		if err := s.keeper.cdc.Unmarshal(value, &entry); err != nil {
+			return false, err
+		}
+
+		if entry.BlockTime.Before(req.From) || (!req.To.IsZero() && entry.BlockTime.After(req.To)) {
+			return false, nil
+		}
+
+		if accumulate {
+			entries = append(entries, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		PriceHistory: entries,
+		Pagination:   pageRes,
+	}, nil
+}
+
 func (s queryServer) Prices(c context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -108,6 +154,44 @@ func (s queryServer) Oracles(c context.Context, req *types.QueryOraclesRequest)
 	}, nil
 }
 
+// OracleStats implements the gRPC service handler for querying the performance of the oracles of a market.
+func (s queryServer) OracleStats(c context.Context, req *types.QueryOracleStatsRequest) (*types.QueryOracleStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	oracles, err := s.keeper.GetOracles(ctx, req.MarketId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	if req.OracleAddress != "" {
+		oracle, err := sdk.AccAddressFromBech32(req.OracleAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if _, err := s.keeper.GetOracle(ctx, req.MarketId, oracle); err != nil {
+			return nil, status.Error(codes.NotFound, "invalid oracle address")
+		}
+		oracles = []sdk.AccAddress{oracle}
+	}
+
+	var statsResponses types.OracleStatsResponses
+	for _, oracle := range oracles {
+		stats, found := s.keeper.GetOracleStats(ctx, req.MarketId, oracle)
+		if !found {
+			stats = types.NewOracleStats(req.MarketId, oracle)
+		}
+		statsResponses = append(statsResponses, stats.ToOracleStatsResponse())
+	}
+
+	return &types.QueryOracleStatsResponse{
+		OracleStats: statsResponses,
+	}, nil
+}
+
 func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest) (*types.QueryMarketsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -24,11 +24,13 @@ type Keeper struct {
 	cdc codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace paramtypes.Subspace
+	// The staking keeper used to weight oracle prices by validator stake
+	stakingKeeper types.StakingKeeper
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
+	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace, sk types.StakingKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -38,6 +40,7 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		stakingKeeper: sk,
 	}
 }
 
@@ -79,29 +82,37 @@ func (k Keeper) SetPrice(
 	return newRawPrice, nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset by aggregating all valid oracle inputs with the
+// market's aggregation method
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
 	}
 	// store current price
 	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
+	prevPrice, err := k.getCurrentPrice(ctx, marketID)
 	if err != nil {
 		validPrevPrice = false
 	}
 
 	prices := k.GetRawPrices(ctx, marketID)
 
-	var notExpiredPrices []types.CurrentPrice
-	// filter out expired prices
+	var notExpiredPrices types.PostedPrices
+	// filter out expired prices and prices of deactivated oracles
 	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+		if v.Expiry.After(ctx.BlockTime()) && !k.IsOracleDeactivated(ctx, marketID, v.OracleAddress) {
+			notExpiredPrices = append(notExpiredPrices, v)
 		}
 	}
 
+	// oracle prices are only compared to a price aggregated from enough oracles
+	var aggregatePrice sdk.Dec
+	if len(notExpiredPrices) >= market.RequiredOracleCount() {
+		aggregatePrice = k.aggregatePrices(ctx, market, notExpiredPrices)
+	}
+	k.updateOracleStats(ctx, market, notExpiredPrices, aggregatePrice)
+
 	if len(notExpiredPrices) == 0 {
 		// NOTE: The current price stored will continue storing the most recent (expired)
 		// price if this is not set.
@@ -111,26 +122,73 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 		return types.ErrNoValidPrice
 	}
 
-	medianPrice := k.CalculateMedianPrice(notExpiredPrices)
+	if len(notExpiredPrices) < market.RequiredOracleCount() {
+		// too few oracles are posting to trust the price, so it is cleared as if all prices expired
+		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+		return sdkerrors.Wrapf(types.ErrInsufficientPrices, "market %s has %d of %d required prices", marketID, len(notExpiredPrices), market.RequiredOracleCount())
+	}
+
+	if market.HasCircuitBreaker() {
+		if !k.checkPriceDeviation(ctx, market, aggregatePrice, prevPrice, validPrevPrice) {
+			// the previous price is kept until the suspended price is confirmed or reset
+			return nil
+		}
+	} else {
+		// clear any circuit breaker state left by a previous market configuration
+		k.DeleteMarketSuspension(ctx, marketID)
+		k.DeletePriceReference(ctx, marketID)
+	}
 
 	// check case that market price was not set in genesis
-	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
+	if validPrevPrice && !aggregatePrice.Equal(prevPrice.Price) {
 		// only emit event if price has changed
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeMarketPriceUpdated,
 				sdk.NewAttribute(types.AttributeMarketID, marketID),
-				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+				sdk.NewAttribute(types.AttributeMarketPrice, aggregatePrice.String()),
 			),
 		)
 	}
 
-	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
+	currentPrice := types.NewCurrentPrice(marketID, aggregatePrice)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
+	k.recordPriceHistory(ctx, market, aggregatePrice, len(notExpiredPrices))
 
 	return nil
 }
 
+// aggregatePrices combines the posted prices of a market into a single price with the market's aggregation method
+func (k Keeper) aggregatePrices(ctx sdk.Context, market types.Market, postedPrices types.PostedPrices) sdk.Dec {
+	prices := make([]types.CurrentPrice, len(postedPrices))
+	for i, pp := range postedPrices {
+		prices[i] = types.NewCurrentPrice(pp.MarketID, pp.Price)
+	}
+
+	switch market.AggregationMethod {
+	case types.AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN:
+		weights := make([]sdk.Int, len(postedPrices))
+		for i, pp := range postedPrices {
+			weights[i] = k.oracleStake(ctx, pp.OracleAddress)
+		}
+		return k.CalculateWeightedMedianPrice(prices, weights)
+	case types.AGGREGATION_METHOD_TRIMMED_MEAN:
+		return k.CalculateTrimmedMeanPrice(prices, market.TrimCount)
+	default:
+		return k.CalculateMedianPrice(prices)
+	}
+}
+
+// oracleStake returns the bonded tokens of the validator operated by an oracle, or zero if the oracle
+// does not operate a bonded validator
+func (k Keeper) oracleStake(ctx sdk.Context, oracle sdk.AccAddress) sdk.Int {
+	validator, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(oracle))
+	if !found {
+		return sdk.ZeroInt()
+	}
+	return validator.GetBondedTokens()
+}
+
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
 	store := ctx.KVStore(k.key)
 	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshal(&currentPrice))
@@ -157,14 +215,95 @@ func (k Keeper) CalculateMedianPrice(prices []types.CurrentPrice) sdk.Dec {
 	return prices[l/2].Price
 }
 
+// CalculateWeightedMedianPrice calculates the weighted median of the input prices, the lowest price at which
+// the prices at or below it hold at least half of the total weight. If exactly half the weight is at or below
+// a price, the median is the mean of that price and the next. The plain median is returned if the total weight
+// is zero.
+func (k Keeper) CalculateWeightedMedianPrice(prices []types.CurrentPrice, weights []sdk.Int) sdk.Dec {
+	type weightedPrice struct {
+		price  types.CurrentPrice
+		weight sdk.Int
+	}
+
+	weighted := make([]weightedPrice, len(prices))
+	totalWeight := sdk.ZeroInt()
+	for i := range prices {
+		weighted[i] = weightedPrice{price: prices[i], weight: weights[i]}
+		totalWeight = totalWeight.Add(weights[i])
+	}
+
+	if !totalWeight.IsPositive() {
+		return k.CalculateMedianPrice(prices)
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].price.Price.LT(weighted[j].price.Price)
+	})
+
+	// compare twice the cumulative weight to the total to avoid rounding half of an odd total
+	cumulativeWeight := sdk.ZeroInt()
+	for i, wp := range weighted {
+		cumulativeWeight = cumulativeWeight.Add(wp.weight)
+		doubled := cumulativeWeight.MulRaw(2)
+		if doubled.Equal(totalWeight) {
+			// the median falls between this price and the next price with a positive weight
+			for _, next := range weighted[i+1:] {
+				if next.weight.IsPositive() {
+					return k.calculateMeanPrice(wp.price, next.price)
+				}
+			}
+			return wp.price.Price
+		}
+		if doubled.GT(totalWeight) {
+			return wp.price.Price
+		}
+	}
+
+	// unreachable as the cumulative weight reaches the positive total weight
+	return weighted[len(weighted)-1].price.Price
+}
+
+// CalculateTrimmedMeanPrice calculates the mean of the input prices after discarding the trim count of
+// lowest and of highest prices. Fewer prices are discarded if the trim count would not leave a price.
+func (k Keeper) CalculateTrimmedMeanPrice(prices []types.CurrentPrice, trimCount uint64) sdk.Dec {
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Price.LT(prices[j].Price)
+	})
+
+	trimmed := len(prices)
+	if trimCount < uint64(trimmed) {
+		trimmed = int(trimCount)
+	}
+	if 2*trimmed >= len(prices) {
+		// always keep the middle prices
+		trimmed = (len(prices) - 1) / 2
+	}
+
+	kept := prices[trimmed : len(prices)-trimmed]
+	sum := sdk.ZeroDec()
+	for _, p := range kept {
+		sum = sum.Add(p.Price)
+	}
+	return sum.QuoInt64(int64(len(kept)))
+}
+
 func (k Keeper) calculateMeanPrice(priceA, priceB types.CurrentPrice) sdk.Dec {
 	sum := priceA.Price.Add(priceB.Price)
 	mean := sum.Quo(sdk.NewDec(2))
 	return mean
 }
 
-// GetCurrentPrice fetches the current median price of all oracles for a specific market
+// GetCurrentPrice fetches the current median price of all oracles for a specific market. It returns
+// ErrMarketSuspended while the market's circuit breaker withholds the price.
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	if k.IsMarketSuspended(ctx, marketID) {
+		return types.CurrentPrice{}, sdkerrors.Wrap(types.ErrMarketSuspended, marketID)
+	}
+	return k.getCurrentPrice(ctx, marketID)
+}
+
+// getCurrentPrice fetches the stored current price of a market regardless of suspension
+func (k Keeper) getCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.CurrentPriceKey(marketID))
 
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -236,3 +237,160 @@ func TestKeeper_ExpiredSetCurrentPrices(t *testing.T) {
 	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
 	require.ErrorIs(t, types.ErrNoValidPrice, err, "current prices should be invalid")
 }
+
+func TestKeeper_CalculateWeightedMedianPrice(t *testing.T) {
+	tApp := app.NewTestApp()
+	keeper := tApp.GetPriceFeedKeeper()
+
+	prices := func(values ...string) []types.CurrentPrice {
+		cps := make([]types.CurrentPrice, len(values))
+		for i, v := range values {
+			cps[i] = types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr(v))
+		}
+		return cps
+	}
+	weights := func(values ...int64) []sdk.Int {
+		ws := make([]sdk.Int, len(values))
+		for i, v := range values {
+			ws[i] = sdk.NewInt(v)
+		}
+		return ws
+	}
+
+	testCases := []struct {
+		name     string
+		prices   []types.CurrentPrice
+		weights  []sdk.Int
+		expected sdk.Dec
+	}{
+		{"single price", prices("0.33"), weights(10), sdk.MustNewDecFromStr("0.33")},
+		{"equal weights odd", prices("0.35", "0.33", "0.34"), weights(1, 1, 1), sdk.MustNewDecFromStr("0.34")},
+		{"equal weights even", prices("0.35", "0.33", "0.34", "0.36"), weights(1, 1, 1, 1), sdk.MustNewDecFromStr("0.345")},
+		{"heavy high price", prices("0.33", "0.34", "0.50"), weights(1, 1, 3), sdk.MustNewDecFromStr("0.50")},
+		{"heavy low price", prices("0.10", "0.34", "0.35"), weights(5, 2, 2), sdk.MustNewDecFromStr("0.10")},
+		{"half weight skips zero weights", prices("0.30", "0.31", "0.40"), weights(2, 0, 2), sdk.MustNewDecFromStr("0.35")},
+		{"zero weights use median", prices("0.10", "0.34", "0.35"), weights(0, 0, 0), sdk.MustNewDecFromStr("0.34")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, keeper.CalculateWeightedMedianPrice(tc.prices, tc.weights))
+		})
+	}
+}
+
+func TestKeeper_CalculateTrimmedMeanPrice(t *testing.T) {
+	tApp := app.NewTestApp()
+	keeper := tApp.GetPriceFeedKeeper()
+
+	prices := []types.CurrentPrice{
+		types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("0.30")),
+		types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("0.90")),
+		types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("0.34")),
+		types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("0.01")),
+		types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("0.32")),
+	}
+
+	require.Equal(t, sdk.MustNewDecFromStr("0.374"), keeper.CalculateTrimmedMeanPrice(prices, 0))
+	require.Equal(t, sdk.MustNewDecFromStr("0.32"), keeper.CalculateTrimmedMeanPrice(prices, 1))
+	// the middle price is kept when the trim count would discard every price
+	require.Equal(t, sdk.MustNewDecFromStr("0.32"), keeper.CalculateTrimmedMeanPrice(prices, 3))
+}
+
+func TestKeeper_SetCurrentPrices_MinOracleCount(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: []types.Market{
+			types.NewMarketWithAggregation("tstusd", "tst", "usd", addrs, true, types.AGGREGATION_METHOD_MEDIAN, 2, 0),
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("0.33"), time.Now().Add(time.Hour*1))
+	require.NoError(t, err)
+
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrInsufficientPrices)
+
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice, "a single oracle should not set the price")
+
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("0.35"), time.Now().Add(time.Hour*1))
+	require.NoError(t, err)
+
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.34"), price.Price)
+}
+
+func TestKeeper_SetCurrentPrices_TrimmedMean(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: []types.Market{
+			types.NewMarketWithAggregation("tstusd", "tst", "usd", addrs, true, types.AGGREGATION_METHOD_TRIMMED_MEAN, 3, 1),
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	for i, price := range []string{"0.33", "0.35", "0.01", "0.90"} {
+		_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), time.Now().Add(time.Hour*1))
+		require.NoError(t, err)
+	}
+
+	err := keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.34"), price.Price)
+}
+
+func TestKeeper_SetCurrentPrices_StakeWeightedMedian(t *testing.T) {
+	privKeys, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+	stakingKeeper := tApp.GetStakingKeeper()
+
+	// only the first two oracles operate bonded validators
+	for i, tokens := range []int64{1e6, 3e6} {
+		validator, err := stakingtypes.NewValidator(sdk.ValAddress(addrs[i]), privKeys[i].PubKey(), stakingtypes.Description{})
+		require.NoError(t, err)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = sdk.NewInt(tokens)
+		stakingKeeper.SetValidator(ctx, validator)
+	}
+
+	mp := types.Params{
+		Markets: []types.Market{
+			types.NewMarketWithAggregation("tstusd", "tst", "usd", addrs, true, types.AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN, 0, 0),
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	for i, price := range []string{"0.33", "0.36", "0.34"} {
+		_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), time.Now().Add(time.Hour*1))
+		require.NoError(t, err)
+	}
+
+	err := keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.36"), price.Price, "the price of the largest validator should hold the median")
+}
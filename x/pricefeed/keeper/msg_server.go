@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -32,6 +33,9 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 	if err != nil {
 		return nil, err
 	}
+	if k.keeper.IsOracleDeactivated(ctx, msg.MarketID, from) {
+		return nil, sdkerrors.Wrap(types.ErrOracleDeactivated, msg.From)
+	}
 
 	_, err = k.keeper.SetPrice(ctx, from, msg.MarketID, msg.Price, msg.Expiry)
 	if err != nil {
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// updateOracleStats records whether each active oracle of a market posted a fresh price for the update and the
+// deviation of each fresh price from the current price. Deviations are not recorded if the current price is nil.
+// Oracles that miss the market's max number of consecutive updates are deactivated.
+func (k Keeper) updateOracleStats(ctx sdk.Context, market types.Market, freshPrices types.PostedPrices, currentPrice sdk.Dec) {
+	for _, oracle := range market.Oracles {
+		stats, found := k.GetOracleStats(ctx, market.MarketID, oracle)
+		if !found {
+			stats = types.NewOracleStats(market.MarketID, oracle)
+		}
+		if stats.Deactivated {
+			continue
+		}
+
+		posted, found := freshPrices.Get(oracle)
+		switch {
+		case !found:
+			stats.RecordMiss()
+		case currentPrice.IsNil():
+			// the posted price can not be compared when too few oracles posted
+			stats.RecordPost()
+		default:
+			deviation := types.DeviationBps(currentPrice, posted.Price)
+			stats.RecordDeviation(deviation)
+			if market.IsOracleOutlier(deviation) {
+				stats.RecordMiss()
+			} else {
+				stats.RecordPost()
+			}
+		}
+
+		if market.DeactivatesOracles() && stats.MissedCount >= market.MaxOracleMisses {
+			stats.Deactivated = true
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeOracleDeactivated,
+					sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+					sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+					sdk.NewAttribute(types.AttributeMissedCount, fmt.Sprintf("%d", stats.MissedCount)),
+				),
+			)
+		}
+
+		k.SetOracleStats(ctx, stats)
+	}
+}
+
+// ReinstateOracle reactivates an oracle deactivated for missing price updates and resets its consecutive misses
+func (k Keeper) ReinstateOracle(ctx sdk.Context, marketID string, oracle sdk.AccAddress) error {
+	stats, found := k.GetOracleStats(ctx, marketID, oracle)
+	if !found || !stats.Deactivated {
+		return sdkerrors.Wrapf(types.ErrOracleNotDeactivated, "oracle %s in market %s", oracle, marketID)
+	}
+
+	stats.Deactivated = false
+	stats.MissedCount = 0
+	k.SetOracleStats(ctx, stats)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleReinstated,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+		),
+	)
+
+	return nil
+}
+
+// IsOracleDeactivated returns true if an oracle is deactivated in a market
+func (k Keeper) IsOracleDeactivated(ctx sdk.Context, marketID string, oracle sdk.AccAddress) bool {
+	stats, found := k.GetOracleStats(ctx, marketID, oracle)
+	return found && stats.Deactivated
+}
+
+// GetOracleStats returns the stats of an oracle in a market from the store
+func (k Keeper) GetOracleStats(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleStats, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleStatsKey(marketID, oracle))
+	if bz == nil {
+		return types.OracleStats{}, false
+	}
+
+	var stats types.OracleStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetOracleStats saves the stats of an oracle to the store
+func (k Keeper) SetOracleStats(ctx sdk.Context, stats types.OracleStats) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleStatsKey(stats.MarketID, stats.OracleAddress), k.cdc.MustMarshal(&stats))
+}
+
+// IterateOracleStatsByMarket iterates over the stats of all oracles in a market and performs a callback function
+func (k Keeper) IterateOracleStatsByMarket(ctx sdk.Context, marketID string, cb func(stats types.OracleStats) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OracleStatsIteratorKey(marketID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetMarketOracleStats returns the stats of all oracles in a market
+func (k Keeper) GetMarketOracleStats(ctx sdk.Context, marketID string) types.OracleStatsList {
+	var statsList types.OracleStatsList
+	k.IterateOracleStatsByMarket(ctx, marketID, func(stats types.OracleStats) bool {
+		statsList = append(statsList, stats)
+		return false
+	})
+	return statsList
+}
+
+// GetAllOracleStats returns the stats of all oracles in all markets from the store
+func (k Keeper) GetAllOracleStats(ctx sdk.Context) types.OracleStatsList {
+	var statsList types.OracleStatsList
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleStatsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		statsList = append(statsList, stats)
+	}
+	return statsList
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+var oracleStatsStartTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// setupOracleStats returns a context with a market of three oracles that deactivates an oracle after two
+// consecutive missed updates, counting prices more than 5% from the current price as missed
+func setupOracleStats(t *testing.T) (sdk.Context, keeper.Keeper, []sdk.AccAddress) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(oracleStatsStartTime)
+	k := tApp.GetPriceFeedKeeper()
+
+	k.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarketWithOracleTracking("tstusd", "tst", "usd", addrs, true, 2, 500),
+	}))
+
+	return ctx, k, addrs
+}
+
+// postPrices posts a price for each oracle with a price and updates the current price of the market a minute later
+func postPrices(t *testing.T, ctx sdk.Context, k keeper.Keeper, oracles []sdk.AccAddress, prices []string) sdk.Context {
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	for i, price := range prices {
+		if price == "" {
+			continue
+		}
+		_, err := k.SetPrice(ctx, oracles[i], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Minute))
+		require.NoError(t, err)
+	}
+	_ = k.SetCurrentPrices(ctx, "tstusd")
+	return ctx
+}
+
+func TestKeeper_OracleStats_Record(t *testing.T) {
+	ctx, k, oracles := setupOracleStats(t)
+
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.20", ""})
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.40", "10.00"})
+
+	stats, found := k.GetOracleStats(ctx, "tstusd", oracles[0])
+	require.True(t, found)
+	require.Equal(t, types.OracleStats{
+		MarketID:          "tstusd",
+		OracleAddress:     oracles[0],
+		TotalUpdates:      2,
+		TotalPriced:       2,
+		TotalDeviationBps: 99, // 0.10 from the median of 10.10 rounded down
+		LastDeviationBps:  0,
+	}, stats)
+
+	stats, found = k.GetOracleStats(ctx, "tstusd", oracles[1])
+	require.True(t, found)
+	require.Equal(t, uint64(400), stats.LastDeviationBps)
+	require.Equal(t, uint64(249), stats.AverageDeviationBps())
+
+	stats, found = k.GetOracleStats(ctx, "tstusd", oracles[2])
+	require.True(t, found)
+	require.Equal(t, types.OracleStats{
+		MarketID:      "tstusd",
+		OracleAddress: oracles[2],
+		MissedCount:   0,
+		TotalUpdates:  2,
+		TotalMissed:   1,
+		TotalPriced:   1,
+	}, stats)
+}
+
+func TestKeeper_OracleStats_DeactivateOnMisses(t *testing.T) {
+	ctx, k, oracles := setupOracleStats(t)
+
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.00", ""})
+	require.False(t, k.IsOracleDeactivated(ctx, "tstusd", oracles[2]))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.00", ""})
+	require.True(t, k.IsOracleDeactivated(ctx, "tstusd", oracles[2]))
+
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeOracleDeactivated,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeOracle, oracles[2].String()),
+		sdk.NewAttribute(types.AttributeMissedCount, "2"),
+	))
+
+	// deactivated oracles can not post prices
+	msgServer := keeper.NewMsgServerImpl(k)
+	msg := types.NewMsgPostPrice(oracles[2].String(), "tstusd", sdk.MustNewDecFromStr("10.00"), ctx.BlockTime().Add(time.Hour))
+	_, err := msgServer.PostPrice(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrOracleDeactivated)
+
+	// the stats of deactivated oracles are frozen
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.00", ""})
+	stats, _ := k.GetOracleStats(ctx, "tstusd", oracles[2])
+	require.Equal(t, uint64(2), stats.TotalUpdates)
+}
+
+func TestKeeper_OracleStats_DeactivateOnOutliers(t *testing.T) {
+	ctx, k, oracles := setupOracleStats(t)
+
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.00", "12.00"})
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.00", "12.00"})
+	require.True(t, k.IsOracleDeactivated(ctx, "tstusd", oracles[2]))
+
+	// the price of a deactivated oracle is excluded from the current price
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "11.00", ""})
+	_, err := k.SetPrice(ctx, oracles[2], "tstusd", sdk.MustNewDecFromStr("100.00"), ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+
+	price, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.50"), price.Price)
+}
+
+func TestKeeper_OracleStats_Reinstate(t *testing.T) {
+	ctx, k, oracles := setupOracleStats(t)
+
+	proposal := types.NewReinstateOracleProposal("title", "description", "tstusd", oracles[2])
+	err := keeper.HandleReinstateOracleProposal(ctx, k, proposal)
+	require.ErrorIs(t, err, types.ErrOracleNotDeactivated)
+
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.00", ""})
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.00", ""})
+	require.True(t, k.IsOracleDeactivated(ctx, "tstusd", oracles[2]))
+
+	err = keeper.HandleReinstateOracleProposal(ctx, k, proposal)
+	require.NoError(t, err)
+
+	stats, found := k.GetOracleStats(ctx, "tstusd", oracles[2])
+	require.True(t, found)
+	require.False(t, stats.Deactivated)
+	require.Equal(t, uint64(0), stats.MissedCount)
+	require.Equal(t, uint64(2), stats.TotalMissed)
+
+	// a reinstated oracle can miss the max number of updates again before it is deactivated
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.00", ""})
+	require.False(t, k.IsOracleDeactivated(ctx, "tstusd", oracles[2]))
+}
+
+func TestKeeper_OracleStats_Query(t *testing.T) {
+	ctx, k, oracles := setupOracleStats(t)
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	ctx = postPrices(t, ctx, k, oracles, []string{"10.00", "10.00", ""})
+
+	res, err := queryServer.OracleStats(sdk.WrapSDKContext(ctx), &types.QueryOracleStatsRequest{MarketId: "tstusd"})
+	require.NoError(t, err)
+	require.Len(t, res.OracleStats, 3)
+
+	res, err = queryServer.OracleStats(sdk.WrapSDKContext(ctx), &types.QueryOracleStatsRequest{
+		MarketId:      "tstusd",
+		OracleAddress: oracles[2].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.OracleStatsResponses{{
+		MarketID:      "tstusd",
+		OracleAddress: oracles[2].String(),
+		MissedCount:   1,
+		TotalUpdates:  1,
+		TotalMissed:   1,
+	}}, res.OracleStats)
+
+	_, err = queryServer.OracleStats(sdk.WrapSDKContext(ctx), &types.QueryOracleStatsRequest{
+		MarketId:      "tstusd",
+		OracleAddress: sdk.AccAddress("unknown").String(),
+	})
+	require.Error(t, err)
+
+	_, err = queryServer.OracleStats(sdk.WrapSDKContext(ctx), &types.QueryOracleStatsRequest{MarketId: "invalid"})
+	require.Equal(t, "rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -19,6 +21,11 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
+// GetPriceHistoryRetention returns the period current prices are kept in the price history
+func (k Keeper) GetPriceHistoryRetention(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).PriceHistoryRetention
+}
+
 // GetMarkets returns the markets from params
 func (k Keeper) GetMarkets(ctx sdk.Context) types.Markets {
 	return k.GetParams(ctx).Markets
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordPriceHistory adds the current price of a market to its price history and prunes entries older than
+// the retention period. If the market bounds its price history, the oldest entries beyond the maximum are
+// evicted. Nothing is recorded while the retention period is zero.
+func (k Keeper) recordPriceHistory(ctx sdk.Context, market types.Market, price sdk.Dec, oracleCount int) {
+	retention := k.GetPriceHistoryRetention(ctx)
+	k.prunePriceHistory(ctx, market.MarketID, ctx.BlockTime().Add(-retention))
+
+	if retention == 0 {
+		return
+	}
+
+	k.SetPriceHistoryEntry(ctx, types.NewPriceHistoryEntry(market.MarketID, ctx.BlockTime(), ctx.BlockHeight(), price, uint64(oracleCount)))
+
+	if market.BoundsPriceHistory() {
+		k.evictPriceHistory(ctx, market.MarketID, market.MaxPriceHistoryEntries)
+	}
+}
+
+// evictPriceHistory deletes the oldest price history entries of a market until at most maxEntries remain
+func (k Keeper) evictPriceHistory(ctx sdk.Context, marketID string, maxEntries uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceHistoryIteratorKey(marketID))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for kept := uint64(0); iterator.Valid(); iterator.Next() {
+		if kept < maxEntries {
+			kept++
+			continue
+		}
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// prunePriceHistory deletes the price history entries of a market recorded before the cutoff time
+func (k Keeper) prunePriceHistory(ctx sdk.Context, marketID string, cutoff time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceHistoryIteratorKey(marketID))
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(cutoff))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetPriceHistoryEntry saves a price history entry to the store
+func (k Keeper) SetPriceHistoryEntry(ctx sdk.Context, entry types.PriceHistoryEntry) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceHistoryKey(entry.MarketID, entry.BlockTime), k.cdc.MustMarshal(&entry))
+}
+
+// IteratePriceHistory iterates over the price history entries of a market recorded between the from and to
+// times, inclusive, from oldest to newest and performs a callback function. A zero to time includes all later
+// entries.
+func (k Keeper) IteratePriceHistory(ctx sdk.Context, marketID string, from, to time.Time, cb func(entry types.PriceHistoryEntry) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceHistoryIteratorKey(marketID))
+
+	var end []byte
+	if !to.IsZero() {
+		end = sdk.PrefixEndBytes(sdk.FormatTimeBytes(to))
+	}
+	iterator := store.Iterator(sdk.FormatTimeBytes(from), end)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PriceHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// GetPriceHistory returns the price history entries of a market recorded between the from and to times, inclusive
+func (k Keeper) GetPriceHistory(ctx sdk.Context, marketID string, from, to time.Time) types.PriceHistoryEntries {
+	var entries types.PriceHistoryEntries
+	k.IteratePriceHistory(ctx, marketID, from, to, func(entry types.PriceHistoryEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
+
+// GetAllPriceHistory returns the price history entries of all markets from the store
+func (k Keeper) GetAllPriceHistory(ctx sdk.Context) types.PriceHistoryEntries {
+	var entries types.PriceHistoryEntries
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceHistoryPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PriceHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+var priceHistoryStartTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// setupPriceHistory returns a context with a market that records its prices for the retention period
+func setupPriceHistory(t *testing.T, retention time.Duration) (sdk.Context, keeper.Keeper, []sdk.AccAddress) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(priceHistoryStartTime).
+		WithBlockHeight(1)
+	k := tApp.GetPriceFeedKeeper()
+
+	k.SetParams(ctx, types.NewParamsWithPriceHistory(
+		[]types.Market{types.NewMarket("tstusd", "tst", "usd", addrs, true)},
+		retention,
+	))
+
+	return ctx, k, addrs
+}
+
+// postAndUpdateAt posts a price from each oracle and updates the current price of the market at the block time
+func postAndUpdateAt(t *testing.T, ctx sdk.Context, k keeper.Keeper, oracles []sdk.AccAddress, blockTime time.Time, price string) sdk.Context {
+	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(ctx.BlockHeight() + 1)
+	for _, oracle := range oracles {
+		_, err := k.SetPrice(ctx, oracle, "tstusd", sdk.MustNewDecFromStr(price), blockTime.Add(24*time.Hour))
+		require.NoError(t, err)
+	}
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	return ctx
+}
+
+func TestKeeper_PriceHistory_Record(t *testing.T) {
+	ctx, k, oracles := setupPriceHistory(t, time.Hour)
+
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime, "10.00")
+	ctx = postAndUpdateAt(t, ctx, k, oracles[:1], priceHistoryStartTime.Add(30*time.Minute), "11.00")
+
+	history := k.GetPriceHistory(ctx, "tstusd", time.Time{}, time.Time{})
+	require.Equal(t, types.PriceHistoryEntries{
+		types.NewPriceHistoryEntry("tstusd", priceHistoryStartTime, 2, sdk.MustNewDecFromStr("10.00"), 2),
+		// the other oracle's price of 10.00 is still valid and contributes to the median
+		types.NewPriceHistoryEntry("tstusd", priceHistoryStartTime.Add(30*time.Minute), 3, sdk.MustNewDecFromStr("10.50"), 2),
+	}, history)
+}
+
+func TestKeeper_PriceHistory_Prune(t *testing.T) {
+	ctx, k, oracles := setupPriceHistory(t, time.Hour)
+
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime, "10.00")
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime.Add(30*time.Minute), "11.00")
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime.Add(time.Hour), "12.00")
+
+	// entries at the edge of the retention period are kept
+	history := k.GetPriceHistory(ctx, "tstusd", time.Time{}, time.Time{})
+	require.Len(t, history, 3)
+
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime.Add(100*time.Minute), "13.00")
+
+	history = k.GetPriceHistory(ctx, "tstusd", time.Time{}, time.Time{})
+	require.Len(t, history, 2)
+	require.Equal(t, priceHistoryStartTime.Add(time.Hour), history[0].BlockTime)
+	require.Equal(t, priceHistoryStartTime.Add(100*time.Minute), history[1].BlockTime)
+}
+
+func TestKeeper_PriceHistory_MaxEntries(t *testing.T) {
+	ctx, k, oracles := setupPriceHistory(t, 24*time.Hour)
+
+	params := k.GetParams(ctx)
+	params.Markets[0].MaxPriceHistoryEntries = 2
+	k.SetParams(ctx, params)
+
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime, "10.00")
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime.Add(time.Minute), "11.00")
+	require.Len(t, k.GetPriceHistory(ctx, "tstusd", time.Time{}, time.Time{}), 2)
+
+	// recording into a full history evicts the oldest entry
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime.Add(2*time.Minute), "12.00")
+	history := k.GetPriceHistory(ctx, "tstusd", time.Time{}, time.Time{})
+	require.Len(t, history, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("11.00"), history[0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("12.00"), history[1].Price)
+
+	// lowering the maximum evicts all entries beyond it on the next record
+	params.Markets[0].MaxPriceHistoryEntries = 1
+	k.SetParams(ctx, params)
+
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime.Add(3*time.Minute), "13.00")
+	history = k.GetPriceHistory(ctx, "tstusd", time.Time{}, time.Time{})
+	require.Len(t, history, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("13.00"), history[0].Price)
+}
+
+func TestKeeper_PriceHistory_Disabled(t *testing.T) {
+	ctx, k, oracles := setupPriceHistory(t, time.Hour)
+
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime, "10.00")
+	require.Len(t, k.GetAllPriceHistory(ctx), 1)
+
+	// a zero retention period stops recording and prunes the existing history
+	params := k.GetParams(ctx)
+	params.PriceHistoryRetention = 0
+	k.SetParams(ctx, params)
+
+	ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime.Add(time.Minute), "11.00")
+	require.Empty(t, k.GetAllPriceHistory(ctx))
+}
+
+func TestKeeper_PriceHistory_Query(t *testing.T) {
+	ctx, k, oracles := setupPriceHistory(t, 24*time.Hour)
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	for i, price := range []string{"10.00", "11.00", "12.00", "13.00"} {
+		ctx = postAndUpdateAt(t, ctx, k, oracles, priceHistoryStartTime.Add(time.Duration(i)*time.Hour), price)
+	}
+
+	res, err := queryServer.PriceHistory(sdk.WrapSDKContext(ctx), &types.QueryPriceHistoryRequest{
+		MarketId: "tstusd",
+		From:     priceHistoryStartTime.Add(time.Hour),
+		To:       priceHistoryStartTime.Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.PriceHistory, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("11.00"), res.PriceHistory[0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("12.00"), res.PriceHistory[1].Price)
+
+	// a zero to time includes all later entries
+	res, err = queryServer.PriceHistory(sdk.WrapSDKContext(ctx), &types.QueryPriceHistoryRequest{
+		MarketId:   "tstusd",
+		From:       priceHistoryStartTime.Add(time.Hour),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.PriceHistory, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("11.00"), res.PriceHistory[0].Price)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = queryServer.PriceHistory(sdk.WrapSDKContext(ctx), &types.QueryPriceHistoryRequest{
+		MarketId:   "tstusd",
+		From:       priceHistoryStartTime.Add(time.Hour),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.PriceHistory, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("13.00"), res.PriceHistory[0].Price)
+
+	_, err = queryServer.PriceHistory(sdk.WrapSDKContext(ctx), &types.QueryPriceHistoryRequest{
+		MarketId: "tstusd",
+		From:     priceHistoryStartTime.Add(time.Hour),
+		To:       priceHistoryStartTime,
+	})
+	require.Error(t, err)
+
+	_, err = queryServer.PriceHistory(sdk.WrapSDKContext(ctx), &types.QueryPriceHistoryRequest{MarketId: "invalid"})
+	require.Equal(t, "rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
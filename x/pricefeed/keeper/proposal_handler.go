@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// HandleResetMarketSuspensionProposal is a handler for executing a passed reset market suspension proposal
+func HandleResetMarketSuspensionProposal(ctx sdk.Context, k Keeper, p *types.ResetMarketSuspensionProposal) error {
+	return k.ResetMarketSuspension(ctx, p.MarketID)
+}
+
+// HandleReinstateOracleProposal is a handler for executing a passed reinstate oracle proposal
+func HandleReinstateOracleProposal(ctx sdk.Context, k Keeper, p *types.ReinstateOracleProposal) error {
+	oracle, err := sdk.AccAddressFromBech32(p.Oracle)
+	if err != nil {
+		return err
+	}
+	return k.ReinstateOracle(ctx, p.MarketID, oracle)
+}
@@ -87,7 +87,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "bnb:usd:30",
@@ -96,7 +104,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "atom:usd",
@@ -105,7 +121,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "atom:usd:30",
@@ -114,7 +138,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "akt:usd",
@@ -123,7 +155,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "akt:usd:30",
@@ -132,7 +172,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "luna:usd",
@@ -141,7 +189,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "luna:usd:30",
@@ -150,7 +206,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "osmo:usd",
@@ -159,7 +223,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "osmo:usd:30",
@@ -168,7 +240,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "ust:usd",
@@ -177,7 +257,15 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				},
 				{
 					"market_id": "ust:usd:30",
@@ -186,9 +274,18 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation_method": "AGGREGATION_METHOD_UNSPECIFIED",
+					"min_oracle_count": "0",
+					"trim_count": "0",
+					"max_deviation_bps": "0",
+					"max_oracle_misses": "0",
+					"max_oracle_deviation_bps": "0",
+					"max_price_history_entries": "0",
+					"deviation_interval": "0s"
 				}
-			]
+			],
+			"price_history_retention": "0s"
 		},
 		"posted_prices": [
 			{
@@ -203,7 +300,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 				"price": "217.962650000000001782",
 				"expiry": "2022-07-20T00:00:00Z"
 			}
-		]
+		],
+		"market_suspensions": [],
+		"price_history": [],
+		"oracle_stats": []
 	}`
 
 	err := s.legacyCdc.UnmarshalJSON([]byte(v15Params), &s.v15genstate)
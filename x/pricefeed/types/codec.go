@@ -8,12 +8,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 // RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
 // governance module.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPostPrice{}, "pricefeed/MsgPostPrice", nil)
+	cdc.RegisterConcrete(&ResetMarketSuspensionProposal{}, "kava/ResetMarketSuspensionProposal", nil)
+	cdc.RegisterConcrete(&ReinstateOracleProposal{}, "kava/ReinstateOracleProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -21,6 +24,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgPostPrice{},
 	)
 
+	registry.RegisterImplementations(
+		(*govv1beta1.Content)(nil),
+		&ResetMarketSuspensionProposal{},
+		&ReinstateOracleProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
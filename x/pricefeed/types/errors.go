@@ -19,4 +19,14 @@ var (
 	ErrInvalidOracle = sdkerrors.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = sdkerrors.Register(ModuleName, 7, "asset not found")
+	// ErrInsufficientPrices error for markets with fewer unexpired prices than their min oracle count
+	ErrInsufficientPrices = sdkerrors.Register(ModuleName, 8, "insufficient unexpired oracle prices")
+	// ErrMarketSuspended error for markets whose price deviated beyond their circuit breaker bound
+	ErrMarketSuspended = sdkerrors.Register(ModuleName, 9, "market price is suspended")
+	// ErrMarketNotSuspended error for resetting a market that is not suspended
+	ErrMarketNotSuspended = sdkerrors.Register(ModuleName, 10, "market is not suspended")
+	// ErrOracleDeactivated error for oracles deactivated for missing price updates
+	ErrOracleDeactivated = sdkerrors.Register(ModuleName, 11, "oracle is deactivated")
+	// ErrOracleNotDeactivated error for reinstating an oracle that is not deactivated
+	ErrOracleNotDeactivated = sdkerrors.Register(ModuleName, 12, "oracle is not deactivated")
 )
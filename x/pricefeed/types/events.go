@@ -5,10 +5,21 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketSuspended    = "market_suspended"
+	EventTypeMarketResumed      = "market_resumed"
+	EventTypeOracleDeactivated  = "oracle_deactivated"
+	EventTypeOracleReinstated   = "oracle_reinstated"
 
-	AttributeValueCategory = ModuleName
-	AttributeMarketID      = "market_id"
-	AttributeMarketPrice   = "market_price"
-	AttributeOracle        = "oracle"
-	AttributeExpiry        = "expiry"
+	AttributeValueCategory  = ModuleName
+	AttributeMarketID       = "market_id"
+	AttributeMarketPrice    = "market_price"
+	AttributeOracle         = "oracle"
+	AttributeExpiry         = "expiry"
+	AttributeReferencePrice = "reference_price"
+	AttributeResumeReason   = "resume_reason"
+	AttributeMissedCount    = "missed_count"
+
+	AttributeValueReverted  = "reverted"
+	AttributeValueConfirmed = "confirmed"
+	AttributeValueReset     = "reset"
 )
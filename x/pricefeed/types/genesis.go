@@ -1,10 +1,15 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, ms []MarketSuspension, ph []PriceHistoryEntry, os []OracleStats) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:            p,
+		PostedPrices:      pp,
+		MarketSuspensions: ms,
+		PriceHistory:      ph,
+		OracleStats:       os,
 	}
 }
 
@@ -13,6 +18,9 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		[]MarketSuspension{},
+		[]PriceHistoryEntry{},
+		[]OracleStats{},
 	)
 }
 
@@ -23,5 +31,26 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.MarketSuspensions.Validate(); err != nil {
+		return err
+	}
+	for _, ms := range gs.MarketSuspensions {
+		market, found := gs.Params.Markets.Get(ms.MarketID)
+		if !found {
+			return fmt.Errorf("suspension for unknown market %s", ms.MarketID)
+		}
+		if !market.HasCircuitBreaker() {
+			return fmt.Errorf("suspension for market %s without a circuit breaker", ms.MarketID)
+		}
+	}
+
+	if err := gs.PriceHistory.Validate(); err != nil {
+		return err
+	}
+
+	return gs.OracleStats.Validate()
 }
@@ -26,8 +26,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the pricefeed module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
-	Params       Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PostedPrices PostedPrices `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	Params            Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PostedPrices      PostedPrices        `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	MarketSuspensions MarketSuspensions   `protobuf:"bytes,3,rep,name=market_suspensions,json=marketSuspensions,proto3,castrepeated=MarketSuspensions" json:"market_suspensions"`
+	PriceHistory      PriceHistoryEntries `protobuf:"bytes,4,rep,name=price_history,json=priceHistory,proto3,castrepeated=PriceHistoryEntries" json:"price_history"`
+	OracleStats       OracleStatsList     `protobuf:"bytes,5,rep,name=oracle_stats,json=oracleStats,proto3,castrepeated=OracleStatsList" json:"oracle_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +80,27 @@ func (m *GenesisState) GetPostedPrices() PostedPrices {
 	return nil
 }
 
+func (m *GenesisState) GetMarketSuspensions() MarketSuspensions {
+	if m != nil {
+		return m.MarketSuspensions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHistory() PriceHistoryEntries {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleStats() OracleStatsList {
+	if m != nil {
+		return m.OracleStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.pricefeed.v1beta1.GenesisState")
 }
@@ -86,24 +110,31 @@ func init() {
 }
 
 var fileDescriptor_fffec798191784d2 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x28, 0xca, 0x4c, 0x4e, 0x4d, 0x4b, 0x4d, 0x4d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xa9, 0xd2, 0x83, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x70, 0x98, 0x59, 0x5c, 0x92, 0x5f, 0x94,
-	0x0a, 0x51, 0xa3, 0xb4, 0x86, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x0d, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x9c, 0x1e, 0x76, 0x3b, 0xf5, 0x02, 0xc0, 0xaa, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xea, 0x11, 0x8a, 0xe3, 0xe2, 0x2d, 0xc8, 0x2f, 0x2e, 0x49, 0x4d, 0x89, 0x07, 0x6b, 0x28,
-	0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0x69, 0x08, 0x58, 0x71, 0x00, 0x48, 0xdc,
-	0x49, 0x04, 0x64, 0xd2, 0xaa, 0xfb, 0xf2, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0x05, 0x48, 0x3c,
-	0x27, 0xdf, 0x07, 0x0f, 0xe5, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0x90, 0xa5, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x60, 0x96, 0x7e, 0x05, 0x52, 0x58, 0x94, 0x54,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf3,
-	0x1e, 0xef, 0x7e, 0x01, 0x00, 0x00,
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xda, 0x30,
+	0x18, 0xc7, 0x93, 0xc1, 0x38, 0x84, 0xa0, 0x89, 0x0c, 0x6d, 0x19, 0x93, 0x0c, 0x62, 0x3b, 0x30,
+	0x4d, 0x4b, 0x04, 0xbb, 0xee, 0x14, 0x69, 0xda, 0x0e, 0x45, 0x45, 0xe1, 0xd6, 0x43, 0x23, 0x07,
+	0xdc, 0x10, 0x41, 0xe2, 0xc8, 0x9f, 0x41, 0xe5, 0x2d, 0xfa, 0x18, 0x55, 0x9f, 0x84, 0x23, 0xc7,
+	0x9e, 0x5a, 0x1a, 0xee, 0x7d, 0x86, 0xca, 0x4e, 0x84, 0x22, 0xd4, 0xf4, 0xe6, 0xfc, 0xfd, 0xfb,
+	0xfe, 0x3f, 0xd9, 0xb1, 0xf6, 0x7d, 0x81, 0xd7, 0xd8, 0x4e, 0x58, 0x38, 0x25, 0x57, 0x84, 0xcc,
+	0xec, 0xf5, 0xc0, 0x27, 0x1c, 0x0f, 0xec, 0x80, 0xc4, 0x04, 0x42, 0xb0, 0x12, 0x46, 0x39, 0x35,
+	0x3e, 0x09, 0xca, 0x3a, 0x52, 0x56, 0x4e, 0xb5, 0x5b, 0x01, 0x0d, 0xa8, 0x44, 0x6c, 0xb1, 0xca,
+	0xe8, 0x76, 0xaf, 0xa4, 0x13, 0x38, 0x65, 0x24, 0x63, 0x7a, 0xcf, 0x15, 0x4d, 0xff, 0x97, 0x39,
+	0x26, 0x1c, 0x73, 0x62, 0xfc, 0xd1, 0x6a, 0x09, 0x66, 0x38, 0x02, 0x53, 0xed, 0xaa, 0xfd, 0xfa,
+	0x10, 0x59, 0xaf, 0x3b, 0xad, 0xb1, 0xa4, 0x9c, 0xea, 0xf6, 0xa1, 0xa3, 0xb8, 0xf9, 0x8c, 0x71,
+	0xa9, 0x35, 0x12, 0x0a, 0x9c, 0xcc, 0x3c, 0x39, 0x00, 0xe6, 0xbb, 0x6e, 0xa5, 0x5f, 0x1f, 0x7e,
+	0x2b, 0x2d, 0x91, 0xf0, 0x58, 0xe4, 0x4e, 0x4b, 0x34, 0xdd, 0x3d, 0x76, 0xf4, 0x42, 0x08, 0xae,
+	0x9e, 0x14, 0xbe, 0x0c, 0xa6, 0x19, 0x11, 0x66, 0x0b, 0xc2, 0x3d, 0x58, 0x41, 0x42, 0x62, 0x08,
+	0x69, 0x0c, 0x66, 0x45, 0x4a, 0xfa, 0x65, 0x92, 0x91, 0x9c, 0x98, 0x1c, 0x07, 0x9c, 0x2f, 0xb9,
+	0xa9, 0x79, 0xba, 0x03, 0x6e, 0x33, 0x3a, 0x8d, 0x8c, 0x85, 0xd6, 0x90, 0x9d, 0xde, 0x3c, 0x14,
+	0x37, 0xb7, 0x31, 0xab, 0x52, 0xf7, 0xa3, 0xf4, 0x4c, 0x22, 0xf9, 0x9f, 0xb1, 0x7f, 0x63, 0xce,
+	0x36, 0xce, 0xd7, 0xdc, 0xf7, 0xf1, 0x74, 0x2b, 0x94, 0x07, 0x2c, 0x84, 0x86, 0xa7, 0xe9, 0x94,
+	0xe1, 0xe9, 0x92, 0x78, 0xc0, 0x31, 0x07, 0xf3, 0xfd, 0xdb, 0xf7, 0x77, 0x2e, 0x59, 0xf1, 0xe7,
+	0xc0, 0xf9, 0x9c, 0x5b, 0x3e, 0x14, 0xc2, 0xb3, 0x10, 0xb8, 0x5b, 0xa7, 0x05, 0x6a, 0xb4, 0x7f,
+	0x42, 0xea, 0x6d, 0x8a, 0xd4, 0x6d, 0x8a, 0xd4, 0x5d, 0x8a, 0xd4, 0x7d, 0x8a, 0xd4, 0x9b, 0x03,
+	0x52, 0x76, 0x07, 0xa4, 0xdc, 0x1f, 0x90, 0x72, 0xf1, 0x33, 0x08, 0xf9, 0x7c, 0xe5, 0x5b, 0x53,
+	0x1a, 0xd9, 0x42, 0xfb, 0x6b, 0x89, 0x7d, 0x90, 0x2b, 0xfb, 0xba, 0xf0, 0x9a, 0xf8, 0x26, 0x21,
+	0xe0, 0xd7, 0xe4, 0x33, 0xfa, 0xfd, 0x32, 0x00, 0x06, 0xf1, 0xb5, 0x8d, 0xc0, 0x02, 0x00, 0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -142,6 +173,30 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("PostedPrices this[%v](%v) Not Equal that[%v](%v)", i, this.PostedPrices[i], i, that1.PostedPrices[i])
 		}
 	}
+	if len(this.MarketSuspensions) != len(that1.MarketSuspensions) {
+		return fmt.Errorf("MarketSuspensions this(%v) Not Equal that(%v)", len(this.MarketSuspensions), len(that1.MarketSuspensions))
+	}
+	for i := range this.MarketSuspensions {
+		if !this.MarketSuspensions[i].Equal(&that1.MarketSuspensions[i]) {
+			return fmt.Errorf("MarketSuspensions this[%v](%v) Not Equal that[%v](%v)", i, this.MarketSuspensions[i], i, that1.MarketSuspensions[i])
+		}
+	}
+	if len(this.PriceHistory) != len(that1.PriceHistory) {
+		return fmt.Errorf("PriceHistory this(%v) Not Equal that(%v)", len(this.PriceHistory), len(that1.PriceHistory))
+	}
+	for i := range this.PriceHistory {
+		if !this.PriceHistory[i].Equal(&that1.PriceHistory[i]) {
+			return fmt.Errorf("PriceHistory this[%v](%v) Not Equal that[%v](%v)", i, this.PriceHistory[i], i, that1.PriceHistory[i])
+		}
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return fmt.Errorf("OracleStats this(%v) Not Equal that(%v)", len(this.OracleStats), len(that1.OracleStats))
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return fmt.Errorf("OracleStats this[%v](%v) Not Equal that[%v](%v)", i, this.OracleStats[i], i, that1.OracleStats[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -174,6 +229,30 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.MarketSuspensions) != len(that1.MarketSuspensions) {
+		return false
+	}
+	for i := range this.MarketSuspensions {
+		if !this.MarketSuspensions[i].Equal(&that1.MarketSuspensions[i]) {
+			return false
+		}
+	}
+	if len(this.PriceHistory) != len(that1.PriceHistory) {
+		return false
+	}
+	for i := range this.PriceHistory {
+		if !this.PriceHistory[i].Equal(&that1.PriceHistory[i]) {
+			return false
+		}
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return false
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +275,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleStats) > 0 {
+		for iNdEx := len(m.OracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MarketSuspensions) > 0 {
+		for iNdEx := len(m.MarketSuspensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketSuspensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PostedPrices) > 0 {
 		for iNdEx := len(m.PostedPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -248,6 +369,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MarketSuspensions) > 0 {
+		for _, e := range m.MarketSuspensions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleStats) > 0 {
+		for _, e := range m.OracleStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -353,6 +492,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketSuspensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketSuspensions = append(m.MarketSuspensions, MarketSuspension{})
+			if err := m.MarketSuspensions[len(m.MarketSuspensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHistory = append(m.PriceHistory, PriceHistoryEntry{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleStats = append(m.OracleStats, OracleStats{})
+			if err := m.OracleStats[len(m.OracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
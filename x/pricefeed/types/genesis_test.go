@@ -32,9 +32,12 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
 			),
 			expPass: true,
 		},
@@ -42,9 +45,12 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
 			),
 			expPass: false,
 		},
@@ -52,10 +58,13 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
 			),
 			expPass: false,
 		},
@@ -64,6 +73,9 @@ func TestGenesisStateValidate(t *testing.T) {
 			genesisState: NewGenesisState(
 				NewParams([]Market{}),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
 			),
 			expPass: false,
 		},
@@ -75,6 +87,160 @@ func TestGenesisStateValidate(t *testing.T) {
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid suspension",
+			genesisState: NewGenesisState(
+				NewParams([]Market{
+					NewMarketWithCircuitBreaker("market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 1000, time.Hour),
+				}),
+				[]PostedPrice{},
+				[]MarketSuspension{NewMarketSuspension("market", sdk.OneDec(), sdk.NewDec(2), now)},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "suspension for unknown market",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]MarketSuspension{NewMarketSuspension("market", sdk.OneDec(), sdk.NewDec(2), now)},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "suspension for market without circuit breaker",
+			genesisState: NewGenesisState(
+				NewParams([]Market{
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}),
+				[]PostedPrice{},
+				[]MarketSuspension{NewMarketSuspension("market", sdk.OneDec(), sdk.NewDec(2), now)},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated suspension",
+			genesisState: NewGenesisState(
+				NewParams([]Market{
+					NewMarketWithCircuitBreaker("market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 1000, time.Hour),
+				}),
+				[]PostedPrice{},
+				[]MarketSuspension{
+					NewMarketSuspension("market", sdk.OneDec(), sdk.NewDec(2), now),
+					NewMarketSuspension("market", sdk.OneDec(), sdk.NewDec(3), now),
+				},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid suspended price",
+			genesisState: NewGenesisState(
+				NewParams([]Market{
+					NewMarketWithCircuitBreaker("market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 1000, time.Hour),
+				}),
+				[]PostedPrice{},
+				[]MarketSuspension{NewMarketSuspension("market", sdk.OneDec(), sdk.ZeroDec(), now)},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid price history",
+			genesisState: NewGenesisState(
+				NewParamsWithPriceHistory([]Market{}, time.Hour),
+				[]PostedPrice{},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{
+					NewPriceHistoryEntry("xrp", now, 10, sdk.OneDec(), 1),
+					NewPriceHistoryEntry("xrp", now.Add(time.Second), 11, sdk.OneDec(), 1),
+				},
+				[]OracleStats{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "duplicated price history entry",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{
+					NewPriceHistoryEntry("xrp", now, 10, sdk.OneDec(), 1),
+					NewPriceHistoryEntry("xrp", now, 10, sdk.NewDec(2), 1),
+				},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid price history entry",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{NewPriceHistoryEntry("xrp", now, 10, sdk.ZeroDec(), 1)},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "negative price history retention",
+			genesisState: NewGenesisState(
+				NewParamsWithPriceHistory([]Market{}, -time.Hour),
+				[]PostedPrice{},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{},
+				[]OracleStats{
+					{MarketID: "xrp", OracleAddress: addr, MissedCount: 2, TotalUpdates: 5, TotalMissed: 3, TotalPriced: 2, Deactivated: true},
+				},
+			),
+			expPass: true,
+		},
+		{
+			msg: "duplicated oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{},
+				[]OracleStats{NewOracleStats("xrp", addr), NewOracleStats("xrp", addr)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]MarketSuspension{},
+				[]PriceHistoryEntry{},
+				[]OracleStats{{MarketID: "xrp", OracleAddress: addr, TotalUpdates: 1, TotalMissed: 2}},
 			),
 			expPass: false,
 		},
@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName The name that will be used throughout the module
@@ -25,6 +29,18 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// PriceReferencePrefix prefix for the circuit breaker reference price of a market
+	PriceReferencePrefix = []byte{0x02}
+
+	// MarketSuspensionPrefix prefix for the suspension of a market
+	MarketSuspensionPrefix = []byte{0x03}
+
+	// PriceHistoryPrefix prefix for the recorded current prices of a market
+	PriceHistoryPrefix = []byte{0x04}
+
+	// OracleStatsPrefix prefix for the performance of an oracle in a market
+	OracleStatsPrefix = []byte{0x05}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -32,6 +48,48 @@ func CurrentPriceKey(marketID string) []byte {
 	return append(CurrentPricePrefix, []byte(marketID)...)
 }
 
+// PriceReferenceKey returns the key for the reference price of a market
+func PriceReferenceKey(marketID string) []byte {
+	return append(PriceReferencePrefix, []byte(marketID)...)
+}
+
+// MarketSuspensionKey returns the key for the suspension of a market
+func MarketSuspensionKey(marketID string) []byte {
+	return append(MarketSuspensionPrefix, []byte(marketID)...)
+}
+
+// PriceHistoryIteratorKey returns the prefix for the price history of a single market
+func PriceHistoryIteratorKey(marketID string) []byte {
+	return append(
+		PriceHistoryPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// PriceHistoryKey returns the key for the price history entry of a market at a block time
+func PriceHistoryKey(marketID string, blockTime time.Time) []byte {
+	return append(
+		PriceHistoryIteratorKey(marketID),
+		sdk.FormatTimeBytes(blockTime)...,
+	)
+}
+
+// OracleStatsIteratorKey returns the prefix for the oracle stats of a single market
+func OracleStatsIteratorKey(marketID string) []byte {
+	return append(
+		OracleStatsPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// OracleStatsKey returns the key for the stats of an oracle in a market
+func OracleStatsKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		OracleStatsIteratorKey(marketID),
+		lengthPrefixWithByte(oracleAddr)...,
+	)
+}
+
 // RawPriceIteratorKey returns the prefix for the raw price for a single market
 func RawPriceIteratorKey(marketID string) []byte {
 	return append(
@@ -20,6 +20,94 @@ func NewMarket(id, base, quote string, oracles []sdk.AccAddress, active bool) Ma
 	}
 }
 
+// NewMarketWithAggregation returns a new Market that combines oracle prices with the given aggregation
+// method once at least minOracleCount unexpired prices are posted
+func NewMarketWithAggregation(
+	id, base, quote string,
+	oracles []sdk.AccAddress,
+	active bool,
+	method AggregationMethod,
+	minOracleCount uint64,
+	trimCount uint64,
+) Market {
+	market := NewMarket(id, base, quote, oracles, active)
+	market.AggregationMethod = method
+	market.MinOracleCount = minOracleCount
+	market.TrimCount = trimCount
+	return market
+}
+
+// NewMarketWithCircuitBreaker returns a new Market that is suspended when a new current price deviates more
+// than maxDeviationBps basis points from the price at the start of each interval
+func NewMarketWithCircuitBreaker(
+	id, base, quote string,
+	oracles []sdk.AccAddress,
+	active bool,
+	maxDeviationBps uint64,
+	interval time.Duration,
+) Market {
+	market := NewMarket(id, base, quote, oracles, active)
+	market.MaxDeviationBps = maxDeviationBps
+	market.DeviationInterval = interval
+	return market
+}
+
+// NewMarketWithOracleTracking returns a new Market that deactivates oracles after maxOracleMisses consecutive
+// missed price updates, where a price deviating more than maxOracleDeviationBps basis points from the current
+// price counts as missed
+func NewMarketWithOracleTracking(
+	id, base, quote string,
+	oracles []sdk.AccAddress,
+	active bool,
+	maxOracleMisses uint64,
+	maxOracleDeviationBps uint64,
+) Market {
+	market := NewMarket(id, base, quote, oracles, active)
+	market.MaxOracleMisses = maxOracleMisses
+	market.MaxOracleDeviationBps = maxOracleDeviationBps
+	return market
+}
+
+// HasCircuitBreaker returns true if the market is suspended by large price deviations
+func (m Market) HasCircuitBreaker() bool {
+	return m.MaxDeviationBps > 0
+}
+
+// WithinDeviation returns true if the price deviates from the reference price by at most the market's
+// max deviation. Any price is within the deviation of a non-positive reference price.
+func (m Market) WithinDeviation(reference, price sdk.Dec) bool {
+	if !reference.IsPositive() {
+		return true
+	}
+	// |price - reference| / reference <= bps / 10000
+	deviation := price.Sub(reference).Abs().MulInt64(10000)
+	return deviation.LTE(reference.MulInt64(int64(m.MaxDeviationBps)))
+}
+
+// DeactivatesOracles returns true if oracles that miss too many price updates are deactivated
+func (m Market) DeactivatesOracles() bool {
+	return m.MaxOracleMisses > 0
+}
+
+// BoundsPriceHistory returns true if the number of price history entries kept for the market is limited
+func (m Market) BoundsPriceHistory() bool {
+	return m.MaxPriceHistoryEntries > 0
+}
+
+// IsOracleOutlier returns true if an oracle price deviation exceeds the market's max oracle deviation.
+// Deviations are never outliers if the max oracle deviation is zero.
+func (m Market) IsOracleOutlier(deviationBps uint64) bool {
+	return m.MaxOracleDeviationBps > 0 && deviationBps > m.MaxOracleDeviationBps
+}
+
+// RequiredOracleCount returns the number of unexpired oracle prices needed to set a current price
+func (m Market) RequiredOracleCount() int {
+	if m.MinOracleCount == 0 {
+		return 1
+	}
+	return int(m.MinOracleCount)
+}
+
 // Validate performs a basic validation of the market params
 func (m Market) Validate() error {
 	if strings.TrimSpace(m.MarketID) == "" {
@@ -41,12 +129,42 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if !m.AggregationMethod.IsValid() {
+		return fmt.Errorf("invalid aggregation method %d", m.AggregationMethod)
+	}
+	if m.MinOracleCount > uint64(len(m.Oracles)) {
+		return fmt.Errorf("min oracle count %d exceeds %d oracles", m.MinOracleCount, len(m.Oracles))
+	}
+	if m.TrimCount > 0 && 2*m.TrimCount >= uint64(m.RequiredOracleCount()) {
+		return fmt.Errorf("trim count %d must leave at least one of %d required prices", m.TrimCount, m.RequiredOracleCount())
+	}
+	if m.DeviationInterval < 0 {
+		return fmt.Errorf("deviation interval %s cannot be negative", m.DeviationInterval)
+	}
+	if m.HasCircuitBreaker() && m.DeviationInterval == 0 {
+		return fmt.Errorf("deviation interval must be positive for max deviation %d bps", m.MaxDeviationBps)
+	}
 	return nil
 }
 
+// IsValid returns true if the aggregation method is a known method
+func (a AggregationMethod) IsValid() bool {
+	_, ok := AggregationMethod_name[int32(a)]
+	return ok
+}
+
 // ToMarketResponse returns a new MarketResponse from a Market
 func (m Market) ToMarketResponse() MarketResponse {
-	return NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	response := NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	response.AggregationMethod = m.AggregationMethod
+	response.MinOracleCount = m.MinOracleCount
+	response.TrimCount = m.TrimCount
+	response.MaxDeviationBps = m.MaxDeviationBps
+	response.DeviationInterval = m.DeviationInterval
+	response.MaxOracleMisses = m.MaxOracleMisses
+	response.MaxOracleDeviationBps = m.MaxOracleDeviationBps
+	response.MaxPriceHistoryEntries = m.MaxPriceHistoryEntries
+	return response
 }
 
 // Markets is a slice of Market
@@ -68,6 +186,16 @@ func (ms Markets) Validate() error {
 	return nil
 }
 
+// Get returns the market with the given id
+func (ms Markets) Get(marketID string) (Market, bool) {
+	for _, m := range ms {
+		if m.MarketID == marketID {
+			return m, true
+		}
+	}
+	return Market{}, false
+}
+
 // NewMarketResponse returns a new MarketResponse
 func NewMarketResponse(id, base, quote string, oracles []sdk.AccAddress, active bool) MarketResponse {
 	var strOracles []string
@@ -151,6 +279,16 @@ func (pps PostedPrices) Validate() error {
 	return nil
 }
 
+// Get returns the price posted by an oracle
+func (pps PostedPrices) Get(oracle sdk.AccAddress) (PostedPrice, bool) {
+	for _, pp := range pps {
+		if pp.OracleAddress.Equals(oracle) {
+			return pp, true
+		}
+	}
+	return PostedPrice{}, false
+}
+
 // NewPostedPrice returns a new PostedPrice
 func NewPostedPriceResponse(marketID string, oracle sdk.AccAddress, price sdk.Dec, expiry time.Time) PostedPriceResponse {
 	return PostedPriceResponse{
@@ -164,6 +302,56 @@ func NewPostedPriceResponse(marketID string, oracle sdk.AccAddress, price sdk.De
 // PostedPriceResponses is a slice of PostedPriceResponse
 type PostedPriceResponses []PostedPriceResponse
 
+// NewMarketSuspension returns a new MarketSuspension
+func NewMarketSuspension(marketID string, referencePrice, suspendedPrice sdk.Dec, suspendedAt time.Time) MarketSuspension {
+	return MarketSuspension{
+		MarketID:       marketID,
+		ReferencePrice: referencePrice,
+		SuspendedPrice: suspendedPrice,
+		SuspendedAt:    suspendedAt,
+	}
+}
+
+// Validate performs a basic check of a MarketSuspension.
+func (ms MarketSuspension) Validate() error {
+	if strings.TrimSpace(ms.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if ms.ReferencePrice.IsNil() || ms.ReferencePrice.IsNegative() {
+		return fmt.Errorf("invalid reference price %s", ms.ReferencePrice)
+	}
+	if ms.SuspendedPrice.IsNil() || !ms.SuspendedPrice.IsPositive() {
+		return fmt.Errorf("suspended price must be positive %s", ms.SuspendedPrice)
+	}
+	if ms.SuspendedAt.Unix() <= 0 {
+		return errors.New("suspended at time cannot be zero")
+	}
+	return nil
+}
+
+// MarketSuspensions is a slice of MarketSuspension
+type MarketSuspensions []MarketSuspension
+
+// Validate checks if all the suspensions are valid and there are no duplicated markets.
+func (mss MarketSuspensions) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, ms := range mss {
+		if seenMarkets[ms.MarketID] {
+			return fmt.Errorf("duplicated suspension for market %s", ms.MarketID)
+		}
+		if err := ms.Validate(); err != nil {
+			return err
+		}
+		seenMarkets[ms.MarketID] = true
+	}
+	return nil
+}
+
+// NewPriceReference returns a new PriceReference
+func NewPriceReference(marketID string, price sdk.Dec, startTime time.Time) PriceReference {
+	return PriceReference{MarketID: marketID, Price: price, StartTime: startTime}
+}
+
 // SortDecs provides the interface needed to sort sdk.Dec slices
 type SortDecs []sdk.Dec
 
@@ -16,6 +16,8 @@ func TestMarketValidate(t *testing.T) {
 	pubkey, err := mockPrivKey.GetPubKey()
 	require.NoError(t, err)
 	addr := sdk.AccAddress(pubkey.Address())
+	addr2 := sdk.AccAddress("oracle2")
+	addr3 := sdk.AccAddress("oracle3")
 
 	testCases := []struct {
 		msg     string
@@ -78,6 +80,41 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid aggregation",
+			NewMarketWithAggregation("market", "xrp", "bnb", []sdk.AccAddress{addr, addr2, addr3}, true, AGGREGATION_METHOD_TRIMMED_MEAN, 3, 1),
+			true,
+		},
+		{
+			"invalid aggregation method",
+			NewMarketWithAggregation("market", "xrp", "bnb", []sdk.AccAddress{addr}, true, AggregationMethod(4), 0, 0),
+			false,
+		},
+		{
+			"min oracle count exceeds oracles",
+			NewMarketWithAggregation("market", "xrp", "bnb", []sdk.AccAddress{addr, addr2}, true, AGGREGATION_METHOD_MEDIAN, 3, 0),
+			false,
+		},
+		{
+			"trim count discards all required prices",
+			NewMarketWithAggregation("market", "xrp", "bnb", []sdk.AccAddress{addr, addr2, addr3}, true, AGGREGATION_METHOD_TRIMMED_MEAN, 2, 1),
+			false,
+		},
+		{
+			"valid circuit breaker",
+			NewMarketWithCircuitBreaker("market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 1000, time.Hour),
+			true,
+		},
+		{
+			"circuit breaker without interval",
+			NewMarketWithCircuitBreaker("market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 1000, 0),
+			false,
+		},
+		{
+			"negative deviation interval",
+			NewMarketWithCircuitBreaker("market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, -time.Hour),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -92,6 +129,24 @@ func TestMarketValidate(t *testing.T) {
 	}
 }
 
+func TestMarketRequiredOracleCount(t *testing.T) {
+	market := NewMarket("market", "xrp", "bnb", nil, true)
+	require.Equal(t, 1, market.RequiredOracleCount())
+
+	market.MinOracleCount = 3
+	require.Equal(t, 3, market.RequiredOracleCount())
+}
+
+func TestMarketWithinDeviation(t *testing.T) {
+	market := NewMarketWithCircuitBreaker("market", "xrp", "bnb", nil, true, 1000, time.Hour)
+
+	require.True(t, market.WithinDeviation(sdk.NewDec(10), sdk.NewDec(11)))
+	require.True(t, market.WithinDeviation(sdk.NewDec(10), sdk.NewDec(9)))
+	require.False(t, market.WithinDeviation(sdk.NewDec(10), sdk.MustNewDecFromStr("11.01")))
+	require.False(t, market.WithinDeviation(sdk.NewDec(10), sdk.MustNewDecFromStr("8.99")))
+	require.True(t, market.WithinDeviation(sdk.ZeroDec(), sdk.NewDec(100)))
+}
+
 func TestPostedPriceValidate(t *testing.T) {
 	now := time.Now()
 	mockPrivKey := tmtypes.NewMockPV()
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOracleStats returns a new OracleStats with no recorded price updates
+func NewOracleStats(marketID string, oracle sdk.AccAddress) OracleStats {
+	return OracleStats{
+		MarketID:      marketID,
+		OracleAddress: oracle,
+	}
+}
+
+// RecordMiss records a price update the oracle missed
+func (s *OracleStats) RecordMiss() {
+	s.TotalUpdates++
+	s.TotalMissed++
+	s.MissedCount++
+}
+
+// RecordPost records a price update the oracle posted a fresh price for
+func (s *OracleStats) RecordPost() {
+	s.TotalUpdates++
+	s.MissedCount = 0
+}
+
+// RecordDeviation records the deviation of the oracle's price from the current price
+func (s *OracleStats) RecordDeviation(deviationBps uint64) {
+	s.TotalPriced++
+	if s.TotalDeviationBps+deviationBps < s.TotalDeviationBps {
+		// saturate rather than wrap the total of extreme deviations
+		s.TotalDeviationBps = math.MaxUint64
+	} else {
+		s.TotalDeviationBps += deviationBps
+	}
+	s.LastDeviationBps = deviationBps
+}
+
+// AverageDeviationBps returns the mean deviation of the oracle's prices from the current price
+func (s OracleStats) AverageDeviationBps() uint64 {
+	if s.TotalPriced == 0 {
+		return 0
+	}
+	return s.TotalDeviationBps / s.TotalPriced
+}
+
+// Validate performs a basic check of OracleStats.
+func (s OracleStats) Validate() error {
+	if strings.TrimSpace(s.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(s.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if s.TotalMissed > s.TotalUpdates {
+		return fmt.Errorf("total missed %d exceeds total updates %d", s.TotalMissed, s.TotalUpdates)
+	}
+	if s.MissedCount > s.TotalMissed {
+		return fmt.Errorf("missed count %d exceeds total missed %d", s.MissedCount, s.TotalMissed)
+	}
+	if s.TotalPriced > s.TotalUpdates {
+		return fmt.Errorf("total priced %d exceeds total updates %d", s.TotalPriced, s.TotalUpdates)
+	}
+	return nil
+}
+
+// ToOracleStatsResponse returns a new OracleStatsResponse from OracleStats
+func (s OracleStats) ToOracleStatsResponse() OracleStatsResponse {
+	return OracleStatsResponse{
+		MarketID:            s.MarketID,
+		OracleAddress:       s.OracleAddress.String(),
+		MissedCount:         s.MissedCount,
+		TotalUpdates:        s.TotalUpdates,
+		TotalMissed:         s.TotalMissed,
+		TotalPriced:         s.TotalPriced,
+		AverageDeviationBps: s.AverageDeviationBps(),
+		LastDeviationBps:    s.LastDeviationBps,
+		Deactivated:         s.Deactivated,
+	}
+}
+
+// OracleStatsList is a slice of OracleStats
+type OracleStatsList []OracleStats
+
+// Validate checks if all the stats are valid and there are at most one stats per market and oracle.
+func (sl OracleStatsList) Validate() error {
+	seenStats := make(map[string]bool)
+	for _, s := range sl {
+		key := fmt.Sprintf("%s/%s", s.MarketID, s.OracleAddress)
+		if seenStats[key] {
+			return fmt.Errorf("duplicated oracle stats for oracle %s in market %s", s.OracleAddress, s.MarketID)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		seenStats[key] = true
+	}
+	return nil
+}
+
+// OracleStatsResponses is a slice of OracleStatsResponse
+type OracleStatsResponses []OracleStatsResponse
+
+// DeviationBps returns the deviation, in basis points, of a price from a reference price rounded down.
+// The deviation from a non-positive reference price is zero.
+func DeviationBps(reference, price sdk.Dec) uint64 {
+	if !reference.IsPositive() {
+		return 0
+	}
+	deviation := price.Sub(reference).Abs().MulInt64(10000).Quo(reference).TruncateInt()
+	if !deviation.IsUint64() {
+		return math.MaxUint64
+	}
+	return deviation.Uint64()
+}
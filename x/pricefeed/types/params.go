@@ -2,20 +2,30 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = []Market{}
+	KeyMarkets                   = []byte("Markets")
+	KeyPriceHistoryRetention     = []byte("PriceHistoryRetention")
+	DefaultMarkets               = []Market{}
+	DefaultPriceHistoryRetention = time.Duration(0)
 )
 
 // NewParams creates a new AssetParams object
 func NewParams(markets []Market) Params {
+	return NewParamsWithPriceHistory(markets, DefaultPriceHistoryRetention)
+}
+
+// NewParamsWithPriceHistory creates a new AssetParams object that keeps current prices in the price history
+// for the retention period
+func NewParamsWithPriceHistory(markets []Market, priceHistoryRetention time.Duration) Params {
 	return Params{
-		Markets: markets,
+		Markets:               markets,
+		PriceHistoryRetention: priceHistoryRetention,
 	}
 }
 
@@ -34,12 +44,16 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMarkets, &p.Markets, validateMarketParams),
+		paramtypes.NewParamSetPair(KeyPriceHistoryRetention, &p.PriceHistoryRetention, validatePriceHistoryRetentionParam),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateMarketParams(p.Markets)
+	if err := validateMarketParams(p.Markets); err != nil {
+		return err
+	}
+	return validatePriceHistoryRetentionParam(p.PriceHistoryRetention)
 }
 
 func validateMarketParams(i interface{}) error {
@@ -50,3 +64,15 @@ func validateMarketParams(i interface{}) error {
 
 	return markets.Validate()
 }
+
+func validatePriceHistoryRetentionParam(i interface{}) error {
+	retention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if retention < 0 {
+		return fmt.Errorf("price history retention cannot be negative: %s", retention)
+	}
+	return nil
+}
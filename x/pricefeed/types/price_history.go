@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPriceHistoryEntry returns a new PriceHistoryEntry
+func NewPriceHistoryEntry(marketID string, blockTime time.Time, blockHeight int64, price sdk.Dec, oracleCount uint64) PriceHistoryEntry {
+	return PriceHistoryEntry{
+		MarketID:    marketID,
+		BlockTime:   blockTime,
+		BlockHeight: blockHeight,
+		Price:       price,
+		OracleCount: oracleCount,
+	}
+}
+
+// Validate performs a basic check of a PriceHistoryEntry.
+func (e PriceHistoryEntry) Validate() error {
+	if strings.TrimSpace(e.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if e.BlockTime.Unix() <= 0 {
+		return errors.New("block time cannot be zero")
+	}
+	if e.BlockHeight < 0 {
+		return fmt.Errorf("block height cannot be negative %d", e.BlockHeight)
+	}
+	if e.Price.IsNil() || !e.Price.IsPositive() {
+		return fmt.Errorf("price must be positive %s", e.Price)
+	}
+	if e.OracleCount == 0 {
+		return errors.New("oracle count must be positive")
+	}
+	return nil
+}
+
+// PriceHistoryEntries is a slice of PriceHistoryEntry
+type PriceHistoryEntries []PriceHistoryEntry
+
+// Validate checks if all the entries are valid and there is at most one entry per market and block time.
+func (entries PriceHistoryEntries) Validate() error {
+	seenEntries := make(map[string]bool)
+	for _, e := range entries {
+		key := fmt.Sprintf("%s/%d", e.MarketID, e.BlockTime.UnixNano())
+		if seenEntries[key] {
+			return fmt.Errorf("duplicated price history entry for market %s at %s", e.MarketID, e.BlockTime)
+		}
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		seenEntries[key] = true
+	}
+	return nil
+}
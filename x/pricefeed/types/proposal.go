@@ -0,0 +1,121 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeResetMarketSuspension defines the type for a ResetMarketSuspensionProposal
+	ProposalTypeResetMarketSuspension = "ResetMarketSuspension"
+	// ProposalTypeReinstateOracle defines the type for a ReinstateOracleProposal
+	ProposalTypeReinstateOracle = "ReinstateOracle"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govv1beta1.Content = &ResetMarketSuspensionProposal{}
+	_ govv1beta1.Content = &ReinstateOracleProposal{}
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeResetMarketSuspension)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&ResetMarketSuspensionProposal{}, "kava/ResetMarketSuspensionProposal", nil)
+	govv1beta1.RegisterProposalType(ProposalTypeReinstateOracle)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&ReinstateOracleProposal{}, "kava/ReinstateOracleProposal", nil)
+}
+
+// NewResetMarketSuspensionProposal creates a new reset market suspension proposal.
+func NewResetMarketSuspensionProposal(title, description, marketID string) *ResetMarketSuspensionProposal {
+	return &ResetMarketSuspensionProposal{
+		Title:       title,
+		Description: description,
+		MarketID:    marketID,
+	}
+}
+
+// GetTitle returns the title of a reset market suspension proposal.
+func (p *ResetMarketSuspensionProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a reset market suspension proposal.
+func (p *ResetMarketSuspensionProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a reset market suspension proposal.
+func (p *ResetMarketSuspensionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a reset market suspension proposal.
+func (p *ResetMarketSuspensionProposal) ProposalType() string {
+	return ProposalTypeResetMarketSuspension
+}
+
+// ValidateBasic stateless validation of a reset market suspension proposal.
+func (p *ResetMarketSuspensionProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p *ResetMarketSuspensionProposal) String() string {
+	return fmt.Sprintf(`Reset Market Suspension Proposal:
+  Title:       %s
+  Description: %s
+  Market ID:   %s
+`, p.Title, p.Description, p.MarketID)
+}
+
+// NewReinstateOracleProposal creates a new reinstate oracle proposal.
+func NewReinstateOracleProposal(title, description, marketID string, oracle sdk.AccAddress) *ReinstateOracleProposal {
+	return &ReinstateOracleProposal{
+		Title:       title,
+		Description: description,
+		MarketID:    marketID,
+		Oracle:      oracle.String(),
+	}
+}
+
+// GetTitle returns the title of a reinstate oracle proposal.
+func (p *ReinstateOracleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a reinstate oracle proposal.
+func (p *ReinstateOracleProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a reinstate oracle proposal.
+func (p *ReinstateOracleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a reinstate oracle proposal.
+func (p *ReinstateOracleProposal) ProposalType() string {
+	return ProposalTypeReinstateOracle
+}
+
+// ValidateBasic stateless validation of a reinstate oracle proposal.
+func (p *ReinstateOracleProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Oracle); err != nil {
+		return fmt.Errorf("invalid oracle address: %w", err)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p *ReinstateOracleProposal) String() string {
+	return fmt.Sprintf(`Reinstate Oracle Proposal:
+  Title:       %s
+  Description: %s
+  Market ID:   %s
+  Oracle:      %s
+`, p.Title, p.Description, p.MarketID, p.Oracle)
+}
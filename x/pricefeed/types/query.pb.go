@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -15,6 +16,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -187,6 +189,91 @@ func (m *QueryPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPriceResponse proto.InternalMessageInfo
 
+// QueryPriceHistoryRequest is the request type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryRequest struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// from is the earliest block time to return, inclusive
+	From time.Time `protobuf:"bytes,2,opt,name=from,proto3,stdtime" json:"from"`
+	// to is the latest block time to return, inclusive, the zero time returns
+	// all later prices
+	To time.Time `protobuf:"bytes,3,opt,name=to,proto3,stdtime" json:"to"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPriceHistoryRequest) Reset()         { *m = QueryPriceHistoryRequest{} }
+func (m *QueryPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84567be3085e4c6c, []int{4}
+}
+func (m *QueryPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHistoryRequest proto.InternalMessageInfo
+
+// QueryPriceHistoryResponse is the response type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryResponse struct {
+	PriceHistory PriceHistoryEntries `protobuf:"bytes,1,rep,name=price_history,json=priceHistory,proto3,castrepeated=PriceHistoryEntries" json:"price_history"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPriceHistoryResponse) Reset()         { *m = QueryPriceHistoryResponse{} }
+func (m *QueryPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84567be3085e4c6c, []int{5}
+}
+func (m *QueryPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHistoryResponse proto.InternalMessageInfo
+
 // QueryPricesRequest is the request type for the Query/Prices RPC method.
 type QueryPricesRequest struct {
 }
@@ -195,7 +282,7 @@ func (m *QueryPricesRequest) Reset()         { *m = QueryPricesRequest{} }
 func (m *QueryPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPricesRequest) ProtoMessage()    {}
 func (*QueryPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{4}
+	return fileDescriptor_84567be3085e4c6c, []int{6}
 }
 func (m *QueryPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,7 +320,7 @@ func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
 func (m *QueryPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPricesResponse) ProtoMessage()    {}
 func (*QueryPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{5}
+	return fileDescriptor_84567be3085e4c6c, []int{7}
 }
 func (m *QueryPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,7 +358,7 @@ func (m *QueryRawPricesRequest) Reset()         { *m = QueryRawPricesRequest{} }
 func (m *QueryRawPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawPricesRequest) ProtoMessage()    {}
 func (*QueryRawPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{6}
+	return fileDescriptor_84567be3085e4c6c, []int{8}
 }
 func (m *QueryRawPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,7 +397,7 @@ func (m *QueryRawPricesResponse) Reset()         { *m = QueryRawPricesResponse{}
 func (m *QueryRawPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawPricesResponse) ProtoMessage()    {}
 func (*QueryRawPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{7}
+	return fileDescriptor_84567be3085e4c6c, []int{9}
 }
 func (m *QueryRawPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +435,7 @@ func (m *QueryOraclesRequest) Reset()         { *m = QueryOraclesRequest{} }
 func (m *QueryOraclesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOraclesRequest) ProtoMessage()    {}
 func (*QueryOraclesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{8}
+	return fileDescriptor_84567be3085e4c6c, []int{10}
 }
 func (m *QueryOraclesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +474,7 @@ func (m *QueryOraclesResponse) Reset()         { *m = QueryOraclesResponse{} }
 func (m *QueryOraclesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOraclesResponse) ProtoMessage()    {}
 func (*QueryOraclesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{9}
+	return fileDescriptor_84567be3085e4c6c, []int{11}
 }
 func (m *QueryOraclesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,6 +503,84 @@ func (m *QueryOraclesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryOraclesResponse proto.InternalMessageInfo
 
+// QueryOracleStatsRequest is the request type for the Query/OracleStats RPC method.
+type QueryOracleStatsRequest struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// oracle_address optionally filters the stats to a single oracle
+	OracleAddress string `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3" json:"oracle_address,omitempty"`
+}
+
+func (m *QueryOracleStatsRequest) Reset()         { *m = QueryOracleStatsRequest{} }
+func (m *QueryOracleStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleStatsRequest) ProtoMessage()    {}
+func (*QueryOracleStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84567be3085e4c6c, []int{12}
+}
+func (m *QueryOracleStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleStatsRequest.Merge(m, src)
+}
+func (m *QueryOracleStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleStatsRequest proto.InternalMessageInfo
+
+// QueryOracleStatsResponse is the response type for the Query/OracleStats RPC method.
+type QueryOracleStatsResponse struct {
+	OracleStats OracleStatsResponses `protobuf:"bytes,1,rep,name=oracle_stats,json=oracleStats,proto3,castrepeated=OracleStatsResponses" json:"oracle_stats"`
+}
+
+func (m *QueryOracleStatsResponse) Reset()         { *m = QueryOracleStatsResponse{} }
+func (m *QueryOracleStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleStatsResponse) ProtoMessage()    {}
+func (*QueryOracleStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84567be3085e4c6c, []int{13}
+}
+func (m *QueryOracleStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleStatsResponse.Merge(m, src)
+}
+func (m *QueryOracleStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleStatsResponse proto.InternalMessageInfo
+
 // QueryMarketsRequest is the request type for the Query/Markets RPC method.
 type QueryMarketsRequest struct {
 }
@@ -424,7 +589,7 @@ func (m *QueryMarketsRequest) Reset()         { *m = QueryMarketsRequest{} }
 func (m *QueryMarketsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketsRequest) ProtoMessage()    {}
 func (*QueryMarketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{10}
+	return fileDescriptor_84567be3085e4c6c, []int{14}
 }
 func (m *QueryMarketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,7 +628,7 @@ func (m *QueryMarketsResponse) Reset()         { *m = QueryMarketsResponse{} }
 func (m *QueryMarketsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketsResponse) ProtoMessage()    {}
 func (*QueryMarketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{11}
+	return fileDescriptor_84567be3085e4c6c, []int{15}
 }
 func (m *QueryMarketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,7 +669,7 @@ func (m *PostedPriceResponse) Reset()         { *m = PostedPriceResponse{} }
 func (m *PostedPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*PostedPriceResponse) ProtoMessage()    {}
 func (*PostedPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{12}
+	return fileDescriptor_84567be3085e4c6c, []int{16}
 }
 func (m *PostedPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +730,7 @@ func (m *CurrentPriceResponse) Reset()         { *m = CurrentPriceResponse{} }
 func (m *CurrentPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*CurrentPriceResponse) ProtoMessage()    {}
 func (*CurrentPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{13}
+	return fileDescriptor_84567be3085e4c6c, []int{17}
 }
 func (m *CurrentPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,18 +768,26 @@ func (m *CurrentPriceResponse) GetMarketID() string {
 
 // MarketResponse defines an asset in the pricefeed.
 type MarketResponse struct {
-	MarketID   string   `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BaseAsset  string   `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
-	QuoteAsset string   `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
-	Oracles    []string `protobuf:"bytes,4,rep,name=oracles,proto3" json:"oracles,omitempty"`
-	Active     bool     `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	MarketID               string            `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BaseAsset              string            `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset             string            `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Oracles                []string          `protobuf:"bytes,4,rep,name=oracles,proto3" json:"oracles,omitempty"`
+	Active                 bool              `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	AggregationMethod      AggregationMethod `protobuf:"varint,6,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=kava.pricefeed.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
+	MinOracleCount         uint64            `protobuf:"varint,7,opt,name=min_oracle_count,json=minOracleCount,proto3" json:"min_oracle_count,omitempty"`
+	TrimCount              uint64            `protobuf:"varint,8,opt,name=trim_count,json=trimCount,proto3" json:"trim_count,omitempty"`
+	MaxDeviationBps        uint64            `protobuf:"varint,9,opt,name=max_deviation_bps,json=maxDeviationBps,proto3" json:"max_deviation_bps,omitempty"`
+	DeviationInterval      time.Duration     `protobuf:"bytes,10,opt,name=deviation_interval,json=deviationInterval,proto3,stdduration" json:"deviation_interval"`
+	MaxOracleMisses        uint64            `protobuf:"varint,11,opt,name=max_oracle_misses,json=maxOracleMisses,proto3" json:"max_oracle_misses,omitempty"`
+	MaxOracleDeviationBps  uint64            `protobuf:"varint,12,opt,name=max_oracle_deviation_bps,json=maxOracleDeviationBps,proto3" json:"max_oracle_deviation_bps,omitempty"`
+	MaxPriceHistoryEntries uint64            `protobuf:"varint,13,opt,name=max_price_history_entries,json=maxPriceHistoryEntries,proto3" json:"max_price_history_entries,omitempty"`
 }
 
 func (m *MarketResponse) Reset()         { *m = MarketResponse{} }
 func (m *MarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MarketResponse) ProtoMessage()    {}
 func (*MarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{14}
+	return fileDescriptor_84567be3085e4c6c, []int{18}
 }
 func (m *MarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,22 +851,194 @@ func (m *MarketResponse) GetActive() bool {
 	return false
 }
 
+func (m *MarketResponse) GetAggregationMethod() AggregationMethod {
+	if m != nil {
+		return m.AggregationMethod
+	}
+	return AGGREGATION_METHOD_UNSPECIFIED
+}
+
+func (m *MarketResponse) GetMinOracleCount() uint64 {
+	if m != nil {
+		return m.MinOracleCount
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetTrimCount() uint64 {
+	if m != nil {
+		return m.TrimCount
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetMaxDeviationBps() uint64 {
+	if m != nil {
+		return m.MaxDeviationBps
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetDeviationInterval() time.Duration {
+	if m != nil {
+		return m.DeviationInterval
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetMaxOracleMisses() uint64 {
+	if m != nil {
+		return m.MaxOracleMisses
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetMaxOracleDeviationBps() uint64 {
+	if m != nil {
+		return m.MaxOracleDeviationBps
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetMaxPriceHistoryEntries() uint64 {
+	if m != nil {
+		return m.MaxPriceHistoryEntries
+	}
+	return 0
+}
+
+// OracleStatsResponse defines the performance of an oracle in a market.
+type OracleStatsResponse struct {
+	MarketID      string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress string `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3" json:"oracle_address,omitempty"`
+	MissedCount   uint64 `protobuf:"varint,3,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+	TotalUpdates  uint64 `protobuf:"varint,4,opt,name=total_updates,json=totalUpdates,proto3" json:"total_updates,omitempty"`
+	TotalMissed   uint64 `protobuf:"varint,5,opt,name=total_missed,json=totalMissed,proto3" json:"total_missed,omitempty"`
+	TotalPriced   uint64 `protobuf:"varint,6,opt,name=total_priced,json=totalPriced,proto3" json:"total_priced,omitempty"`
+	// average_deviation_bps is the mean deviation, in basis points, of the
+	// oracle's prices from the current price
+	AverageDeviationBps uint64 `protobuf:"varint,7,opt,name=average_deviation_bps,json=averageDeviationBps,proto3" json:"average_deviation_bps,omitempty"`
+	LastDeviationBps    uint64 `protobuf:"varint,8,opt,name=last_deviation_bps,json=lastDeviationBps,proto3" json:"last_deviation_bps,omitempty"`
+	Deactivated         bool   `protobuf:"varint,9,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+}
+
+func (m *OracleStatsResponse) Reset()         { *m = OracleStatsResponse{} }
+func (m *OracleStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*OracleStatsResponse) ProtoMessage()    {}
+func (*OracleStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84567be3085e4c6c, []int{19}
+}
+func (m *OracleStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleStatsResponse.Merge(m, src)
+}
+func (m *OracleStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleStatsResponse proto.InternalMessageInfo
+
+func (m *OracleStatsResponse) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *OracleStatsResponse) GetOracleAddress() string {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return ""
+}
+
+func (m *OracleStatsResponse) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
+func (m *OracleStatsResponse) GetTotalUpdates() uint64 {
+	if m != nil {
+		return m.TotalUpdates
+	}
+	return 0
+}
+
+func (m *OracleStatsResponse) GetTotalMissed() uint64 {
+	if m != nil {
+		return m.TotalMissed
+	}
+	return 0
+}
+
+func (m *OracleStatsResponse) GetTotalPriced() uint64 {
+	if m != nil {
+		return m.TotalPriced
+	}
+	return 0
+}
+
+func (m *OracleStatsResponse) GetAverageDeviationBps() uint64 {
+	if m != nil {
+		return m.AverageDeviationBps
+	}
+	return 0
+}
+
+func (m *OracleStatsResponse) GetLastDeviationBps() uint64 {
+	if m != nil {
+		return m.LastDeviationBps
+	}
+	return 0
+}
+
+func (m *OracleStatsResponse) GetDeactivated() bool {
+	if m != nil {
+		return m.Deactivated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.pricefeed.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.pricefeed.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryPriceRequest)(nil), "kava.pricefeed.v1beta1.QueryPriceRequest")
 	proto.RegisterType((*QueryPriceResponse)(nil), "kava.pricefeed.v1beta1.QueryPriceResponse")
+	proto.RegisterType((*QueryPriceHistoryRequest)(nil), "kava.pricefeed.v1beta1.QueryPriceHistoryRequest")
+	proto.RegisterType((*QueryPriceHistoryResponse)(nil), "kava.pricefeed.v1beta1.QueryPriceHistoryResponse")
 	proto.RegisterType((*QueryPricesRequest)(nil), "kava.pricefeed.v1beta1.QueryPricesRequest")
 	proto.RegisterType((*QueryPricesResponse)(nil), "kava.pricefeed.v1beta1.QueryPricesResponse")
 	proto.RegisterType((*QueryRawPricesRequest)(nil), "kava.pricefeed.v1beta1.QueryRawPricesRequest")
 	proto.RegisterType((*QueryRawPricesResponse)(nil), "kava.pricefeed.v1beta1.QueryRawPricesResponse")
 	proto.RegisterType((*QueryOraclesRequest)(nil), "kava.pricefeed.v1beta1.QueryOraclesRequest")
 	proto.RegisterType((*QueryOraclesResponse)(nil), "kava.pricefeed.v1beta1.QueryOraclesResponse")
+	proto.RegisterType((*QueryOracleStatsRequest)(nil), "kava.pricefeed.v1beta1.QueryOracleStatsRequest")
+	proto.RegisterType((*QueryOracleStatsResponse)(nil), "kava.pricefeed.v1beta1.QueryOracleStatsResponse")
 	proto.RegisterType((*QueryMarketsRequest)(nil), "kava.pricefeed.v1beta1.QueryMarketsRequest")
 	proto.RegisterType((*QueryMarketsResponse)(nil), "kava.pricefeed.v1beta1.QueryMarketsResponse")
 	proto.RegisterType((*PostedPriceResponse)(nil), "kava.pricefeed.v1beta1.PostedPriceResponse")
 	proto.RegisterType((*CurrentPriceResponse)(nil), "kava.pricefeed.v1beta1.CurrentPriceResponse")
 	proto.RegisterType((*MarketResponse)(nil), "kava.pricefeed.v1beta1.MarketResponse")
+	proto.RegisterType((*OracleStatsResponse)(nil), "kava.pricefeed.v1beta1.OracleStatsResponse")
 }
 
 func init() {
@@ -701,63 +1046,98 @@ func init() {
 }
 
 var fileDescriptor_84567be3085e4c6c = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0xa9, 0x7f, 0x4e, 0xa1, 0x88, 0xa9, 0x13, 0x2c, 0xd3, 0xee, 0x86, 0x95, 0x08,
-	0x6d, 0x1c, 0xef, 0xaa, 0xa9, 0xa8, 0x50, 0xc5, 0xa5, 0x26, 0x07, 0x7a, 0xa8, 0x80, 0x15, 0x97,
-	0x72, 0xb1, 0xc6, 0xde, 0xa9, 0xbb, 0x4a, 0xec, 0xd9, 0xec, 0x8c, 0xe3, 0x46, 0x08, 0x09, 0x21,
-	0x24, 0xca, 0x01, 0x29, 0x82, 0x13, 0x37, 0xb8, 0x21, 0x24, 0xfe, 0x8f, 0x1c, 0x23, 0x71, 0x41,
-	0x1c, 0x92, 0xe0, 0x70, 0xe3, 0x9f, 0x40, 0x3b, 0xf3, 0x76, 0xf1, 0x26, 0xde, 0x64, 0x2d, 0x4e,
-	0xc9, 0xbe, 0x7d, 0x3f, 0x3e, 0xef, 0xbb, 0x33, 0x5f, 0x63, 0x6b, 0x9b, 0xee, 0x51, 0x27, 0x08,
-	0xfd, 0x3e, 0x7b, 0xc6, 0x98, 0xe7, 0xec, 0xdd, 0xeb, 0x31, 0x49, 0xef, 0x39, 0xbb, 0x63, 0x16,
-	0xee, 0xdb, 0x41, 0xc8, 0x25, 0x27, 0x2b, 0x51, 0x8e, 0x9d, 0xe4, 0xd8, 0x90, 0xd3, 0xac, 0x0f,
-	0xf8, 0x80, 0xab, 0x14, 0x27, 0xfa, 0x4f, 0x67, 0x37, 0x6f, 0x0d, 0x38, 0x1f, 0xec, 0x30, 0x87,
-	0x06, 0xbe, 0x43, 0x47, 0x23, 0x2e, 0xa9, 0xf4, 0xf9, 0x48, 0xc0, 0x5b, 0x13, 0xde, 0xaa, 0xa7,
-	0xde, 0xf8, 0x99, 0x23, 0xfd, 0x21, 0x13, 0x92, 0x0e, 0x03, 0x48, 0xc8, 0x02, 0x12, 0x92, 0x87,
-	0x4c, 0xe7, 0x58, 0x75, 0x4c, 0x3e, 0x89, 0xf8, 0x3e, 0xa6, 0x21, 0x1d, 0x0a, 0x97, 0xed, 0x8e,
-	0x99, 0x90, 0xd6, 0x53, 0x7c, 0x33, 0x15, 0x15, 0x01, 0x1f, 0x09, 0x46, 0xde, 0xc7, 0xe5, 0x40,
-	0x45, 0x1a, 0x68, 0x15, 0xdd, 0xb9, 0xbe, 0x69, 0xd8, 0xf3, 0xd7, 0xb1, 0x75, 0x5d, 0xa7, 0x78,
-	0x78, 0x6c, 0x16, 0x5c, 0xa8, 0x79, 0x58, 0x7c, 0xf9, 0x93, 0x59, 0xb0, 0x1e, 0xe0, 0xd7, 0x75,
-	0xeb, 0xa8, 0x08, 0xe6, 0x91, 0x37, 0x71, 0x6d, 0x48, 0xc3, 0x6d, 0x26, 0xbb, 0xbe, 0xa7, 0x7a,
-	0xd7, 0xdc, 0xaa, 0x0e, 0x3c, 0xf6, 0xa0, 0xce, 0x8b, 0x41, 0x75, 0x1d, 0x10, 0x7d, 0x88, 0x4b,
-	0x6a, 0x3a, 0x00, 0x6d, 0x64, 0x01, 0x7d, 0x30, 0x0e, 0x43, 0x36, 0x92, 0xa9, 0x62, 0xc0, 0xd3,
-	0x0d, 0x60, 0x4a, 0x7d, 0x76, 0x4a, 0x22, 0xc7, 0x97, 0x28, 0xd6, 0x03, 0xc2, 0x30, 0xbd, 0x8f,
-	0xcb, 0xaa, 0x38, 0xd2, 0xe3, 0xda, 0xc2, 0xe3, 0x6f, 0x47, 0xe3, 0x7f, 0x3d, 0x31, 0x97, 0xe7,
-	0xbd, 0x15, 0x2e, 0xb4, 0x06, 0xb0, 0x87, 0x78, 0x59, 0x11, 0xb8, 0x74, 0x92, 0x62, 0xcb, 0x23,
-	0xdd, 0x4b, 0x84, 0x57, 0xce, 0x17, 0xc3, 0x06, 0xcf, 0x31, 0x0e, 0xe9, 0xa4, 0x9b, 0xda, 0xa2,
-	0x95, 0xf9, 0x55, 0xb9, 0x90, 0xcc, 0x4b, 0x2f, 0x71, 0x0b, 0x96, 0xa8, 0xcf, 0x79, 0x29, 0xdc,
-	0x5a, 0x18, 0x4f, 0x04, 0x94, 0xf7, 0x40, 0xc8, 0x8f, 0x42, 0xda, 0xdf, 0x59, 0x68, 0x89, 0x07,
-	0xb8, 0x9e, 0xae, 0x84, 0x0d, 0x1a, 0xb8, 0xc2, 0x75, 0x48, 0xe1, 0xd7, 0xdc, 0xf8, 0x11, 0xea,
-	0x96, 0x61, 0xe2, 0x13, 0xd5, 0x2e, 0xf9, 0xa4, 0x13, 0x68, 0x97, 0x84, 0xa1, 0xdd, 0x53, 0x5c,
-	0xd1, 0x83, 0x63, 0x35, 0xd6, 0xb2, 0xd4, 0xd0, 0x95, 0x89, 0x10, 0x6f, 0x80, 0x10, 0xaf, 0xa5,
-	0xe3, 0xc2, 0x8d, 0xfb, 0x01, 0xcf, 0x3f, 0x08, 0xdf, 0x9c, 0xa3, 0x15, 0xb9, 0x7b, 0x41, 0x82,
-	0xce, 0x2b, 0xd3, 0x63, 0xb3, 0xaa, 0xdb, 0x3d, 0xde, 0xfa, 0x4f, 0x10, 0xf2, 0x36, 0xbe, 0xa1,
-	0x77, 0xec, 0x52, 0xcf, 0x0b, 0x99, 0x10, 0x8d, 0x25, 0x25, 0xd9, 0xab, 0x3a, 0xfa, 0x48, 0x07,
-	0xc9, 0x56, 0x7c, 0x37, 0xae, 0xa9, 0x6e, 0x76, 0x04, 0xf8, 0xe7, 0xb1, 0xb9, 0x36, 0xf0, 0xe5,
-	0xf3, 0x71, 0xcf, 0xee, 0xf3, 0xa1, 0xd3, 0xe7, 0x62, 0xc8, 0x05, 0xfc, 0x69, 0x0b, 0x6f, 0xdb,
-	0x91, 0xfb, 0x01, 0x13, 0xf6, 0x16, 0xeb, 0xc3, 0xbd, 0x88, 0xee, 0x3c, 0x7b, 0x11, 0xf8, 0xe1,
-	0x7e, 0xa3, 0xa8, 0xae, 0x58, 0xd3, 0xd6, 0xb6, 0x63, 0xc7, 0xb6, 0x63, 0x7f, 0x1a, 0xdb, 0x4e,
-	0xa7, 0x1a, 0x8d, 0x38, 0x38, 0x31, 0x91, 0x0b, 0x35, 0xd6, 0x37, 0x08, 0xd7, 0xe7, 0x1d, 0xef,
-	0x45, 0xd6, 0x4d, 0xf6, 0x58, 0xfa, 0x1f, 0x7b, 0x58, 0xbf, 0x21, 0x7c, 0x23, 0xfd, 0x69, 0x16,
-	0x61, 0xb8, 0x8d, 0x71, 0x8f, 0x0a, 0xd6, 0xa5, 0x42, 0x30, 0x09, 0x72, 0xd7, 0xa2, 0xc8, 0xa3,
-	0x28, 0x40, 0x4c, 0x7c, 0x7d, 0x77, 0xcc, 0x65, 0xfc, 0x5e, 0x09, 0xee, 0x62, 0x15, 0xd2, 0x09,
-	0x33, 0xa7, 0xb4, 0x98, 0x3a, 0xa5, 0x64, 0x05, 0x97, 0x69, 0x5f, 0xfa, 0x7b, 0xac, 0x51, 0x5a,
-	0x45, 0x77, 0xaa, 0x2e, 0x3c, 0x6d, 0x7e, 0x5d, 0xc1, 0x25, 0x75, 0x42, 0xc9, 0xb7, 0x08, 0x97,
-	0xb5, 0xa1, 0x92, 0xf5, 0xac, 0xc3, 0x78, 0xd1, 0xc3, 0x9b, 0xad, 0x5c, 0xb9, 0x5a, 0x0a, 0x6b,
-	0xed, 0xab, 0xdf, 0xff, 0xfe, 0x61, 0x69, 0x95, 0x18, 0x4e, 0xc6, 0x6f, 0x86, 0xf6, 0x70, 0xf2,
-	0x3d, 0xc2, 0x25, 0xf5, 0x21, 0xc9, 0xdd, 0xcb, 0xdb, 0xcf, 0xb8, 0x7b, 0x73, 0x3d, 0x4f, 0x2a,
-	0x80, 0x6c, 0x2a, 0x90, 0x0d, 0xb2, 0x9e, 0x09, 0xa2, 0xec, 0xc4, 0xf9, 0x3c, 0xf9, 0x72, 0x5f,
-	0x68, 0x81, 0x54, 0x98, 0xe4, 0x18, 0x95, 0x57, 0xa0, 0x94, 0x51, 0xe6, 0x10, 0x48, 0x03, 0xfc,
-	0x8c, 0x70, 0x2d, 0xb1, 0x59, 0xd2, 0xbe, 0x74, 0xc4, 0x79, 0x2f, 0x6f, 0xda, 0x79, 0xd3, 0x01,
-	0xea, 0x5d, 0x05, 0xe5, 0x90, 0x76, 0x16, 0x54, 0x48, 0x27, 0x73, 0xf4, 0xfa, 0x11, 0xe1, 0x0a,
-	0xd8, 0x28, 0xb9, 0x5c, 0x84, 0xb4, 0x4d, 0x37, 0x37, 0xf2, 0x25, 0x03, 0xdd, 0x7d, 0x45, 0xd7,
-	0x26, 0xad, 0x2c, 0x3a, 0xb8, 0x02, 0x29, 0xb6, 0xef, 0x10, 0xae, 0x80, 0x27, 0x5f, 0xc1, 0x96,
-	0x36, 0xf4, 0x2b, 0xd8, 0xce, 0xd9, 0xbc, 0xf5, 0x8e, 0x62, 0x7b, 0x8b, 0x98, 0x59, 0x6c, 0x60,
-	0xda, 0x9d, 0x27, 0xa7, 0x7f, 0x19, 0xe8, 0x97, 0xa9, 0x81, 0x0e, 0xa7, 0x06, 0x3a, 0x9a, 0x1a,
-	0xe8, 0x74, 0x6a, 0xa0, 0x83, 0x33, 0xa3, 0x70, 0x74, 0x66, 0x14, 0xfe, 0x38, 0x33, 0x0a, 0x9f,
-	0xb5, 0x66, 0x7c, 0x28, 0x6a, 0xd6, 0xde, 0xa1, 0x3d, 0xa1, 0xdb, 0xbe, 0x98, 0x69, 0xac, 0x0c,
-	0xa9, 0x57, 0x56, 0xae, 0x79, 0xff, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x99, 0x27, 0xa2,
-	0x2c, 0x0a, 0x00, 0x00,
+	// 1456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0x24, 0xce, 0x87, 0x8f, 0x9d, 0xb4, 0xb9, 0x71, 0xd2, 0xa9, 0xdb, 0xda, 0xae, 0x9f,
+	0x5e, 0x9a, 0xe6, 0x63, 0xa6, 0x75, 0xfb, 0xfa, 0xfa, 0xa2, 0xb7, 0x69, 0x1a, 0xa0, 0x5d, 0x44,
+	0xc0, 0x00, 0x12, 0x65, 0x63, 0x5d, 0x7b, 0x6e, 0x9d, 0x51, 0x3c, 0x1e, 0x77, 0xee, 0x75, 0x3e,
+	0x84, 0x90, 0x10, 0x1b, 0xca, 0x02, 0x54, 0xc1, 0x06, 0x76, 0x65, 0x87, 0xba, 0xe1, 0xdf, 0xe8,
+	0xb2, 0x12, 0x1b, 0xc4, 0xa2, 0x2d, 0x09, 0x0b, 0x24, 0x36, 0x6c, 0x59, 0x20, 0xa1, 0xb9, 0xf7,
+	0xd8, 0x9e, 0x71, 0xed, 0x64, 0x2c, 0x58, 0x25, 0xf3, 0xbb, 0xe7, 0x77, 0xce, 0xef, 0x9c, 0x7b,
+	0xee, 0xbd, 0xc7, 0x50, 0xdc, 0xa1, 0xbb, 0xd4, 0x6c, 0xfa, 0x4e, 0x95, 0xdd, 0x67, 0xcc, 0x36,
+	0x77, 0xaf, 0x56, 0x98, 0xa0, 0x57, 0xcd, 0x07, 0x2d, 0xe6, 0x1f, 0x18, 0x4d, 0xdf, 0x13, 0x1e,
+	0x59, 0x08, 0x6c, 0x8c, 0x8e, 0x8d, 0x81, 0x36, 0xd9, 0xe5, 0xaa, 0xc7, 0x5d, 0x8f, 0x9b, 0x15,
+	0xca, 0x99, 0x22, 0x74, 0xe8, 0x4d, 0x5a, 0x73, 0x1a, 0x54, 0x38, 0x5e, 0x43, 0xf9, 0xc8, 0x66,
+	0x6a, 0x5e, 0xcd, 0x93, 0xff, 0x9a, 0xc1, 0x7f, 0x88, 0x9e, 0xaf, 0x79, 0x5e, 0xad, 0xce, 0x4c,
+	0xda, 0x74, 0x4c, 0xda, 0x68, 0x78, 0x42, 0x52, 0x38, 0xae, 0xe6, 0x70, 0x55, 0x7e, 0x55, 0x5a,
+	0xf7, 0x4d, 0xbb, 0xe5, 0x87, 0x7d, 0xe6, 0x7b, 0xd7, 0x85, 0xe3, 0x32, 0x2e, 0xa8, 0xdb, 0x44,
+	0x83, 0x41, 0xc9, 0x71, 0xe1, 0xf9, 0x4c, 0xd9, 0x14, 0x33, 0x40, 0xde, 0x0e, 0xa4, 0xbf, 0x45,
+	0x7d, 0xea, 0x72, 0x8b, 0x3d, 0x68, 0x31, 0x2e, 0x8a, 0xf7, 0x60, 0x2e, 0x82, 0xf2, 0xa6, 0xd7,
+	0xe0, 0x8c, 0xfc, 0x1f, 0x26, 0x9a, 0x12, 0xd1, 0xb5, 0x82, 0xb6, 0x94, 0x2a, 0xe5, 0x8c, 0xfe,
+	0xa5, 0x31, 0x14, 0x6f, 0x23, 0xf1, 0xf4, 0x79, 0x7e, 0xc4, 0x42, 0xce, 0x7a, 0xe2, 0xe1, 0xe3,
+	0xfc, 0x48, 0xf1, 0x06, 0xcc, 0x2a, 0xd7, 0x01, 0x09, 0xe3, 0x91, 0x73, 0x90, 0x74, 0xa9, 0xbf,
+	0xc3, 0x44, 0xd9, 0xb1, 0xa5, 0xef, 0xa4, 0x35, 0xa5, 0x80, 0xbb, 0x36, 0xf2, 0x6c, 0x20, 0x61,
+	0x1e, 0x2a, 0xba, 0x03, 0xe3, 0x32, 0x3a, 0x0a, 0x5a, 0x1d, 0x24, 0xe8, 0x76, 0xcb, 0xf7, 0x59,
+	0x43, 0x44, 0xc8, 0x28, 0x4f, 0x39, 0xc0, 0x28, 0x7f, 0x6a, 0xa0, 0x77, 0xc3, 0xdc, 0x71, 0x82,
+	0x52, 0x1d, 0xc4, 0x51, 0x49, 0x6e, 0x42, 0xe2, 0xbe, 0xef, 0xb9, 0xfa, 0xa8, 0x14, 0x92, 0x35,
+	0xd4, 0xe6, 0x18, 0xed, 0xcd, 0x31, 0xde, 0x6d, 0x6f, 0xce, 0xc6, 0x54, 0x10, 0xf6, 0xd1, 0x8b,
+	0xbc, 0x66, 0x49, 0x06, 0xb9, 0x0e, 0xa3, 0xc2, 0xd3, 0xc7, 0x86, 0xe0, 0x8d, 0x0a, 0x8f, 0xbc,
+	0x0e, 0xd0, 0xed, 0x32, 0x3d, 0x21, 0xd9, 0x8b, 0x86, 0x6a, 0x49, 0x23, 0x68, 0x49, 0x43, 0xf5,
+	0x70, 0x77, 0x4b, 0x6a, 0xed, 0x72, 0x5b, 0x21, 0xe6, 0x7a, 0x3a, 0xc8, 0xfb, 0xf1, 0xe3, 0xfc,
+	0xc8, 0xaf, 0x41, 0xfe, 0x2f, 0x34, 0x38, 0xdb, 0x27, 0x7f, 0xac, 0xf6, 0x0e, 0x4c, 0xcb, 0x62,
+	0x95, 0xb7, 0xd5, 0x82, 0xae, 0x15, 0xc6, 0x96, 0x52, 0xa5, 0xcb, 0x03, 0xdb, 0x20, 0xe4, 0xe4,
+	0xb5, 0x86, 0xf0, 0x0f, 0x36, 0xce, 0x05, 0x39, 0x3c, 0x79, 0x91, 0x9f, 0xeb, 0x5d, 0x72, 0x18,
+	0xb7, 0xd2, 0xcd, 0x10, 0x48, 0xde, 0x88, 0x24, 0xa8, 0xca, 0x7a, 0xe9, 0xc4, 0x04, 0x95, 0xd2,
+	0x63, 0x32, 0xcc, 0x84, 0xfb, 0xa8, 0xd3, 0xf0, 0x1f, 0x6b, 0x30, 0x17, 0x81, 0x31, 0xe3, 0x2a,
+	0x4c, 0x48, 0x51, 0x1c, 0x53, 0x1d, 0xae, 0xc1, 0x2e, 0x60, 0xb6, 0xf3, 0xfd, 0x56, 0xb9, 0x85,
+	0xae, 0xb1, 0xf5, 0xd6, 0x61, 0x5e, 0x2a, 0xb0, 0xe8, 0x5e, 0x44, 0x5b, 0x9c, 0xc3, 0xf1, 0x50,
+	0x83, 0x85, 0x5e, 0x32, 0x66, 0xb0, 0x0d, 0xe0, 0xd3, 0xbd, 0x72, 0x24, 0x8b, 0x95, 0x81, 0x1b,
+	0xe6, 0x71, 0xc1, 0xec, 0x68, 0x12, 0xe7, 0x31, 0x89, 0x4c, 0x9f, 0x45, 0x6e, 0x25, 0xfd, 0x76,
+	0x44, 0x94, 0x72, 0x13, 0x0b, 0xf9, 0xa6, 0x4f, 0xab, 0xf5, 0xa1, 0x92, 0xb8, 0x01, 0x99, 0x28,
+	0x13, 0x33, 0xd0, 0x61, 0xd2, 0x53, 0x90, 0x94, 0x9f, 0xb4, 0xda, 0x9f, 0xc8, 0xab, 0xc2, 0x99,
+	0x10, 0xef, 0x1d, 0x41, 0x45, 0xac, 0xa8, 0xe4, 0xdf, 0x30, 0xa3, 0x1c, 0x95, 0xa9, 0x6d, 0xfb,
+	0x8c, 0x73, 0xd9, 0x64, 0x49, 0x6b, 0x5a, 0xa1, 0xb7, 0x14, 0x88, 0x41, 0xbe, 0x68, 0x5f, 0x0c,
+	0x91, 0x28, 0xa8, 0xb0, 0x0e, 0x69, 0xf4, 0xc4, 0x03, 0xfc, 0xa4, 0x2a, 0xf7, 0x71, 0xd1, 0xad,
+	0x72, 0x9f, 0x45, 0x6e, 0xa5, 0xbc, 0x2e, 0x8a, 0x82, 0xe6, 0xb1, 0xce, 0x5b, 0x32, 0x9d, 0x4e,
+	0x23, 0xef, 0x41, 0x26, 0x0a, 0xa3, 0xc4, 0x7b, 0x30, 0xa9, 0x12, 0x6f, 0xab, 0x5b, 0x1c, 0xa4,
+	0x4e, 0x31, 0x3b, 0xc2, 0xce, 0xa0, 0xb0, 0x53, 0x51, 0x9c, 0x5b, 0x6d, 0x7f, 0xa8, 0xe7, 0x37,
+	0x0d, 0xe6, 0xfa, 0x74, 0x08, 0xb9, 0xfc, 0xca, 0x16, 0x6c, 0xa4, 0x0f, 0x9f, 0xe7, 0xa7, 0x94,
+	0xbb, 0xbb, 0x9b, 0x43, 0x6f, 0x08, 0xd9, 0x6c, 0xdf, 0xf9, 0x63, 0xd2, 0x9b, 0x11, 0x08, 0xfc,
+	0xe9, 0x79, 0x7e, 0xb1, 0xe6, 0x88, 0xed, 0x56, 0xc5, 0xa8, 0x7a, 0xae, 0x89, 0x2f, 0xb3, 0xfa,
+	0xb3, 0xc6, 0xed, 0x1d, 0x53, 0x1c, 0x34, 0x19, 0x37, 0x36, 0x59, 0x15, 0xef, 0xfb, 0xe0, 0x2d,
+	0x63, 0xfb, 0x4d, 0xc7, 0x3f, 0xd0, 0x13, 0x43, 0xdc, 0xbc, 0xc8, 0x29, 0x7e, 0xaa, 0x41, 0xa6,
+	0xdf, 0xa1, 0x1e, 0x26, 0xdd, 0x4e, 0x1e, 0xa3, 0x7f, 0x23, 0x8f, 0xe2, 0xef, 0x09, 0x98, 0x89,
+	0x6e, 0xcd, 0x30, 0x1a, 0x2e, 0x00, 0x04, 0x57, 0x69, 0x99, 0x72, 0xce, 0x04, 0x96, 0x3b, 0x19,
+	0x20, 0xb7, 0x02, 0x80, 0xe4, 0x21, 0xf5, 0xa0, 0xe5, 0x89, 0xf6, 0xba, 0x2c, 0xb8, 0x05, 0x12,
+	0x52, 0x06, 0xa1, 0xb3, 0x99, 0x88, 0x9c, 0x4d, 0xb2, 0x00, 0x13, 0xb4, 0x2a, 0x9c, 0x5d, 0xa6,
+	0x8f, 0x17, 0xb4, 0xa5, 0x29, 0x0b, 0xbf, 0xc8, 0xfb, 0x40, 0x68, 0xad, 0xe6, 0xb3, 0x9a, 0xbc,
+	0x9c, 0xcb, 0x2e, 0x13, 0xdb, 0x9e, 0xad, 0x4f, 0x14, 0xb4, 0xa5, 0x99, 0xc1, 0x0f, 0xc9, 0xad,
+	0x2e, 0x63, 0x4b, 0x12, 0xac, 0x59, 0xda, 0x0b, 0x91, 0x25, 0x38, 0xed, 0x3a, 0x8d, 0x32, 0xb6,
+	0x50, 0xd5, 0x6b, 0x35, 0x84, 0x3e, 0x59, 0xd0, 0x96, 0x12, 0xd6, 0x8c, 0xeb, 0x34, 0xd4, 0xb9,
+	0xba, 0x1d, 0xa0, 0x41, 0xd6, 0xc2, 0x77, 0x5c, 0xb4, 0x99, 0x92, 0x36, 0xc9, 0x00, 0x51, 0xcb,
+	0xcb, 0x30, 0xeb, 0xd2, 0xfd, 0xb2, 0xcd, 0x76, 0x1d, 0x25, 0xb2, 0xd2, 0xe4, 0x7a, 0x52, 0x5a,
+	0x9d, 0x72, 0xe9, 0xfe, 0x66, 0x1b, 0xdf, 0x68, 0x72, 0x62, 0x01, 0xe9, 0xda, 0x39, 0x0d, 0xc1,
+	0xfc, 0x5d, 0x5a, 0xd7, 0x41, 0xb6, 0xd4, 0xd9, 0x57, 0x5a, 0x6a, 0x13, 0x27, 0x38, 0xd5, 0x51,
+	0x5f, 0x07, 0x1d, 0x35, 0xdb, 0xa1, 0xdf, 0x45, 0x76, 0x3b, 0x3e, 0x26, 0xe2, 0x3a, 0x9c, 0x33,
+	0xae, 0xa7, 0x3a, 0xf1, 0x55, 0x26, 0x5b, 0x12, 0x26, 0xff, 0x05, 0x3d, 0x64, 0x1b, 0x95, 0x9c,
+	0x96, 0x94, 0xf9, 0x0e, 0x25, 0x22, 0xfc, 0x7f, 0x70, 0x36, 0x20, 0x46, 0xde, 0xf3, 0x32, 0x53,
+	0x2f, 0xb1, 0x3e, 0x2d, 0x99, 0x0b, 0x2e, 0xdd, 0xef, 0xf3, 0x4e, 0x17, 0xff, 0x18, 0x85, 0xb9,
+	0x7e, 0xd7, 0xe0, 0x3f, 0x7f, 0xd4, 0x2f, 0x42, 0x5a, 0xa6, 0x6f, 0xe3, 0x56, 0x8d, 0x49, 0x5d,
+	0x29, 0x85, 0xa9, 0xcd, 0xfa, 0x17, 0x4c, 0x0b, 0x4f, 0xd0, 0x7a, 0xb9, 0xd5, 0xb4, 0xa9, 0x90,
+	0x7d, 0x18, 0xd8, 0xa4, 0x25, 0xf8, 0x9e, 0xc2, 0x02, 0x3f, 0xca, 0x48, 0x31, 0x65, 0x4b, 0x26,
+	0xac, 0x94, 0xc4, 0x64, 0x21, 0xed, 0xae, 0x89, 0xac, 0x88, 0xea, 0xc8, 0xb6, 0x89, 0x2c, 0x82,
+	0x4d, 0x4a, 0x30, 0x4f, 0x77, 0x99, 0x4f, 0x6b, 0xbd, 0x85, 0x56, 0x5d, 0x36, 0x87, 0x8b, 0x91,
+	0x32, 0xaf, 0x02, 0xa9, 0x53, 0x2e, 0x7a, 0x08, 0xaa, 0xe5, 0x4e, 0x07, 0x2b, 0x11, 0xeb, 0x02,
+	0xa4, 0x6c, 0x26, 0x0f, 0x0a, 0x15, 0xcc, 0x96, 0x3d, 0x37, 0x65, 0x85, 0xa1, 0xd2, 0x51, 0x12,
+	0xc6, 0xe5, 0x05, 0x4f, 0x3e, 0xd3, 0x60, 0x42, 0xcd, 0xd9, 0x64, 0x79, 0xd0, 0xb9, 0x79, 0x75,
+	0xb4, 0xcf, 0xae, 0xc4, 0xb2, 0x55, 0x3b, 0x5a, 0x5c, 0xfc, 0xe4, 0x87, 0x5f, 0xbe, 0x1a, 0x2d,
+	0x90, 0x9c, 0x39, 0xe0, 0xa7, 0x84, 0x1a, 0xed, 0xc9, 0x97, 0x1a, 0x8c, 0xcb, 0x22, 0x91, 0xcb,
+	0xc7, 0xbb, 0x0f, 0x0d, 0xfd, 0xd9, 0xe5, 0x38, 0xa6, 0x28, 0xa4, 0x24, 0x85, 0xac, 0x92, 0xe5,
+	0x81, 0x42, 0x02, 0x84, 0x9b, 0x1f, 0x76, 0x1a, 0xf0, 0x23, 0x55, 0x20, 0x09, 0x93, 0x18, 0xa1,
+	0xe2, 0x16, 0x28, 0x32, 0x5d, 0xc5, 0x28, 0x90, 0x12, 0xf0, 0xbd, 0x06, 0xe9, 0xf0, 0x51, 0x22,
+	0x57, 0x4e, 0x8e, 0x12, 0xfd, 0xf5, 0x91, 0xbd, 0x3a, 0x04, 0x03, 0xd5, 0xad, 0x4b, 0x75, 0xd7,
+	0x49, 0x29, 0x7e, 0xd5, 0x4c, 0xbc, 0x0a, 0xc8, 0xb7, 0x1a, 0x24, 0x3b, 0xd3, 0x24, 0x59, 0x3b,
+	0x36, 0x78, 0xef, 0xc8, 0x9a, 0x35, 0xe2, 0x9a, 0xa3, 0xd0, 0xff, 0x48, 0xa1, 0x26, 0x59, 0x1b,
+	0x24, 0xd4, 0xa7, 0x7b, 0x7d, 0x76, 0xf8, 0x1b, 0x0d, 0x26, 0x71, 0x5a, 0x24, 0xc7, 0x6f, 0x5b,
+	0x74, 0x1a, 0xcd, 0xae, 0xc6, 0x33, 0x46, 0x75, 0xd7, 0xa4, 0xba, 0x35, 0xb2, 0x32, 0x48, 0x1d,
+	0xbe, 0x79, 0x11, 0x6d, 0x4f, 0x34, 0x48, 0x85, 0x2e, 0x49, 0x62, 0xc6, 0x08, 0x19, 0x9e, 0x5d,
+	0xb3, 0x57, 0xe2, 0x13, 0x50, 0xe7, 0x4d, 0xa9, 0xb3, 0x44, 0xae, 0x1c, 0xaf, 0x53, 0x0d, 0xa9,
+	0x11, 0xb1, 0x9f, 0x6b, 0x30, 0x89, 0x13, 0xe3, 0x09, 0x85, 0x8c, 0x8e, 0x9b, 0xd9, 0xd5, 0x78,
+	0xc6, 0x28, 0xf0, 0x92, 0x14, 0x78, 0x91, 0xe4, 0x07, 0x09, 0x54, 0x92, 0xf8, 0xc6, 0xd6, 0xcb,
+	0x9f, 0x73, 0xda, 0x77, 0x87, 0x39, 0xed, 0xe9, 0x61, 0x4e, 0x7b, 0x76, 0x98, 0xd3, 0x5e, 0x1e,
+	0xe6, 0xb4, 0x47, 0x47, 0xb9, 0x91, 0x67, 0x47, 0xb9, 0x91, 0x1f, 0x8f, 0x72, 0x23, 0x1f, 0xac,
+	0x84, 0xa6, 0xa4, 0xc0, 0xd9, 0x5a, 0x9d, 0x56, 0xb8, 0x72, 0xbb, 0x1f, 0x72, 0x2c, 0xc7, 0xa5,
+	0xca, 0x84, 0x7c, 0x80, 0xaf, 0xfd, 0x35, 0x00, 0xbf, 0xaf, 0x63, 0xc7, 0xee, 0x11, 0x00, 0x00,
 }
 
 func (this *QueryParamsRequest) VerboseEqual(that interface{}) error {
@@ -1318,7 +1698,7 @@ func (this *QueryOraclesResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *QueryMarketsRequest) VerboseEqual(that interface{}) error {
+func (this *QueryOracleStatsRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1326,33 +1706,39 @@ func (this *QueryMarketsRequest) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*QueryMarketsRequest)
+	that1, ok := that.(*QueryOracleStatsRequest)
 	if !ok {
-		that2, ok := that.(QueryMarketsRequest)
+		that2, ok := that.(QueryOracleStatsRequest)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *QueryMarketsRequest")
+			return fmt.Errorf("that is not of type *QueryOracleStatsRequest")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *QueryMarketsRequest but is nil && this != nil")
+		return fmt.Errorf("that is type *QueryOracleStatsRequest but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *QueryMarketsRequest but is not nil && this == nil")
+		return fmt.Errorf("that is type *QueryOracleStatsRequest but is not nil && this == nil")
+	}
+	if this.MarketId != that1.MarketId {
+		return fmt.Errorf("MarketId this(%v) Not Equal that(%v)", this.MarketId, that1.MarketId)
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
 	}
 	return nil
 }
-func (this *QueryMarketsRequest) Equal(that interface{}) bool {
+func (this *QueryOracleStatsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryMarketsRequest)
+	that1, ok := that.(*QueryOracleStatsRequest)
 	if !ok {
-		that2, ok := that.(QueryMarketsRequest)
+		that2, ok := that.(QueryOracleStatsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1364,9 +1750,15 @@ func (this *QueryMarketsRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MarketId != that1.MarketId {
+		return false
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return false
+	}
 	return true
 }
-func (this *QueryMarketsResponse) VerboseEqual(that interface{}) error {
+func (this *QueryOracleStatsResponse) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1374,41 +1766,41 @@ func (this *QueryMarketsResponse) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*QueryMarketsResponse)
+	that1, ok := that.(*QueryOracleStatsResponse)
 	if !ok {
-		that2, ok := that.(QueryMarketsResponse)
+		that2, ok := that.(QueryOracleStatsResponse)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *QueryMarketsResponse")
+			return fmt.Errorf("that is not of type *QueryOracleStatsResponse")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *QueryMarketsResponse but is nil && this != nil")
+		return fmt.Errorf("that is type *QueryOracleStatsResponse but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *QueryMarketsResponse but is not nil && this == nil")
+		return fmt.Errorf("that is type *QueryOracleStatsResponse but is not nil && this == nil")
 	}
-	if len(this.Markets) != len(that1.Markets) {
-		return fmt.Errorf("Markets this(%v) Not Equal that(%v)", len(this.Markets), len(that1.Markets))
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return fmt.Errorf("OracleStats this(%v) Not Equal that(%v)", len(this.OracleStats), len(that1.OracleStats))
 	}
-	for i := range this.Markets {
-		if !this.Markets[i].Equal(&that1.Markets[i]) {
-			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return fmt.Errorf("OracleStats this[%v](%v) Not Equal that[%v](%v)", i, this.OracleStats[i], i, that1.OracleStats[i])
 		}
 	}
 	return nil
 }
-func (this *QueryMarketsResponse) Equal(that interface{}) bool {
+func (this *QueryOracleStatsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryMarketsResponse)
+	that1, ok := that.(*QueryOracleStatsResponse)
 	if !ok {
-		that2, ok := that.(QueryMarketsResponse)
+		that2, ok := that.(QueryOracleStatsResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1420,17 +1812,17 @@ func (this *QueryMarketsResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Markets) != len(that1.Markets) {
+	if len(this.OracleStats) != len(that1.OracleStats) {
 		return false
 	}
-	for i := range this.Markets {
-		if !this.Markets[i].Equal(&that1.Markets[i]) {
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
+func (this *QueryMarketsRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1438,28 +1830,140 @@ func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*PostedPriceResponse)
+	that1, ok := that.(*QueryMarketsRequest)
 	if !ok {
-		that2, ok := that.(PostedPriceResponse)
+		that2, ok := that.(QueryMarketsRequest)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *PostedPriceResponse")
+			return fmt.Errorf("that is not of type *QueryMarketsRequest")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *PostedPriceResponse but is nil && this != nil")
+		return fmt.Errorf("that is type *QueryMarketsRequest but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *PostedPriceResponse but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
-	}
-	if this.OracleAddress != that1.OracleAddress {
-		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+		return fmt.Errorf("that is type *QueryMarketsRequest but is not nil && this == nil")
+	}
+	return nil
+}
+func (this *QueryMarketsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryMarketsRequest)
+	if !ok {
+		that2, ok := that.(QueryMarketsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *QueryMarketsResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*QueryMarketsResponse)
+	if !ok {
+		that2, ok := that.(QueryMarketsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryMarketsResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryMarketsResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryMarketsResponse but is not nil && this == nil")
+	}
+	if len(this.Markets) != len(that1.Markets) {
+		return fmt.Errorf("Markets this(%v) Not Equal that(%v)", len(this.Markets), len(that1.Markets))
+	}
+	for i := range this.Markets {
+		if !this.Markets[i].Equal(&that1.Markets[i]) {
+			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
+		}
+	}
+	return nil
+}
+func (this *QueryMarketsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryMarketsResponse)
+	if !ok {
+		that2, ok := that.(QueryMarketsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Markets) != len(that1.Markets) {
+		return false
+	}
+	for i := range this.Markets {
+		if !this.Markets[i].Equal(&that1.Markets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PostedPriceResponse)
+	if !ok {
+		that2, ok := that.(PostedPriceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PostedPriceResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PostedPriceResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PostedPriceResponse but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
 	}
 	if !this.Price.Equal(that1.Price) {
 		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
@@ -1607,6 +2111,30 @@ func (this *MarketResponse) VerboseEqual(that interface{}) error {
 	if this.Active != that1.Active {
 		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
 	}
+	if this.AggregationMethod != that1.AggregationMethod {
+		return fmt.Errorf("AggregationMethod this(%v) Not Equal that(%v)", this.AggregationMethod, that1.AggregationMethod)
+	}
+	if this.MinOracleCount != that1.MinOracleCount {
+		return fmt.Errorf("MinOracleCount this(%v) Not Equal that(%v)", this.MinOracleCount, that1.MinOracleCount)
+	}
+	if this.TrimCount != that1.TrimCount {
+		return fmt.Errorf("TrimCount this(%v) Not Equal that(%v)", this.TrimCount, that1.TrimCount)
+	}
+	if this.MaxDeviationBps != that1.MaxDeviationBps {
+		return fmt.Errorf("MaxDeviationBps this(%v) Not Equal that(%v)", this.MaxDeviationBps, that1.MaxDeviationBps)
+	}
+	if this.DeviationInterval != that1.DeviationInterval {
+		return fmt.Errorf("DeviationInterval this(%v) Not Equal that(%v)", this.DeviationInterval, that1.DeviationInterval)
+	}
+	if this.MaxOracleMisses != that1.MaxOracleMisses {
+		return fmt.Errorf("MaxOracleMisses this(%v) Not Equal that(%v)", this.MaxOracleMisses, that1.MaxOracleMisses)
+	}
+	if this.MaxOracleDeviationBps != that1.MaxOracleDeviationBps {
+		return fmt.Errorf("MaxOracleDeviationBps this(%v) Not Equal that(%v)", this.MaxOracleDeviationBps, that1.MaxOracleDeviationBps)
+	}
+	if this.MaxPriceHistoryEntries != that1.MaxPriceHistoryEntries {
+		return fmt.Errorf("MaxPriceHistoryEntries this(%v) Not Equal that(%v)", this.MaxPriceHistoryEntries, that1.MaxPriceHistoryEntries)
+	}
 	return nil
 }
 func (this *MarketResponse) Equal(that interface{}) bool {
@@ -1648,6 +2176,132 @@ func (this *MarketResponse) Equal(that interface{}) bool {
 	if this.Active != that1.Active {
 		return false
 	}
+	if this.AggregationMethod != that1.AggregationMethod {
+		return false
+	}
+	if this.MinOracleCount != that1.MinOracleCount {
+		return false
+	}
+	if this.TrimCount != that1.TrimCount {
+		return false
+	}
+	if this.MaxDeviationBps != that1.MaxDeviationBps {
+		return false
+	}
+	if this.DeviationInterval != that1.DeviationInterval {
+		return false
+	}
+	if this.MaxOracleMisses != that1.MaxOracleMisses {
+		return false
+	}
+	if this.MaxOracleDeviationBps != that1.MaxOracleDeviationBps {
+		return false
+	}
+	if this.MaxPriceHistoryEntries != that1.MaxPriceHistoryEntries {
+		return false
+	}
+	return true
+}
+func (this *OracleStatsResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleStatsResponse)
+	if !ok {
+		that2, ok := that.(OracleStatsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleStatsResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleStatsResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleStatsResponse but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.MissedCount != that1.MissedCount {
+		return fmt.Errorf("MissedCount this(%v) Not Equal that(%v)", this.MissedCount, that1.MissedCount)
+	}
+	if this.TotalUpdates != that1.TotalUpdates {
+		return fmt.Errorf("TotalUpdates this(%v) Not Equal that(%v)", this.TotalUpdates, that1.TotalUpdates)
+	}
+	if this.TotalMissed != that1.TotalMissed {
+		return fmt.Errorf("TotalMissed this(%v) Not Equal that(%v)", this.TotalMissed, that1.TotalMissed)
+	}
+	if this.TotalPriced != that1.TotalPriced {
+		return fmt.Errorf("TotalPriced this(%v) Not Equal that(%v)", this.TotalPriced, that1.TotalPriced)
+	}
+	if this.AverageDeviationBps != that1.AverageDeviationBps {
+		return fmt.Errorf("AverageDeviationBps this(%v) Not Equal that(%v)", this.AverageDeviationBps, that1.AverageDeviationBps)
+	}
+	if this.LastDeviationBps != that1.LastDeviationBps {
+		return fmt.Errorf("LastDeviationBps this(%v) Not Equal that(%v)", this.LastDeviationBps, that1.LastDeviationBps)
+	}
+	if this.Deactivated != that1.Deactivated {
+		return fmt.Errorf("Deactivated this(%v) Not Equal that(%v)", this.Deactivated, that1.Deactivated)
+	}
+	return nil
+}
+func (this *OracleStatsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleStatsResponse)
+	if !ok {
+		that2, ok := that.(OracleStatsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return false
+	}
+	if this.MissedCount != that1.MissedCount {
+		return false
+	}
+	if this.TotalUpdates != that1.TotalUpdates {
+		return false
+	}
+	if this.TotalMissed != that1.TotalMissed {
+		return false
+	}
+	if this.TotalPriced != that1.TotalPriced {
+		return false
+	}
+	if this.AverageDeviationBps != that1.AverageDeviationBps {
+		return false
+	}
+	if this.LastDeviationBps != that1.LastDeviationBps {
+		return false
+	}
+	if this.Deactivated != that1.Deactivated {
+		return false
+	}
 	return true
 }
 
@@ -1669,10 +2323,14 @@ type QueryClient interface {
 	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
 	// Prices queries all prices
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// PriceHistory queries the recorded current prices of a market between two times
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
 	// RawPrices queries all raw prices based on a market
 	RawPrices(ctx context.Context, in *QueryRawPricesRequest, opts ...grpc.CallOption) (*QueryRawPricesResponse, error)
 	// Oracles queries all oracles based on a market
 	Oracles(ctx context.Context, in *QueryOraclesRequest, opts ...grpc.CallOption) (*QueryOraclesResponse, error)
+	// OracleStats queries the performance of the oracles of a market
+	OracleStats(ctx context.Context, in *QueryOracleStatsRequest, opts ...grpc.CallOption) (*QueryOracleStatsResponse, error)
 	// Markets queries all markets
 	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
 }
@@ -1712,6 +2370,15 @@ func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kava.pricefeed.v1beta1.Query/PriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RawPrices(ctx context.Context, in *QueryRawPricesRequest, opts ...grpc.CallOption) (*QueryRawPricesResponse, error) {
 	out := new(QueryRawPricesResponse)
 	err := c.cc.Invoke(ctx, "/kava.pricefeed.v1beta1.Query/RawPrices", in, out, opts...)
@@ -1730,6 +2397,15 @@ func (c *queryClient) Oracles(ctx context.Context, in *QueryOraclesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) OracleStats(ctx context.Context, in *QueryOracleStatsRequest, opts ...grpc.CallOption) (*QueryOracleStatsResponse, error) {
+	out := new(QueryOracleStatsResponse)
+	err := c.cc.Invoke(ctx, "/kava.pricefeed.v1beta1.Query/OracleStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
 	out := new(QueryMarketsResponse)
 	err := c.cc.Invoke(ctx, "/kava.pricefeed.v1beta1.Query/Markets", in, out, opts...)
@@ -1747,10 +2423,14 @@ type QueryServer interface {
 	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
 	// Prices queries all prices
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// PriceHistory queries the recorded current prices of a market between two times
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
 	// RawPrices queries all raw prices based on a market
 	RawPrices(context.Context, *QueryRawPricesRequest) (*QueryRawPricesResponse, error)
 	// Oracles queries all oracles based on a market
 	Oracles(context.Context, *QueryOraclesRequest) (*QueryOraclesResponse, error)
+	// OracleStats queries the performance of the oracles of a market
+	OracleStats(context.Context, *QueryOracleStatsRequest) (*QueryOracleStatsResponse, error)
 	// Markets queries all markets
 	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
 }
@@ -1768,12 +2448,18 @@ func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceReque
 func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (*UnimplementedQueryServer) PriceHistory(ctx context.Context, req *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
 func (*UnimplementedQueryServer) RawPrices(ctx context.Context, req *QueryRawPricesRequest) (*QueryRawPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RawPrices not implemented")
 }
 func (*UnimplementedQueryServer) Oracles(ctx context.Context, req *QueryOraclesRequest) (*QueryOraclesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Oracles not implemented")
 }
+func (*UnimplementedQueryServer) OracleStats(ctx context.Context, req *QueryOracleStatsRequest) (*QueryOracleStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleStats not implemented")
+}
 func (*UnimplementedQueryServer) Markets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
 }
@@ -1836,6 +2522,24 @@ func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.pricefeed.v1beta1.Query/PriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RawPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRawPricesRequest)
 	if err := dec(in); err != nil {
@@ -1872,6 +2576,24 @@ func _Query_Oracles_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OracleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.pricefeed.v1beta1.Query/OracleStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleStats(ctx, req.(*QueryOracleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryMarketsRequest)
 	if err := dec(in); err != nil {
@@ -1906,6 +2628,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Prices",
 			Handler:    _Query_Prices_Handler,
 		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
 		{
 			MethodName: "RawPrices",
 			Handler:    _Query_RawPrices_Handler,
@@ -1914,6 +2640,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Oracles",
 			Handler:    _Query_Oracles_Handler,
 		},
+		{
+			MethodName: "OracleStats",
+			Handler:    _Query_OracleStats_Handler,
+		},
 		{
 			MethodName: "Markets",
 			Handler:    _Query_Markets_Handler,
@@ -2042,6 +2772,113 @@ func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.To, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.To):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.From, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.From):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2231,6 +3068,80 @@ func (m *QueryOraclesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOracleStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleStats) > 0 {
+		for iNdEx := len(m.OracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryMarketsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2311,12 +3222,12 @@ func (m *PostedPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
-	if err3 != nil {
-		return 0, err3
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x22
 	{
@@ -2406,6 +3317,49 @@ func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPriceHistoryEntries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPriceHistoryEntries))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxOracleDeviationBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxOracleDeviationBps))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxOracleMisses != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxOracleMisses))
+		i--
+		dAtA[i] = 0x58
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DeviationInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeviationInterval):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x52
+	if m.MaxDeviationBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDeviationBps))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TrimCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrimCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinOracleCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinOracleCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AggregationMethod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AggregationMethod))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -2449,6 +3403,83 @@ func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OracleStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deactivated {
+		i--
+		if m.Deactivated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastDeviationBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastDeviationBps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AverageDeviationBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AverageDeviationBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalPriced != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPriced))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalMissed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalMissed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalUpdates != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalUpdates))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2504,6 +3535,46 @@ func (m *QueryPriceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.From)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.To)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryPricesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2584,23 +3655,55 @@ func (m *QueryOraclesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryMarketsRequest) Size() (n int) {
+func (m *QueryOracleStatsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryMarketsResponse) Size() (n int) {
+func (m *QueryOracleStatsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
+	if len(m.OracleStats) > 0 {
+		for _, e := range m.OracleStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2671,6 +3774,67 @@ func (m *MarketResponse) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if m.AggregationMethod != 0 {
+		n += 1 + sovQuery(uint64(m.AggregationMethod))
+	}
+	if m.MinOracleCount != 0 {
+		n += 1 + sovQuery(uint64(m.MinOracleCount))
+	}
+	if m.TrimCount != 0 {
+		n += 1 + sovQuery(uint64(m.TrimCount))
+	}
+	if m.MaxDeviationBps != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDeviationBps))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeviationInterval)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxOracleMisses != 0 {
+		n += 1 + sovQuery(uint64(m.MaxOracleMisses))
+	}
+	if m.MaxOracleDeviationBps != 0 {
+		n += 1 + sovQuery(uint64(m.MaxOracleDeviationBps))
+	}
+	if m.MaxPriceHistoryEntries != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPriceHistoryEntries))
+	}
+	return n
+}
+
+func (m *OracleStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovQuery(uint64(m.MissedCount))
+	}
+	if m.TotalUpdates != 0 {
+		n += 1 + sovQuery(uint64(m.TotalUpdates))
+	}
+	if m.TotalMissed != 0 {
+		n += 1 + sovQuery(uint64(m.TotalMissed))
+	}
+	if m.TotalPriced != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPriced))
+	}
+	if m.AverageDeviationBps != 0 {
+		n += 1 + sovQuery(uint64(m.AverageDeviationBps))
+	}
+	if m.LastDeviationBps != 0 {
+		n += 1 + sovQuery(uint64(m.LastDeviationBps))
+	}
+	if m.Deactivated {
+		n += 2
+	}
 	return n
 }
 
@@ -2978,7 +4142,7 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3001,65 +4165,47 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3086,66 +4232,48 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, CurrentPriceResponse{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.From, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.To, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawPricesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3155,23 +4283,27 @@ func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3194,7 +4326,7 @@ func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3217,15 +4349,15 @@ func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3252,8 +4384,44 @@ func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawPrices = append(m.RawPrices, PostedPriceResponse{})
-			if err := m.RawPrices[len(m.RawPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceHistory = append(m.PriceHistory, PriceHistoryEntry{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3278,7 +4446,7 @@ func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3301,44 +4469,12 @@ func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOraclesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOraclesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3360,7 +4496,7 @@ func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3383,17 +4519,17 @@ func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOraclesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOraclesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3403,23 +4539,25 @@ func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			m.Prices = append(m.Prices, CurrentPriceResponse{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3442,7 +4580,7 @@ func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3465,12 +4603,44 @@ func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRawPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRawPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3492,7 +4662,7 @@ func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3515,15 +4685,15 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRawPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRawPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3550,8 +4720,8 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, MarketResponse{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RawPrices = append(m.RawPrices, PostedPriceResponse{})
+			if err := m.RawPrices[len(m.RawPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3576,7 +4746,7 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3599,15 +4769,15 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostedPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOraclesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostedPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOraclesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3635,43 +4805,61 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOraclesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOraclesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3699,42 +4887,7 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3757,7 +4910,7 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3780,15 +4933,15 @@ func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3816,11 +4969,11 @@ func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3848,9 +5001,7 @@ func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OracleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3873,7 +5024,7 @@ func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3896,17 +5047,17 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3916,27 +5067,213 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			m.OracleStats = append(m.OracleStats, OracleStatsResponse{})
+			if err := m.OracleStats[len(m.OracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, MarketResponse{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostedPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostedPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3964,11 +5301,11 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			m.MarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3996,11 +5333,11 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			m.OracleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4028,13 +5365,15 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4044,12 +5383,753 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+			}
+			m.AggregationMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracleCount", wireType)
+			}
+			m.MinOracleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOracleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimCount", wireType)
+			}
+			m.TrimCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrimCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviationBps", wireType)
+			}
+			m.MaxDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DeviationInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleMisses", wireType)
+			}
+			m.MaxOracleMisses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOracleMisses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleDeviationBps", wireType)
+			}
+			m.MaxOracleDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOracleDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceHistoryEntries", wireType)
+			}
+			m.MaxPriceHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceHistoryEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
+			}
+			m.MissedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUpdates", wireType)
+			}
+			m.TotalUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissed", wireType)
+			}
+			m.TotalMissed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMissed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPriced", wireType)
+			}
+			m.TotalPriced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPriced |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDeviationBps", wireType)
+			}
+			m.AverageDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeviationBps", wireType)
+			}
+			m.LastDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deactivated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,9 +31,51 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AggregationMethod defines how the posted prices of a market are combined
+// into its current price.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_UNSPECIFIED uses the median of the posted prices
+	AGGREGATION_METHOD_UNSPECIFIED AggregationMethod = 0
+	// AGGREGATION_METHOD_MEDIAN uses the median of the posted prices
+	AGGREGATION_METHOD_MEDIAN AggregationMethod = 1
+	// AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN uses the median of the posted
+	// prices weighted by the bonded tokens of each oracle's validator
+	AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN AggregationMethod = 2
+	// AGGREGATION_METHOD_TRIMMED_MEAN uses the mean of the posted prices after
+	// discarding the trim count of lowest and highest prices
+	AGGREGATION_METHOD_TRIMMED_MEAN AggregationMethod = 3
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "AGGREGATION_METHOD_UNSPECIFIED",
+	1: "AGGREGATION_METHOD_MEDIAN",
+	2: "AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN",
+	3: "AGGREGATION_METHOD_TRIMMED_MEAN",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"AGGREGATION_METHOD_UNSPECIFIED":           0,
+	"AGGREGATION_METHOD_MEDIAN":                1,
+	"AGGREGATION_METHOD_STAKE_WEIGHTED_MEDIAN": 2,
+	"AGGREGATION_METHOD_TRIMMED_MEAN":          3,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{0}
+}
+
 // Params defines the parameters for the pricefeed module.
 type Params struct {
 	Markets Markets `protobuf:"bytes,1,rep,name=markets,proto3,castrepeated=Markets" json:"markets"`
+	// price_history_retention is the period current prices are kept in the
+	// price history, zero disables the price history
+	PriceHistoryRetention time.Duration `protobuf:"bytes,2,opt,name=price_history_retention,json=priceHistoryRetention,proto3,stdduration" json:"price_history_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -75,6 +118,13 @@ func (m *Params) GetMarkets() Markets {
 	return nil
 }
 
+func (m *Params) GetPriceHistoryRetention() time.Duration {
+	if m != nil {
+		return m.PriceHistoryRetention
+	}
+	return 0
+}
+
 // Market defines an asset in the pricefeed.
 type Market struct {
 	MarketID   string                                          `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -82,6 +132,35 @@ type Market struct {
 	QuoteAsset string                                          `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	Oracles    []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=oracles,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracles,omitempty"`
 	Active     bool                                            `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// aggregation_method selects how fresh oracle prices are combined into the
+	// current price, unspecified markets use the median
+	AggregationMethod AggregationMethod `protobuf:"varint,6,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=kava.pricefeed.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
+	// min_oracle_count is the minimum number of unexpired oracle prices required
+	// to set a current price, zero requires a single price
+	MinOracleCount uint64 `protobuf:"varint,7,opt,name=min_oracle_count,json=minOracleCount,proto3" json:"min_oracle_count,omitempty"`
+	// trim_count is the number of lowest and of highest prices discarded by the
+	// trimmed mean aggregation method
+	TrimCount uint64 `protobuf:"varint,8,opt,name=trim_count,json=trimCount,proto3" json:"trim_count,omitempty"`
+	// max_deviation_bps is the maximum deviation, in basis points, of a new
+	// current price from the market's reference price before the market is
+	// suspended, zero disables the circuit breaker
+	MaxDeviationBps uint64 `protobuf:"varint,9,opt,name=max_deviation_bps,json=maxDeviationBps,proto3" json:"max_deviation_bps,omitempty"`
+	// deviation_interval is the period after which the reference price is reset
+	// to the current price and for which a suspended price must hold to be
+	// confirmed
+	DeviationInterval time.Duration `protobuf:"bytes,10,opt,name=deviation_interval,json=deviationInterval,proto3,stdduration" json:"deviation_interval,omitempty"`
+	// max_oracle_misses is the number of consecutive price updates an oracle may
+	// miss before it is deactivated, zero disables deactivation
+	MaxOracleMisses uint64 `protobuf:"varint,11,opt,name=max_oracle_misses,json=maxOracleMisses,proto3" json:"max_oracle_misses,omitempty"`
+	// max_oracle_deviation_bps is the maximum deviation, in basis points, of an
+	// oracle's price from the current price before the update counts as missed,
+	// zero only records deviations
+	MaxOracleDeviationBps uint64 `protobuf:"varint,12,opt,name=max_oracle_deviation_bps,json=maxOracleDeviationBps,proto3" json:"max_oracle_deviation_bps,omitempty"`
+	// max_price_history_entries is the maximum number of price history entries
+	// kept for the market, the oldest entries are evicted when a new price is
+	// recorded into a full history, zero only bounds the history by the
+	// retention period
+	MaxPriceHistoryEntries uint64 `protobuf:"varint,13,opt,name=max_price_history_entries,json=maxPriceHistoryEntries,proto3" json:"max_price_history_entries,omitempty"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -152,6 +231,62 @@ func (m *Market) GetActive() bool {
 	return false
 }
 
+func (m *Market) GetAggregationMethod() AggregationMethod {
+	if m != nil {
+		return m.AggregationMethod
+	}
+	return AGGREGATION_METHOD_UNSPECIFIED
+}
+
+func (m *Market) GetMinOracleCount() uint64 {
+	if m != nil {
+		return m.MinOracleCount
+	}
+	return 0
+}
+
+func (m *Market) GetTrimCount() uint64 {
+	if m != nil {
+		return m.TrimCount
+	}
+	return 0
+}
+
+func (m *Market) GetMaxDeviationBps() uint64 {
+	if m != nil {
+		return m.MaxDeviationBps
+	}
+	return 0
+}
+
+func (m *Market) GetDeviationInterval() time.Duration {
+	if m != nil {
+		return m.DeviationInterval
+	}
+	return 0
+}
+
+func (m *Market) GetMaxOracleMisses() uint64 {
+	if m != nil {
+		return m.MaxOracleMisses
+	}
+	return 0
+}
+
+func (m *Market) GetMaxOracleDeviationBps() uint64 {
+	if m != nil {
+		return m.MaxOracleDeviationBps
+	}
+	return 0
+}
+
+func (m *Market) GetMaxPriceHistoryEntries() uint64 {
+	if m != nil {
+		return m.MaxPriceHistoryEntries
+	}
+	return 0
+}
+
 // PostedPrice defines a price for market posted by a specific oracle.
 type PostedPrice struct {
 	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -261,11 +396,322 @@ func (m *CurrentPrice) GetMarketID() string {
 	return ""
 }
 
+// PriceReference defines the price a market's new current prices are compared
+// to by the circuit breaker during an interval.
+type PriceReference struct {
+	MarketID  string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	StartTime time.Time                              `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *PriceReference) Reset()         { *m = PriceReference{} }
+func (m *PriceReference) String() string { return proto.CompactTextString(m) }
+func (*PriceReference) ProtoMessage()    {}
+func (*PriceReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{4}
+}
+func (m *PriceReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceReference.Merge(m, src)
+}
+func (m *PriceReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceReference proto.InternalMessageInfo
+
+func (m *PriceReference) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PriceReference) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+// MarketSuspension defines a market whose current price is withheld because a
+// new price deviated too far from its reference price.
+type MarketSuspension struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// reference_price is the price the suspended price deviated from
+	ReferencePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price"`
+	// suspended_price is the latest price awaiting confirmation
+	SuspendedPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=suspended_price,json=suspendedPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"suspended_price"`
+	// suspended_at is the time the suspended price was first seen
+	SuspendedAt time.Time `protobuf:"bytes,4,opt,name=suspended_at,json=suspendedAt,proto3,stdtime" json:"suspended_at"`
+}
+
+func (m *MarketSuspension) Reset()         { *m = MarketSuspension{} }
+func (m *MarketSuspension) String() string { return proto.CompactTextString(m) }
+func (*MarketSuspension) ProtoMessage()    {}
+func (*MarketSuspension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{5}
+}
+func (m *MarketSuspension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketSuspension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketSuspension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketSuspension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketSuspension.Merge(m, src)
+}
+func (m *MarketSuspension) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketSuspension) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketSuspension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketSuspension proto.InternalMessageInfo
+
+func (m *MarketSuspension) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *MarketSuspension) GetSuspendedAt() time.Time {
+	if m != nil {
+		return m.SuspendedAt
+	}
+	return time.Time{}
+}
+
+// PriceHistoryEntry defines the current price of a market at a block.
+type PriceHistoryEntry struct {
+	MarketID    string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BlockTime   time.Time                              `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	BlockHeight int64                                  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// oracle_count is the number of unexpired oracle prices the price was
+	// aggregated from
+	OracleCount uint64 `protobuf:"varint,5,opt,name=oracle_count,json=oracleCount,proto3" json:"oracle_count,omitempty"`
+}
+
+func (m *PriceHistoryEntry) Reset()         { *m = PriceHistoryEntry{} }
+func (m *PriceHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PriceHistoryEntry) ProtoMessage()    {}
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{6}
+}
+func (m *PriceHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistoryEntry.Merge(m, src)
+}
+func (m *PriceHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistoryEntry proto.InternalMessageInfo
+
+func (m *PriceHistoryEntry) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PriceHistoryEntry) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *PriceHistoryEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PriceHistoryEntry) GetOracleCount() uint64 {
+	if m != nil {
+		return m.OracleCount
+	}
+	return 0
+}
+
+// OracleStats defines the performance of an oracle in a market.
+type OracleStats struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	// missed_count is the number of consecutive price updates missed
+	MissedCount uint64 `protobuf:"varint,3,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+	// total_updates is the number of price updates the oracle was expected in
+	TotalUpdates uint64 `protobuf:"varint,4,opt,name=total_updates,json=totalUpdates,proto3" json:"total_updates,omitempty"`
+	// total_missed is the number of price updates missed
+	TotalMissed uint64 `protobuf:"varint,5,opt,name=total_missed,json=totalMissed,proto3" json:"total_missed,omitempty"`
+	// total_priced is the number of price updates the oracle's price was
+	// compared to the current price in
+	TotalPriced uint64 `protobuf:"varint,6,opt,name=total_priced,json=totalPriced,proto3" json:"total_priced,omitempty"`
+	// total_deviation_bps is the sum of the deviations, in basis points, of the
+	// oracle's prices from the current price
+	TotalDeviationBps uint64 `protobuf:"varint,7,opt,name=total_deviation_bps,json=totalDeviationBps,proto3" json:"total_deviation_bps,omitempty"`
+	// last_deviation_bps is the deviation, in basis points, of the oracle's
+	// latest compared price from the current price
+	LastDeviationBps uint64 `protobuf:"varint,8,opt,name=last_deviation_bps,json=lastDeviationBps,proto3" json:"last_deviation_bps,omitempty"`
+	// deactivated is true once the oracle has missed the market's maximum number
+	// of price updates
+	Deactivated bool `protobuf:"varint,9,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+}
+
+func (m *OracleStats) Reset()         { *m = OracleStats{} }
+func (m *OracleStats) String() string { return proto.CompactTextString(m) }
+func (*OracleStats) ProtoMessage()    {}
+func (*OracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{7}
+}
+func (m *OracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleStats.Merge(m, src)
+}
+func (m *OracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleStats proto.InternalMessageInfo
+
+func (m *OracleStats) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *OracleStats) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return nil
+}
+
+func (m *OracleStats) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
+func (m *OracleStats) GetTotalUpdates() uint64 {
+	if m != nil {
+		return m.TotalUpdates
+	}
+	return 0
+}
+
+func (m *OracleStats) GetTotalMissed() uint64 {
+	if m != nil {
+		return m.TotalMissed
+	}
+	return 0
+}
+
+func (m *OracleStats) GetTotalPriced() uint64 {
+	if m != nil {
+		return m.TotalPriced
+	}
+	return 0
+}
+
+func (m *OracleStats) GetTotalDeviationBps() uint64 {
+	if m != nil {
+		return m.TotalDeviationBps
+	}
+	return 0
+}
+
+func (m *OracleStats) GetLastDeviationBps() uint64 {
+	if m != nil {
+		return m.LastDeviationBps
+	}
+	return 0
+}
+
+func (m *OracleStats) GetDeactivated() bool {
+	if m != nil {
+		return m.Deactivated
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("kava.pricefeed.v1beta1.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
 	proto.RegisterType((*Params)(nil), "kava.pricefeed.v1beta1.Params")
 	proto.RegisterType((*Market)(nil), "kava.pricefeed.v1beta1.Market")
 	proto.RegisterType((*PostedPrice)(nil), "kava.pricefeed.v1beta1.PostedPrice")
 	proto.RegisterType((*CurrentPrice)(nil), "kava.pricefeed.v1beta1.CurrentPrice")
+	proto.RegisterType((*PriceReference)(nil), "kava.pricefeed.v1beta1.PriceReference")
+	proto.RegisterType((*MarketSuspension)(nil), "kava.pricefeed.v1beta1.MarketSuspension")
+	proto.RegisterType((*PriceHistoryEntry)(nil), "kava.pricefeed.v1beta1.PriceHistoryEntry")
+	proto.RegisterType((*OracleStats)(nil), "kava.pricefeed.v1beta1.OracleStats")
 }
 
 func init() {
@@ -273,39 +719,79 @@ func init() {
 }
 
 var fileDescriptor_9df40639f5e16f9a = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0x6d, 0xfe, 0x5c, 0x02, 0x48, 0x06, 0x55, 0x26, 0x12, 0x76, 0x94, 0x01, 0x19,
-	0xa1, 0x9c, 0xd5, 0xb2, 0xb2, 0xc4, 0x64, 0x20, 0x43, 0xa5, 0xc8, 0x30, 0xb1, 0x44, 0x67, 0xfb,
-	0xd5, 0x58, 0x89, 0x39, 0x73, 0x77, 0x89, 0x9a, 0x89, 0xaf, 0xd0, 0x8f, 0x81, 0x90, 0xd8, 0xf8,
-	0x10, 0x1d, 0x2b, 0x26, 0xc4, 0x90, 0x16, 0xe7, 0x03, 0xb0, 0x33, 0x21, 0xdf, 0xd9, 0x55, 0x07,
-	0x06, 0x2a, 0x98, 0xee, 0xde, 0xef, 0xfd, 0xde, 0xbf, 0xdf, 0xbd, 0xc3, 0xc3, 0x05, 0x5d, 0x53,
-	0x37, 0xe3, 0x49, 0x08, 0x27, 0x00, 0x91, 0xbb, 0x3e, 0x0c, 0x40, 0xd2, 0x43, 0x57, 0x48, 0xc6,
-	0x81, 0x64, 0x9c, 0x49, 0x66, 0x1c, 0x14, 0x1c, 0x72, 0xcd, 0x21, 0x25, 0xa7, 0xff, 0x30, 0x64,
-	0x22, 0x65, 0x62, 0xae, 0x58, 0xae, 0x36, 0x74, 0x48, 0xff, 0x41, 0xcc, 0x62, 0xa6, 0xf1, 0xe2,
-	0x56, 0xa2, 0x76, 0xcc, 0x58, 0xbc, 0x04, 0x57, 0x59, 0xc1, 0xea, 0xc4, 0x95, 0x49, 0x0a, 0x42,
-	0xd2, 0x34, 0xd3, 0x84, 0xe1, 0x2b, 0xdc, 0x9c, 0x51, 0x4e, 0x53, 0x61, 0x4c, 0x71, 0x2b, 0xa5,
-	0x7c, 0x01, 0x52, 0x98, 0x68, 0xd0, 0x70, 0xba, 0x47, 0x16, 0xf9, 0x73, 0x17, 0xe4, 0x58, 0xd1,
-	0xbc, 0x7b, 0xe7, 0x5b, 0xbb, 0xf6, 0xe9, 0xd2, 0x6e, 0x69, 0x5b, 0xf8, 0x55, 0xfc, 0xf0, 0x27,
-	0xc2, 0x4d, 0x0d, 0x1a, 0x4f, 0x70, 0x47, 0xa3, 0xf3, 0x24, 0x32, 0xd1, 0x00, 0x39, 0x1d, 0xaf,
-	0x97, 0x6f, 0xed, 0xb6, 0x76, 0x4f, 0x27, 0x7e, 0x5b, 0xbb, 0xa7, 0x91, 0xf1, 0x08, 0xe3, 0x80,
-	0x0a, 0x98, 0x53, 0x21, 0x40, 0x9a, 0xf5, 0x82, 0xeb, 0x77, 0x0a, 0x64, 0x5c, 0x00, 0x86, 0x8d,
-	0xbb, 0xef, 0x57, 0x4c, 0x56, 0xfe, 0x86, 0xf2, 0x63, 0x05, 0x69, 0x42, 0x80, 0x5b, 0x8c, 0xd3,
-	0x70, 0x09, 0xc2, 0xdc, 0x1b, 0x34, 0x9c, 0x9e, 0xf7, 0xf2, 0xd7, 0xd6, 0x1e, 0xc5, 0x89, 0x7c,
-	0xbb, 0x0a, 0x48, 0xc8, 0xd2, 0x52, 0xaf, 0xf2, 0x18, 0x89, 0x68, 0xe1, 0xca, 0x4d, 0x06, 0x82,
-	0x8c, 0xc3, 0x70, 0x1c, 0x45, 0x1c, 0x84, 0xf8, 0xfa, 0x65, 0x74, 0xbf, 0x54, 0xb5, 0x44, 0xbc,
-	0x8d, 0x04, 0xe1, 0x57, 0x89, 0x8d, 0x03, 0xdc, 0xa4, 0xa1, 0x4c, 0xd6, 0x60, 0xee, 0x0f, 0x90,
-	0xd3, 0xf6, 0x4b, 0x6b, 0xf8, 0xb9, 0x8e, 0xbb, 0x33, 0x26, 0x24, 0x44, 0xb3, 0x42, 0xae, 0xdb,
-	0x8c, 0xcd, 0xf0, 0x5d, 0x9d, 0x7d, 0x4e, 0x75, 0x49, 0x35, 0xfa, 0xff, 0xec, 0xfe, 0x8e, 0xce,
-	0x5f, 0x62, 0xc6, 0x04, 0xef, 0xab, 0x37, 0xd5, 0x12, 0x7a, 0xa4, 0x78, 0xc6, 0xef, 0x5b, 0xfb,
-	0xf1, 0x5f, 0xd4, 0x9a, 0x40, 0xe8, 0xeb, 0x60, 0xe3, 0x39, 0x6e, 0xc2, 0x69, 0x96, 0xf0, 0x8d,
-	0xb9, 0x37, 0x40, 0x4e, 0xf7, 0xa8, 0x4f, 0xf4, 0xaa, 0x91, 0x6a, 0xd5, 0xc8, 0xeb, 0x6a, 0xd5,
-	0xbc, 0x76, 0x51, 0xe2, 0xec, 0xd2, 0x46, 0x7e, 0x19, 0x33, 0xfc, 0x80, 0x7b, 0x2f, 0x56, 0x9c,
-	0xc3, 0x3b, 0x79, 0x6b, 0xbd, 0xae, 0xdb, 0xaf, 0xff, 0x43, 0xfb, 0xde, 0xf1, 0xd5, 0x0f, 0x0b,
-	0x7d, 0xcc, 0x2d, 0x74, 0x9e, 0x5b, 0xe8, 0x22, 0xb7, 0xd0, 0x55, 0x6e, 0xa1, 0xb3, 0x9d, 0x55,
-	0xbb, 0xd8, 0x59, 0xb5, 0x6f, 0x3b, 0xab, 0xf6, 0xe6, 0xe9, 0x8d, 0x84, 0xc5, 0x47, 0x18, 0x2d,
-	0x69, 0x20, 0xd4, 0xcd, 0x3d, 0xbd, 0xf1, 0x7d, 0x55, 0xe6, 0xa0, 0xa9, 0xa6, 0x7e, 0xf6, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0x18, 0xb5, 0x5b, 0xc1, 0xdd, 0x03, 0x00, 0x00,
+	// 1139 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x6f, 0x23, 0xc5,
+	0x17, 0xf7, 0xda, 0x89, 0x63, 0x3f, 0x3b, 0x8e, 0x3d, 0xf7, 0xbd, 0x7c, 0x37, 0x11, 0xb7, 0x76,
+	0x7c, 0x12, 0xf2, 0x1d, 0xc9, 0x5a, 0x17, 0x0a, 0x84, 0x44, 0x63, 0xc7, 0x26, 0xb1, 0x90, 0x93,
+	0x68, 0xe3, 0x53, 0x10, 0x14, 0xab, 0xb1, 0x77, 0xe2, 0xac, 0xe2, 0xf5, 0x98, 0x9d, 0x71, 0x14,
+	0x57, 0xb4, 0x74, 0x5c, 0x49, 0x4f, 0x83, 0x90, 0x10, 0x14, 0x48, 0xf0, 0x17, 0xa0, 0x2b, 0x4f,
+	0x57, 0x21, 0x8a, 0xdc, 0x91, 0x74, 0xfc, 0x09, 0x54, 0x68, 0x66, 0x76, 0xed, 0x38, 0x09, 0x02,
+	0x2b, 0x48, 0x50, 0xd9, 0xfb, 0x79, 0x9f, 0xf7, 0xf6, 0xfd, 0xf8, 0xec, 0xbc, 0x81, 0xe2, 0x09,
+	0x3e, 0xc5, 0xe5, 0x81, 0xef, 0x76, 0xc8, 0x11, 0x21, 0x4e, 0xf9, 0xf4, 0x49, 0x9b, 0x70, 0xfc,
+	0xa4, 0xcc, 0x38, 0xf5, 0x89, 0x39, 0xf0, 0x29, 0xa7, 0x68, 0x59, 0x70, 0xcc, 0x31, 0xc7, 0x0c,
+	0x38, 0xab, 0x2b, 0x1d, 0xca, 0x3c, 0xca, 0x6c, 0xc9, 0x2a, 0xab, 0x07, 0xe5, 0xb2, 0xfa, 0xbf,
+	0x2e, 0xed, 0x52, 0x85, 0x8b, 0x7f, 0x01, 0x6a, 0x74, 0x29, 0xed, 0xf6, 0x48, 0x59, 0x3e, 0xb5,
+	0x87, 0x47, 0x65, 0x67, 0xe8, 0x63, 0xee, 0xd2, 0x7e, 0x60, 0xcf, 0x5f, 0xb7, 0x73, 0xd7, 0x23,
+	0x8c, 0x63, 0x6f, 0xa0, 0x08, 0xc5, 0xef, 0x34, 0x88, 0xef, 0x63, 0x1f, 0x7b, 0x0c, 0x35, 0x60,
+	0xc1, 0xc3, 0xfe, 0x09, 0xe1, 0x4c, 0xd7, 0x0a, 0xb1, 0x52, 0x6a, 0xd3, 0x30, 0x6f, 0x4f, 0xd3,
+	0x6c, 0x4a, 0x5a, 0x75, 0xe9, 0xf9, 0x79, 0x3e, 0xf2, 0xf5, 0xab, 0xfc, 0x82, 0x7a, 0x66, 0x56,
+	0xe8, 0x8f, 0x3e, 0x86, 0xff, 0x4b, 0x2f, 0xfb, 0xd8, 0x15, 0x65, 0x8f, 0x6c, 0x9f, 0x70, 0xd2,
+	0x17, 0x79, 0xe9, 0xd1, 0x82, 0x56, 0x4a, 0x6d, 0xae, 0x98, 0x2a, 0x31, 0x33, 0x4c, 0xcc, 0xac,
+	0x05, 0x89, 0x57, 0x13, 0x22, 0xea, 0x17, 0xaf, 0xf2, 0x9a, 0x75, 0x5f, 0xc6, 0xd8, 0x51, 0x21,
+	0xac, 0x30, 0x42, 0xf1, 0xe5, 0x3c, 0xc4, 0xd5, 0x1b, 0xd1, 0x23, 0x48, 0xaa, 0x57, 0xda, 0xae,
+	0xa3, 0x6b, 0x05, 0xad, 0x94, 0xac, 0xa6, 0x2f, 0xce, 0xf3, 0x09, 0x65, 0x6e, 0xd4, 0xac, 0x84,
+	0x32, 0x37, 0x1c, 0xf4, 0x00, 0xa0, 0x8d, 0x19, 0xb1, 0x31, 0x63, 0x84, 0xcb, 0x2c, 0x92, 0x56,
+	0x52, 0x20, 0x15, 0x01, 0xa0, 0x3c, 0xa4, 0x3e, 0x19, 0x52, 0x1e, 0xda, 0x63, 0xd2, 0x0e, 0x12,
+	0x52, 0x84, 0x36, 0x2c, 0x50, 0x1f, 0x77, 0x7a, 0x84, 0xe9, 0x73, 0x85, 0x58, 0x29, 0x5d, 0xdd,
+	0xf9, 0xfd, 0x3c, 0xbf, 0xd1, 0x75, 0xf9, 0xf1, 0xb0, 0x6d, 0x76, 0xa8, 0x17, 0x4c, 0x2b, 0xf8,
+	0xd9, 0x60, 0xce, 0x49, 0x99, 0x8f, 0x06, 0x84, 0x99, 0x95, 0x4e, 0xa7, 0xe2, 0x38, 0x3e, 0x61,
+	0xec, 0xe5, 0xf7, 0x1b, 0xf7, 0x82, 0x99, 0x06, 0x48, 0x75, 0xc4, 0x09, 0xb3, 0xc2, 0xc0, 0x68,
+	0x19, 0xe2, 0xb8, 0xc3, 0xdd, 0x53, 0xa2, 0xcf, 0x17, 0xb4, 0x52, 0xc2, 0x0a, 0x9e, 0xd0, 0x87,
+	0x80, 0x70, 0xb7, 0xeb, 0x93, 0xae, 0xec, 0x90, 0xed, 0x11, 0x7e, 0x4c, 0x1d, 0x3d, 0x5e, 0xd0,
+	0x4a, 0x99, 0xcd, 0x47, 0x7f, 0x36, 0xa4, 0xca, 0xc4, 0xa3, 0x29, 0x1d, 0xac, 0x1c, 0xbe, 0x0e,
+	0xa1, 0x12, 0x64, 0x3d, 0xb7, 0x6f, 0xab, 0x04, 0xec, 0x0e, 0x1d, 0xf6, 0xb9, 0xbe, 0x50, 0xd0,
+	0x4a, 0x73, 0x56, 0xc6, 0x73, 0xfb, 0x7b, 0x12, 0xde, 0x12, 0xa8, 0xe8, 0x1f, 0xf7, 0x5d, 0x2f,
+	0xe0, 0x24, 0x24, 0x27, 0x29, 0x10, 0x65, 0x7e, 0x0c, 0x39, 0x0f, 0x9f, 0xd9, 0x0e, 0x39, 0x75,
+	0x55, 0x92, 0xed, 0x01, 0xd3, 0x93, 0x92, 0xb5, 0xe4, 0xe1, 0xb3, 0x5a, 0x88, 0x57, 0x07, 0x0c,
+	0xf9, 0x80, 0x26, 0x3c, 0xb7, 0xcf, 0x89, 0x7f, 0x8a, 0x7b, 0x3a, 0xfc, 0x95, 0x30, 0x4a, 0x42,
+	0x18, 0xbf, 0x9d, 0xe7, 0xdf, 0xb8, 0xe9, 0xbc, 0x4e, 0x3d, 0x97, 0x13, 0x6f, 0xc0, 0x47, 0x52,
+	0x38, 0xb9, 0x31, 0xa3, 0x11, 0x10, 0xc2, 0xfc, 0x82, 0x42, 0x3d, 0x97, 0x31, 0xc2, 0xf4, 0xd4,
+	0x38, 0x3f, 0x55, 0x69, 0x53, 0xc2, 0xe8, 0x1d, 0xd0, 0xaf, 0x70, 0xa7, 0x4b, 0x4a, 0x4b, 0x97,
+	0xfb, 0x63, 0x97, 0xa9, 0xc2, 0xde, 0x85, 0x15, 0xe1, 0x38, 0x2d, 0x7d, 0xd2, 0xe7, 0xbe, 0x4b,
+	0x98, 0xbe, 0x28, 0x3d, 0x97, 0x3d, 0x7c, 0xb6, 0x7f, 0x45, 0xd6, 0x75, 0x65, 0x2d, 0x7e, 0x13,
+	0x85, 0xd4, 0x3e, 0x65, 0x9c, 0x38, 0xd2, 0x3a, 0x8b, 0xb2, 0x29, 0x64, 0x82, 0x54, 0xb1, 0x52,
+	0x95, 0x54, 0xf7, 0x3f, 0x29, 0xd0, 0x45, 0x15, 0x3f, 0xc0, 0x50, 0x0d, 0xe6, 0x65, 0x89, 0xea,
+	0x2b, 0xa9, 0x9a, 0x62, 0x2e, 0xbf, 0x9c, 0xe7, 0xdf, 0xfc, 0x1b, 0xef, 0xaa, 0x91, 0x8e, 0xa5,
+	0x9c, 0xd1, 0x7b, 0x10, 0x27, 0x67, 0x03, 0xd7, 0x1f, 0xe9, 0x73, 0x72, 0xf2, 0xab, 0x37, 0x26,
+	0xdf, 0x0a, 0xcf, 0x2a, 0x75, 0x26, 0x3c, 0x13, 0xa3, 0x0d, 0x7c, 0x8a, 0x9f, 0x42, 0x7a, 0x6b,
+	0xe8, 0xfb, 0xa4, 0xcf, 0x67, 0xee, 0xd7, 0x38, 0xfd, 0xe8, 0x1d, 0xd2, 0x2f, 0xfe, 0xa4, 0x41,
+	0x46, 0xbe, 0xda, 0x22, 0x47, 0xc4, 0x27, 0xfd, 0x7f, 0x21, 0x07, 0xb4, 0x05, 0xc0, 0x38, 0xf6,
+	0xb9, 0x2d, 0x4e, 0x75, 0x3d, 0x36, 0x43, 0x1b, 0x93, 0xd2, 0x4f, 0x58, 0x8a, 0x3f, 0x46, 0x21,
+	0xab, 0x32, 0x3c, 0x18, 0xb2, 0x01, 0xe9, 0x33, 0x97, 0xf6, 0x67, 0x29, 0xe5, 0x10, 0x96, 0xfc,
+	0xb0, 0x05, 0xf6, 0x5d, 0x8a, 0xca, 0x8c, 0xc3, 0xa8, 0x91, 0x1e, 0xc2, 0x12, 0x93, 0x19, 0x39,
+	0xc4, 0xb1, 0xef, 0x22, 0xb8, 0xcc, 0x38, 0x8c, 0x0a, 0xbc, 0x0d, 0xe9, 0x49, 0x60, 0xcc, 0x67,
+	0xd2, 0x5f, 0x6a, 0xec, 0x59, 0xe1, 0xc5, 0xcf, 0xa3, 0x90, 0xbb, 0xfe, 0x31, 0x8f, 0x66, 0xe9,
+	0xdd, 0x16, 0x40, 0xbb, 0x47, 0x3b, 0x27, 0x6a, 0x80, 0xd1, 0x59, 0x06, 0x28, 0xfd, 0x84, 0x05,
+	0xad, 0x41, 0x5a, 0x05, 0x39, 0x26, 0x6e, 0xf7, 0x58, 0xed, 0xae, 0x98, 0x95, 0x92, 0xd8, 0x8e,
+	0x84, 0x26, 0x72, 0x9b, 0xbb, 0x8b, 0xdc, 0xd6, 0x20, 0x3d, 0xb5, 0x28, 0xe6, 0xe5, 0x89, 0x96,
+	0xa2, 0x93, 0x2d, 0x51, 0xfc, 0x21, 0x06, 0x29, 0x75, 0x30, 0x1e, 0x70, 0xcc, 0xd9, 0x7f, 0xfa,
+	0x18, 0x5b, 0x83, 0xb4, 0xdc, 0x03, 0x4e, 0x50, 0x4e, 0x4c, 0x95, 0xa3, 0x30, 0xb5, 0xd5, 0x1e,
+	0xc2, 0x22, 0xa7, 0x1c, 0xf7, 0xec, 0xe1, 0xc0, 0xc1, 0x5c, 0xae, 0x7e, 0xc1, 0x49, 0x4b, 0xf0,
+	0xa9, 0xc2, 0x44, 0x1c, 0x45, 0x52, 0x9e, 0x61, 0x5b, 0x24, 0x26, 0x37, 0x8a, 0x33, 0xa1, 0xc8,
+	0x46, 0xaa, 0xd5, 0x1d, 0x52, 0xa4, 0x80, 0x1c, 0x64, 0xc2, 0x3d, 0x45, 0x99, 0xde, 0x37, 0x6a,
+	0x19, 0xe7, 0xa4, 0x69, 0x6a, 0xd7, 0xac, 0x03, 0xea, 0x61, 0xc6, 0xaf, 0xd1, 0xd5, 0x5e, 0xce,
+	0x0a, 0xcb, 0x14, 0xbb, 0x00, 0x29, 0x87, 0xc8, 0xdb, 0x04, 0xe6, 0xc4, 0x91, 0x8b, 0x39, 0x61,
+	0x5d, 0x85, 0x1e, 0x7f, 0xab, 0x41, 0xee, 0xc6, 0x95, 0x01, 0x15, 0xc1, 0xa8, 0x6c, 0x6f, 0x5b,
+	0xf5, 0xed, 0x4a, 0xab, 0xb1, 0xb7, 0x6b, 0x37, 0xeb, 0xad, 0x9d, 0xbd, 0x9a, 0xfd, 0x74, 0xf7,
+	0x60, 0xbf, 0xbe, 0xd5, 0x78, 0xbf, 0x51, 0xaf, 0x65, 0x23, 0xe8, 0x01, 0xac, 0xdc, 0xc2, 0x69,
+	0xd6, 0x6b, 0x8d, 0xca, 0x6e, 0x56, 0x43, 0xeb, 0x50, 0xba, 0xc5, 0x7c, 0xd0, 0xaa, 0x7c, 0x50,
+	0xb7, 0x0f, 0xeb, 0x8d, 0xed, 0x9d, 0x56, 0x7d, 0xcc, 0x8e, 0xa2, 0x87, 0x90, 0xbf, 0x85, 0xdd,
+	0xb2, 0x1a, 0xcd, 0xa6, 0xa4, 0x55, 0x76, 0xb3, 0xb1, 0xd5, 0xb9, 0xcf, 0xbe, 0x34, 0x22, 0xd5,
+	0xe6, 0xeb, 0x5f, 0x0d, 0xed, 0xab, 0x0b, 0x43, 0x7b, 0x7e, 0x61, 0x68, 0x2f, 0x2e, 0x0c, 0xed,
+	0xf5, 0x85, 0xa1, 0x3d, 0xbb, 0x34, 0x22, 0x2f, 0x2e, 0x8d, 0xc8, 0xcf, 0x97, 0x46, 0xe4, 0xa3,
+	0xb7, 0xae, 0xc8, 0x46, 0xdc, 0x92, 0x36, 0x7a, 0xb8, 0xcd, 0xe4, 0xbf, 0xf2, 0xd9, 0x95, 0x1b,
+	0xba, 0xd4, 0x4f, 0x3b, 0x2e, 0xbf, 0xb7, 0xb7, 0xff, 0x18, 0x00, 0x86, 0xfa, 0xe6, 0x98, 0xc0,
+	0x0b, 0x00, 0x00,
 }
 
 func (this *Params) VerboseEqual(that interface{}) error {
@@ -341,6 +827,9 @@ func (this *Params) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
 		}
 	}
+	if this.PriceHistoryRetention != that1.PriceHistoryRetention {
+		return fmt.Errorf("PriceHistoryRetention this(%v) Not Equal that(%v)", this.PriceHistoryRetention, that1.PriceHistoryRetention)
+	}
 	return nil
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -370,6 +859,9 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.PriceHistoryRetention != that1.PriceHistoryRetention {
+		return false
+	}
 	return true
 }
 func (this *Market) VerboseEqual(that interface{}) error {
@@ -417,6 +909,30 @@ func (this *Market) VerboseEqual(that interface{}) error {
 	if this.Active != that1.Active {
 		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
 	}
+	if this.AggregationMethod != that1.AggregationMethod {
+		return fmt.Errorf("AggregationMethod this(%v) Not Equal that(%v)", this.AggregationMethod, that1.AggregationMethod)
+	}
+	if this.MinOracleCount != that1.MinOracleCount {
+		return fmt.Errorf("MinOracleCount this(%v) Not Equal that(%v)", this.MinOracleCount, that1.MinOracleCount)
+	}
+	if this.TrimCount != that1.TrimCount {
+		return fmt.Errorf("TrimCount this(%v) Not Equal that(%v)", this.TrimCount, that1.TrimCount)
+	}
+	if this.MaxDeviationBps != that1.MaxDeviationBps {
+		return fmt.Errorf("MaxDeviationBps this(%v) Not Equal that(%v)", this.MaxDeviationBps, that1.MaxDeviationBps)
+	}
+	if this.DeviationInterval != that1.DeviationInterval {
+		return fmt.Errorf("DeviationInterval this(%v) Not Equal that(%v)", this.DeviationInterval, that1.DeviationInterval)
+	}
+	if this.MaxOracleMisses != that1.MaxOracleMisses {
+		return fmt.Errorf("MaxOracleMisses this(%v) Not Equal that(%v)", this.MaxOracleMisses, that1.MaxOracleMisses)
+	}
+	if this.MaxOracleDeviationBps != that1.MaxOracleDeviationBps {
+		return fmt.Errorf("MaxOracleDeviationBps this(%v) Not Equal that(%v)", this.MaxOracleDeviationBps, that1.MaxOracleDeviationBps)
+	}
+	if this.MaxPriceHistoryEntries != that1.MaxPriceHistoryEntries {
+		return fmt.Errorf("MaxPriceHistoryEntries this(%v) Not Equal that(%v)", this.MaxPriceHistoryEntries, that1.MaxPriceHistoryEntries)
+	}
 	return nil
 }
 func (this *Market) Equal(that interface{}) bool {
@@ -458,6 +974,30 @@ func (this *Market) Equal(that interface{}) bool {
 	if this.Active != that1.Active {
 		return false
 	}
+	if this.AggregationMethod != that1.AggregationMethod {
+		return false
+	}
+	if this.MinOracleCount != that1.MinOracleCount {
+		return false
+	}
+	if this.TrimCount != that1.TrimCount {
+		return false
+	}
+	if this.MaxDeviationBps != that1.MaxDeviationBps {
+		return false
+	}
+	if this.DeviationInterval != that1.DeviationInterval {
+		return false
+	}
+	if this.MaxOracleMisses != that1.MaxOracleMisses {
+		return false
+	}
+	if this.MaxOracleDeviationBps != that1.MaxOracleDeviationBps {
+		return false
+	}
+	if this.MaxPriceHistoryEntries != that1.MaxPriceHistoryEntries {
+		return false
+	}
 	return true
 }
 func (this *PostedPrice) VerboseEqual(that interface{}) error {
@@ -592,15 +1132,333 @@ func (this *CurrentPrice) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PriceReference) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return dAtA[:n], nil
-}
+
+	that1, ok := that.(*PriceReference)
+	if !ok {
+		that2, ok := that.(PriceReference)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceReference")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceReference but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceReference but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return fmt.Errorf("StartTime this(%v) Not Equal that(%v)", this.StartTime, that1.StartTime)
+	}
+	return nil
+}
+func (this *PriceReference) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceReference)
+	if !ok {
+		that2, ok := that.(PriceReference)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	return true
+}
+func (this *MarketSuspension) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MarketSuspension)
+	if !ok {
+		that2, ok := that.(MarketSuspension)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MarketSuspension")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MarketSuspension but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MarketSuspension but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.ReferencePrice.Equal(that1.ReferencePrice) {
+		return fmt.Errorf("ReferencePrice this(%v) Not Equal that(%v)", this.ReferencePrice, that1.ReferencePrice)
+	}
+	if !this.SuspendedPrice.Equal(that1.SuspendedPrice) {
+		return fmt.Errorf("SuspendedPrice this(%v) Not Equal that(%v)", this.SuspendedPrice, that1.SuspendedPrice)
+	}
+	if !this.SuspendedAt.Equal(that1.SuspendedAt) {
+		return fmt.Errorf("SuspendedAt this(%v) Not Equal that(%v)", this.SuspendedAt, that1.SuspendedAt)
+	}
+	return nil
+}
+func (this *MarketSuspension) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MarketSuspension)
+	if !ok {
+		that2, ok := that.(MarketSuspension)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.ReferencePrice.Equal(that1.ReferencePrice) {
+		return false
+	}
+	if !this.SuspendedPrice.Equal(that1.SuspendedPrice) {
+		return false
+	}
+	if !this.SuspendedAt.Equal(that1.SuspendedAt) {
+		return false
+	}
+	return true
+}
+func (this *PriceHistoryEntry) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceHistoryEntry)
+	if !ok {
+		that2, ok := that.(PriceHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceHistoryEntry")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceHistoryEntry but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceHistoryEntry but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.BlockTime.Equal(that1.BlockTime) {
+		return fmt.Errorf("BlockTime this(%v) Not Equal that(%v)", this.BlockTime, that1.BlockTime)
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return fmt.Errorf("BlockHeight this(%v) Not Equal that(%v)", this.BlockHeight, that1.BlockHeight)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if this.OracleCount != that1.OracleCount {
+		return fmt.Errorf("OracleCount this(%v) Not Equal that(%v)", this.OracleCount, that1.OracleCount)
+	}
+	return nil
+}
+func (this *PriceHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceHistoryEntry)
+	if !ok {
+		that2, ok := that.(PriceHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.BlockTime.Equal(that1.BlockTime) {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if this.OracleCount != that1.OracleCount {
+		return false
+	}
+	return true
+}
+func (this *OracleStats) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleStats")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleStats but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleStats but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.MissedCount != that1.MissedCount {
+		return fmt.Errorf("MissedCount this(%v) Not Equal that(%v)", this.MissedCount, that1.MissedCount)
+	}
+	if this.TotalUpdates != that1.TotalUpdates {
+		return fmt.Errorf("TotalUpdates this(%v) Not Equal that(%v)", this.TotalUpdates, that1.TotalUpdates)
+	}
+	if this.TotalMissed != that1.TotalMissed {
+		return fmt.Errorf("TotalMissed this(%v) Not Equal that(%v)", this.TotalMissed, that1.TotalMissed)
+	}
+	if this.TotalPriced != that1.TotalPriced {
+		return fmt.Errorf("TotalPriced this(%v) Not Equal that(%v)", this.TotalPriced, that1.TotalPriced)
+	}
+	if this.TotalDeviationBps != that1.TotalDeviationBps {
+		return fmt.Errorf("TotalDeviationBps this(%v) Not Equal that(%v)", this.TotalDeviationBps, that1.TotalDeviationBps)
+	}
+	if this.LastDeviationBps != that1.LastDeviationBps {
+		return fmt.Errorf("LastDeviationBps this(%v) Not Equal that(%v)", this.LastDeviationBps, that1.LastDeviationBps)
+	}
+	if this.Deactivated != that1.Deactivated {
+		return fmt.Errorf("Deactivated this(%v) Not Equal that(%v)", this.Deactivated, that1.Deactivated)
+	}
+	return nil
+}
+func (this *OracleStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if this.MissedCount != that1.MissedCount {
+		return false
+	}
+	if this.TotalUpdates != that1.TotalUpdates {
+		return false
+	}
+	if this.TotalMissed != that1.TotalMissed {
+		return false
+	}
+	if this.TotalPriced != that1.TotalPriced {
+		return false
+	}
+	if this.TotalDeviationBps != that1.TotalDeviationBps {
+		return false
+	}
+	if this.LastDeviationBps != that1.LastDeviationBps {
+		return false
+	}
+	if this.Deactivated != that1.Deactivated {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
 
 func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
@@ -612,6 +1470,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PriceHistoryRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceHistoryRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStore(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	if len(m.Markets) > 0 {
 		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -649,13 +1515,56 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Active {
+	if m.MaxPriceHistoryEntries != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MaxPriceHistoryEntries))
 		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x68
+	}
+	if m.MaxOracleDeviationBps != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MaxOracleDeviationBps))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxOracleMisses != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MaxOracleMisses))
+		i--
+		dAtA[i] = 0x58
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DeviationInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeviationInterval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStore(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	if m.MaxDeviationBps != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MaxDeviationBps))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TrimCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.TrimCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinOracleCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MinOracleCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AggregationMethod != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.AggregationMethod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x28
 	}
@@ -712,12 +1621,12 @@ func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintStore(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintStore(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x22
 	{
@@ -787,6 +1696,247 @@ func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStore(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketSuspension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketSuspension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketSuspension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SuspendedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SuspendedAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStore(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SuspendedPrice.Size()
+		i -= size
+		if _, err := m.SuspendedPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.OracleCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStore(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deactivated {
+		i--
+		if m.Deactivated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastDeviationBps != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.LastDeviationBps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalDeviationBps != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.TotalDeviationBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalPriced != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.TotalPriced))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalMissed != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.TotalMissed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalUpdates != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.TotalUpdates))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStore(v)
 	base := offset
@@ -810,6 +1960,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovStore(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceHistoryRetention)
+	n += 1 + l + sovStore(uint64(l))
 	return n
 }
 
@@ -840,6 +1992,29 @@ func (m *Market) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if m.AggregationMethod != 0 {
+		n += 1 + sovStore(uint64(m.AggregationMethod))
+	}
+	if m.MinOracleCount != 0 {
+		n += 1 + sovStore(uint64(m.MinOracleCount))
+	}
+	if m.TrimCount != 0 {
+		n += 1 + sovStore(uint64(m.TrimCount))
+	}
+	if m.MaxDeviationBps != 0 {
+		n += 1 + sovStore(uint64(m.MaxDeviationBps))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeviationInterval)
+	n += 1 + l + sovStore(uint64(l))
+	if m.MaxOracleMisses != 0 {
+		n += 1 + sovStore(uint64(m.MaxOracleMisses))
+	}
+	if m.MaxOracleDeviationBps != 0 {
+		n += 1 + sovStore(uint64(m.MaxOracleDeviationBps))
+	}
+	if m.MaxPriceHistoryEntries != 0 {
+		n += 1 + sovStore(uint64(m.MaxPriceHistoryEntries))
+	}
 	return n
 }
 
@@ -879,44 +2054,987 @@ func (m *CurrentPrice) Size() (n int) {
 	return n
 }
 
-func sovStore(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *PriceReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStore(uint64(l))
+	return n
 }
-func sozStore(x uint64) (n int) {
-	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MarketSuspension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.SuspendedPrice.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SuspendedAt)
+	n += 1 + l + sovStore(uint64(l))
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *PriceHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovStore(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovStore(uint64(m.BlockHeight))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.OracleCount != 0 {
+		n += 1 + sovStore(uint64(m.OracleCount))
+	}
+	return n
+}
+
+func (m *OracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovStore(uint64(m.MissedCount))
+	}
+	if m.TotalUpdates != 0 {
+		n += 1 + sovStore(uint64(m.TotalUpdates))
+	}
+	if m.TotalMissed != 0 {
+		n += 1 + sovStore(uint64(m.TotalMissed))
+	}
+	if m.TotalPriced != 0 {
+		n += 1 + sovStore(uint64(m.TotalPriced))
+	}
+	if m.TotalDeviationBps != 0 {
+		n += 1 + sovStore(uint64(m.TotalDeviationBps))
+	}
+	if m.LastDeviationBps != 0 {
+		n += 1 + sovStore(uint64(m.LastDeviationBps))
+	}
+	if m.Deactivated {
+		n += 2
+	}
+	return n
+}
+
+func sovStore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStore(x uint64) (n int) {
+	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PriceHistoryRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
+			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+			}
+			m.AggregationMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracleCount", wireType)
+			}
+			m.MinOracleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOracleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimCount", wireType)
+			}
+			m.TrimCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrimCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviationBps", wireType)
+			}
+			m.MaxDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DeviationInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleMisses", wireType)
+			}
+			m.MaxOracleMisses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOracleMisses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleDeviationBps", wireType)
+			}
+			m.MaxOracleDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOracleDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceHistoryEntries", wireType)
+			}
+			m.MaxPriceHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceHistoryEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowStore
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -943,8 +3061,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -969,7 +3086,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *MarketSuspension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,10 +3109,10 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketSuspension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketSuspension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1032,7 +3149,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1060,11 +3177,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1092,13 +3211,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			if err := m.SuspendedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1108,44 +3229,25 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
-			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStore
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SuspendedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Active = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -1167,7 +3269,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+func (m *PriceHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,10 +3292,10 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1230,9 +3332,9 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1242,27 +3344,45 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.OracleAddress == nil {
-				m.OracleAddress = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
@@ -1296,11 +3416,11 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleCount", wireType)
 			}
-			var msglen int
+			m.OracleCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1310,25 +3430,11 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OracleCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStore
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStore
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -1350,7 +3456,7 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
+func (m *OracleStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1373,10 +3479,10 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1413,9 +3519,9 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1425,26 +3531,160 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
+			}
+			m.MissedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUpdates", wireType)
+			}
+			m.TotalUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissed", wireType)
+			}
+			m.TotalMissed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMissed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPriced", wireType)
+			}
+			m.TotalPriced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPriced |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeviationBps", wireType)
+			}
+			m.TotalDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeviationBps", wireType)
+			}
+			m.LastDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deactivated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -0,0 +1,21 @@
+package swap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+)
+
+// BeginBlocker starts ramping the amplification of stable swap pools whose allowed pool amplification
+// changed in the previous block, before any swap of the block uses it.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.RampAmplifications(ctx)
+}
+
+// EndBlocker refunds expired limit orders and then fills open limit orders whose limit price is
+// reachable against the pool reserves left by the swaps of the block, up to the max fills per block
+// for each pool and direction.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ExpireLimitOrders(ctx)
+	k.FillLimitOrders(ctx)
+}
@@ -2,12 +2,15 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/swap/types"
 )
@@ -32,6 +35,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		queryParamsCmd(queryRoute),
 		queryDepositsCmd(queryRoute),
 		queryPoolsCmd(queryRoute),
+		querySimulateRouteCmd(queryRoute),
+		queryQuoteExactInputCmd(queryRoute),
+		queryQuoteExactOutputCmd(queryRoute),
+		queryTWAPCmd(queryRoute),
+		queryLimitOrdersCmd(queryRoute),
 	}
 
 	for _, cmd := range cmds {
@@ -152,3 +160,205 @@ func queryPoolsCmd(queryRoute string) *cobra.Command {
 	}
 	return cmd
 }
+
+func querySimulateRouteCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "simulate-route [path] [input|output] [amount]",
+		Short: "simulate a swap through a comma separated path of denoms",
+		Long: strings.TrimSpace(`simulate a swap with an exact input or exact output amount through a path of pools:
+ 		Example:
+ 		$ kvcli q swap simulate-route bnb,usdx,hard input 1000000
+ 		$ kvcli q swap simulate-route bnb,usdx,hard output 5000000`,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[2])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QuerySimulateRouteRequest{
+				Path:           strings.Split(args[0], ","),
+				ExactDirection: args[1],
+				Amount:         amount,
+			}
+			res, err := queryClient.SimulateRoute(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryQuoteExactInputCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "quote-exact-input [tokenIn] [denomOut]",
+		Short: "quote the output of swapping an exact input against current pool reserves",
+		Long: strings.TrimSpace(`quote the output, fee, price impact and resulting spot price of swapping an exact input:
+ 		Example:
+ 		$ kvcli q swap quote-exact-input 1000000ukava usdx`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryQuoteExactInputRequest{
+				TokenIn:  tokenIn,
+				DenomOut: args[1],
+			}
+			res, err := queryClient.QuoteExactInput(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryQuoteExactOutputCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "quote-exact-output [tokenOut] [denomIn]",
+		Short: "quote the input of swapping for an exact output against current pool reserves",
+		Long: strings.TrimSpace(`quote the input, fee, price impact and resulting spot price of swapping for an exact output:
+ 		Example:
+ 		$ kvcli q swap quote-exact-output 5000000usdx ukava`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenOut, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryQuoteExactOutputRequest{
+				TokenOut: tokenOut,
+				DenomIn:  args[1],
+			}
+			res, err := queryClient.QuoteExactOutput(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryTWAPCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "twap [pool-id] [window]",
+		Short: "query the time weighted average prices of a pool",
+		Long: strings.TrimSpace(`query the time weighted average prices of a pool over a trailing window of at most 24h:
+ 		Example:
+ 		$ kvcli q swap twap ukava:usdx 1h`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryTWAPRequest{
+				PoolId: args[0],
+				Window: window,
+			}
+			res, err := queryClient.TWAP(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryLimitOrdersCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders",
+		Short: "get open limit orders",
+		Long: strings.TrimSpace(`get open limit orders:
+ 		Example:
+ 		$ kvcli q swap limit-orders --pool ukava:usdx
+ 		$ kvcli q swap limit-orders --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+ 		$ kvcli q swap limit-orders --page=2 --limit=100
+ 		`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bechOwnerAddr, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			pool, err := cmd.Flags().GetString(flagPool)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryLimitOrdersRequest{
+				Owner:      bechOwnerAddr,
+				PoolId:     pool,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.LimitOrders(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders")
+
+	cmd.Flags().String(flagPool, "", "pool name")
+	cmd.Flags().String(flagOwner, "", "owner of the orders")
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -30,6 +31,10 @@ func GetTxCmd() *cobra.Command {
 		getCmdWithdraw(),
 		getCmdSwapExactForTokens(),
 		getCmdSwapForExactTokens(),
+		getCmdSwapExactForTokensRouted(),
+		getCmdSwapForExactTokensRouted(),
+		getCmdPlaceLimitOrder(),
+		getCmdCancelLimitOrder(),
 	}
 
 	for _, cmd := range cmds {
@@ -225,3 +230,171 @@ func getCmdSwapForExactTokens() *cobra.Command {
 		},
 	}
 }
+
+func getCmdSwapExactForTokensRouted() *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-exact-for-tokens-routed [exactCoinA] [coinB] [path] [slippage] [deadline]",
+		Short: "swap an exact amount of token a for token b through a comma separated path of denoms",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-exact-for-tokens-routed 1000000bnb 5000000hard bnb,usdx,hard 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			path := strings.Split(args[2], ",")
+
+			slippage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapExactForTokensRouted(fromAddr.String(), exactTokenA, tokenB, path, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdSwapForExactTokensRouted() *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-for-exact-tokens-routed [coinA] [exactCoinB] [path] [slippage] [deadline]",
+		Short: "swap token a for exact amount of token b through a comma separated path of denoms",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-for-exact-tokens-routed 1000000bnb 5000000hard bnb,usdx,hard 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			exactTokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			path := strings.Split(args[2], ",")
+
+			slippage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapForExactTokensRouted(fromAddr.String(), tokenA, exactTokenB, path, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdPlaceLimitOrder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "place-limit-order [tokenIn] [denomOut] [limitPrice] [expiry]",
+		Short: "place an order to sell coins to a swap liquidity pool at a minimum price",
+		Example: fmt.Sprintf(
+			`%s tx %s place-limit-order 1000000ukava usdx 5.25 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			limitPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			expiry, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgPlaceLimitOrder(signer.String(), tokenIn, args[1], limitPrice, expiry)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdCancelLimitOrder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-limit-order [id]",
+		Short: "cancel an open limit order and refund its escrow",
+		Example: fmt.Sprintf(
+			`%s tx %s cancel-limit-order 12 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgCancelLimitOrder(signer.String(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
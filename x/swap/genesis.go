@@ -22,6 +22,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, sh := range gs.ShareRecords {
 		k.SetDepositorShares(ctx, sh)
 	}
+	for _, po := range gs.PriceObservations {
+		k.SetPriceObservation(ctx, po)
+	}
+	for _, lo := range gs.LimitOrders {
+		k.SetLimitOrder(ctx, lo)
+	}
+	k.SetNextLimitOrderID(ctx, gs.NextLimitOrderID)
 }
 
 // ExportGenesis exports the genesis state
@@ -29,6 +36,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	pools := k.GetAllPools(ctx)
 	shares := k.GetAllDepositorShares(ctx)
+	observations := k.GetAllPriceObservations(ctx)
+	orders := k.GetAllLimitOrders(ctx)
+	nextOrderID := k.GetNextLimitOrderID(ctx)
 
-	return types.NewGenesisState(params, pools, shares)
+	return types.NewGenesisState(params, pools, shares, observations, orders, nextOrderID)
 }
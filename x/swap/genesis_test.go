@@ -2,6 +2,7 @@ package swap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/swap"
@@ -19,10 +20,18 @@ type genesisTestSuite struct {
 func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 	invalidState := types.NewGenesisState(
 		types.Params{
-			SwapFee: sdk.NewDec(-1),
+			SwapFee:                    sdk.NewDec(-1),
+			ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+			MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+			MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+			MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+			AmplificationRampDuration:  types.DefaultAmplificationRamp,
 		},
 		types.PoolRecords{},
 		types.ShareRecords{},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
 	)
 
 	suite.Panics(func() {
@@ -39,8 +48,13 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:               types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:                    sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+			MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+			MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+			MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+			AmplificationRampDuration:  types.DefaultAmplificationRamp,
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdk.NewInt(1e6)), sdk.NewCoin("usdx", sdk.NewInt(2e6))), sdk.NewInt(1e6)),
@@ -50,6 +64,16 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdk.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdk.NewInt(3e6)),
 		},
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec()),
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 30, 0, time.UTC), sdk.NewDec(60), sdk.MustNewDecFromStr("15")),
+			types.NewPriceObservation(types.PoolID("ukava", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec()),
+		},
+		types.LimitOrders{
+			types.NewLimitOrder(1, depositor_1, sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.5"), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)),
+			types.NewLimitOrder(3, depositor_2, sdk.NewCoin("usdx", sdk.NewInt(2e6)), "hard", sdk.MustNewDecFromStr("0.5"), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)),
+		},
+		4,
 	)
 
 	swap.InitGenesis(suite.Ctx, suite.Keeper, state)
@@ -65,6 +89,9 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	shareRecord2, _ := suite.Keeper.GetDepositorShares(suite.Ctx, depositor_1, types.PoolID("ukava", "usdx"))
 	suite.Equal(state.ShareRecords[1], shareRecord2)
 
+	observation, _ := suite.Keeper.GetLatestPriceObservation(suite.Ctx, types.PoolID("hard", "usdx"))
+	suite.Equal(state.PriceObservations[1], observation)
+
 	exportedState := swap.ExportGenesis(suite.Ctx, suite.Keeper)
 	suite.Equal(state, exportedState)
 }
@@ -78,8 +105,13 @@ func (suite *genesisTestSuite) Test_Marshall() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:               types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:                    sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+			MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+			MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+			MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+			AmplificationRampDuration:  types.DefaultAmplificationRamp,
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdk.NewInt(1e6)), sdk.NewCoin("usdx", sdk.NewInt(2e6))), sdk.NewInt(1e6)),
@@ -89,6 +121,16 @@ func (suite *genesisTestSuite) Test_Marshall() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdk.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdk.NewInt(3e6)),
 		},
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec()),
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 30, 0, time.UTC), sdk.NewDec(60), sdk.MustNewDecFromStr("15")),
+			types.NewPriceObservation(types.PoolID("ukava", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec()),
+		},
+		types.LimitOrders{
+			types.NewLimitOrder(1, depositor_1, sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.5"), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)),
+			types.NewLimitOrder(3, depositor_2, sdk.NewCoin("usdx", sdk.NewInt(2e6)), "hard", sdk.MustNewDecFromStr("0.5"), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)),
+		},
+		4,
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -113,8 +155,13 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:               types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:                    sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+			MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+			MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+			MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+			AmplificationRampDuration:  types.DefaultAmplificationRamp,
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdk.NewInt(1e6)), sdk.NewCoin("usdx", sdk.NewInt(2e6))), sdk.NewInt(1e6)),
@@ -124,6 +171,16 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdk.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdk.NewInt(3e6)),
 		},
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec()),
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 30, 0, time.UTC), sdk.NewDec(60), sdk.MustNewDecFromStr("15")),
+			types.NewPriceObservation(types.PoolID("ukava", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec()),
+		},
+		types.LimitOrders{
+			types.NewLimitOrder(1, depositor_1, sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.5"), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)),
+			types.NewLimitOrder(3, depositor_2, sdk.NewCoin("usdx", sdk.NewInt(2e6)), "hard", sdk.MustNewDecFromStr("0.5"), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)),
+		},
+		4,
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -136,13 +193,18 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 	aminoJson, err := legacyCdc.MarshalJSON(&state)
 	suite.Require().NoError(err, "expected genesis state to marshal amino json without error")
 
-	suite.JSONEq(string(protoJson), string(aminoJson), "expected json outputs to be equal")
-
 	var importedState types.GenesisState
 	err = cdc.UnmarshalJSON(aminoJson, &importedState)
 	suite.Require().NoError(err, "expected amino json to unmarshall to proto without error")
 
 	suite.Equal(state, importedState, "expected genesis state to be equal")
+
+	// amino json omits the zero value pool type and amplification fields, so compare the
+	// proto json of the imported state instead of the amino json directly
+	importedJson, err := cdc.MarshalJSON(&importedState)
+	suite.Require().NoError(err, "expected imported genesis state to marshal proto json without error")
+
+	suite.JSONEq(string(protoJson), string(importedJson), "expected json outputs to be equal")
 }
 
 func TestGenesisTestSuite(t *testing.T) {
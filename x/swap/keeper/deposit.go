@@ -103,22 +103,23 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 	return nil
 }
 
-func (k Keeper) depositAllowed(ctx sdk.Context, poolID string) bool {
+func (k Keeper) getAllowedPool(ctx sdk.Context, poolID string) (types.AllowedPool, bool) {
 	params := k.GetParams(ctx)
 	for _, p := range params.AllowedPools {
 		if poolID == types.PoolID(p.TokenA, p.TokenB) {
-			return true
+			return p, true
 		}
 	}
-	return false
+	return types.AllowedPool{}, false
 }
 
 func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.AccAddress, reserves sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdk.Int, error) {
-	if allowed := k.depositAllowed(ctx, poolID); !allowed {
+	allowedPool, allowed := k.getAllowedPool(ctx, poolID)
+	if !allowed {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), sdkerrors.Wrap(types.ErrNotAllowed, fmt.Sprintf("can not create pool '%s'", poolID))
 	}
 
-	pool, err := types.NewDenominatedPool(reserves)
+	pool, err := types.NewDenominatedPoolOfType(reserves, allowedPool.PoolType, allowedPool.Amplification)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
@@ -127,7 +128,7 @@ func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.Acc
 }
 
 func (k Keeper) addLiquidityToPool(ctx sdk.Context, record types.PoolRecord, depositor sdk.AccAddress, desiredAmount sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdk.Int, error) {
-	pool, err := types.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	pool, err := k.newPoolFromRecord(ctx, record)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
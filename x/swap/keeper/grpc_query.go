@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kava-labs/kava/x/swap/types"
@@ -59,7 +60,7 @@ func (s queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*ty
 		}
 
 		if shouldAccumulate {
-			denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+			denominatedPool, err := s.keeper.newPoolFromRecord(ctx, poolRecord)
 			if err != nil {
 				return true, types.ErrInvalidPool
 			}
@@ -148,3 +149,220 @@ func (s queryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 		Pagination: pageRes,
 	}, nil
 }
+
+// SimulateRoute implements the Query/SimulateRoute gRPC method
+func (s queryServer) SimulateRoute(c context.Context, req *types.QuerySimulateRouteRequest) (*types.QuerySimulateRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Path) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "path must contain at least two denoms")
+	}
+
+	if err := types.ValidateSwapPath(req.Path, req.Path[0], req.Path[len(req.Path)-1]); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Amount.IsNil() || !req.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		hops []routeHop
+		err  error
+	)
+	switch req.ExactDirection {
+	case "input":
+		hops, err = s.keeper.routeWithExactInput(ctx, sdk.NewCoin(req.Path[0], req.Amount), req.Path)
+	case "output":
+		hops, err = s.keeper.routeWithExactOutput(ctx, sdk.NewCoin(req.Path[len(req.Path)-1], req.Amount), req.Path)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid exact direction '%s', must be input or output", req.ExactDirection)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hopResponses := make([]types.RouteHopResponse, len(hops))
+	for i, hop := range hops {
+		hopResponses[i] = types.RouteHopResponse{
+			PoolId:     hop.poolID,
+			SwapInput:  hop.swapInput,
+			SwapOutput: hop.swapOutput,
+			FeePaid:    hop.feePaid,
+		}
+	}
+
+	return &types.QuerySimulateRouteResponse{
+		TokenIn:  hops[0].swapInput,
+		TokenOut: hops[len(hops)-1].swapOutput,
+		Hops:     hopResponses,
+	}, nil
+}
+
+// QuoteExactInput implements the Query/QuoteExactInput gRPC method
+func (s queryServer) QuoteExactInput(c context.Context, req *types.QueryQuoteExactInputRequest) (*types.QueryQuoteExactInputResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !req.TokenIn.IsValid() || !req.TokenIn.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in %s", req.TokenIn)
+	}
+
+	if err := sdk.ValidateDenom(req.DenomOut); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	poolID, pool, err := s.keeper.loadPool(ctx, req.TokenIn.Denom, req.DenomOut)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pool is loaded into memory only and is never persisted
+	initialPrice := pool.SpotPrice(req.TokenIn.Denom)
+
+	tokenOut, feePaid := pool.SwapWithExactInput(req.TokenIn, s.keeper.GetPoolSwapFee(ctx, poolID))
+	if tokenOut.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
+	}
+
+	return &types.QueryQuoteExactInputResponse{
+		TokenOut:    tokenOut,
+		FeePaid:     feePaid,
+		PriceImpact: priceImpact(initialPrice, req.TokenIn.Sub(feePaid).Amount, tokenOut.Amount),
+		SpotPrice:   pool.SpotPrice(req.TokenIn.Denom),
+	}, nil
+}
+
+// QuoteExactOutput implements the Query/QuoteExactOutput gRPC method
+func (s queryServer) QuoteExactOutput(c context.Context, req *types.QueryQuoteExactOutputRequest) (*types.QueryQuoteExactOutputResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !req.TokenOut.IsValid() || !req.TokenOut.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out %s", req.TokenOut)
+	}
+
+	if err := sdk.ValidateDenom(req.DenomIn); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	poolID, pool, err := s.keeper.loadPool(ctx, req.DenomIn, req.TokenOut.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TokenOut.Amount.GTE(pool.Reserves().AmountOf(req.TokenOut.Denom)) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInsufficientLiquidity,
+			"output %s >= pool reserves %s", req.TokenOut.Amount.String(), pool.Reserves().AmountOf(req.TokenOut.Denom).String(),
+		)
+	}
+
+	// the pool is loaded into memory only and is never persisted
+	initialPrice := pool.SpotPrice(req.DenomIn)
+
+	tokenIn, feePaid := pool.SwapWithExactOutput(req.TokenOut, s.keeper.GetPoolSwapFee(ctx, poolID))
+
+	return &types.QueryQuoteExactOutputResponse{
+		TokenIn:     tokenIn,
+		FeePaid:     feePaid,
+		PriceImpact: priceImpact(initialPrice, tokenIn.Sub(feePaid).Amount, req.TokenOut.Amount),
+		SpotPrice:   pool.SpotPrice(req.DenomIn),
+	}, nil
+}
+
+// TWAP implements the Query/TWAP gRPC method
+func (s queryServer) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	priceA, priceB, err := s.keeper.TWAP(ctx, req.PoolId, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pool record exists if the average prices were found
+	record, _ := s.keeper.GetPool(ctx, req.PoolId)
+
+	return &types.QueryTWAPResponse{
+		TokenA: record.ReservesA.Denom,
+		TokenB: record.ReservesB.Denom,
+		PriceA: priceA,
+		PriceB: priceB,
+	}, nil
+}
+
+// LimitOrders implements the Query/LimitOrders gRPC method
+func (s queryServer) LimitOrders(c context.Context, req *types.QueryLimitOrdersRequest) (*types.QueryLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.LimitOrderKeyPrefix)
+
+	var queryResults []types.LimitOrderResponse
+	pageRes, err := query.FilteredPaginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var order types.LimitOrder
+			err := s.keeper.cdc.Unmarshal(value, &order)
+			if err != nil {
+				return false, err
+			}
+
+			// Filter for results match the request's pool ID/owner params if given
+			matchOwner, matchPool := true, true
+			if len(req.Owner) > 0 {
+				matchOwner = order.Owner.String() == req.Owner
+			}
+			if len(req.PoolId) > 0 {
+				matchPool = strings.Compare(order.PoolID, req.PoolId) == 0
+			}
+			if !(matchOwner && matchPool) {
+				return false, nil
+			}
+			if accumulate {
+				queryResults = append(queryResults, types.LimitOrderResponse{
+					Id:         order.ID,
+					Owner:      order.Owner.String(),
+					PoolId:     order.PoolID,
+					TokenIn:    order.TokenIn,
+					DenomOut:   order.DenomOut,
+					LimitPrice: order.LimitPrice,
+					Expiry:     order.Expiry,
+				})
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLimitOrdersResponse{
+		LimitOrders: queryResults,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// priceImpact returns the relative difference between a spot price and the execution price of
+// trading an input amount, excluding fees, for an output amount
+func priceImpact(initialPrice sdk.Dec, input, output sdk.Int) sdk.Dec {
+	executionPrice := sdk.NewDecFromInt(output).Quo(sdk.NewDecFromInt(input))
+	return sdk.OneDec().Sub(executionPrice.Quo(initialPrice))
+}
@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+type grpcQueryTestSuite struct {
+	keeperTestSuite
+
+	queryClient types.QueryClient
+}
+
+func (suite *grpcQueryTestSuite) SetupTest() {
+	suite.keeperTestSuite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:                    sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
+	})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServerImpl(suite.Keeper))
+
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestGrpcQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(grpcQueryTestSuite))
+}
+
+func (suite *grpcQueryTestSuite) setupRoutePools() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	totalShares := sdk.NewInt(30e6)
+	suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	), totalShares, owner.GetAddress())
+	suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("hard", sdk.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	), totalShares, owner.GetAddress())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySimulateRoute() {
+	suite.setupRoutePools()
+	path := []string{"ukava", "usdx", "hard"}
+
+	res, err := suite.queryClient.SimulateRoute(context.Background(), &types.QuerySimulateRouteRequest{
+		Path:           path,
+		ExactDirection: "input",
+		Amount:         sdk.NewInt(1e6),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QuerySimulateRouteResponse{
+		TokenIn:  sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+		TokenOut: sdk.NewCoin("hard", sdk.NewInt(9890985)),
+		Hops: []types.RouteHopResponse{
+			{
+				PoolId:     "ukava:usdx",
+				SwapInput:  sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+				SwapOutput: sdk.NewCoin("usdx", sdk.NewInt(4982529)),
+				FeePaid:    sdk.NewCoin("ukava", sdk.NewInt(2500)),
+			},
+			{
+				PoolId:     "hard:usdx",
+				SwapInput:  sdk.NewCoin("usdx", sdk.NewInt(4982529)),
+				SwapOutput: sdk.NewCoin("hard", sdk.NewInt(9890985)),
+				FeePaid:    sdk.NewCoin("usdx", sdk.NewInt(12457)),
+			},
+		},
+	}, res)
+
+	res, err = suite.queryClient.SimulateRoute(context.Background(), &types.QuerySimulateRouteRequest{
+		Path:           path,
+		ExactDirection: "output",
+		Amount:         sdk.NewInt(10e6),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("ukava", sdk.NewInt(1011089)), res.TokenIn)
+	suite.Equal(sdk.NewCoin("hard", sdk.NewInt(10e6)), res.TokenOut)
+	suite.Len(res.Hops, 2)
+
+	// simulating does not modify pool state
+	suite.PoolReservesEqual("ukava:usdx", sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	))
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySimulateRoute_Invalid() {
+	suite.setupRoutePools()
+
+	testCases := []struct {
+		name        string
+		req         *types.QuerySimulateRouteRequest
+		expectedErr string
+	}{
+		{
+			name:        "short path",
+			req:         &types.QuerySimulateRouteRequest{Path: []string{"ukava"}, ExactDirection: "input", Amount: sdk.NewInt(1e6)},
+			expectedErr: "path must contain at least two denoms",
+		},
+		{
+			name:        "duplicate denoms",
+			req:         &types.QuerySimulateRouteRequest{Path: []string{"ukava", "usdx", "ukava"}, ExactDirection: "input", Amount: sdk.NewInt(1e6)},
+			expectedErr: "duplicate denom ukava: invalid path",
+		},
+		{
+			name:        "invalid direction",
+			req:         &types.QuerySimulateRouteRequest{Path: []string{"ukava", "usdx"}, ExactDirection: "sideways", Amount: sdk.NewInt(1e6)},
+			expectedErr: "invalid exact direction 'sideways', must be input or output",
+		},
+		{
+			name:        "zero amount",
+			req:         &types.QuerySimulateRouteRequest{Path: []string{"ukava", "usdx"}, ExactDirection: "input", Amount: sdk.ZeroInt()},
+			expectedErr: "amount must be positive",
+		},
+		{
+			name:        "missing pool",
+			req:         &types.QuerySimulateRouteRequest{Path: []string{"ukava", "bnb"}, ExactDirection: "input", Amount: sdk.NewInt(1e6)},
+			expectedErr: "pool bnb:ukava not found: invalid pool",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.queryClient.SimulateRoute(context.Background(), tc.req)
+			suite.Require().ErrorContains(err, tc.expectedErr)
+		})
+	}
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryQuoteExactInput() {
+	suite.setupRoutePools()
+
+	res, err := suite.queryClient.QuoteExactInput(context.Background(), &types.QueryQuoteExactInputRequest{
+		TokenIn:  sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+		DenomOut: "usdx",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryQuoteExactInputResponse{
+		TokenOut:    sdk.NewCoin("usdx", sdk.NewInt(4982529)),
+		FeePaid:     sdk.NewCoin("ukava", sdk.NewInt(2500)),
+		PriceImpact: sdk.MustNewDecFromStr("0.000996691729323308"),
+		SpotPrice:   sdk.MustNewDecFromStr("4.990027443556443556"),
+	}, res)
+
+	// quoting does not modify pool state
+	suite.PoolReservesEqual("ukava:usdx", sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	))
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryQuoteExactOutput() {
+	suite.setupRoutePools()
+
+	res, err := suite.queryClient.QuoteExactOutput(context.Background(), &types.QueryQuoteExactOutputRequest{
+		TokenOut: sdk.NewCoin("usdx", sdk.NewInt(4982529)),
+		DenomIn:  "ukava",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("ukava", sdk.NewInt(1e6)), res.TokenIn)
+	suite.Equal(sdk.NewCoin("ukava", sdk.NewInt(2500)), res.FeePaid)
+	suite.Equal(sdk.MustNewDecFromStr("0.000996691729323308"), res.PriceImpact)
+	suite.Equal(sdk.MustNewDecFromStr("4.990027443556443556"), res.SpotPrice)
+
+	// quoting does not modify pool state
+	suite.PoolReservesEqual("ukava:usdx", sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	))
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryQuote_Invalid() {
+	suite.setupRoutePools()
+
+	_, err := suite.queryClient.QuoteExactInput(context.Background(), &types.QueryQuoteExactInputRequest{
+		TokenIn:  sdk.NewCoin("ukava", sdk.ZeroInt()),
+		DenomOut: "usdx",
+	})
+	suite.Require().ErrorContains(err, "invalid token in 0ukava")
+
+	_, err = suite.queryClient.QuoteExactInput(context.Background(), &types.QueryQuoteExactInputRequest{
+		TokenIn:  sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+		DenomOut: "bnb",
+	})
+	suite.Require().ErrorContains(err, "pool bnb:ukava not found: invalid pool")
+
+	_, err = suite.queryClient.QuoteExactOutput(context.Background(), &types.QueryQuoteExactOutputRequest{
+		TokenOut: sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+		DenomIn:  "ukava",
+	})
+	suite.Require().ErrorContains(err, "output 5000000000 >= pool reserves 5000000000: insufficient liquidity")
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryTWAP() {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.setupTWAPPool(startTime)
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Hour))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServerImpl(suite.Keeper))
+	queryClient := types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+		PoolId: "ukava:usdx",
+		Window: time.Hour,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryTWAPResponse{
+		TokenA: "ukava",
+		TokenB: "usdx",
+		PriceA: sdk.NewDec(5),
+		PriceB: sdk.MustNewDecFromStr("0.2"),
+	}, res)
+
+	_, err = queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+		PoolId: "ukava:usdx",
+		Window: 2 * time.Hour,
+	})
+	suite.Require().ErrorContains(err, "insufficient price history")
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryLimitOrders() {
+	suite.setupRoutePools()
+
+	owner_1 := suite.NewAccountFromAddr(sdk.AccAddress("owner 1-------------"), sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6))))
+	owner_2 := suite.NewAccountFromAddr(sdk.AccAddress("owner 2-------------"), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10e6))))
+	expiry := suite.Ctx.BlockTime().Add(time.Hour).UTC()
+
+	_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner_1.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.NewDec(6), expiry)
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner_2.GetAddress(), sdk.NewCoin("usdx", sdk.NewInt(1e6)), "ukava", sdk.MustNewDecFromStr("0.25"), expiry)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.LimitOrders(context.Background(), &types.QueryLimitOrdersRequest{})
+	suite.Require().NoError(err)
+	suite.Len(res.LimitOrders, 2)
+
+	res, err = suite.queryClient.LimitOrders(context.Background(), &types.QueryLimitOrdersRequest{
+		Owner: owner_2.GetAddress().String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]types.LimitOrderResponse{
+		{
+			Id:         2,
+			Owner:      owner_2.GetAddress().String(),
+			PoolId:     "ukava:usdx",
+			TokenIn:    sdk.NewCoin("usdx", sdk.NewInt(1e6)),
+			DenomOut:   "ukava",
+			LimitPrice: sdk.MustNewDecFromStr("0.25"),
+			Expiry:     expiry,
+		},
+	}, res.LimitOrders)
+
+	res, err = suite.queryClient.LimitOrders(context.Background(), &types.QueryLimitOrdersRequest{
+		PoolId: "hard:usdx",
+	})
+	suite.Require().NoError(err)
+	suite.Empty(res.LimitOrders)
+}
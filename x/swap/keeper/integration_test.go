@@ -4,13 +4,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-//nolint
+// nolint
 func i(in int64) sdk.Int { return sdk.NewInt(in) }
 
-//nolint
+// nolint
 func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
 
-//nolint
+// nolint
 func cs(coins ...sdk.Coin) sdk.Coins { return sdk.NewCoins(coins...) }
 
 // func NewAuthGenStateFromAccs(accounts ...authexported.GenesisAccount) app.GenesisState {
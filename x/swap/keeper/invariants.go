@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"math/big"
+
 	"github.com/kava-labs/kava/x/swap/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +14,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "share-records", ShareRecordsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-reserves", PoolReservesInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-shares", PoolSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-curves", PoolCurvesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the swap module
@@ -29,7 +32,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		res, stop := PoolSharesInvariant(k)(ctx)
+		if res, stop := PoolSharesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := PoolCurvesInvariant(k)(ctx)
 		return res, stop
 	}
 }
@@ -70,7 +77,8 @@ func ShareRecordsInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// PoolReservesInvariant iterates all pools and ensures the total reserves matches the module account coins
+// PoolReservesInvariant iterates all pools and limit orders and ensures the total reserves and order escrow
+// matches the module account coins
 func PoolReservesInvariant(k Keeper) sdk.Invariant {
 	message := sdk.FormatInvariant(types.ModuleName, "pool reserves broken", "pool reserves do not match module account")
 
@@ -84,6 +92,7 @@ func PoolReservesInvariant(k Keeper) sdk.Invariant {
 			}
 			return false
 		})
+		reserves = reserves.Add(k.GetAllLimitOrders(ctx).Escrow()...)
 
 		broken := !reserves.IsEqual(balance)
 		return message, broken
@@ -136,3 +145,45 @@ func PoolSharesInvariant(k Keeper) sdk.Invariant {
 		return message, broken
 	}
 }
+
+// PoolCurvesInvariant rebuilds each pool from its record and asserts that its reserves are valid for the
+// invariant of its pool type.  Constant product pools mint sqrt(x*y) shares on creation and x*y per
+// share never decreases, so x*y must be at least the square of the total shares.  Stable swap pools must
+// have an invariant D, at the amplification stored with the record, between 2*sqrt(x*y) and x+y.
+func PoolCurvesInvariant(k Keeper) sdk.Invariant {
+	broken := false
+	message := sdk.FormatInvariant(types.ModuleName, "pool curves broken", "pool reserves do not satisfy the pool invariant")
+
+	return func(ctx sdk.Context) (string, bool) {
+		k.IteratePools(ctx, func(record types.PoolRecord) bool {
+			pool, err := k.newPoolFromRecord(ctx, record)
+			if err != nil {
+				broken = true
+				return true
+			}
+
+			reserves := pool.Reserves()
+			x, y := reserves[0].Amount.BigInt(), reserves[1].Amount.BigInt()
+			invariant := pool.Invariant()
+
+			if pool.PoolType().IsStableSwap() {
+				// D is rounded down, so it may be one less than the geometric mean bound
+				lower := new(big.Int).Sqrt(new(big.Int).Mul(x, y))
+				lower.Mul(lower, big.NewInt(2))
+				upper := new(big.Int).Add(x, y)
+				if new(big.Int).Add(invariant, big.NewInt(1)).Cmp(lower) < 0 || invariant.Cmp(upper) > 0 {
+					broken = true
+				}
+			} else {
+				shares := pool.TotalShares().BigInt()
+				if invariant.Cmp(new(big.Int).Mul(shares, shares)) < 0 {
+					broken = true
+				}
+			}
+
+			return broken
+		})
+
+		return message, broken
+	}
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/keeper"
 	"github.com/kava-labs/kava/x/swap/testutil"
@@ -25,14 +26,14 @@ func (suite *invariantTestSuite) SetupTest() {
 func (suite *invariantTestSuite) SetupValidState() {
 	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(
 		sdk.NewCoins(
-			sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+			sdk.NewCoin("ukava", sdk.NewInt(2e6)),
 			sdk.NewCoin("usdx", sdk.NewInt(5e6)),
 		),
 		sdk.NewInt(3e6),
 	))
 	suite.AddCoinsToModule(
 		sdk.NewCoins(
-			sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+			sdk.NewCoin("ukava", sdk.NewInt(2e6)),
 			sdk.NewCoin("usdx", sdk.NewInt(5e6)),
 		),
 	)
@@ -157,6 +158,21 @@ func (suite *invariantTestSuite) TestPoolReservesInvariant() {
 	suite.Equal("swap: pool reserves broken invariant\npool reserves do not match module account\n", message)
 	suite.Equal(false, broken)
 
+	// not broken when the module balance includes limit order escrow
+	escrow := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	suite.AddCoinsToModule(sdk.NewCoins(escrow))
+	suite.Keeper.SetLimitOrder(suite.Ctx, types.NewLimitOrder(
+		1,
+		sdk.AccAddress("owner---------------"),
+		escrow,
+		"usdx",
+		sdk.OneDec(),
+		suite.Ctx.BlockTime().Add(time.Hour),
+	))
+	message, broken = suite.runInvariant("pool-reserves", keeper.PoolReservesInvariant)
+	suite.Equal("swap: pool reserves broken invariant\npool reserves do not match module account\n", message)
+	suite.Equal(false, broken)
+
 	// broken when reserves are greater than module balance
 	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(
 		sdk.NewCoins(
@@ -195,7 +211,7 @@ func (suite *invariantTestSuite) TestPoolSharesInvariant() {
 	// broken when total shares are greater than depositor shares
 	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(
 		sdk.NewCoins(
-			sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+			sdk.NewCoin("ukava", sdk.NewInt(2e6)),
 			sdk.NewCoin("usdx", sdk.NewInt(5e6)),
 		),
 		sdk.NewInt(5e6),
@@ -207,7 +223,7 @@ func (suite *invariantTestSuite) TestPoolSharesInvariant() {
 	// broken when total shares are less than the depositor shares
 	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(
 		sdk.NewCoins(
-			sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+			sdk.NewCoin("ukava", sdk.NewInt(2e6)),
 			sdk.NewCoin("usdx", sdk.NewInt(5e6)),
 		),
 		sdk.NewInt(1e5),
@@ -220,7 +236,7 @@ func (suite *invariantTestSuite) TestPoolSharesInvariant() {
 	suite.Keeper.DeletePool(suite.Ctx, types.PoolID("ukava", "usdx"))
 	suite.RemoveCoinsFromModule(
 		sdk.NewCoins(
-			sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+			sdk.NewCoin("ukava", sdk.NewInt(2e6)),
 			sdk.NewCoin("usdx", sdk.NewInt(5e6)),
 		),
 	)
@@ -229,6 +245,30 @@ func (suite *invariantTestSuite) TestPoolSharesInvariant() {
 	suite.Equal(true, broken)
 }
 
+func (suite *invariantTestSuite) TestPoolCurvesInvariant() {
+	message, broken := suite.runInvariant("pool-curves", keeper.PoolCurvesInvariant)
+	suite.Equal("swap: pool curves broken invariant\npool reserves do not satisfy the pool invariant\n", message)
+	suite.Equal(false, broken)
+
+	suite.SetupValidState()
+	message, broken = suite.runInvariant("pool-curves", keeper.PoolCurvesInvariant)
+	suite.Equal("swap: pool curves broken invariant\npool reserves do not satisfy the pool invariant\n", message)
+	suite.Equal(false, broken)
+
+	// broken when reserves leave a constant product pool without burning shares
+	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(
+		sdk.NewCoins(
+			sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+			sdk.NewCoin("usdx", sdk.NewInt(5e6)),
+		),
+		sdk.NewInt(3e6),
+	))
+	suite.RemoveCoinsFromModule(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1e6))))
+	message, broken = suite.runInvariant("pool-curves", keeper.PoolCurvesInvariant)
+	suite.Equal("swap: pool curves broken invariant\npool reserves do not satisfy the pool invariant\n", message)
+	suite.Equal(true, broken)
+}
+
 func TestInvariantTestSuite(t *testing.T) {
 	suite.Run(t, new(invariantTestSuite))
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -15,12 +16,13 @@ import (
 
 // Keeper keeper for the swap module
 type Keeper struct {
-	key           storetypes.StoreKey
-	cdc           codec.Codec
-	paramSubspace paramtypes.Subspace
-	hooks         types.SwapHooks
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
+	key             storetypes.StoreKey
+	cdc             codec.Codec
+	paramSubspace   paramtypes.Subspace
+	hooks           types.SwapHooks
+	accountKeeper   types.AccountKeeper
+	bankKeeper      types.BankKeeper
+	communityKeeper types.CommunityKeeper
 }
 
 // NewKeeper creates a new keeper
@@ -30,17 +32,19 @@ func NewKeeper(
 	paramstore paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	communityKeeper types.CommunityKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		key:           key,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
+		key:             key,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		communityKeeper: communityKeeper,
 	}
 }
 
@@ -75,6 +79,24 @@ func (k Keeper) GetSwapFee(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).SwapFee
 }
 
+// GetPoolSwapFee returns the swap fee of a pool, which is the swap fee override of its allowed pool
+// when set, and otherwise the global swap fee
+func (k Keeper) GetPoolSwapFee(ctx sdk.Context, poolID string) sdk.Dec {
+	params := k.GetParams(ctx)
+	for _, allowedPool := range params.AllowedPools {
+		if allowedPool.Name() == poolID && allowedPool.HasSwapFee() {
+			return *allowedPool.SwapFee
+		}
+	}
+
+	return params.SwapFee
+}
+
+// GetProtocolFeeAccount returns the swap protocol fee ModuleAccount
+func (k Keeper) GetProtocolFeeAccount(ctx sdk.Context) authtypes.ModuleAccountI {
+	return k.accountKeeper.GetModuleAccount(ctx, types.ProtocolFeeAccountName)
+}
+
 // GetSwapModuleAccount returns the swap ModuleAccount
 func (k Keeper) GetSwapModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
@@ -238,12 +260,15 @@ func (k Keeper) GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddre
 	return record.SharesOwned, true
 }
 
-// updatePool updates a pool, deleting the pool record if the shares are zero
+// updatePool updates a pool and its price accumulator, deleting the pool record and price history if the
+// shares are zero
 func (k Keeper) updatePool(ctx sdk.Context, poolID string, pool *types.DenominatedPool) {
 	if pool.TotalShares().IsZero() {
 		k.DeletePool(ctx, poolID)
+		k.DeletePoolPriceObservations(ctx, poolID)
 	} else {
-		k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+		k.updatePriceAccumulator(ctx, poolID)
+		k.SetPool(ctx, k.newRecordFromPool(ctx, pool))
 	}
 }
 
@@ -262,9 +287,72 @@ func (k Keeper) loadDenominatedPool(ctx sdk.Context, poolID string) (*types.Deno
 	if !found {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
-	denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	denominatedPool, err := k.newPoolFromRecord(ctx, poolRecord)
 	if err != nil {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
 	return denominatedPool, nil
 }
+
+// newPoolFromRecord returns a denominated pool of the record's pool type.  Stable swap pools use the
+// amplification of their record at the block time, which ramps to a changed allowed pool amplification
+// instead of applying it immediately.
+func (k Keeper) newPoolFromRecord(ctx sdk.Context, record types.PoolRecord) (*types.DenominatedPool, error) {
+	amplification := record.AmplificationAt(ctx.BlockTime())
+	return types.NewDenominatedPoolOfTypeWithExistingShares(record.Reserves(), record.TotalShares, record.PoolType, amplification)
+}
+
+// newRecordFromPool returns the record of a pool for storage.  A pool only holds its amplification at
+// the block time, so the amplification ramp of the stored record is kept.
+func (k Keeper) newRecordFromPool(ctx sdk.Context, pool *types.DenominatedPool) types.PoolRecord {
+	record := types.NewPoolRecordFromPool(pool)
+	if stored, found := k.GetPool(ctx, record.PoolID); found && stored.PoolType == record.PoolType {
+		record.Amplification = stored.Amplification
+		record.AmplificationRamp = stored.AmplificationRamp
+	}
+	return record
+}
+
+// RampAmplifications starts an amplification ramp for each stable swap pool whose allowed pool
+// amplification differs from the amplification the pool is ramping to.  The ramp starts at the current
+// amplification of the pool and ends after the amplification ramp duration.  Ramps that have ended are
+// removed from their records.
+func (k Keeper) RampAmplifications(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, allowedPool := range params.AllowedPools {
+		if !allowedPool.PoolType.IsStableSwap() {
+			continue
+		}
+		record, found := k.GetPool(ctx, allowedPool.Name())
+		if !found || !record.PoolType.IsStableSwap() {
+			continue
+		}
+
+		if record.TargetAmplification() == allowedPool.Amplification {
+			if record.AmplificationRamp != nil && !ctx.BlockTime().Before(record.AmplificationRamp.EndTime) {
+				record.Amplification = record.AmplificationRamp.FutureAmplification
+				record.AmplificationRamp = nil
+				k.SetPool(ctx, record)
+			}
+			continue
+		}
+
+		record.Amplification = record.AmplificationAt(ctx.BlockTime())
+		record.AmplificationRamp = &types.AmplificationRamp{
+			FutureAmplification: allowedPool.Amplification,
+			StartTime:           ctx.BlockTime(),
+			EndTime:             ctx.BlockTime().Add(time.Duration(params.AmplificationRampDuration) * time.Second),
+		}
+		k.SetPool(ctx, record)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRampAmplification,
+				sdk.NewAttribute(types.AttributeKeyPoolID, record.PoolID),
+				sdk.NewAttribute(types.AttributeKeyAmplification, fmt.Sprintf("%d", record.Amplification)),
+				sdk.NewAttribute(types.AttributeKeyFutureAmplification, fmt.Sprintf("%d", allowedPool.Amplification)),
+				sdk.NewAttribute(types.AttributeKeyEndTime, record.AmplificationRamp.EndTime.String()),
+			),
+		)
+	}
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/swap/testutil"
@@ -62,7 +63,12 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("ukava", "usdx"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.03"),
+		SwapFee:                    sdk.MustNewDecFromStr("0.03"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.Equal(keeper.GetParams(suite.Ctx), params)
@@ -72,7 +78,12 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("hard", "ukava"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.01"),
+		SwapFee:                    sdk.MustNewDecFromStr("0.01"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.NotEqual(keeper.GetParams(suite.Ctx), oldParams)
@@ -83,7 +94,12 @@ func (suite keeperTestSuite) TestParams_GetSwapFee() {
 	keeper := suite.Keeper
 
 	params := types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.00333"),
+		SwapFee:                    sdk.MustNewDecFromStr("0.00333"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
 	}
 	keeper.SetParams(suite.Ctx, params)
 
@@ -165,6 +181,65 @@ func (suite *keeperTestSuite) TestShare_PanicsWhenInvalid() {
 	}, "expected set depositor shares to panic with invalid record")
 }
 
+func (suite *keeperTestSuite) TestRampAmplifications() {
+	pool := types.NewAllowedStableSwapPool("busd", "usdx", 100)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), sdk.MustNewDecFromStr("0.0025")))
+
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("busd", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	)
+	depositor := suite.CreateAccount(reserves)
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+
+	// nothing ramps while the allowed pool amplification is unchanged
+	suite.Keeper.RampAmplifications(suite.Ctx)
+	record, found := suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Nil(record.AmplificationRamp)
+
+	pool.Amplification = 200
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), sdk.MustNewDecFromStr("0.0025")))
+	start := suite.Ctx.BlockTime()
+	suite.Keeper.RampAmplifications(suite.Ctx)
+
+	expectedRamp := &types.AmplificationRamp{
+		FutureAmplification: 200,
+		StartTime:           start,
+		EndTime:             start.Add(24 * time.Hour),
+	}
+	record, _ = suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Equal(uint64(100), record.Amplification)
+	suite.Equal(expectedRamp, record.AmplificationRamp)
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeRampAmplification,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyAmplification, "100"),
+		sdk.NewAttribute(types.AttributeKeyFutureAmplification, "200"),
+		sdk.NewAttribute(types.AttributeKeyEndTime, expectedRamp.EndTime.String()),
+	))
+
+	// swaps use the amplification of the ramp and keep it in the record
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(12 * time.Hour))
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("busd", sdk.NewInt(10e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("busd", sdk.NewInt(10e6)), sdk.NewCoin("usdx", sdk.NewInt(10e6)), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	record, _ = suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Equal(uint64(100), record.Amplification)
+	suite.Equal(expectedRamp, record.AmplificationRamp)
+	suite.Equal(uint64(150), record.AmplificationAt(suite.Ctx.BlockTime()))
+
+	// the ramp is removed once it has ended
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(24 * time.Hour))
+	suite.Keeper.RampAmplifications(suite.Ctx)
+
+	record, _ = suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Equal(uint64(200), record.Amplification)
+	suite.Nil(record.AmplificationRamp)
+}
+
 func (suite *keeperTestSuite) TestHooks() {
 	// ensure no hooks are set
 	suite.Keeper.ClearHooks()
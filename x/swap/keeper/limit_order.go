@@ -0,0 +1,359 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// PlaceLimitOrder escrows the token in of an order to sell it to a pool once the pool offers at least the
+// limit price.  The pool must exist, the expiry must be after the current block time and within the
+// max limit order duration, and the token in must be at least the min limit order size of the pool reserves.
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, owner sdk.AccAddress, tokenIn sdk.Coin, denomOut string, limitPrice sdk.Dec, expiry time.Time) (uint64, error) {
+	poolID := types.PoolID(tokenIn.Denom, denomOut)
+	record, found := k.GetPool(ctx, poolID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	if !expiry.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidLimitOrder, "expiry %s must be after block time %s", expiry, ctx.BlockTime())
+	}
+
+	params := k.GetParams(ctx)
+	maxExpiry := ctx.BlockTime().Add(time.Duration(params.MaxLimitOrderDuration) * time.Second)
+	if expiry.After(maxExpiry) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidLimitOrder, "expiry %s must not be after %s", expiry, maxExpiry)
+	}
+
+	minSize := sdk.NewDecFromInt(record.Reserves().AmountOf(tokenIn.Denom)).Mul(params.MinLimitOrderSize).Ceil().TruncateInt()
+	if tokenIn.Amount.LT(minSize) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidLimitOrder, "token in %s is smaller than the minimum order size %s%s", tokenIn, minSize, tokenIn.Denom)
+	}
+
+	order := types.NewLimitOrder(k.GetNextLimitOrderID(ctx), owner, tokenIn, denomOut, limitPrice, expiry)
+	if err := order.Validate(); err != nil {
+		return 0, sdkerrors.Wrap(types.ErrInvalidLimitOrder, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleAccountName, sdk.NewCoins(tokenIn)); err != nil {
+		return 0, err
+	}
+
+	k.SetLimitOrder(ctx, order)
+	k.SetNextLimitOrderID(ctx, order.ID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePlaceLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, tokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyLimitPrice, limitPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiry, expiry.String()),
+		),
+	)
+
+	return order.ID, nil
+}
+
+// CancelLimitOrder deletes an open order and refunds its escrow to the owner
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	order, found := k.GetLimitOrder(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrLimitOrderNotFound, "limit order %d not found", id)
+	}
+
+	if !order.Owner.Equals(owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "limit order %d is not owned by %s", id, owner)
+	}
+
+	k.refundLimitOrder(ctx, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		),
+	)
+
+	return nil
+}
+
+// ExpireLimitOrders refunds and deletes all orders that expire at or before the current block time
+func (k Keeper) ExpireLimitOrders(ctx sdk.Context) {
+	var expired types.LimitOrders
+	k.IterateLimitOrdersByExpiry(ctx, ctx.BlockTime(), func(order types.LimitOrder) bool {
+		expired = append(expired, order)
+		return false
+	})
+
+	for _, order := range expired {
+		k.refundLimitOrder(ctx, order)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireLimitOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+				sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+				sdk.NewAttribute(types.AttributeKeyOwner, order.Owner.String()),
+			),
+		)
+	}
+}
+
+// FillLimitOrders attempts to fill the open orders of each pool, in both directions, from the lowest to
+// the highest limit price.  Orders that can not be filled against the pool reserves are skipped and
+// remain open, so they never hold back the orders behind them.  Each pool and direction fills at most
+// the max limit order fills per block, and each fill trades against the pool reserves left by the
+// previous fills.
+func (k Keeper) FillLimitOrders(ctx sdk.Context) {
+	limit := k.GetParams(ctx).MaxLimitOrderFillsPerBlock
+
+	var records types.PoolRecords
+	k.IteratePools(ctx, func(record types.PoolRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	for _, record := range records {
+		for _, denoms := range [][2]string{
+			{record.ReservesA.Denom, record.ReservesB.Denom},
+			{record.ReservesB.Denom, record.ReservesA.Denom},
+		} {
+			for _, order := range k.getFillableLimitOrders(ctx, denoms[0], denoms[1], limit) {
+				k.fillLimitOrder(ctx, order)
+			}
+		}
+	}
+}
+
+// getFillableLimitOrders returns up to limit orders selling denomIn for denomOut, from the lowest to the
+// highest limit price, that can be filled one after another.  Each order is swapped against an in-memory
+// copy of the pool left by the previous fillable orders, and orders whose output is below their limit
+// price are skipped.  Iteration stops at the first order above the spot price net of swap fees, since a
+// swap never returns more than the spot price and fills only lower it further.
+func (k Keeper) getFillableLimitOrders(ctx sdk.Context, denomIn, denomOut string, limit uint64) (orders types.LimitOrders) {
+	poolID, pool, err := k.loadPool(ctx, denomIn, denomOut)
+	if err != nil || pool.IsEmpty() {
+		return nil
+	}
+	swapFee := k.GetPoolSwapFee(ctx, poolID)
+
+	k.IterateLimitOrdersByPrice(ctx, denomIn, denomOut, func(order types.LimitOrder) bool {
+		if order.LimitPrice.GT(pool.SpotPrice(denomIn).Mul(sdk.OneDec().Sub(swapFee))) {
+			return true
+		}
+
+		trial, err := types.NewDenominatedPoolOfTypeWithExistingShares(pool.Reserves(), pool.TotalShares(), pool.PoolType(), pool.Amplification())
+		if err != nil {
+			panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
+		}
+		swapOutput, feePaid := trial.SwapWithExactInput(order.TokenIn, swapFee)
+		minOutput := sdk.NewDecFromInt(order.TokenIn.Amount).Mul(order.LimitPrice)
+		if swapOutput.IsZero() || sdk.NewDecFromInt(swapOutput.Amount).LT(minOutput) {
+			return false
+		}
+
+		// the protocol fee leaves the reserves when the order is filled
+		reserves := trial.Reserves().Sub(k.protocolFee(ctx, feePaid))
+		pool, err = types.NewDenominatedPoolOfTypeWithExistingShares(reserves, trial.TotalShares(), trial.PoolType(), trial.Amplification())
+		if err != nil {
+			panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
+		}
+
+		orders = append(orders, order)
+		return uint64(len(orders)) >= limit
+	})
+	return orders
+}
+
+// fillLimitOrder swaps the escrowed token in of an order and pays the output to the owner if the pool
+// offers at least the limit price.  It returns true if the order was filled.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, order types.LimitOrder) bool {
+	poolID, pool, err := k.loadPool(ctx, order.TokenIn.Denom, order.DenomOut)
+	if err != nil {
+		// orders remain open while their pool has no liquidity
+		return false
+	}
+
+	swapOutput, feePaid := pool.SwapWithExactInput(order.TokenIn, k.GetPoolSwapFee(ctx, poolID))
+	if swapOutput.IsZero() {
+		return false
+	}
+
+	minOutput := sdk.NewDecFromInt(order.TokenIn.Amount).Mul(order.LimitPrice)
+	if sdk.NewDecFromInt(swapOutput.Amount).LT(minOutput) {
+		return false
+	}
+
+	protocolFee := k.protocolFee(ctx, feePaid)
+
+	// the token in is already held by the module account and only moves into the pool reserves
+	k.updatePriceAccumulator(ctx, poolID)
+	k.SetPool(ctx, deductProtocolFee(k.newRecordFromPool(ctx, pool), protocolFee))
+	k.DeleteLimitOrder(ctx, order)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, order.Owner, sdk.NewCoins(swapOutput)); err != nil {
+		panic(err)
+	}
+
+	k.payProtocolFee(ctx, poolID, protocolFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapTrade,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyRequester, order.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, order.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, feePaid.String()),
+			sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+		),
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFillLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
+		),
+	)
+
+	return true
+}
+
+// refundLimitOrder deletes an order and returns its escrow to the owner
+func (k Keeper) refundLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	k.DeleteLimitOrder(ctx, order)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, order.Owner, sdk.NewCoins(order.TokenIn)); err != nil {
+		panic(err)
+	}
+}
+
+// GetLimitOrder returns a limit order from the store
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id uint64) (types.LimitOrder, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderKeyPrefix)
+	bz := store.Get(types.LimitOrderKey(id))
+	if bz == nil {
+		return types.LimitOrder{}, false
+	}
+
+	var order types.LimitOrder
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, true
+}
+
+// SetLimitOrder saves a limit order and its expiry and price indexes to the store and panics if the
+// order is invalid
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	if err := order.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid limit order: %s", err))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderKeyPrefix)
+	bz := k.cdc.MustMarshal(&order)
+	store.Set(types.LimitOrderKey(order.ID), bz)
+
+	idBz := sdk.Uint64ToBigEndian(order.ID)
+	expiryStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByExpiryPrefix)
+	expiryStore.Set(types.LimitOrderByExpiryKey(order.Expiry, order.ID), idBz)
+	priceStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByPricePrefix)
+	priceStore.Set(types.LimitOrderByPriceKey(order.TokenIn.Denom, order.DenomOut, order.LimitPrice, order.ID), idBz)
+}
+
+// DeleteLimitOrder deletes a limit order and its expiry and price indexes from the store
+func (k Keeper) DeleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderKeyPrefix)
+	store.Delete(types.LimitOrderKey(order.ID))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByExpiryPrefix)
+	expiryStore.Delete(types.LimitOrderByExpiryKey(order.Expiry, order.ID))
+	priceStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByPricePrefix)
+	priceStore.Delete(types.LimitOrderByPriceKey(order.TokenIn.Denom, order.DenomOut, order.LimitPrice, order.ID))
+}
+
+// IterateLimitOrders iterates over all limit orders in the store from oldest to newest and performs
+// a callback function
+func (k Keeper) IterateLimitOrders(ctx sdk.Context, cb func(order types.LimitOrder) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		if cb(order) {
+			break
+		}
+	}
+}
+
+// IterateLimitOrdersByExpiry iterates over the limit orders that expire at or before the cutoff time,
+// from the earliest to the latest expiry, and performs a callback function
+func (k Keeper) IterateLimitOrdersByExpiry(ctx sdk.Context, cutoff time.Time, cb func(order types.LimitOrder) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByExpiryPrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(cutoff)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic(fmt.Sprintf("limit order %d in expiry index not found", sdk.BigEndianToUint64(iterator.Value())))
+		}
+		if cb(order) {
+			break
+		}
+	}
+}
+
+// IterateLimitOrdersByPrice iterates over the limit orders selling denomIn for denomOut, from the lowest
+// to the highest limit price, and performs a callback function
+func (k Keeper) IterateLimitOrdersByPrice(ctx sdk.Context, denomIn, denomOut string, cb func(order types.LimitOrder) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByPricePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.LimitOrdersByPriceKey(denomIn, denomOut))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic(fmt.Sprintf("limit order %d in price index not found", sdk.BigEndianToUint64(iterator.Value())))
+		}
+		if cb(order) {
+			break
+		}
+	}
+}
+
+// GetAllLimitOrders returns all limit orders from the store
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) (orders types.LimitOrders) {
+	k.IterateLimitOrders(ctx, func(order types.LimitOrder) bool {
+		orders = append(orders, order)
+		return false
+	})
+	return
+}
+
+// GetNextLimitOrderID returns the id assigned to the next limit order, starting from the default id
+// if no order has been placed
+func (k Keeper) GetNextLimitOrderID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextLimitOrderIDKey)
+	if bz == nil {
+		return types.DefaultNextLimitOrderID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextLimitOrderID sets the id assigned to the next limit order
+func (k Keeper) SetNextLimitOrderID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextLimitOrderIDKey, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,316 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *keeperTestSuite) setupLimitOrderPool() (string, sdk.Coins) {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:                    sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	return suite.setupPool(reserves, sdk.NewInt(30e6), owner.GetAddress()), reserves
+}
+
+func (suite *keeperTestSuite) TestPlaceLimitOrder() {
+	poolID, reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	tokenIn := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	limitPrice := sdk.MustNewDecFromStr("5.1")
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tokenIn, "usdx", limitPrice, expiry)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), id)
+	suite.Equal(uint64(2), suite.Keeper.GetNextLimitOrderID(suite.Ctx))
+
+	order, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(types.NewLimitOrder(id, owner.GetAddress(), tokenIn, "usdx", limitPrice, expiry), order)
+
+	// token in is escrowed by the module account without changing the pool reserves
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(tokenIn))
+	suite.ModuleAccountBalanceEqual(reserves.Add(tokenIn))
+	suite.PoolLiquidityEqual(reserves)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypePlaceLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyLimitPrice, limitPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiry, expiry.String()),
+	))
+
+	id, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tokenIn, "usdx", limitPrice, expiry)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(2), id)
+}
+
+func (suite *keeperTestSuite) TestPlaceLimitOrder_Invalid() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)), sdk.NewCoin("hard", sdk.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+
+	_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("hard", sdk.NewInt(1e6)), "usdx", sdk.OneDec(), expiry)
+	suite.ErrorIs(err, types.ErrInvalidPool)
+
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.OneDec(), suite.Ctx.BlockTime())
+	suite.ErrorIs(err, types.ErrInvalidLimitOrder)
+
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.ZeroDec(), expiry)
+	suite.ErrorIs(err, types.ErrInvalidLimitOrder)
+
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(11e6)), "usdx", sdk.OneDec(), expiry)
+	suite.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the default max limit order duration is 30 days
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.OneDec(), suite.Ctx.BlockTime().Add(31*24*time.Hour))
+	suite.ErrorIs(err, types.ErrInvalidLimitOrder)
+
+	// the default min limit order size is 0.0001 of the 1000e6ukava reserves
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(99999)), "usdx", sdk.OneDec(), expiry)
+	suite.ErrorIs(err, types.ErrInvalidLimitOrder)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), balance)
+	suite.Empty(suite.Keeper.GetAllLimitOrders(suite.Ctx))
+}
+
+func (suite *keeperTestSuite) TestCancelLimitOrder() {
+	poolID, reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	tokenIn := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tokenIn, "usdx", sdk.NewDec(6), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, sdk.AccAddress("other---------------"), id)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, owner.GetAddress(), id+1)
+	suite.ErrorIs(err, types.ErrLimitOrderNotFound)
+
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, owner.GetAddress(), id)
+	suite.Require().NoError(err)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.False(found)
+	suite.AccountBalanceEqual(owner.GetAddress(), balance)
+	suite.ModuleAccountBalanceEqual(reserves)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeCancelLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestExpireLimitOrders() {
+	poolID, reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	tokenIn := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+
+	expiredID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tokenIn, "usdx", sdk.NewDec(6), suite.Ctx.BlockTime().Add(time.Minute))
+	suite.Require().NoError(err)
+	openID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tokenIn, "usdx", sdk.NewDec(6), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Minute))
+	suite.Keeper.ExpireLimitOrders(suite.Ctx)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, expiredID)
+	suite.False(found)
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, openID)
+	suite.True(found)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(tokenIn))
+	suite.ModuleAccountBalanceEqual(reserves.Add(tokenIn))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeExpireLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders() {
+	poolID, reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)))
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	tokenIn := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+
+	// the pool pays 4982529usdx for 1ukava, so only the lower limit price can be filled
+	fillID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tokenIn, "usdx", sdk.MustNewDecFromStr("4.98"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	openID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tokenIn, "usdx", sdk.NewDec(5), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, fillID)
+	suite.False(found)
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, openID)
+	suite.True(found)
+
+	expectedOutput := sdk.NewCoin("usdx", sdk.NewInt(4982529))
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(tokenIn).Sub(tokenIn).Add(expectedOutput))
+	suite.PoolLiquidityEqual(reserves.Add(tokenIn).Sub(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reserves.Add(tokenIn).Sub(expectedOutput).Add(tokenIn))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeFillLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+	))
+
+	// buying ukava from the pool raises its price until the remaining order can be filled
+	buyer := suite.NewAccountFromAddr(sdk.AccAddress("buyer---------------"), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, buyer.GetAddress(), sdk.NewCoin("usdx", sdk.NewInt(100e6)), sdk.NewCoin("ukava", sdk.NewInt(19e6)), sdk.MustNewDecFromStr("0.1"))
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx)
+
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, openID)
+	suite.False(found)
+	suite.True(suite.BankKeeper.GetBalance(suite.Ctx, owner.GetAddress(), "usdx").Amount.GTE(expectedOutput.Amount.Add(sdk.NewInt(5e6))))
+	suite.Empty(suite.Keeper.GetAllLimitOrders(suite.Ctx))
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_MaxFillsPerBlock() {
+	suite.setupLimitOrderPool()
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.MaxLimitOrderFillsPerBlock = 2
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6))))
+	tokenIn := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+
+	// the order above the spot price is never attempted and does not use up the fills of the block
+	unreachableID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tokenIn, "usdx", sdk.NewDec(6), expiry)
+	suite.Require().NoError(err)
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), tokenIn, "usdx", sdk.MustNewDecFromStr("4.9"), expiry)
+		suite.Require().NoError(err)
+		ids = append(ids, id)
+	}
+
+	suite.Keeper.FillLimitOrders(suite.Ctx)
+
+	for i, id := range ids {
+		_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+		suite.Equal(i == 2, found)
+	}
+
+	suite.Keeper.FillLimitOrders(suite.Ctx)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, ids[2])
+	suite.False(found)
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, unreachableID)
+	suite.True(found)
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_SkipsUnfillableOrders() {
+	suite.setupLimitOrderPool()
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.MaxLimitOrderFillsPerBlock = 2
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	owner := suite.CreateAccount(sdk.Coins{})
+	suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("xrp", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	), sdk.NewInt(30e6), owner.GetAddress())
+
+	trader := suite.NewAccountFromAddr(sdk.AccAddress("trader--------------"), sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(400e6)),
+		sdk.NewCoin("xrp", sdk.NewInt(10e6)),
+	))
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+
+	// the price impact of each large order pays about 4.53usdx per ukava, so none of them can be filled,
+	// but they are first in line and fill the max fills per block if every attempt is counted
+	var unfillableIDs []uint64
+	for i := 0; i < 3; i++ {
+		id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, trader.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(100e6)), "usdx", sdk.MustNewDecFromStr("4.9"), expiry)
+		suite.Require().NoError(err)
+		unfillableIDs = append(unfillableIDs, id)
+	}
+	fillableID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, trader.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("4.95"), expiry)
+	suite.Require().NoError(err)
+	otherPoolID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, trader.GetAddress(), sdk.NewCoin("xrp", sdk.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("0.99"), expiry)
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx)
+
+	for _, id := range unfillableIDs {
+		_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+		suite.True(found)
+	}
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, fillableID)
+	suite.False(found)
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, otherPoolID)
+	suite.False(found)
+}
+
+func (suite *keeperTestSuite) TestFillLimitOrders_ProtocolFee() {
+	suite.setupLimitOrderPool()
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.ProtocolFeeFraction = sdk.MustNewDecFromStr("0.2")
+	params.ProtocolFeeDestination = types.PROTOCOL_FEE_DESTINATION_MODULE_ACCOUNT
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6))))
+	_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("4.98"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillLimitOrders(suite.Ctx)
+
+	suite.Empty(suite.Keeper.GetAllLimitOrders(suite.Ctx))
+	suite.Equal(
+		sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(500))),
+		suite.BankKeeper.GetAllBalances(suite.Ctx, suite.Keeper.GetProtocolFeeAccount(suite.Ctx).GetAddress()),
+	)
+
+	_, broken := keeper.PoolReservesInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+}
@@ -133,6 +133,111 @@ func (m msgServer) SwapForExactTokens(goCtx context.Context, msg *types.MsgSwapF
 	return &types.MsgSwapForExactTokensResponse{}, nil
 }
 
+// SwapExactForTokensRouted handles MsgSwapExactForTokensRouted messages
+func (m msgServer) SwapExactForTokensRouted(goCtx context.Context, msg *types.MsgSwapExactForTokensRouted) (*types.MsgSwapExactForTokensRoutedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapExactForTokensRouted(ctx, requester, msg.ExactTokenA, msg.TokenB, msg.Path, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapExactForTokensRoutedResponse{}, nil
+}
+
+// SwapForExactTokensRouted handles MsgSwapForExactTokensRouted messages
+func (m msgServer) SwapForExactTokensRouted(goCtx context.Context, msg *types.MsgSwapForExactTokensRouted) (*types.MsgSwapForExactTokensRoutedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapForExactTokensRouted(ctx, requester, msg.TokenA, msg.ExactTokenB, msg.Path, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapForExactTokensRoutedResponse{}, nil
+}
+
+// PlaceLimitOrder handles MsgPlaceLimitOrder messages
+func (m msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := m.keeper.PlaceLimitOrder(ctx, owner, msg.TokenIn, msg.DenomOut, msg.LimitPrice, msg.GetExpiry())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgPlaceLimitOrderResponse{ID: id}, nil
+}
+
+// CancelLimitOrder handles MsgCancelLimitOrder messages
+func (m msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.CancelLimitOrder(ctx, owner, msg.ID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgCancelLimitOrderResponse{}, nil
+}
+
 // checkDeadline returns an error if block time exceeds an included deadline
 func checkDeadline(ctx sdk.Context, msg sdk.Msg) error {
 	deadlineMsg, ok := msg.(types.MsgWithDeadline)
@@ -585,6 +585,234 @@ func (suite *msgServerTestSuite) TestSwapForExactTokens_DeadlineExceeded() {
 	suite.Nil(res)
 }
 
+func (suite *msgServerTestSuite) TestSwapExactForTokensRouted() {
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdk.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reservesA))
+	suite.Require().NoError(suite.CreatePool(reservesB))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapInput := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	swapMsg := types.NewMsgSwapExactForTokensRouted(
+		requester.GetAddress().String(),
+		swapInput,
+		sdk.NewCoin("hard", sdk.NewInt(10e6)),
+		[]string{"ukava", "usdx", "hard"},
+		sdk.MustNewDecFromStr("0.02"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapExactForTokensRouted(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Equal(&types.MsgSwapExactForTokensRoutedResponse{}, res)
+	suite.Require().NoError(err)
+
+	intermediate := sdk.NewCoin("usdx", sdk.NewInt(4980034))
+	expectedSwapOutput := sdk.NewCoin("hard", sdk.NewInt(9881125))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(swapInput).Add(expectedSwapOutput))
+	suite.ModuleAccountBalanceEqual(reservesA.Add(reservesB...).Add(swapInput).Sub(expectedSwapOutput))
+	suite.PoolReservesEqual(types.PoolID("ukava", "usdx"), reservesA.Add(swapInput).Sub(intermediate))
+	suite.PoolReservesEqual(types.PoolID("hard", "usdx"), reservesB.Add(intermediate).Sub(expectedSwapOutput))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, requester.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		bank.EventTypeTransfer,
+		sdk.NewAttribute(bank.AttributeKeyRecipient, swapModuleAccountAddress.String()),
+		sdk.NewAttribute(bank.AttributeKeySender, requester.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, swapInput.String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		bank.EventTypeTransfer,
+		sdk.NewAttribute(bank.AttributeKeyRecipient, requester.GetAddress().String()),
+		sdk.NewAttribute(bank.AttributeKeySender, swapModuleAccountAddress.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedSwapOutput.String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, types.PoolID("hard", "usdx")),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedSwapOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "14941usdx"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSwapExactForTokensRouted_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapMsg := types.NewMsgSwapExactForTokensRouted(
+		requester.GetAddress().String(),
+		sdk.NewCoin("ukava", sdk.NewInt(5e6)),
+		sdk.NewCoin("hard", sdk.NewInt(50e6)),
+		[]string{"ukava", "usdx", "hard"},
+		sdk.MustNewDecFromStr("0.01"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.SwapExactForTokensRouted(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Nil(res)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), swapMsg.GetDeadline().Unix()))
+}
+
+func (suite *msgServerTestSuite) TestSwapForExactTokensRouted() {
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdk.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reservesA))
+	suite.Require().NoError(suite.CreatePool(reservesB))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapOutput := sdk.NewCoin("hard", sdk.NewInt(10e6))
+	swapMsg := types.NewMsgSwapForExactTokensRouted(
+		requester.GetAddress().String(),
+		sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+		swapOutput,
+		[]string{"ukava", "usdx", "hard"},
+		sdk.MustNewDecFromStr("0.02"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapForExactTokensRouted(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Equal(&types.MsgSwapForExactTokensRoutedResponse{}, res)
+	suite.Require().NoError(err)
+
+	expectedSwapInput := sdk.NewCoin("ukava", sdk.NewInt(1012104))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(expectedSwapInput).Add(swapOutput))
+	suite.ModuleAccountBalanceEqual(reservesA.Add(reservesB...).Add(expectedSwapInput).Sub(swapOutput))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, requester.GetAddress().String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSwapForExactTokensRouted_MatchesSinglePoolSlippage() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	swapOutput := sdk.NewCoin("usdx", sdk.NewInt(5e6))
+	deadline := time.Now().Add(10 * time.Minute).Unix()
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	// the input net of fees is 1001505ukava, a slippage of 0.0015 against 1000000ukava
+	for _, limit := range []string{"0.001", "0.0015", "0.002", "0.01"} {
+		slippage := sdk.MustNewDecFromStr(limit)
+
+		singleCtx, _ := suite.Ctx.CacheContext()
+		_, singleErr := suite.msgServer.SwapForExactTokens(sdk.WrapSDKContext(singleCtx), types.NewMsgSwapForExactTokens(
+			requester.GetAddress().String(), coinA, swapOutput, slippage, deadline,
+		))
+
+		routedCtx, _ := suite.Ctx.CacheContext()
+		_, routedErr := suite.msgServer.SwapForExactTokensRouted(sdk.WrapSDKContext(routedCtx), types.NewMsgSwapForExactTokensRouted(
+			requester.GetAddress().String(), coinA, swapOutput, []string{"ukava", "usdx"}, slippage, deadline,
+		))
+
+		if singleErr != nil {
+			suite.Require().ErrorIs(singleErr, types.ErrSlippageExceeded, limit)
+			suite.EqualError(routedErr, singleErr.Error(), limit)
+			continue
+		}
+		suite.Require().NoError(routedErr, limit)
+		suite.Equal(
+			suite.BankKeeper.GetAllBalances(singleCtx, requester.GetAddress()),
+			suite.BankKeeper.GetAllBalances(routedCtx, requester.GetAddress()),
+			limit,
+		)
+	}
+}
+
+func (suite *msgServerTestSuite) TestPlaceAndCancelLimitOrder() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	tokenIn := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+
+	placeMsg := types.NewMsgPlaceLimitOrder(
+		owner.GetAddress().String(),
+		tokenIn,
+		"usdx",
+		sdk.NewDec(6),
+		suite.Ctx.BlockTime().Add(time.Hour).Unix(),
+	)
+
+	placeRes, err := suite.msgServer.PlaceLimitOrder(sdk.WrapSDKContext(suite.Ctx), placeMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgPlaceLimitOrderResponse{ID: 1}, placeRes)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(tokenIn))
+	suite.ModuleAccountBalanceEqual(reserves.Add(tokenIn))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.GetAddress().String()),
+	))
+
+	cancelMsg := types.NewMsgCancelLimitOrder(owner.GetAddress().String(), placeRes.ID)
+
+	cancelRes, err := suite.msgServer.CancelLimitOrder(sdk.WrapSDKContext(suite.Ctx), cancelMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgCancelLimitOrderResponse{}, cancelRes)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), balance)
+	suite.ModuleAccountBalanceEqual(reserves)
+
+	_, err = suite.msgServer.CancelLimitOrder(sdk.WrapSDKContext(suite.Ctx), cancelMsg)
+	suite.ErrorIs(err, types.ErrLimitOrderNotFound)
+}
+
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(msgServerTestSuite))
 }
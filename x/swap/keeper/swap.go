@@ -16,7 +16,7 @@ func (k *Keeper) SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, e
 		return err
 	}
 
-	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetSwapFee(ctx))
+	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetPoolSwapFee(ctx, poolID))
 	if swapOutput.IsZero() {
 		return sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
 	}
@@ -47,7 +47,7 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 		)
 	}
 
-	swapInput, feePaid := pool.SwapWithExactOutput(exactCoinB, k.GetSwapFee(ctx))
+	swapInput, feePaid := pool.SwapWithExactOutput(exactCoinB, k.GetPoolSwapFee(ctx, poolID))
 
 	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(sdk.NewDecFromInt(swapInput.Sub(feePaid).Amount))
 	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
@@ -61,6 +61,115 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 	return nil
 }
 
+// SwapExactForTokensRouted swaps an exact coin a input for a coin b output through an ordered path of pools
+func (k *Keeper) SwapExactForTokensRouted(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, path []string, slippageLimit sdk.Dec) error {
+	if err := types.ValidateSwapPath(path, exactCoinA.Denom, coinB.Denom); err != nil {
+		return err
+	}
+
+	hops, err := k.routeWithExactInput(ctx, exactCoinA, path)
+	if err != nil {
+		return err
+	}
+	swapOutput := hops[len(hops)-1].swapOutput
+
+	priceChange := sdk.NewDecFromInt(swapOutput.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	return k.commitRoutedSwap(ctx, hops, requester, exactCoinA, swapOutput, "input")
+}
+
+// SwapForExactTokensRouted swaps a coin a input for an exact coin b output through an ordered path of pools
+func (k *Keeper) SwapForExactTokensRouted(ctx sdk.Context, requester sdk.AccAddress, coinA, exactCoinB sdk.Coin, path []string, slippageLimit sdk.Dec) error {
+	if err := types.ValidateSwapPath(path, coinA.Denom, exactCoinB.Denom); err != nil {
+		return err
+	}
+
+	hops, err := k.routeWithExactOutput(ctx, exactCoinB, path)
+	if err != nil {
+		return err
+	}
+	swapInput := hops[0].swapInput
+
+	// as with a single pool, slippage is measured against the input net of fees, so the fee paid at
+	// each later hop is removed from the route input in proportion to that hop's input
+	netInput := sdk.NewDecFromInt(swapInput.Sub(hops[0].feePaid).Amount)
+	for _, hop := range hops[1:] {
+		netInput = netInput.MulInt(hop.swapInput.Sub(hop.feePaid).Amount).QuoInt(hop.swapInput.Amount)
+	}
+
+	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(netInput)
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	return k.commitRoutedSwap(ctx, hops, requester, swapInput, exactCoinB, "output")
+}
+
+// routeHop represents a single trade against a pool within a routed swap
+type routeHop struct {
+	poolID     string
+	pool       *types.DenominatedPool
+	swapInput  sdk.Coin
+	swapOutput sdk.Coin
+	feePaid    sdk.Coin
+}
+
+// routeWithExactInput trades an exact input through each pool of the path in order, using the output
+// of each hop as the input of the next. The path must be valid and pools are updated in memory only.
+func (k Keeper) routeWithExactInput(ctx sdk.Context, exactInput sdk.Coin, path []string) ([]routeHop, error) {
+	hops := make([]routeHop, len(path)-1)
+
+	swapInput := exactInput
+	for i := range hops {
+		poolID, pool, err := k.loadPool(ctx, path[i], path[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		swapOutput, feePaid := pool.SwapWithExactInput(swapInput, k.GetPoolSwapFee(ctx, poolID))
+		if swapOutput.IsZero() {
+			return nil, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero in pool %s, increase input amount", poolID)
+		}
+
+		hops[i] = routeHop{poolID: poolID, pool: pool, swapInput: swapInput, swapOutput: swapOutput, feePaid: feePaid}
+		swapInput = swapOutput
+	}
+
+	return hops, nil
+}
+
+// routeWithExactOutput trades for an exact output through each pool of the path in reverse order, using
+// the input required by each hop as the output of the previous. The path must be valid and pools are
+// updated in memory only.
+func (k Keeper) routeWithExactOutput(ctx sdk.Context, exactOutput sdk.Coin, path []string) ([]routeHop, error) {
+	hops := make([]routeHop, len(path)-1)
+
+	swapOutput := exactOutput
+	for i := len(hops) - 1; i >= 0; i-- {
+		poolID, pool, err := k.loadPool(ctx, path[i], path[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		if swapOutput.Amount.GTE(pool.Reserves().AmountOf(swapOutput.Denom)) {
+			return nil, sdkerrors.Wrapf(
+				types.ErrInsufficientLiquidity,
+				"output %s >= pool %s reserves %s", swapOutput.Amount.String(), poolID, pool.Reserves().AmountOf(swapOutput.Denom).String(),
+			)
+		}
+
+		swapInput, feePaid := pool.SwapWithExactOutput(swapOutput, k.GetPoolSwapFee(ctx, poolID))
+
+		hops[i] = routeHop{poolID: poolID, pool: pool, swapInput: swapInput, swapOutput: swapOutput, feePaid: feePaid}
+		swapOutput = swapInput
+	}
+
+	return hops, nil
+}
+
 func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string, *types.DenominatedPool, error) {
 	poolID := types.PoolID(denomA, denomB)
 
@@ -69,7 +178,7 @@ func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string,
 		return poolID, nil, sdkerrors.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
 	}
 
-	pool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := k.newPoolFromRecord(ctx, poolRecord)
 	if err != nil {
 		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
 	}
@@ -96,7 +205,10 @@ func (k Keeper) commitSwap(
 	feePaid sdk.Coin,
 	exactDirection string,
 ) error {
-	k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+	protocolFee := k.protocolFee(ctx, feePaid)
+
+	k.updatePriceAccumulator(ctx, poolID)
+	k.SetPool(ctx, deductProtocolFee(k.newRecordFromPool(ctx, pool), protocolFee))
 
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
 		return err
@@ -106,6 +218,8 @@ func (k Keeper) commitSwap(
 		panic(err)
 	}
 
+	k.payProtocolFee(ctx, poolID, protocolFee)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSwapTrade,
@@ -120,3 +234,101 @@ func (k Keeper) commitSwap(
 
 	return nil
 }
+
+func (k Keeper) commitRoutedSwap(
+	ctx sdk.Context,
+	hops []routeHop,
+	requester sdk.AccAddress,
+	swapInput sdk.Coin,
+	swapOutput sdk.Coin,
+	exactDirection string,
+) error {
+	protocolFees := make([]sdk.Coin, len(hops))
+	for i, hop := range hops {
+		protocolFees[i] = k.protocolFee(ctx, hop.feePaid)
+
+		k.updatePriceAccumulator(ctx, hop.poolID)
+		k.SetPool(ctx, deductProtocolFee(k.newRecordFromPool(ctx, hop.pool), protocolFees[i]))
+	}
+
+	// intermediate coins never leave the module account, only the route input and output are transferred
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, requester, sdk.NewCoins(swapOutput)); err != nil {
+		panic(err)
+	}
+
+	for i, hop := range hops {
+		k.payProtocolFee(ctx, hop.poolID, protocolFees[i])
+	}
+
+	for _, hop := range hops {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapTrade,
+				sdk.NewAttribute(types.AttributeKeyPoolID, hop.poolID),
+				sdk.NewAttribute(types.AttributeKeyRequester, requester.String()),
+				sdk.NewAttribute(types.AttributeKeySwapInput, hop.swapInput.String()),
+				sdk.NewAttribute(types.AttributeKeySwapOutput, hop.swapOutput.String()),
+				sdk.NewAttribute(types.AttributeKeyFeePaid, hop.feePaid.String()),
+				sdk.NewAttribute(types.AttributeKeyExactDirection, exactDirection),
+			),
+		)
+	}
+
+	return nil
+}
+
+// protocolFee returns the portion of a swap fee that is paid to the protocol fee destination instead of
+// liquidity providers
+func (k Keeper) protocolFee(ctx sdk.Context, feePaid sdk.Coin) sdk.Coin {
+	fraction := k.GetParams(ctx).ProtocolFeeFraction
+	if fraction.IsNil() || !fraction.IsPositive() {
+		return sdk.NewCoin(feePaid.Denom, sdk.ZeroInt())
+	}
+
+	return sdk.NewCoin(feePaid.Denom, sdk.NewDecFromInt(feePaid.Amount).Mul(fraction).TruncateInt())
+}
+
+// payProtocolFee transfers a protocol fee from the swap module account to the protocol fee destination.
+// The fee must already be deducted from the reserves of the pool.
+func (k Keeper) payProtocolFee(ctx sdk.Context, poolID string, protocolFee sdk.Coin) {
+	if !protocolFee.IsPositive() {
+		return
+	}
+
+	destination := k.GetParams(ctx).ProtocolFeeDestination
+	if destination.IsCommunityPool() {
+		if err := k.communityKeeper.FundCommunityPool(ctx, k.GetSwapModuleAccount(ctx).GetAddress(), sdk.NewCoins(protocolFee)); err != nil {
+			panic(err)
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, types.ProtocolFeeAccountName, sdk.NewCoins(protocolFee)); err != nil {
+			panic(err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapProtocolFee,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, protocolFee.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, destination.String()),
+		),
+	)
+}
+
+// deductProtocolFee removes a protocol fee from the reserves of a pool record, so it no longer accrues
+// to liquidity providers
+func deductProtocolFee(record types.PoolRecord, protocolFee sdk.Coin) types.PoolRecord {
+	switch protocolFee.Denom {
+	case record.ReservesA.Denom:
+		record.ReservesA = record.ReservesA.Sub(protocolFee)
+	case record.ReservesB.Denom:
+		record.ReservesB = record.ReservesB.Sub(protocolFee)
+	}
+
+	return record
+}
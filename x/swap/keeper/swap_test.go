@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
 	"github.com/kava-labs/kava/x/swap/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -13,7 +14,12 @@ import (
 
 func (suite *keeperTestSuite) TestSwapExactForTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:                    sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -130,7 +136,12 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:                    tc.fee,
+				ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+				MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+				MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+				MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+				AmplificationRampDuration:  types.DefaultAmplificationRamp,
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -320,7 +331,12 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_PanicOnInsufficientModuleAc
 
 func (suite *keeperTestSuite) TestSwapForExactTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:                    sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -441,7 +457,12 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:                    tc.fee,
+				ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+				MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+				MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+				MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+				AmplificationRampDuration:  types.DefaultAmplificationRamp,
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -628,3 +649,414 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_PanicOnInsufficientModuleAc
 		_ = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
 	}, "expected panic when module account does not have enough funds")
 }
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRouted() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:                    sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdk.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	)
+	totalShares := sdk.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdk.NewInt(10e6))
+	path := []string{"ukava", "usdx", "hard"}
+
+	err := suite.Keeper.SwapExactForTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, path, sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+
+	intermediate := sdk.NewCoin("usdx", sdk.NewInt(4982529))
+	expectedOutput := sdk.NewCoin("hard", sdk.NewInt(9890985))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reservesA.Add(reservesB...).Add(coinA).Sub(expectedOutput))
+	suite.PoolReservesEqual(poolIDA, reservesA.Add(coinA).Sub(intermediate))
+	suite.PoolReservesEqual(poolIDB, reservesB.Add(intermediate).Sub(expectedOutput))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDA),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDB),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "12457usdx"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRouted_Slippage() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:                    sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdk.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	)
+	totalShares := sdk.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdk.NewInt(10e6))
+	path := []string{"ukava", "usdx", "hard"}
+
+	// the slippage of each hop is within the limit, but the slippage of the full route is not
+	err := suite.Keeper.SwapExactForTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, path, sdk.MustNewDecFromStr("0.01"))
+	suite.EqualError(err, "slippage 0.010901500000000000 > limit 0.010000000000000000: slippage exceeded")
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.PoolReservesEqual(poolIDA, reservesA)
+	suite.PoolReservesEqual(poolIDB, reservesB)
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRouted_PoolNotFound() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	suite.setupPool(reserves, sdk.NewInt(30e6), owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdk.NewInt(10e6))
+
+	err := suite.Keeper.SwapExactForTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, []string{"ukava", "usdx", "hard"}, sdk.MustNewDecFromStr("0.01"))
+	suite.EqualError(err, "pool hard:usdx not found: invalid pool")
+
+	err = suite.Keeper.SwapExactForTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, []string{"ukava", "bnb", "hard"}, sdk.MustNewDecFromStr("0.01"))
+	suite.EqualError(err, "pool bnb:ukava not found: invalid pool")
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRouted_InvalidPath() {
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6))))
+	coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdk.NewInt(10e6))
+
+	err := suite.Keeper.SwapExactForTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, []string{"usdx", "hard"}, sdk.MustNewDecFromStr("0.01"))
+	suite.EqualError(err, "path must start with input denom ukava: invalid path")
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensRouted() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:                    sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdk.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	)
+	totalShares := sdk.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdk.NewInt(10e6))
+	path := []string{"ukava", "usdx", "hard"}
+
+	err := suite.Keeper.SwapForExactTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, path, sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+
+	intermediate := sdk.NewCoin("usdx", sdk.NewInt(5037721))
+	expectedInput := sdk.NewCoin("ukava", sdk.NewInt(1011089))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(expectedInput).Add(coinB))
+	suite.ModuleAccountBalanceEqual(reservesA.Add(reservesB...).Add(expectedInput).Sub(coinB))
+	suite.PoolReservesEqual(poolIDA, reservesA.Add(expectedInput).Sub(intermediate))
+	suite.PoolReservesEqual(poolIDB, reservesB.Add(intermediate).Sub(coinB))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDA),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, expectedInput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2528ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDB),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, coinB.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "12595usdx"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensRouted_Slippage() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:                    sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdk.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	)
+	totalShares := sdk.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdk.NewInt(10e6))
+	path := []string{"ukava", "usdx", "hard"}
+
+	// slippage excludes the fees paid at each hop
+	err := suite.Keeper.SwapForExactTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, path, sdk.MustNewDecFromStr("0.005"))
+	suite.EqualError(err, "slippage 0.006003201775077518 > limit 0.005000000000000000: slippage exceeded")
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.PoolReservesEqual(poolIDA, reservesA)
+	suite.PoolReservesEqual(poolIDB, reservesB)
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensRouted_OutputLessThanPoolReserves() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdk.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	)
+	totalShares := sdk.NewInt(30e6)
+	suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	path := []string{"ukava", "usdx", "hard"}
+
+	coinB := sdk.NewCoin("hard", sdk.NewInt(2000e6))
+	err := suite.Keeper.SwapForExactTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, path, sdk.MustNewDecFromStr("0.01"))
+	suite.EqualError(err, "output 2000000000 >= pool hard:usdx reserves 2000000000: insufficient liquidity")
+
+	// the second hop requires more usdx than the first pool holds
+	coinB = sdk.NewCoin("hard", sdk.NewInt(1990e6))
+	err = suite.Keeper.SwapForExactTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, path, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLiquidity)
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokens_StableSwapPool() {
+	pool := types.NewAllowedStableSwapPool("busd", "usdx", 100)
+	suite.Require().NoError(pool.Validate())
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), sdk.MustNewDecFromStr("0.0025")))
+
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("busd", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(1000e6)),
+	)
+	depositor := suite.CreateAccount(reserves)
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+
+	record, found := suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(types.POOL_TYPE_STABLE_SWAP, record.PoolType)
+	suite.Equal(uint64(100), record.Amplification)
+
+	balance := sdk.NewCoins(sdk.NewCoin("busd", sdk.NewInt(100e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("busd", sdk.NewInt(100e6))
+	coinB := sdk.NewCoin("usdx", sdk.NewInt(100e6))
+
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	// a constant product pool with the same reserves would output 90702432usdx
+	expectedOutput := sdk.NewCoin("usdx", sdk.NewInt(99700030))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokens_ProtocolFee() {
+	testCases := []struct {
+		name        string
+		destination types.ProtocolFeeDestination
+		account     string
+	}{
+		{"unspecified", types.PROTOCOL_FEE_DESTINATION_UNSPECIFIED, types.ProtocolFeeAccountName},
+		{"module account", types.PROTOCOL_FEE_DESTINATION_MODULE_ACCOUNT, types.ProtocolFeeAccountName},
+		{"community pool", types.PROTOCOL_FEE_DESTINATION_COMMUNITY_POOL, communitytypes.ModuleAccountName},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// the pool fee of 1% overrides the global swap fee
+			pool := types.NewAllowedPool("ukava", "usdx").WithSwapFee(sdk.MustNewDecFromStr("0.01"))
+			suite.Keeper.SetParams(suite.Ctx, types.NewParamsWithProtocolFee(
+				types.NewAllowedPools(pool),
+				sdk.MustNewDecFromStr("0.0025"),
+				sdk.MustNewDecFromStr("0.2"),
+				tc.destination,
+			))
+
+			reserves := sdk.NewCoins(
+				sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+				sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+			)
+			depositor := suite.CreateAccount(reserves)
+			err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.ZeroDec())
+			suite.Require().NoError(err)
+
+			destination := suite.AccountKeeper.GetModuleAddress(tc.account)
+			initialDestinationBalance := suite.BankKeeper.GetAllBalances(suite.Ctx, destination)
+
+			balance := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)))
+			requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+			coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+			coinB := sdk.NewCoin("usdx", sdk.NewInt(5e6))
+
+			ctx := suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+			err = suite.Keeper.SwapExactForTokens(ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.02"))
+			suite.Require().NoError(err)
+
+			expectedOutput := sdk.NewCoin("usdx", sdk.NewInt(4945104))
+			protocolFee := sdk.NewCoin("ukava", sdk.NewInt(2000))
+
+			suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+			suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput).Sub(protocolFee))
+			suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput).Sub(protocolFee))
+			suite.AccountBalanceEqual(destination, initialDestinationBalance.Add(protocolFee))
+
+			suite.EventsContains(ctx.EventManager().Events(), sdk.NewEvent(
+				types.EventTypeSwapProtocolFee,
+				sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+				sdk.NewAttribute(types.AttributeKeyFeePaid, protocolFee.String()),
+				sdk.NewAttribute(types.AttributeKeyDestination, tc.destination.String()),
+			))
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRouted_ProtocolFee() {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParamsWithProtocolFee(
+		types.NewAllowedPools(
+			types.NewAllowedPool("ukava", "usdx").WithSwapFee(sdk.MustNewDecFromStr("0.01")),
+			types.NewAllowedPool("hard", "usdx"),
+		),
+		sdk.MustNewDecFromStr("0.0025"),
+		sdk.MustNewDecFromStr("0.5"),
+		types.PROTOCOL_FEE_DESTINATION_MODULE_ACCOUNT,
+	))
+
+	deposits := []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000e6)), sdk.NewCoin("usdx", sdk.NewInt(5000e6))),
+		sdk.NewCoins(sdk.NewCoin("hard", sdk.NewInt(2000e6)), sdk.NewCoin("usdx", sdk.NewInt(1000e6))),
+	}
+	for _, deposit := range deposits {
+		depositor := suite.CreateAccount(deposit)
+		err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), deposit[0], deposit[1], sdk.ZeroDec())
+		suite.Require().NoError(err)
+	}
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdk.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdk.NewInt(9e6))
+
+	err := suite.Keeper.SwapExactForTokensRouted(suite.Ctx, requester.GetAddress(), coinA, coinB, []string{"ukava", "usdx", "hard"}, sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+
+	// each pool pays half of its own swap fee, in its input denom
+	expectedProtocolFees := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(5000)),
+		sdk.NewCoin("usdx", sdk.NewInt(6181)),
+	)
+	suite.AccountBalanceEqual(suite.AccountKeeper.GetModuleAddress(types.ProtocolFeeAccountName), expectedProtocolFees)
+}
+
+func (suite *keeperTestSuite) TestGetPoolSwapFee() {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(
+			types.NewAllowedPool("ukava", "usdx").WithSwapFee(sdk.MustNewDecFromStr("0.01")),
+			types.NewAllowedPool("hard", "usdx"),
+			types.NewAllowedPool("swp", "usdx").WithSwapFee(sdk.ZeroDec()),
+		),
+		sdk.MustNewDecFromStr("0.0025"),
+	))
+
+	suite.Equal(sdk.MustNewDecFromStr("0.01"), suite.Keeper.GetPoolSwapFee(suite.Ctx, "ukava:usdx"))
+	suite.Equal(sdk.MustNewDecFromStr("0.0025"), suite.Keeper.GetPoolSwapFee(suite.Ctx, "hard:usdx"))
+	suite.Equal(sdk.ZeroDec(), suite.Keeper.GetPoolSwapFee(suite.Ctx, "swp:usdx"))
+	suite.Equal(sdk.MustNewDecFromStr("0.0025"), suite.Keeper.GetPoolSwapFee(suite.Ctx, "bnb:usdx"))
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// TWAP returns the time weighted average prices of a pool over the trailing window ending at the current
+// block time.  The first price returned is of token a denominated in token b, and the second is of token b
+// denominated in token a.
+//
+// An error is returned if the pool does not exist, or if it has no price observation at or before the start
+// of the window.
+func (k Keeper) TWAP(ctx sdk.Context, poolID string, window time.Duration) (sdk.Dec, sdk.Dec, error) {
+	if window <= 0 || window > types.MaxTWAPWindow {
+		return sdk.Dec{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTWAPWindow, "window %s must be positive and at most %s", window, types.MaxTWAPWindow)
+	}
+
+	record, found := k.GetPool(ctx, poolID)
+	if !found {
+		return sdk.Dec{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	latest, found := k.GetLatestPriceObservation(ctx, poolID)
+	if !found {
+		return sdk.Dec{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientHistory, "pool %s has no price observations", poolID)
+	}
+
+	end := k.accumulatePrices(ctx, record, latest, ctx.BlockTime())
+	startTime := end.Timestamp.Add(-window)
+
+	var before, after *types.PriceObservation
+	k.IteratePoolPriceObservations(ctx, poolID, func(observation types.PriceObservation) bool {
+		if observation.Timestamp.After(startTime) {
+			after = &observation
+			return true
+		}
+		before = &observation
+		return false
+	})
+	if before == nil {
+		return sdk.Dec{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientHistory, "pool %s has no price observations at or before %s", poolID, startTime)
+	}
+	if after == nil {
+		after = &end
+	}
+
+	start := interpolatePrices(*before, *after, startTime)
+	seconds := secondsBetween(startTime, end.Timestamp)
+
+	priceA := end.PriceACumulative.Sub(start.PriceACumulative).Quo(seconds)
+	priceB := end.PriceBCumulative.Sub(start.PriceBCumulative).Quo(seconds)
+
+	return priceA, priceB, nil
+}
+
+// GetLatestPriceObservation returns the most recent price observation of a pool
+func (k Keeper) GetLatestPriceObservation(ctx sdk.Context, poolID string) (types.PriceObservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.PriceObservationsKey(poolID))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.PriceObservation{}, false
+	}
+
+	var observation types.PriceObservation
+	k.cdc.MustUnmarshal(iterator.Value(), &observation)
+	return observation, true
+}
+
+// SetPriceObservation saves a price observation to the store
+func (k Keeper) SetPriceObservation(ctx sdk.Context, observation types.PriceObservation) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	bz := k.cdc.MustMarshal(&observation)
+	store.Set(types.PriceObservationKey(observation.PoolID, observation.Timestamp), bz)
+}
+
+// DeletePoolPriceObservations deletes all price observations of a pool
+func (k Keeper) DeletePoolPriceObservations(ctx sdk.Context, poolID string) {
+	k.deletePoolPriceObservationsUntil(ctx, poolID, func(types.PriceObservation) bool { return false })
+}
+
+// IteratePoolPriceObservations iterates over the price observations of a pool from oldest to newest
+// and performs a callback function
+func (k Keeper) IteratePoolPriceObservations(ctx sdk.Context, poolID string, cb func(observation types.PriceObservation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.PriceObservationsKey(poolID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		if cb(observation) {
+			break
+		}
+	}
+}
+
+// IteratePriceObservations iterates over all price observations in the store and performs a callback function
+func (k Keeper) IteratePriceObservations(ctx sdk.Context, cb func(observation types.PriceObservation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		if cb(observation) {
+			break
+		}
+	}
+}
+
+// GetAllPriceObservations returns all price observations from the store
+func (k Keeper) GetAllPriceObservations(ctx sdk.Context) (observations types.PriceObservations) {
+	k.IteratePriceObservations(ctx, func(observation types.PriceObservation) bool {
+		observations = append(observations, observation)
+		return false
+	})
+	return
+}
+
+// updatePriceAccumulator records the cumulative prices of a pool at the current block time.  It must be
+// called before the pool reserves are modified, so the time elapsed since the last observation is weighted
+// by the prices the pool held over that time.  A pool without a record starts accumulating from zero.
+//
+// Observations older than the maximum TWAP window are pruned, except for the most recent of them, which
+// is required to average over a window starting at the cutoff.
+func (k Keeper) updatePriceAccumulator(ctx sdk.Context, poolID string) {
+	blockTime := ctx.BlockTime()
+	observation := types.NewPriceObservation(poolID, blockTime, sdk.ZeroDec(), sdk.ZeroDec())
+
+	latest, hasObservation := k.GetLatestPriceObservation(ctx, poolID)
+	if hasObservation && !blockTime.After(latest.Timestamp) {
+		// prices are only accumulated once per block
+		return
+	}
+
+	record, hasPool := k.GetPool(ctx, poolID)
+	if hasObservation && hasPool {
+		observation = k.accumulatePrices(ctx, record, latest, blockTime)
+	}
+
+	k.SetPriceObservation(ctx, observation)
+
+	cutoff := blockTime.Add(-types.MaxTWAPWindow)
+	var expired []types.PriceObservation
+	k.IteratePoolPriceObservations(ctx, poolID, func(o types.PriceObservation) bool {
+		if o.Timestamp.After(cutoff) {
+			return true
+		}
+		expired = append(expired, o)
+		return false
+	})
+	if len(expired) > 1 {
+		lastExpired := expired[len(expired)-2].Timestamp
+		k.deletePoolPriceObservationsUntil(ctx, poolID, func(o types.PriceObservation) bool {
+			return o.Timestamp.After(lastExpired)
+		})
+	}
+}
+
+// deletePoolPriceObservationsUntil deletes the price observations of a pool from oldest to newest until
+// the stop function returns true
+func (k Keeper) deletePoolPriceObservationsUntil(ctx sdk.Context, poolID string, stop func(observation types.PriceObservation) bool) {
+	var keys [][]byte
+	k.IteratePoolPriceObservations(ctx, poolID, func(observation types.PriceObservation) bool {
+		if stop(observation) {
+			return true
+		}
+		keys = append(keys, types.PriceObservationKey(observation.PoolID, observation.Timestamp))
+		return false
+	})
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// accumulatePrices returns the cumulative prices of a pool at a time on or after the latest observation,
+// using the prices of the pool's current reserves
+func (k Keeper) accumulatePrices(ctx sdk.Context, record types.PoolRecord, latest types.PriceObservation, timestamp time.Time) types.PriceObservation {
+	pool, err := k.newPoolFromRecord(ctx, record)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool %s: %s", record.PoolID, err))
+	}
+
+	elapsed := secondsBetween(latest.Timestamp, timestamp)
+
+	return types.NewPriceObservation(
+		record.PoolID,
+		timestamp,
+		latest.PriceACumulative.Add(pool.SpotPrice(record.ReservesA.Denom).Mul(elapsed)),
+		latest.PriceBCumulative.Add(pool.SpotPrice(record.ReservesB.Denom).Mul(elapsed)),
+	)
+}
+
+// interpolatePrices returns the cumulative prices at a time between two observations.  Pool prices are
+// constant between observations, so cumulative prices increase linearly.
+func interpolatePrices(before, after types.PriceObservation, timestamp time.Time) types.PriceObservation {
+	fraction := secondsBetween(before.Timestamp, timestamp).Quo(secondsBetween(before.Timestamp, after.Timestamp))
+
+	return types.NewPriceObservation(
+		before.PoolID,
+		timestamp,
+		before.PriceACumulative.Add(after.PriceACumulative.Sub(before.PriceACumulative).Mul(fraction)),
+		before.PriceBCumulative.Add(after.PriceBCumulative.Sub(before.PriceBCumulative).Mul(fraction)),
+	)
+}
+
+// secondsBetween returns the seconds elapsed from start until end
+func secondsBetween(start, end time.Time) sdk.Dec {
+	return sdk.NewDecWithPrec(end.Sub(start).Nanoseconds(), 9)
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *keeperTestSuite) setupTWAPPool(startTime time.Time) sdk.AccAddress {
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+	err := suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(5000e6)),
+	))
+	suite.Require().NoError(err)
+
+	return suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000e6)))).GetAddress()
+}
+
+func (suite *keeperTestSuite) TestTWAP() {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	requester := suite.setupTWAPPool(startTime)
+	poolID := types.PoolID("ukava", "usdx")
+
+	observation, found := suite.Keeper.GetLatestPriceObservation(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(types.NewPriceObservation(poolID, startTime, sdk.ZeroDec(), sdk.ZeroDec()), observation)
+
+	// prices are weighted by the reserves held before the swap
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(60 * time.Second))
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester, sdk.NewCoin("ukava", sdk.NewInt(250e6)), sdk.NewCoin("usdx", sdk.NewInt(1000e6)), sdk.OneDec())
+	suite.Require().NoError(err)
+
+	observation, found = suite.Keeper.GetLatestPriceObservation(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(types.NewPriceObservation(poolID, startTime.Add(60*time.Second), sdk.NewDec(300), sdk.NewDec(12)), observation)
+
+	// reserves after the swap are 1250e6 ukava and 4002401441 usdx
+	suite.PoolReservesEqual(poolID, sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(1250e6)),
+		sdk.NewCoin("usdx", sdk.NewInt(4002401441)),
+	))
+	priceA := sdk.MustNewDecFromStr("3.2019211528")
+	priceB := sdk.MustNewDecFromStr("0.312312499989428222")
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(120 * time.Second))
+
+	testCases := []struct {
+		name           string
+		window         time.Duration
+		expectedPriceA sdk.Dec
+		expectedPriceB sdk.Dec
+	}{
+		{
+			name:           "window starting at an observation",
+			window:         120 * time.Second,
+			expectedPriceA: sdk.NewDec(5).Add(priceA).QuoInt64(2),
+			expectedPriceB: sdk.MustNewDecFromStr("0.2").Add(priceB).QuoInt64(2),
+		},
+		{
+			name:           "window starting between observations",
+			window:         90 * time.Second,
+			expectedPriceA: sdk.NewDec(5).Add(priceA.MulInt64(2)).QuoInt64(3),
+			expectedPriceB: sdk.MustNewDecFromStr("0.2").Add(priceB.MulInt64(2)).QuoInt64(3),
+		},
+		{
+			name:           "window starting after the latest observation",
+			window:         30 * time.Second,
+			expectedPriceA: priceA,
+			expectedPriceB: priceB,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			twapA, twapB, err := suite.Keeper.TWAP(suite.Ctx, poolID, tc.window)
+			suite.Require().NoError(err)
+			suite.Equal(tc.expectedPriceA, twapA)
+			suite.Equal(tc.expectedPriceB, twapB)
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestTWAP_OncePerBlock() {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	requester := suite.setupTWAPPool(startTime)
+	poolID := types.PoolID("ukava", "usdx")
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(60 * time.Second))
+	for i := 0; i < 2; i++ {
+		err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester, sdk.NewCoin("ukava", sdk.NewInt(100e6)), sdk.NewCoin("usdx", sdk.NewInt(100e6)), sdk.OneDec())
+		suite.Require().NoError(err)
+	}
+
+	suite.Equal(types.PriceObservations{
+		types.NewPriceObservation(poolID, startTime, sdk.ZeroDec(), sdk.ZeroDec()),
+		types.NewPriceObservation(poolID, startTime.Add(60*time.Second), sdk.NewDec(300), sdk.NewDec(12)),
+	}, suite.Keeper.GetAllPriceObservations(suite.Ctx))
+}
+
+func (suite *keeperTestSuite) TestTWAP_PrunesObservations() {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	requester := suite.setupTWAPPool(startTime)
+	poolID := types.PoolID("ukava", "usdx")
+
+	for _, elapsed := range []time.Duration{time.Hour, 25 * time.Hour, 26 * time.Hour} {
+		suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(elapsed))
+		err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester, sdk.NewCoin("ukava", sdk.NewInt(1e6)), sdk.NewCoin("usdx", sdk.NewInt(1e6)), sdk.OneDec())
+		suite.Require().NoError(err)
+	}
+
+	// the latest observation before the cutoff is kept to interpolate the start of the maximum window
+	var timestamps []time.Time
+	suite.Keeper.IteratePoolPriceObservations(suite.Ctx, poolID, func(observation types.PriceObservation) bool {
+		timestamps = append(timestamps, observation.Timestamp)
+		return false
+	})
+	suite.Equal([]time.Time{startTime.Add(time.Hour), startTime.Add(25 * time.Hour), startTime.Add(26 * time.Hour)}, timestamps)
+
+	_, _, err := suite.Keeper.TWAP(suite.Ctx, poolID, types.MaxTWAPWindow)
+	suite.NoError(err)
+}
+
+func (suite *keeperTestSuite) TestTWAP_DeletedWithPool() {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+
+	pool := types.NewAllowedPool("ukava", "usdx")
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	deposit := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)), sdk.NewCoin("usdx", sdk.NewInt(50e6)))
+	depositor := suite.CreateAccount(deposit)
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), deposit[0], deposit[1], sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	shares, found := suite.Keeper.GetDepositorSharesAmount(suite.Ctx, depositor.GetAddress(), pool.Name())
+	suite.Require().True(found)
+	err = suite.Keeper.Withdraw(suite.Ctx, depositor.GetAddress(), shares, deposit[0], deposit[1])
+	suite.Require().NoError(err)
+
+	suite.PoolDeleted("ukava", "usdx")
+	suite.Empty(suite.Keeper.GetAllPriceObservations(suite.Ctx))
+}
+
+func (suite *keeperTestSuite) TestTWAP_Invalid() {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.setupTWAPPool(startTime)
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Hour))
+
+	testCases := []struct {
+		name        string
+		poolID      string
+		window      time.Duration
+		expectedErr string
+	}{
+		{
+			name:        "zero window",
+			poolID:      "ukava:usdx",
+			window:      0,
+			expectedErr: "window 0s must be positive and at most 24h0m0s: invalid twap window",
+		},
+		{
+			name:        "window longer than maximum",
+			poolID:      "ukava:usdx",
+			window:      types.MaxTWAPWindow + time.Second,
+			expectedErr: "window 24h0m1s must be positive and at most 24h0m0s: invalid twap window",
+		},
+		{
+			name:        "missing pool",
+			poolID:      "bnb:usdx",
+			window:      time.Minute,
+			expectedErr: "pool bnb:usdx not found: invalid pool",
+		},
+		{
+			name:        "window starting before pool creation",
+			poolID:      "ukava:usdx",
+			window:      2 * time.Hour,
+			expectedErr: "pool ukava:usdx has no price observations at or before 2021-12-31 23:00:00 +0000 UTC: insufficient price history",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, _, err := suite.Keeper.TWAP(suite.Ctx, tc.poolID, tc.window)
+			suite.EqualError(err, tc.expectedErr)
+		})
+	}
+}
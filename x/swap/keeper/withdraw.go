@@ -38,7 +38,7 @@ func (k Keeper) Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdk.Int,
 		panic(fmt.Sprintf("pool %s not found", poolID))
 	}
 
-	pool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := k.newPoolFromRecord(ctx, poolRecord)
 	if err != nil {
 		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
 	}
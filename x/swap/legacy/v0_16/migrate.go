@@ -14,8 +14,13 @@ func migrateParams(params v015swap.Params) v016swap.Params {
 		}
 	}
 	return v016swap.Params{
-		AllowedPools: allowedPools,
-		SwapFee:      params.SwapFee,
+		AllowedPools:               allowedPools,
+		SwapFee:                    params.SwapFee,
+		ProtocolFeeFraction:        v016swap.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          v016swap.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      v016swap.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: v016swap.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  v016swap.DefaultAmplificationRamp,
 	}
 }
 
@@ -50,5 +55,7 @@ func Migrate(oldState v015swap.GenesisState) *v016swap.GenesisState {
 		Params:       migrateParams(oldState.Params),
 		PoolRecords:  migratePoolRecords(oldState.PoolRecords),
 		ShareRecords: migrateShareRecords(oldState.ShareRecords),
+		// limit orders did not exist before v0.16, so order ids start from the default
+		NextLimitOrderID: v016swap.DefaultNextLimitOrderID,
 	}
 }
@@ -68,7 +68,12 @@ func (s *migrateTestSuite) TestMigrate_Params() {
 		},
 	}
 	expectedParams := v016swap.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.33"),
+		SwapFee:                    sdk.MustNewDecFromStr("0.33"),
+		ProtocolFeeFraction:        v016swap.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          v016swap.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      v016swap.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: v016swap.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  v016swap.DefaultAmplificationRamp,
 		AllowedPools: v016swap.AllowedPools{
 			{TokenA: "A", TokenB: "B"},
 			{TokenA: "C", TokenB: "D"},
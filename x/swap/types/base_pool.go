@@ -69,6 +69,11 @@ func NewBasePoolWithExistingShares(reservesA, reservesB, totalShares sdk.Int) (*
 	}, nil
 }
 
+// invariant returns the constant product invariant x*y of the reserves
+func (p *BasePool) invariant() *big.Int {
+	return new(big.Int).Mul(p.reservesA.BigInt(), p.reservesB.BigInt())
+}
+
 // ReservesA returns the A reserves of the pool
 func (p *BasePool) ReservesA() sdk.Int {
 	return p.reservesA
@@ -90,6 +95,16 @@ func (p *BasePool) TotalShares() sdk.Int {
 	return p.totalShares
 }
 
+// SpotPriceA returns the marginal price of a denominated in b
+func (p *BasePool) SpotPriceA() sdk.Dec {
+	return sdk.NewDecFromInt(p.reservesB).Quo(sdk.NewDecFromInt(p.reservesA))
+}
+
+// SpotPriceB returns the marginal price of b denominated in a
+func (p *BasePool) SpotPriceB() sdk.Dec {
+	return sdk.NewDecFromInt(p.reservesA).Quo(sdk.NewDecFromInt(p.reservesB))
+}
+
 // AddLiquidity adds liquidity to the pool returns the actual reservesA, reservesB deposits in addition
 // to the number of shares created.  The deposits are always less than or equal to the provided and desired
 // values.
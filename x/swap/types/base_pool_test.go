@@ -413,6 +413,14 @@ func TestBasePool_ReservesOnlyDepletedWithLastShare(t *testing.T) {
 	}
 }
 
+func TestBasePool_SpotPrice(t *testing.T) {
+	pool, err := types.NewBasePool(i(1e6), i(4e6))
+	require.NoError(t, err)
+
+	assert.Equal(t, d("4"), pool.SpotPriceA())
+	assert.Equal(t, d("0.25"), pool.SpotPriceB())
+}
+
 func TestBasePool_Swap_ExactInput(t *testing.T) {
 	testCases := []struct {
 		reservesA      sdk.Int
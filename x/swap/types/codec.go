@@ -16,6 +16,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdraw{}, "swap/MsgWithdraw", nil)
 	cdc.RegisterConcrete(&MsgSwapExactForTokens{}, "swap/MsgSwapExactForTokens", nil)
 	cdc.RegisterConcrete(&MsgSwapForExactTokens{}, "swap/MsgSwapForExactTokens", nil)
+	cdc.RegisterConcrete(&MsgSwapExactForTokensRouted{}, "swap/MsgSwapExactForTokensRouted", nil)
+	cdc.RegisterConcrete(&MsgSwapForExactTokensRouted{}, "swap/MsgSwapForExactTokensRouted", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "swap/MsgPlaceLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "swap/MsgCancelLimitOrder", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -26,6 +30,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdraw{},
 		&MsgSwapExactForTokens{},
 		&MsgSwapForExactTokens{},
+		&MsgSwapExactForTokensRouted{},
+		&MsgSwapForExactTokensRouted{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
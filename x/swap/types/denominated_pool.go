@@ -2,22 +2,60 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// DenominatedPool implements a denominated constant-product liquidity pool
+// swapPool defines the unitless operations of a two asset liquidity pool, implemented by each pool type
+type swapPool interface {
+	ReservesA() sdk.Int
+	ReservesB() sdk.Int
+	TotalShares() sdk.Int
+	IsEmpty() bool
+	SpotPriceA() sdk.Dec
+	SpotPriceB() sdk.Dec
+	AddLiquidity(desiredA sdk.Int, desiredB sdk.Int) (sdk.Int, sdk.Int, sdk.Int)
+	RemoveLiquidity(shares sdk.Int) (sdk.Int, sdk.Int)
+	ShareValue(shares sdk.Int) (sdk.Int, sdk.Int)
+	SwapExactAForB(a sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int)
+	SwapExactBForA(b sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int)
+	SwapAForExactB(b sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int)
+	SwapBForExactA(a sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int)
+	invariant() *big.Int
+}
+
+var (
+	_ swapPool = &BasePool{}
+	_ swapPool = &StableSwapPool{}
+)
+
+// DenominatedPool implements a denominated liquidity pool of any pool type
 type DenominatedPool struct {
-	// all pool operations are implemented in a unitless base pool
-	pool *BasePool
+	// all pool operations are implemented in a unitless pool
+	pool swapPool
+	// track the pool type and its parameters for storage
+	poolType      PoolType
+	amplification uint64
 	// track units of the reserveA and reserveB in base pool
 	denomA string
 	denomB string
 }
 
-// NewDenominatedPool creates a new denominated pool from reserve coins
+// NewDenominatedPool creates a new denominated constant-product pool from reserve coins
 func NewDenominatedPool(reserves sdk.Coins) (*DenominatedPool, error) {
+	return NewDenominatedPoolOfType(reserves, POOL_TYPE_UNSPECIFIED, 0)
+}
+
+// NewDenominatedPoolWithExistingShares creates a new denominated constant-product pool from reserve coins
+func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdk.Int) (*DenominatedPool, error) {
+	return NewDenominatedPoolOfTypeWithExistingShares(reserves, totalShares, POOL_TYPE_UNSPECIFIED, 0)
+}
+
+// NewDenominatedPoolOfType creates a new denominated pool of the provided type from reserve coins.
+// The amplification is only used by stable swap pools.
+func NewDenominatedPoolOfType(reserves sdk.Coins, poolType PoolType, amplification uint64) (*DenominatedPool, error) {
 	if len(reserves) != 2 {
 		return nil, sdkerrors.Wrap(ErrInvalidPool, "reserves must have two denominations")
 	}
@@ -27,20 +65,31 @@ func NewDenominatedPool(reserves sdk.Coins) (*DenominatedPool, error) {
 	reservesA := reserves[0]
 	reservesB := reserves[1]
 
-	pool, err := NewBasePool(reservesA.Amount, reservesB.Amount)
+	var (
+		pool swapPool
+		err  error
+	)
+	if poolType.IsStableSwap() {
+		pool, err = NewStableSwapPool(reservesA.Amount, reservesB.Amount, amplification)
+	} else {
+		pool, err = NewBasePool(reservesA.Amount, reservesB.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &DenominatedPool{
-		pool:   pool,
-		denomA: reservesA.Denom,
-		denomB: reservesB.Denom,
+		pool:          pool,
+		poolType:      poolType,
+		amplification: amplification,
+		denomA:        reservesA.Denom,
+		denomB:        reservesB.Denom,
 	}, nil
 }
 
-// NewDenominatedPoolWithExistingShares creates a new denominated pool from reserve coins
-func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdk.Int) (*DenominatedPool, error) {
+// NewDenominatedPoolOfTypeWithExistingShares creates a new denominated pool of the provided type from
+// reserve coins and existing shares.  The amplification is only used by stable swap pools.
+func NewDenominatedPoolOfTypeWithExistingShares(reserves sdk.Coins, totalShares sdk.Int, poolType PoolType, amplification uint64) (*DenominatedPool, error) {
 	if len(reserves) != 2 {
 		return nil, sdkerrors.Wrap(ErrInvalidPool, "reserves must have two denominations")
 	}
@@ -50,18 +99,44 @@ func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdk.In
 	reservesA := reserves[0]
 	reservesB := reserves[1]
 
-	pool, err := NewBasePoolWithExistingShares(reservesA.Amount, reservesB.Amount, totalShares)
+	var (
+		pool swapPool
+		err  error
+	)
+	if poolType.IsStableSwap() {
+		pool, err = NewStableSwapPoolWithExistingShares(reservesA.Amount, reservesB.Amount, totalShares, amplification)
+	} else {
+		pool, err = NewBasePoolWithExistingShares(reservesA.Amount, reservesB.Amount, totalShares)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &DenominatedPool{
-		pool:   pool,
-		denomA: reservesA.Denom,
-		denomB: reservesB.Denom,
+		pool:          pool,
+		poolType:      poolType,
+		amplification: amplification,
+		denomA:        reservesA.Denom,
+		denomB:        reservesB.Denom,
 	}, nil
 }
 
+// PoolType returns the type of the pool
+func (p *DenominatedPool) PoolType() PoolType {
+	return p.poolType
+}
+
+// Amplification returns the amplification coefficient of a stable swap pool
+func (p *DenominatedPool) Amplification() uint64 {
+	return p.amplification
+}
+
+// Invariant returns the invariant of the pool reserves, x*y for constant product pools and D for stable
+// swap pools
+func (p *DenominatedPool) Invariant() *big.Int {
+	return p.pool.invariant()
+}
+
 // Reserves returns the reserves held in the pool
 func (p *DenominatedPool) Reserves() sdk.Coins {
 	return p.coins(p.pool.ReservesA(), p.pool.ReservesB())
@@ -77,6 +152,19 @@ func (p *DenominatedPool) IsEmpty() bool {
 	return p.pool.IsEmpty()
 }
 
+// SpotPrice returns the marginal price of the base denom, denominated in the other pool reserve.
+// Panics if the base denom does not match the pool reserves.
+func (p *DenominatedPool) SpotPrice(baseDenom string) sdk.Dec {
+	switch baseDenom {
+	case p.denomA:
+		return p.pool.SpotPriceA()
+	case p.denomB:
+		return p.pool.SpotPriceB()
+	default:
+		panic(fmt.Sprintf("invalid denomination: denom '%s' does not match pool reserves", baseDenom))
+	}
+}
+
 // AddLiquidity adds liquidity to the reserves and returns the added amount and shares created
 func (p *DenominatedPool) AddLiquidity(deposit sdk.Coins) (sdk.Coins, sdk.Int) {
 	desiredA := deposit.AmountOf(p.denomA)
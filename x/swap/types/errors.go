@@ -17,4 +17,9 @@ var (
 	ErrDepositNotFound       = sdkerrors.Register(ModuleName, 10, "deposit not found")
 	ErrInvalidCoin           = sdkerrors.Register(ModuleName, 11, "invalid coin")
 	ErrNotImplemented        = sdkerrors.Register(ModuleName, 12, "not implemented")
+	ErrInvalidPath           = sdkerrors.Register(ModuleName, 13, "invalid path")
+	ErrInvalidTWAPWindow     = sdkerrors.Register(ModuleName, 14, "invalid twap window")
+	ErrInsufficientHistory   = sdkerrors.Register(ModuleName, 15, "insufficient price history")
+	ErrInvalidLimitOrder     = sdkerrors.Register(ModuleName, 16, "invalid limit order")
+	ErrLimitOrderNotFound    = sdkerrors.Register(ModuleName, 17, "limit order not found")
 )
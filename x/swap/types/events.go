@@ -2,17 +2,30 @@ package types
 
 // Event types for swap module
 const (
-	AttributeValueCategory     = ModuleName
-	EventTypeSwapDeposit       = "swap_deposit"
-	EventTypeSwapWithdraw      = "swap_withdraw"
-	EventTypeSwapTrade         = "swap_trade"
-	AttributeKeyPoolID         = "pool_id"
-	AttributeKeyDepositor      = "depositor"
-	AttributeKeyShares         = "shares"
-	AttributeKeyOwner          = "owner"
-	AttributeKeyRequester      = "requester"
-	AttributeKeySwapInput      = "input"
-	AttributeKeySwapOutput     = "output"
-	AttributeKeyFeePaid        = "fee"
-	AttributeKeyExactDirection = "exact"
+	AttributeValueCategory          = ModuleName
+	EventTypeSwapDeposit            = "swap_deposit"
+	EventTypeSwapWithdraw           = "swap_withdraw"
+	EventTypeSwapTrade              = "swap_trade"
+	EventTypeSwapProtocolFee        = "swap_protocol_fee"
+	EventTypePlaceLimitOrder        = "swap_place_limit_order"
+	EventTypeCancelLimitOrder       = "swap_cancel_limit_order"
+	EventTypeFillLimitOrder         = "swap_fill_limit_order"
+	EventTypeExpireLimitOrder       = "swap_expire_limit_order"
+	EventTypeRampAmplification      = "swap_ramp_amplification"
+	AttributeKeyPoolID              = "pool_id"
+	AttributeKeyDepositor           = "depositor"
+	AttributeKeyShares              = "shares"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyRequester           = "requester"
+	AttributeKeySwapInput           = "input"
+	AttributeKeySwapOutput          = "output"
+	AttributeKeyFeePaid             = "fee"
+	AttributeKeyExactDirection      = "exact"
+	AttributeKeyDestination         = "destination"
+	AttributeKeyOrderID             = "order_id"
+	AttributeKeyLimitPrice          = "limit_price"
+	AttributeKeyExpiry              = "expiry"
+	AttributeKeyAmplification       = "amplification"
+	AttributeKeyFutureAmplification = "future_amplification"
+	AttributeKeyEndTime             = "end_time"
 )
@@ -24,6 +24,11 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
+// CommunityKeeper defines the expected community keeper used to pay protocol fees to the community pool
+type CommunityKeeper interface {
+	FundCommunityPool(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error
+}
+
 // SwapHooks are event hooks called when a user's deposit to a swap pool changes.
 type SwapHooks interface {
 	AfterPoolDepositCreated(ctx sdk.Context, poolID string, depositor sdk.AccAddress, sharedOwned sdk.Int)
@@ -16,14 +16,30 @@ var (
 	DefaultPoolRecords = PoolRecords{}
 	// DefaultShareRecords is used to set default records in default genesis state
 	DefaultShareRecords = ShareRecords{}
+	// DefaultPriceObservations is used to set default observations in default genesis state
+	DefaultPriceObservations = PriceObservations{}
+	// DefaultLimitOrders is used to set default orders in default genesis state
+	DefaultLimitOrders = LimitOrders{}
+	// DefaultNextLimitOrderID is the id assigned to the first limit order
+	DefaultNextLimitOrderID = uint64(1)
 )
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, poolRecords PoolRecords, shareRecords ShareRecords) GenesisState {
+func NewGenesisState(
+	params Params,
+	poolRecords PoolRecords,
+	shareRecords ShareRecords,
+	priceObservations PriceObservations,
+	limitOrders LimitOrders,
+	nextLimitOrderID uint64,
+) GenesisState {
 	return GenesisState{
-		Params:       params,
-		PoolRecords:  poolRecords,
-		ShareRecords: shareRecords,
+		Params:            params,
+		PoolRecords:       poolRecords,
+		ShareRecords:      shareRecords,
+		PriceObservations: priceObservations,
+		LimitOrders:       limitOrders,
+		NextLimitOrderID:  nextLimitOrderID,
 	}
 }
 
@@ -38,6 +54,21 @@ func (gs GenesisState) Validate() error {
 	if err := gs.ShareRecords.Validate(); err != nil {
 		return err
 	}
+	if err := gs.PriceObservations.Validate(); err != nil {
+		return err
+	}
+	if err := gs.LimitOrders.Validate(); err != nil {
+		return err
+	}
+
+	if gs.NextLimitOrderID == 0 {
+		return fmt.Errorf("next limit order id must be positive")
+	}
+	for _, o := range gs.LimitOrders {
+		if o.ID >= gs.NextLimitOrderID {
+			return fmt.Errorf("limit order id %d must be less than next limit order id %d", o.ID, gs.NextLimitOrderID)
+		}
+	}
 
 	totalShares := make(map[string]poolShares)
 	for _, pr := range gs.PoolRecords {
@@ -73,5 +104,8 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		DefaultPoolRecords,
 		DefaultShareRecords,
+		DefaultPriceObservations,
+		DefaultLimitOrders,
+		DefaultNextLimitOrderID,
 	)
 }
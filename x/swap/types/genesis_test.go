@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -50,9 +51,15 @@ func TestGenesis_Validate_SwapFee(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: types.DefaultAllowedPools,
-					SwapFee:      tc.swapFee,
+					AllowedPools:               types.DefaultAllowedPools,
+					SwapFee:                    tc.swapFee,
+					ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+					MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+					MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+					MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+					AmplificationRampDuration:  types.DefaultAmplificationRamp,
 				},
+				NextLimitOrderID: types.DefaultNextLimitOrderID,
 			}
 
 			err := genesisState.Validate()
@@ -94,9 +101,15 @@ func TestGenesis_Validate_AllowedPools(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: tc.pairs,
-					SwapFee:      types.DefaultSwapFee,
+					AllowedPools:               tc.pairs,
+					SwapFee:                    types.DefaultSwapFee,
+					ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+					MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+					MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+					MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+					AmplificationRampDuration:  types.DefaultAmplificationRamp,
 				},
+				NextLimitOrderID: types.DefaultNextLimitOrderID,
 			}
 
 			err := genesisState.Validate()
@@ -163,12 +176,21 @@ func TestGenesis_JSONEncoding(t *testing.T) {
 }
 
 func TestGenesis_YAMLEncoding(t *testing.T) {
-	expected := `params:
+	expected := `limit_orders: []
+next_limit_order_id: 1
+params:
   allowed_pools:
-  - token_a: ukava
+  - swap_fee: null
+    token_a: ukava
     token_b: usdx
-  - token_a: hard
+  - swap_fee: null
+    token_a: hard
     token_b: busd
+  amplification_ramp_duration: 86400
+  max_limit_order_duration: 2592000
+  max_limit_order_fills_per_block: 100
+  min_limit_order_size: "0.000100000000000000"
+  protocol_fee_fraction: "0.000000000000000000"
   swap_fee: "0.003000000000000000"
 pool_records:
 - pool_id: ukava:usdx
@@ -187,6 +209,7 @@ pool_records:
     amount: "2000000"
     denom: usdx
   total_shares: "1500000"
+price_observations: []
 share_records:
 - depositor: kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w
   pool_id: ukava:usdx
@@ -217,6 +240,9 @@ share_records:
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), i(1e5)),
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), i(2e5)),
 		},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
 	)
 
 	data, err := yaml.Marshal(state)
@@ -232,6 +258,9 @@ func TestGenesis_ValidatePoolRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{invalidPoolRecord},
 		types.ShareRecords{},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
 	)
 
 	assert.Error(t, state.Validate())
@@ -247,11 +276,90 @@ func TestGenesis_ValidateShareRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{},
 		types.ShareRecords{invalidShareRecord},
+		types.PriceObservations{},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
 	)
 
 	assert.Error(t, state.Validate())
 }
 
+func TestGenesis_ValidatePriceObservations(t *testing.T) {
+	invalidObservation := types.NewPriceObservation("ukava:usdx", time.Time{}, sdk.ZeroDec(), sdk.ZeroDec())
+
+	state := types.NewGenesisState(
+		types.DefaultParams(),
+		types.PoolRecords{},
+		types.ShareRecords{},
+		types.PriceObservations{invalidObservation},
+		types.LimitOrders{},
+		types.DefaultNextLimitOrderID,
+	)
+
+	assert.Error(t, state.Validate())
+}
+
+func TestGenesis_ValidateLimitOrders(t *testing.T) {
+	owner, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	validOrder := types.NewLimitOrder(1, owner, ukava(1e6), "usdx", sdk.MustNewDecFromStr("5"), expiry)
+	invalidOrder := types.NewLimitOrder(2, owner, ukava(1e6), "usdx", sdk.ZeroDec(), expiry)
+
+	testCases := []struct {
+		name             string
+		limitOrders      types.LimitOrders
+		nextLimitOrderID uint64
+		expectedErr      string
+	}{
+		{
+			name:             "valid orders",
+			limitOrders:      types.LimitOrders{validOrder},
+			nextLimitOrderID: 2,
+			expectedErr:      "",
+		},
+		{
+			name:             "invalid order",
+			limitOrders:      types.LimitOrders{validOrder, invalidOrder},
+			nextLimitOrderID: 3,
+			expectedErr:      "limit order 2 has invalid limit price: 0.000000000000000000",
+		},
+		{
+			name:             "zero next order id",
+			limitOrders:      types.LimitOrders{},
+			nextLimitOrderID: 0,
+			expectedErr:      "next limit order id must be positive",
+		},
+		{
+			name:             "order id not less than next order id",
+			limitOrders:      types.LimitOrders{validOrder},
+			nextLimitOrderID: 1,
+			expectedErr:      "limit order id 1 must be less than next limit order id 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := types.NewGenesisState(
+				types.DefaultParams(),
+				types.PoolRecords{},
+				types.ShareRecords{},
+				types.PriceObservations{},
+				tc.limitOrders,
+				tc.nextLimitOrderID,
+			)
+			err := state.Validate()
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 	depositor_1, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
 	require.NoError(t, err)
@@ -323,7 +431,7 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords)
+			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords, types.PriceObservations{}, types.LimitOrders{}, types.DefaultNextLimitOrderID)
 			err := state.Validate()
 
 			if tc.expectedErr == "" {
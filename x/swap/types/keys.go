@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,6 +13,9 @@ const (
 	// ModuleAccountName name of module account used to hold liquidity
 	ModuleAccountName = "swap"
 
+	// ProtocolFeeAccountName name of module account used to hold protocol fees
+	ProtocolFeeAccountName = "swap_protocol_fee"
+
 	// StoreKey Top level store key where all module items will be stored
 	StoreKey = ModuleName
 
@@ -28,6 +33,11 @@ const (
 var (
 	PoolKeyPrefix             = []byte{0x01}
 	DepositorPoolSharesPrefix = []byte{0x02}
+	PriceObservationKeyPrefix = []byte{0x03}
+	LimitOrderKeyPrefix       = []byte{0x04}
+	NextLimitOrderIDKey       = []byte{0x05}
+	LimitOrderByExpiryPrefix  = []byte{0x06}
+	LimitOrderByPricePrefix   = []byte{0x07}
 
 	sep = []byte("|")
 )
@@ -42,6 +52,36 @@ func DepositorPoolSharesKey(depositor sdk.AccAddress, poolID string) []byte {
 	return createKey(depositor, sep, []byte(poolID))
 }
 
+// PriceObservationsKey returns the key prefix of all price observations for a poolID
+func PriceObservationsKey(poolID string) []byte {
+	return createKey([]byte(poolID), sep)
+}
+
+// PriceObservationKey returns a key from a poolID and the time of the observation
+func PriceObservationKey(poolID string, timestamp time.Time) []byte {
+	return createKey(PriceObservationsKey(poolID), sdk.FormatTimeBytes(timestamp))
+}
+
+// LimitOrderKey returns a key from a limit order id
+func LimitOrderKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// LimitOrderByExpiryKey returns a key from the expiry and id of a limit order
+func LimitOrderByExpiryKey(expiry time.Time, id uint64) []byte {
+	return createKey(sdk.FormatTimeBytes(expiry), sdk.Uint64ToBigEndian(id))
+}
+
+// LimitOrdersByPriceKey returns the key prefix of all limit orders selling denomIn for denomOut
+func LimitOrdersByPriceKey(denomIn, denomOut string) []byte {
+	return createKey([]byte(denomIn), sep, []byte(denomOut), sep)
+}
+
+// LimitOrderByPriceKey returns a key from the denoms, limit price and id of a limit order
+func LimitOrderByPriceKey(denomIn, denomOut string, limitPrice sdk.Dec, id uint64) []byte {
+	return createKey(LimitOrdersByPriceKey(denomIn, denomOut), sdk.SortableDecBytes(limitPrice), sdk.Uint64ToBigEndian(id))
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
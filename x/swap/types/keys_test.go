@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -15,4 +16,11 @@ func TestKeys(t *testing.T) {
 
 	key = types.DepositorPoolSharesKey(sdk.AccAddress("testaddress1"), types.PoolID("ukava", "usdx"))
 	assert.Equal(t, string(sdk.AccAddress("testaddress1"))+"|"+types.PoolID("ukava", "usdx"), string(key))
+
+	key = types.LimitOrderByExpiryKey(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 1)
+	assert.Equal(t, string(sdk.FormatTimeBytes(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)))+string(sdk.Uint64ToBigEndian(1)), string(key))
+
+	key = types.LimitOrderByPriceKey("ukava", "usdx", sdk.MustNewDecFromStr("5.1"), 1)
+	assert.Equal(t, "ukava|usdx|"+string(sdk.SortableDecBytes(sdk.MustNewDecFromStr("5.1")))+string(sdk.Uint64ToBigEndian(1)), string(key))
+	assert.Less(t, string(key), string(types.LimitOrderByPriceKey("ukava", "usdx", sdk.MustNewDecFromStr("10"), 0)))
 }
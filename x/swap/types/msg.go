@@ -16,6 +16,14 @@ const (
 	TypeSwapExactForTokens = "swap_exact_for_tokens"
 	// TypeSwapForExactTokens represents the type string for MsgSwapForExactTokens
 	TypeSwapForExactTokens = "swap_for_exact_tokens"
+	// TypeSwapExactForTokensRouted represents the type string for MsgSwapExactForTokensRouted
+	TypeSwapExactForTokensRouted = "swap_exact_for_tokens_routed"
+	// TypeSwapForExactTokensRouted represents the type string for MsgSwapForExactTokensRouted
+	TypeSwapForExactTokensRouted = "swap_for_exact_tokens_routed"
+	// TypeMsgPlaceLimitOrder represents the type string for MsgPlaceLimitOrder
+	TypeMsgPlaceLimitOrder = "swap_place_limit_order"
+	// TypeMsgCancelLimitOrder represents the type string for MsgCancelLimitOrder
+	TypeMsgCancelLimitOrder = "swap_cancel_limit_order"
 )
 
 var (
@@ -27,6 +35,12 @@ var (
 	_ MsgWithDeadline = &MsgSwapExactForTokens{}
 	_ sdk.Msg         = &MsgSwapForExactTokens{}
 	_ MsgWithDeadline = &MsgSwapForExactTokens{}
+	_ sdk.Msg         = &MsgSwapExactForTokensRouted{}
+	_ MsgWithDeadline = &MsgSwapExactForTokensRouted{}
+	_ sdk.Msg         = &MsgSwapForExactTokensRouted{}
+	_ MsgWithDeadline = &MsgSwapForExactTokensRouted{}
+	_ sdk.Msg         = &MsgPlaceLimitOrder{}
+	_ sdk.Msg         = &MsgCancelLimitOrder{}
 )
 
 // MsgWithDeadline allows messages to define a deadline of when they are considered invalid
@@ -338,3 +352,301 @@ func (msg MsgSwapForExactTokens) GetDeadline() time.Time {
 func (msg MsgSwapForExactTokens) DeadlineExceeded(blockTime time.Time) bool {
 	return blockTime.Unix() >= msg.Deadline
 }
+
+// NewMsgSwapExactForTokensRouted returns a new MsgSwapExactForTokensRouted
+func NewMsgSwapExactForTokensRouted(requester string, exactTokenA sdk.Coin, tokenB sdk.Coin, path []string, slippage sdk.Dec, deadline int64) *MsgSwapExactForTokensRouted {
+	return &MsgSwapExactForTokensRouted{
+		Requester:   requester,
+		ExactTokenA: exactTokenA,
+		TokenB:      tokenB,
+		Path:        path,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapExactForTokensRouted) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapExactForTokensRouted) Type() string { return TypeSwapExactForTokensRouted }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapExactForTokensRouted) ValidateBasic() error {
+	if msg.Requester == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester address: %s", err)
+	}
+
+	if !msg.ExactTokenA.IsValid() || msg.ExactTokenA.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "exact token a deposit amount %s", msg.ExactTokenA)
+	}
+
+	if !msg.TokenB.IsValid() || msg.TokenB.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token b deposit amount %s", msg.TokenB)
+	}
+
+	if err := ValidateSwapPath(msg.Path, msg.ExactTokenA.Denom, msg.TokenB.Denom); err != nil {
+		return err
+	}
+
+	if msg.Slippage.IsNil() {
+		return sdkerrors.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapExactForTokensRouted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapExactForTokensRouted) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapExactForTokensRouted) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapExactForTokensRouted) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgSwapForExactTokensRouted returns a new MsgSwapForExactTokensRouted
+func NewMsgSwapForExactTokensRouted(requester string, tokenA sdk.Coin, exactTokenB sdk.Coin, path []string, slippage sdk.Dec, deadline int64) *MsgSwapForExactTokensRouted {
+	return &MsgSwapForExactTokensRouted{
+		Requester:   requester,
+		TokenA:      tokenA,
+		ExactTokenB: exactTokenB,
+		Path:        path,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapForExactTokensRouted) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapForExactTokensRouted) Type() string { return TypeSwapForExactTokensRouted }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapForExactTokensRouted) ValidateBasic() error {
+	if msg.Requester == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester address: %s", err)
+	}
+
+	if !msg.TokenA.IsValid() || msg.TokenA.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token a deposit amount %s", msg.TokenA)
+	}
+
+	if !msg.ExactTokenB.IsValid() || msg.ExactTokenB.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "exact token b deposit amount %s", msg.ExactTokenB)
+	}
+
+	if err := ValidateSwapPath(msg.Path, msg.TokenA.Denom, msg.ExactTokenB.Denom); err != nil {
+		return err
+	}
+
+	if msg.Slippage.IsNil() {
+		return sdkerrors.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapForExactTokensRouted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapForExactTokensRouted) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapForExactTokensRouted) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapForExactTokensRouted) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// ValidateSwapPath validates an ordered path of denoms traded through, requiring it to start with
+// the input denom, end with the output denom and not pass through any denom more than once
+func ValidateSwapPath(path []string, inputDenom, outputDenom string) error {
+	if len(path) < 2 {
+		return sdkerrors.Wrapf(ErrInvalidPath, "path must contain at least two denoms")
+	}
+
+	if path[0] != inputDenom {
+		return sdkerrors.Wrapf(ErrInvalidPath, "path must start with input denom %s", inputDenom)
+	}
+
+	if path[len(path)-1] != outputDenom {
+		return sdkerrors.Wrapf(ErrInvalidPath, "path must end with output denom %s", outputDenom)
+	}
+
+	seenDenoms := make(map[string]bool, len(path))
+	for _, denom := range path {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPath, err.Error())
+		}
+
+		if seenDenoms[denom] {
+			return sdkerrors.Wrapf(ErrInvalidPath, "duplicate denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+// NewMsgPlaceLimitOrder returns a new MsgPlaceLimitOrder
+func NewMsgPlaceLimitOrder(owner string, tokenIn sdk.Coin, denomOut string, limitPrice sdk.Dec, expiry int64) *MsgPlaceLimitOrder {
+	return &MsgPlaceLimitOrder{
+		Owner:      owner,
+		TokenIn:    tokenIn,
+		DenomOut:   denomOut,
+		LimitPrice: limitPrice,
+		Expiry:     expiry,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceLimitOrder) Type() string { return TypeMsgPlaceLimitOrder }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	if msg.Owner == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if !msg.TokenIn.IsValid() || msg.TokenIn.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token in amount %s", msg.TokenIn)
+	}
+
+	if err := sdk.ValidateDenom(msg.DenomOut); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "denom out: %s", err)
+	}
+
+	if msg.TokenIn.Denom == msg.DenomOut {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	if msg.LimitPrice.IsNil() || !msg.LimitPrice.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidLimitOrder, "limit price must be positive")
+	}
+
+	if !sdk.ValidSortableDec(msg.LimitPrice) {
+		return sdkerrors.Wrapf(ErrInvalidLimitOrder, "limit price must be at most %s", sdk.MaxSortableDec)
+	}
+
+	if msg.Expiry <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidLimitOrder, "expiry %d", msg.Expiry)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// GetExpiry returns the time at which the order is cancelled if unfilled
+func (msg MsgPlaceLimitOrder) GetExpiry() time.Time {
+	return time.Unix(msg.Expiry, 0)
+}
+
+// NewMsgCancelLimitOrder returns a new MsgCancelLimitOrder
+func NewMsgCancelLimitOrder(owner string, id uint64) *MsgCancelLimitOrder {
+	return &MsgCancelLimitOrder{
+		Owner: owner,
+		ID:    id,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCancelLimitOrder) Type() string { return TypeMsgCancelLimitOrder }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	if msg.Owner == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if msg.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalidLimitOrder, "id must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
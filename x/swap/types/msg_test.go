@@ -763,3 +763,356 @@ func TestMsgSwapForExactTokens_Deadline(t *testing.T) {
 		assert.Equal(t, time.Unix(tc.deadline, 0), msg.GetDeadline())
 	}
 }
+
+func TestMsgSwapExactForTokensRouted_Attributes(t *testing.T) {
+	msg := types.MsgSwapExactForTokensRouted{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_exact_for_tokens_routed", msg.Type())
+}
+
+func TestMsgSwapExactForTokensRouted_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgSwapExactForTokensRouted","value":{"deadline":"1623606299","exact_token_a":{"amount":"1000000","denom":"ukava"},"path":["ukava","usdx","hard"],"requester":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","slippage":"0.010000000000000000","token_b":{"amount":"5000000","denom":"hard"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgSwapExactForTokensRouted(addr.String(), sdk.NewCoin("ukava", sdk.NewInt(1e6)), sdk.NewCoin("hard", sdk.NewInt(5e6)), []string{"ukava", "usdx", "hard"}, sdk.MustNewDecFromStr("0.01"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgSwapExactForTokensRouted_Validation(t *testing.T) {
+	validMsg := types.NewMsgSwapExactForTokensRouted(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+		sdk.NewCoin("hard", sdk.NewInt(5e6)),
+		[]string{"ukava", "usdx", "hard"},
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		requester   string
+		exactTokenA sdk.Coin
+		tokenB      sdk.Coin
+		path        []string
+		slippage    sdk.Dec
+		deadline    int64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			requester:   sdk.AccAddress("").String(),
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "requester address cannot be empty: invalid address",
+		},
+		{
+			name:        "zero token a",
+			requester:   validMsg.Requester,
+			exactTokenA: sdk.Coin{Denom: "ukava", Amount: sdk.NewInt(0)},
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "exact token a deposit amount 0ukava: invalid coins",
+		},
+		{
+			name:        "zero token b",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      sdk.Coin{Denom: "hard", Amount: sdk.NewInt(0)},
+			path:        validMsg.Path,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "token b deposit amount 0hard: invalid coins",
+		},
+		{
+			name:        "empty path",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        []string{},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "path must contain at least two denoms: invalid path",
+		},
+		{
+			name:        "path does not start with token a",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        []string{"usdx", "hard"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "path must start with input denom ukava: invalid path",
+		},
+		{
+			name:        "path does not end with token b",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        []string{"ukava", "usdx"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "path must end with output denom hard: invalid path",
+		},
+		{
+			name:        "path with duplicate denoms",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        []string{"ukava", "usdx", "ukava", "hard"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "duplicate denom ukava: invalid path",
+		},
+		{
+			name:        "denoms can not be the same",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      sdk.Coin{Denom: "ukava", Amount: sdk.NewInt(1e6)},
+			path:        []string{"ukava", "ukava"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "duplicate denom ukava: invalid path",
+		},
+		{
+			name:        "negative slippage",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    sdk.MustNewDecFromStr("-0.01"),
+			deadline:    validMsg.Deadline,
+			expectedErr: "slippage can not be negative: invalid slippage",
+		},
+		{
+			name:        "zero deadline",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    validMsg.Slippage,
+			deadline:    0,
+			expectedErr: "deadline 0: invalid deadline",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapExactForTokensRouted(tc.requester, tc.exactTokenA, tc.tokenB, tc.path, tc.slippage, tc.deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgSwapForExactTokensRouted_Attributes(t *testing.T) {
+	msg := types.MsgSwapForExactTokensRouted{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_for_exact_tokens_routed", msg.Type())
+}
+
+func TestMsgSwapForExactTokensRouted_Validation(t *testing.T) {
+	validMsg := types.NewMsgSwapForExactTokensRouted(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+		sdk.NewCoin("hard", sdk.NewInt(5e6)),
+		[]string{"ukava", "usdx", "hard"},
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		tokenA      sdk.Coin
+		exactTokenB sdk.Coin
+		path        []string
+		expectedErr string
+	}{
+		{
+			name:        "zero token a",
+			tokenA:      sdk.Coin{Denom: "ukava", Amount: sdk.NewInt(0)},
+			exactTokenB: validMsg.ExactTokenB,
+			path:        validMsg.Path,
+			expectedErr: "token a deposit amount 0ukava: invalid coins",
+		},
+		{
+			name:        "zero exact token b",
+			tokenA:      validMsg.TokenA,
+			exactTokenB: sdk.Coin{Denom: "hard", Amount: sdk.NewInt(0)},
+			path:        validMsg.Path,
+			expectedErr: "exact token b deposit amount 0hard: invalid coins",
+		},
+		{
+			name:        "path does not end with exact token b",
+			tokenA:      validMsg.TokenA,
+			exactTokenB: validMsg.ExactTokenB,
+			path:        []string{"ukava", "usdx"},
+			expectedErr: "path must end with output denom hard: invalid path",
+		},
+		{
+			name:        "path with invalid denom",
+			tokenA:      validMsg.TokenA,
+			exactTokenB: validMsg.ExactTokenB,
+			path:        []string{"ukava", "", "hard"},
+			expectedErr: "invalid denom: : invalid path",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapForExactTokensRouted(validMsg.Requester, tc.tokenA, tc.exactTokenB, tc.path, validMsg.Slippage, validMsg.Deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgPlaceLimitOrder_Attributes(t *testing.T) {
+	msg := types.MsgPlaceLimitOrder{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_place_limit_order", msg.Type())
+}
+
+func TestMsgPlaceLimitOrder_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgPlaceLimitOrder","value":{"denom_out":"usdx","expiry":"1623606299","limit_price":"5.250000000000000000","owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","token_in":{"amount":"1000000","denom":"ukava"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgPlaceLimitOrder(addr.String(), sdk.NewCoin("ukava", sdk.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.25"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+	assert.Equal(t, time.Unix(1623606299, 0), msg.GetExpiry())
+}
+
+func TestMsgPlaceLimitOrder_Validation(t *testing.T) {
+	validMsg := types.NewMsgPlaceLimitOrder(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdk.NewInt(1e6)),
+		"usdx",
+		sdk.MustNewDecFromStr("5.25"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		owner       string
+		tokenIn     sdk.Coin
+		denomOut    string
+		limitPrice  sdk.Dec
+		expiry      int64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			owner:       sdk.AccAddress("").String(),
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			limitPrice:  validMsg.LimitPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "owner address cannot be empty: invalid address",
+		},
+		{
+			name:        "zero token in",
+			owner:       validMsg.Owner,
+			tokenIn:     sdk.Coin{Denom: "ukava", Amount: sdk.NewInt(0)},
+			denomOut:    validMsg.DenomOut,
+			limitPrice:  validMsg.LimitPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "token in amount 0ukava: invalid coins",
+		},
+		{
+			name:        "invalid denom out",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    "",
+			limitPrice:  validMsg.LimitPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "denom out: invalid denom: : invalid coins",
+		},
+		{
+			name:        "equal denoms",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    "ukava",
+			limitPrice:  validMsg.LimitPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "denominations can not be equal: invalid coins",
+		},
+		{
+			name:        "zero limit price",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			limitPrice:  sdk.ZeroDec(),
+			expiry:      validMsg.Expiry,
+			expectedErr: "limit price must be positive: invalid limit order",
+		},
+		{
+			name:        "nil limit price",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			limitPrice:  sdk.Dec{},
+			expiry:      validMsg.Expiry,
+			expectedErr: "limit price must be positive: invalid limit order",
+		},
+		{
+			name:        "zero expiry",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			limitPrice:  validMsg.LimitPrice,
+			expiry:      0,
+			expectedErr: "expiry 0: invalid limit order",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgPlaceLimitOrder(tc.owner, tc.tokenIn, tc.denomOut, tc.limitPrice, tc.expiry)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgCancelLimitOrder_Attributes(t *testing.T) {
+	msg := types.MsgCancelLimitOrder{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_cancel_limit_order", msg.Type())
+}
+
+func TestMsgCancelLimitOrder_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgCancelLimitOrder","value":{"id":"12","owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgCancelLimitOrder(addr.String(), 12)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgCancelLimitOrder_Validation(t *testing.T) {
+	require.NoError(t, types.NewMsgCancelLimitOrder(sdk.AccAddress("test1").String(), 1).ValidateBasic())
+
+	err := types.NewMsgCancelLimitOrder(sdk.AccAddress("").String(), 1).ValidateBasic()
+	assert.EqualError(t, err, "owner address cannot be empty: invalid address")
+
+	err = types.NewMsgCancelLimitOrder(sdk.AccAddress("test1").String(), 0).ValidateBasic()
+	assert.EqualError(t, err, "id must be positive: invalid limit order")
+}
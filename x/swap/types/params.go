@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -10,18 +11,49 @@ import (
 
 // Parameter keys and default values
 var (
-	KeyAllowedPools     = []byte("AllowedPools")
-	KeySwapFee          = []byte("SwapFee")
-	DefaultAllowedPools = AllowedPools{}
-	DefaultSwapFee      = sdk.ZeroDec()
-	MaxSwapFee          = sdk.OneDec()
+	KeyAllowedPools               = []byte("AllowedPools")
+	KeySwapFee                    = []byte("SwapFee")
+	KeyProtocolFeeFraction        = []byte("ProtocolFeeFraction")
+	KeyProtocolFeeDestination     = []byte("ProtocolFeeDestination")
+	KeyMinLimitOrderSize          = []byte("MinLimitOrderSize")
+	KeyMaxLimitOrderDuration      = []byte("MaxLimitOrderDuration")
+	KeyMaxLimitOrderFillsPerBlock = []byte("MaxLimitOrderFillsPerBlock")
+	KeyAmplificationRampDuration  = []byte("AmplificationRampDuration")
+	DefaultAllowedPools           = AllowedPools{}
+	DefaultSwapFee                = sdk.ZeroDec()
+	DefaultProtocolFeeFraction    = sdk.ZeroDec()
+	DefaultProtocolFeeDestination = PROTOCOL_FEE_DESTINATION_UNSPECIFIED
+	DefaultMinLimitOrderSize      = sdk.MustNewDecFromStr("0.0001")
+	DefaultMaxLimitOrderDuration  = uint64((30 * 24 * time.Hour).Seconds())
+	DefaultMaxLimitOrderFills     = uint64(100)
+	DefaultAmplificationRamp      = uint64((24 * time.Hour).Seconds())
+	MaxAmplificationRamp          = uint64((365 * 24 * time.Hour).Seconds())
+	MaxSwapFee                    = sdk.OneDec()
+	MaxProtocolFeeFraction        = sdk.OneDec()
 )
 
-// NewParams returns a new params object
+// NewParams returns a new params object with the default protocol fee settings
 func NewParams(pairs AllowedPools, swapFee sdk.Dec) Params {
+	return NewParamsWithProtocolFee(pairs, swapFee, DefaultProtocolFeeFraction, DefaultProtocolFeeDestination)
+}
+
+// NewParamsWithProtocolFee returns a new params object that pays a fraction of each swap fee to a
+// protocol fee destination
+func NewParamsWithProtocolFee(
+	pairs AllowedPools,
+	swapFee sdk.Dec,
+	protocolFeeFraction sdk.Dec,
+	protocolFeeDestination ProtocolFeeDestination,
+) Params {
 	return Params{
-		AllowedPools: pairs,
-		SwapFee:      swapFee,
+		AllowedPools:               pairs,
+		SwapFee:                    swapFee,
+		ProtocolFeeFraction:        protocolFeeFraction,
+		ProtocolFeeDestination:     protocolFeeDestination,
+		MinLimitOrderSize:          DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  DefaultAmplificationRamp,
 	}
 }
 
@@ -37,8 +69,15 @@ func DefaultParams() Params {
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
 	AllowedPools: %s
-	SwapFee: %s`,
-		p.AllowedPools, p.SwapFee)
+	SwapFee: %s
+	ProtocolFeeFraction: %s
+	ProtocolFeeDestination: %s
+	MinLimitOrderSize: %s
+	MaxLimitOrderDuration: %d
+	MaxLimitOrderFillsPerBlock: %d
+	AmplificationRampDuration: %d`,
+		p.AllowedPools, p.SwapFee, p.ProtocolFeeFraction, p.ProtocolFeeDestination,
+		p.MinLimitOrderSize, p.MaxLimitOrderDuration, p.MaxLimitOrderFillsPerBlock, p.AmplificationRampDuration)
 }
 
 // ParamKeyTable for swap module.
@@ -51,6 +90,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedPools, &p.AllowedPools, validateAllowedPoolsParams),
 		paramtypes.NewParamSetPair(KeySwapFee, &p.SwapFee, validateSwapFee),
+		paramtypes.NewParamSetPair(KeyProtocolFeeFraction, &p.ProtocolFeeFraction, validateProtocolFeeFraction),
+		paramtypes.NewParamSetPair(KeyProtocolFeeDestination, &p.ProtocolFeeDestination, validateProtocolFeeDestination),
+		paramtypes.NewParamSetPair(KeyMinLimitOrderSize, &p.MinLimitOrderSize, validateMinLimitOrderSize),
+		paramtypes.NewParamSetPair(KeyMaxLimitOrderDuration, &p.MaxLimitOrderDuration, validateMaxLimitOrderDuration),
+		paramtypes.NewParamSetPair(KeyMaxLimitOrderFillsPerBlock, &p.MaxLimitOrderFillsPerBlock, validateMaxLimitOrderFillsPerBlock),
+		paramtypes.NewParamSetPair(KeyAmplificationRampDuration, &p.AmplificationRampDuration, validateAmplificationRampDuration),
 	}
 }
 
@@ -60,7 +105,31 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateSwapFee(p.SwapFee)
+	if err := validateSwapFee(p.SwapFee); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFeeFraction(p.ProtocolFeeFraction); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFeeDestination(p.ProtocolFeeDestination); err != nil {
+		return err
+	}
+
+	if err := validateMinLimitOrderSize(p.MinLimitOrderSize); err != nil {
+		return err
+	}
+
+	if err := validateMaxLimitOrderDuration(p.MaxLimitOrderDuration); err != nil {
+		return err
+	}
+
+	if err := validateMaxLimitOrderFillsPerBlock(p.MaxLimitOrderFillsPerBlock); err != nil {
+		return err
+	}
+
+	return validateAmplificationRampDuration(p.AmplificationRampDuration)
 }
 
 func validateAllowedPoolsParams(i interface{}) error {
@@ -85,6 +154,90 @@ func validateSwapFee(i interface{}) error {
 	return nil
 }
 
+func validateProtocolFeeFraction(i interface{}) error {
+	fraction, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GTE(MaxProtocolFeeFraction) {
+		return fmt.Errorf("invalid protocol fee fraction: %s", fraction)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeDestination(i interface{}) error {
+	destination, ok := i.(ProtocolFeeDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ProtocolFeeDestination_name[int32(destination)]; !ok {
+		return fmt.Errorf("invalid protocol fee destination: %d", destination)
+	}
+
+	return nil
+}
+
+func validateMinLimitOrderSize(i interface{}) error {
+	size, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if size.IsNil() || size.IsNegative() || size.GTE(sdk.OneDec()) {
+		return fmt.Errorf("invalid min limit order size: %s", size)
+	}
+
+	return nil
+}
+
+func validateMaxLimitOrderDuration(i interface{}) error {
+	duration, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration == 0 {
+		return fmt.Errorf("max limit order duration must be positive")
+	}
+
+	return nil
+}
+
+func validateMaxLimitOrderFillsPerBlock(i interface{}) error {
+	fills, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fills == 0 {
+		return fmt.Errorf("max limit order fills per block must be positive")
+	}
+
+	return nil
+}
+
+func validateAmplificationRampDuration(i interface{}) error {
+	duration, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration == 0 || duration > MaxAmplificationRamp {
+		return fmt.Errorf("amplification ramp duration must be between 1 and %d seconds, got %d", MaxAmplificationRamp, duration)
+	}
+
+	return nil
+}
+
+// IsCommunityPool returns true if protocol fees are paid to the community pool.  All other
+// destinations, including unspecified, pay protocol fees to the protocol fee module account.
+func (d ProtocolFeeDestination) IsCommunityPool() bool {
+	return d == PROTOCOL_FEE_DESTINATION_COMMUNITY_POOL
+}
+
 // NewAllowedPool returns a new AllowedPool object
 func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 	return AllowedPool{
@@ -93,6 +246,28 @@ func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 	}
 }
 
+// NewAllowedStableSwapPool returns a new AllowedPool object for a stable swap pool
+func NewAllowedStableSwapPool(tokenA, tokenB string, amplification uint64) AllowedPool {
+	return AllowedPool{
+		TokenA:        tokenA,
+		TokenB:        tokenB,
+		PoolType:      POOL_TYPE_STABLE_SWAP,
+		Amplification: amplification,
+	}
+}
+
+// WithSwapFee returns a copy of the allowed pool that overrides the global swap fee, which may be
+// overridden with zero to make the pool free to swap
+func (p AllowedPool) WithSwapFee(swapFee sdk.Dec) AllowedPool {
+	p.SwapFee = &swapFee
+	return p
+}
+
+// HasSwapFee returns true if the allowed pool overrides the global swap fee
+func (p AllowedPool) HasSwapFee() bool {
+	return p.SwapFee != nil
+}
+
 // Validate validates allowedPool attributes and returns an error if invalid
 func (p AllowedPool) Validate() error {
 	err := sdk.ValidateDenom(p.TokenA)
@@ -129,7 +304,13 @@ func (p AllowedPool) Validate() error {
 		)
 	}
 
-	return nil
+	if p.SwapFee != nil {
+		if err := validateSwapFee(*p.SwapFee); err != nil {
+			return fmt.Errorf("pool %s has %w", p.Name(), err)
+		}
+	}
+
+	return validatePoolType(p.PoolType, p.Amplification)
 }
 
 // Name returns the name for the allowed pool
@@ -139,11 +320,18 @@ func (p AllowedPool) Name() string {
 
 // String pretty prints the allowedPool
 func (p AllowedPool) String() string {
+	swapFee := "global"
+	if p.SwapFee != nil {
+		swapFee = p.SwapFee.String()
+	}
 	return fmt.Sprintf(`AllowedPool:
   Name: %s
 	Token A: %s
 	Token B: %s
-`, p.Name(), p.TokenA, p.TokenB)
+	Pool Type: %s
+	Amplification: %d
+	Swap Fee: %s
+`, p.Name(), p.TokenA, p.TokenB, p.PoolType, p.Amplification, swapFee)
 }
 
 // AllowedPools is a slice of AllowedPool
@@ -171,3 +359,26 @@ func (p AllowedPools) Validate() error {
 
 	return nil
 }
+
+// IsStableSwap returns true if the pool type uses the StableSwap invariant.  All other
+// pool types, including unspecified, use the constant product invariant.
+func (t PoolType) IsStableSwap() bool {
+	return t == POOL_TYPE_STABLE_SWAP
+}
+
+// validatePoolType returns an error if the pool type is unknown or the amplification is
+// invalid for the pool type
+func validatePoolType(poolType PoolType, amplification uint64) error {
+	if _, ok := PoolType_name[int32(poolType)]; !ok {
+		return fmt.Errorf("invalid pool type: %d", poolType)
+	}
+
+	if !poolType.IsStableSwap() {
+		if amplification != 0 {
+			return fmt.Errorf("amplification can only be set for stable swap pools, got %d", amplification)
+		}
+		return nil
+	}
+
+	return validateAmplification(amplification)
+}
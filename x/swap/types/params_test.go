@@ -52,8 +52,13 @@ func TestParams_MarshalYAML(t *testing.T) {
 	require.NoError(t, err)
 
 	p := types.Params{
-		AllowedPools: pools,
-		SwapFee:      fee,
+		AllowedPools:               pools,
+		SwapFee:                    fee,
+		ProtocolFeeFraction:        types.DefaultProtocolFeeFraction,
+		MinLimitOrderSize:          types.DefaultMinLimitOrderSize,
+		MaxLimitOrderDuration:      types.DefaultMaxLimitOrderDuration,
+		MaxLimitOrderFillsPerBlock: types.DefaultMaxLimitOrderFills,
+		AmplificationRampDuration:  types.DefaultAmplificationRamp,
 	}
 
 	data, err := yaml.Marshal(p)
@@ -178,6 +183,54 @@ func TestParams_Validation(t *testing.T) {
 			},
 			expectedErr: "invalid swap fee: 1.000000000000000000",
 		},
+		{
+			name: "nil protocol fee fraction",
+			key:  types.KeyProtocolFeeFraction,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeFraction = sdk.Dec{}
+			},
+			expectedErr: "invalid protocol fee fraction: <nil>",
+		},
+		{
+			name: "negative protocol fee fraction",
+			key:  types.KeyProtocolFeeFraction,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeFraction = sdk.NewDec(-1)
+			},
+			expectedErr: "invalid protocol fee fraction: -1.000000000000000000",
+		},
+		{
+			name: "protocol fee fraction less than 1",
+			key:  types.KeyProtocolFeeFraction,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeFraction = sdk.MustNewDecFromStr("0.999999999999999999")
+			},
+			expectedErr: "",
+		},
+		{
+			name: "1 protocol fee fraction",
+			key:  types.KeyProtocolFeeFraction,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeFraction = sdk.OneDec()
+			},
+			expectedErr: "invalid protocol fee fraction: 1.000000000000000000",
+		},
+		{
+			name: "community pool protocol fee destination",
+			key:  types.KeyProtocolFeeDestination,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeDestination = types.PROTOCOL_FEE_DESTINATION_COMMUNITY_POOL
+			},
+			expectedErr: "",
+		},
+		{
+			name: "unknown protocol fee destination",
+			key:  types.KeyProtocolFeeDestination,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeDestination = 3
+			},
+			expectedErr: "invalid protocol fee destination: 3",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -309,8 +362,121 @@ func TestAllowedPool_String(t *testing.T) {
   Name: hard:ukava
 	Token A: hard
 	Token B: ukava
+	Pool Type: POOL_TYPE_UNSPECIFIED
+	Amplification: 0
+	Swap Fee: global
 `
 	assert.Equal(t, output, allowedPool.String())
+
+	allowedPool = allowedPool.WithSwapFee(sdk.ZeroDec())
+	assert.Contains(t, allowedPool.String(), "Swap Fee: 0.000000000000000000")
+}
+
+func TestAllowedPool_PoolType(t *testing.T) {
+	testCases := []struct {
+		name        string
+		allowedPool types.AllowedPool
+		expectedErr string
+	}{
+		{
+			name:        "unspecified",
+			allowedPool: types.NewAllowedPool("hard", "ukava"),
+		},
+		{
+			name:        "constant product",
+			allowedPool: types.AllowedPool{TokenA: "hard", TokenB: "ukava", PoolType: types.POOL_TYPE_CONSTANT_PRODUCT},
+		},
+		{
+			name:        "stable swap",
+			allowedPool: types.NewAllowedStableSwapPool("usdc", "usdx", 100),
+		},
+		{
+			name:        "unknown pool type",
+			allowedPool: types.AllowedPool{TokenA: "hard", TokenB: "ukava", PoolType: 3},
+			expectedErr: "invalid pool type: 3",
+		},
+		{
+			name:        "constant product with amplification",
+			allowedPool: types.AllowedPool{TokenA: "hard", TokenB: "ukava", PoolType: types.POOL_TYPE_CONSTANT_PRODUCT, Amplification: 100},
+			expectedErr: "amplification can only be set for stable swap pools, got 100",
+		},
+		{
+			name:        "stable swap without amplification",
+			allowedPool: types.NewAllowedStableSwapPool("usdc", "usdx", 0),
+			expectedErr: "amplification must be between 1 and 1000000, got 0",
+		},
+		{
+			name:        "stable swap with amplification too large",
+			allowedPool: types.NewAllowedStableSwapPool("usdc", "usdx", 1000001),
+			expectedErr: "amplification must be between 1 and 1000000, got 1000001",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.allowedPool.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAllowedPool_SwapFee(t *testing.T) {
+	testCases := []struct {
+		name            string
+		allowedPool     types.AllowedPool
+		expectedSwapFee bool
+		expectedErr     string
+	}{
+		{
+			name:        "default",
+			allowedPool: types.NewAllowedPool("hard", "ukava"),
+		},
+		{
+			name:        "nil",
+			allowedPool: types.AllowedPool{TokenA: "hard", TokenB: "ukava"},
+		},
+		{
+			name:            "override",
+			allowedPool:     types.NewAllowedPool("hard", "ukava").WithSwapFee(sdk.MustNewDecFromStr("0.01")),
+			expectedSwapFee: true,
+		},
+		{
+			name:            "stable swap override",
+			allowedPool:     types.NewAllowedStableSwapPool("usdc", "usdx", 100).WithSwapFee(sdk.MustNewDecFromStr("0.0001")),
+			expectedSwapFee: true,
+		},
+		{
+			name:            "zero override",
+			allowedPool:     types.NewAllowedPool("hard", "ukava").WithSwapFee(sdk.ZeroDec()),
+			expectedSwapFee: true,
+		},
+		{
+			name:        "negative",
+			allowedPool: types.NewAllowedPool("hard", "ukava").WithSwapFee(sdk.NewDec(-1)),
+			expectedErr: "pool hard:ukava has invalid swap fee: -1.000000000000000000",
+		},
+		{
+			name:        "1",
+			allowedPool: types.NewAllowedPool("hard", "ukava").WithSwapFee(sdk.OneDec()),
+			expectedErr: "pool hard:ukava has invalid swap fee: 1.000000000000000000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.allowedPool.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSwapFee, tc.allowedPool.HasSwapFee())
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
 }
 
 func TestAllowedPool_Name(t *testing.T) {
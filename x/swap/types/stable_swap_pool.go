@@ -0,0 +1,362 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxAmplification is the largest amplification coefficient a stable swap pool may use
+	MaxAmplification = 1_000_000
+	// stableSwapMaxIterations bounds the newton iterations used to solve the stable swap invariant
+	stableSwapMaxIterations = 255
+)
+
+// StableSwapPool implements a unitless two asset liquidity pool using the StableSwap invariant
+//
+//	4A(x+y) + D = 4AD + D^3/(4xy)
+//
+// where A is the amplification coefficient and D is the invariant.  For large A the pool behaves like
+// a constant sum pool near balanced reserves, and as A approaches zero it behaves like a constant product
+// pool, providing deep liquidity for assets expected to trade near a 1:1 price.
+//
+// Liquidity is always added and removed in the ratio of the reserves, so deposits, withdraws and share
+// accounting are shared with the constant product BasePool, and only swaps use the StableSwap invariant.
+type StableSwapPool struct {
+	*BasePool
+	amplification sdk.Int
+}
+
+// NewStableSwapPool returns a pointer to a stable swap pool with reserves and total shares initialized
+func NewStableSwapPool(reservesA, reservesB sdk.Int, amplification uint64) (*StableSwapPool, error) {
+	if err := validateAmplification(amplification); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidPool, err.Error())
+	}
+
+	pool, err := NewBasePool(reservesA, reservesB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StableSwapPool{
+		BasePool:      pool,
+		amplification: sdk.NewIntFromUint64(amplification),
+	}, nil
+}
+
+// NewStableSwapPoolWithExistingShares returns a pointer to a stable swap pool with existing shares
+func NewStableSwapPoolWithExistingShares(reservesA, reservesB, totalShares sdk.Int, amplification uint64) (*StableSwapPool, error) {
+	if err := validateAmplification(amplification); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidPool, err.Error())
+	}
+
+	pool, err := NewBasePoolWithExistingShares(reservesA, reservesB, totalShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StableSwapPool{
+		BasePool:      pool,
+		amplification: sdk.NewIntFromUint64(amplification),
+	}, nil
+}
+
+// Invariant returns the StableSwap invariant D of the current reserves
+func (p *StableSwapPool) Invariant() sdk.Int {
+	return sdk.NewIntFromBigInt(p.invariant())
+}
+
+// invariant returns the StableSwap invariant D of the reserves
+func (p *StableSwapPool) invariant() *big.Int {
+	return p.calculateInvariant(p.reservesA.BigInt(), p.reservesB.BigInt())
+}
+
+// SpotPriceA returns the marginal price of a denominated in b
+func (p *StableSwapPool) SpotPriceA() sdk.Dec {
+	return p.calculateSpotPrice(p.reservesA.BigInt(), p.reservesB.BigInt())
+}
+
+// SpotPriceB returns the marginal price of b denominated in a
+func (p *StableSwapPool) SpotPriceB() sdk.Dec {
+	return p.calculateSpotPrice(p.reservesB.BigInt(), p.reservesA.BigInt())
+}
+
+// SwapExactAForB trades an exact value of a for b.  Returns the positive amount b
+// that is removed from the pool and the portion of a that is used for paying the fee.
+func (p *StableSwapPool) SwapExactAForB(a sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int) {
+	b, feeValue := p.calculateOutputForExactInput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return b, feeValue
+}
+
+// SwapExactBForA trades an exact value of b for a.  Returns the positive amount a
+// that is removed from the pool and the portion of b that is used for paying the fee.
+func (p *StableSwapPool) SwapExactBForA(b sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int) {
+	a, feeValue := p.calculateOutputForExactInput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return a, feeValue
+}
+
+// SwapAForExactB trades a for an exact b.  Returns the positive amount a
+// that is added to the pool, and the portion of a that is used to pay the fee.
+func (p *StableSwapPool) SwapAForExactB(b sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int) {
+	a, feeValue := p.calculateInputForExactOutput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return a, feeValue
+}
+
+// SwapBForExactA trades b for an exact a.  Returns the positive amount b
+// that is added to the pool, and the portion of b that is used to pay the fee.
+func (p *StableSwapPool) SwapBForExactA(a sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int) {
+	b, feeValue := p.calculateInputForExactOutput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return b, feeValue
+}
+
+// calculateOutputForExactInput calculates the output amount of a swap using a fixed input, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The fee is calculated the same as the constant product pool.  The output is the largest amount that keeps
+// the reserves after the swap on or above the curve of the current invariant, ensuring the invariant is always
+// greater than or equal to the previous invariant.
+func (p *StableSwapPool) calculateOutputForExactInput(in, inReserves, outReserves sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int) {
+	p.assertSwapInputIsValid(in)
+	p.assertFeeIsValid(fee)
+
+	inAfterFee := sdk.NewDecFromInt(in).Mul(sdk.OneDec().Sub(fee)).TruncateInt()
+
+	d := p.calculateCeilInvariant(inReserves.BigInt(), outReserves.BigInt())
+	newOutReserves := p.calculateReserves(inReserves.Add(inAfterFee).BigInt(), d)
+
+	out := outReserves.Sub(sdk.NewIntFromBigInt(newOutReserves))
+	if out.IsNegative() {
+		out = sdk.ZeroInt()
+	}
+	feeValue := in.Sub(inAfterFee)
+
+	return out, feeValue
+}
+
+// calculateInputForExactOutput calculates the input amount of a swap using a fixed output, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The fee is calculated the same as the constant product pool.  The input is the smallest amount that keeps
+// the reserves after the swap on or above the curve of the current invariant, ensuring the invariant is always
+// greater than or equal to the previous invariant.
+func (p *StableSwapPool) calculateInputForExactOutput(out, outReserves, inReserves sdk.Int, fee sdk.Dec) (sdk.Int, sdk.Int) {
+	p.assertSwapOutputIsValid(out, outReserves)
+	p.assertFeeIsValid(fee)
+
+	d := p.calculateCeilInvariant(inReserves.BigInt(), outReserves.BigInt())
+	newInReserves := p.calculateReserves(outReserves.Sub(out).BigInt(), d)
+
+	inWithoutFee := sdk.NewIntFromBigInt(newInReserves).Sub(inReserves)
+	if !inWithoutFee.IsPositive() {
+		inWithoutFee = sdk.OneInt()
+	}
+
+	in := sdk.NewDecFromInt(inWithoutFee).Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt()
+	feeValue := in.Sub(inWithoutFee)
+
+	return in, feeValue
+}
+
+// calculateInvariant returns the invariant D of reserves x and y, rounded down.
+//
+// D is approximated using newton's method, starting from D = x + y and iterating
+//
+//	D' = (4A(x+y) + 2Dp) * D / ((4A-1) * D + 3Dp)
+//
+// where Dp = D^3/(4xy), then adjusted to the largest integer on or below the curve.  Panics if the
+// iterations do not converge.
+func (p *StableSwapPool) calculateInvariant(x, y *big.Int) *big.Int {
+	sum := new(big.Int).Add(x, y)
+	if sum.Sign() == 0 {
+		return sum
+	}
+
+	ann := new(big.Int).Mul(p.amplification.BigInt(), big.NewInt(4))
+	annSum := new(big.Int).Mul(ann, sum)
+	annMinusOne := new(big.Int).Sub(ann, big.NewInt(1))
+
+	d := new(big.Int).Set(sum)
+	for i := 0; ; i++ {
+		if i == stableSwapMaxIterations {
+			panic(fmt.Sprintf("invalid state: invariant did not converge for reserves %s and %s", x, y))
+		}
+
+		// dp = d^3 / (4xy), calculated in steps to limit the size of intermediate values
+		dp := new(big.Int).Set(d)
+		dp.Mul(dp, d).Quo(dp, new(big.Int).Mul(x, big.NewInt(2)))
+		dp.Mul(dp, d).Quo(dp, new(big.Int).Mul(y, big.NewInt(2)))
+
+		numerator := new(big.Int).Mul(dp, big.NewInt(2))
+		numerator.Add(numerator, annSum).Mul(numerator, d)
+
+		denominator := new(big.Int).Mul(annMinusOne, d)
+		denominator.Add(denominator, new(big.Int).Mul(dp, big.NewInt(3)))
+
+		prevD := d
+		d = numerator.Quo(numerator, denominator)
+
+		if withinOne(d, prevD) {
+			break
+		}
+	}
+
+	one := big.NewInt(1)
+	for p.curveSign(x, y, d) < 0 {
+		d.Sub(d, one)
+	}
+	for p.curveSign(x, y, new(big.Int).Add(d, one)) >= 0 {
+		d.Add(d, one)
+	}
+
+	return d
+}
+
+// calculateCeilInvariant returns the invariant D of reserves x and y, rounded up
+func (p *StableSwapPool) calculateCeilInvariant(x, y *big.Int) *big.Int {
+	d := p.calculateInvariant(x, y)
+	if p.curveSign(x, y, d) > 0 {
+		d.Add(d, big.NewInt(1))
+	}
+
+	return d
+}
+
+// calculateReserves returns the smallest reserves y paired with reserves x that are on or above
+// the curve of the invariant D.
+//
+// y is approximated using newton's method, starting from y = D and iterating
+//
+//	y' = (y^2 + c) / (2y + b - D)
+//
+// where c = D^3/(16Ax) and b = x + D/4A, then adjusted to the smallest integer on or above the curve.
+// Panics if the iterations do not converge.
+func (p *StableSwapPool) calculateReserves(x, d *big.Int) *big.Int {
+	ann := new(big.Int).Mul(p.amplification.BigInt(), big.NewInt(4))
+
+	c := new(big.Int).Set(d)
+	c.Mul(c, d).Quo(c, new(big.Int).Mul(x, big.NewInt(2)))
+	c.Mul(c, d).Quo(c, new(big.Int).Mul(ann, big.NewInt(2)))
+
+	b := new(big.Int).Quo(d, ann)
+	b.Add(b, x)
+
+	y := new(big.Int).Set(d)
+	for i := 0; ; i++ {
+		if i == stableSwapMaxIterations {
+			panic(fmt.Sprintf("invalid state: reserves did not converge for reserves %s and invariant %s", x, d))
+		}
+
+		numerator := new(big.Int).Mul(y, y)
+		numerator.Add(numerator, c)
+
+		denominator := new(big.Int).Mul(y, big.NewInt(2))
+		denominator.Add(denominator, b).Sub(denominator, d)
+
+		prevY := y
+		y = numerator.Quo(numerator, denominator)
+
+		if withinOne(y, prevY) {
+			break
+		}
+	}
+
+	one := big.NewInt(1)
+	if y.Sign() <= 0 {
+		y.SetInt64(1)
+	}
+	for p.curveSign(x, y, d) < 0 {
+		y.Add(y, one)
+	}
+	for y.Cmp(one) > 0 && p.curveSign(x, new(big.Int).Sub(y, one), d) >= 0 {
+		y.Sub(y, one)
+	}
+
+	return y
+}
+
+// calculateSpotPrice returns the marginal price of reserves x denominated in reserves y, the ratio of
+// the partial derivatives of the invariant
+//
+//	dy/dx = y(16Ax^2y + D^3) / x(16Axy^2 + D^3)
+func (p *StableSwapPool) calculateSpotPrice(x, y *big.Int) sdk.Dec {
+	d := p.calculateInvariant(x, y)
+	d3 := new(big.Int).Mul(d, d)
+	d3.Mul(d3, d)
+
+	a16xy := new(big.Int).Mul(p.amplification.BigInt(), big.NewInt(16))
+	a16xy.Mul(a16xy, x).Mul(a16xy, y)
+
+	numerator := new(big.Int).Mul(a16xy, x)
+	numerator.Add(numerator, d3).Mul(numerator, y)
+
+	denominator := new(big.Int).Mul(a16xy, y)
+	denominator.Add(denominator, d3).Mul(denominator, x)
+
+	return sdk.NewDecFromBigInt(numerator).Quo(sdk.NewDecFromBigInt(denominator))
+}
+
+// curveSign returns the sign of 4xy(4A(x+y) + D - 4AD) - D^3, which is positive when the reserves x
+// and y are above the curve of the invariant D, zero when on the curve, and negative when below.
+func (p *StableSwapPool) curveSign(x, y, d *big.Int) int {
+	ann := new(big.Int).Mul(p.amplification.BigInt(), big.NewInt(4))
+
+	lhs := new(big.Int).Add(x, y)
+	lhs.Mul(lhs, ann).Add(lhs, d).Sub(lhs, new(big.Int).Mul(ann, d))
+	lhs.Mul(lhs, x).Mul(lhs, y).Mul(lhs, big.NewInt(4))
+
+	rhs := new(big.Int).Mul(d, d)
+	rhs.Mul(rhs, d)
+
+	return lhs.Cmp(rhs)
+}
+
+// assertInvariantAndUpdateReserves asserts the StableSwap invariant is not violated, subtracting
+// any fees first, then updates the pool reserves.  Panics if invariant is violated.
+func (p *StableSwapPool) assertInvariantAndUpdateReserves(newReservesA, feeA, newReservesB, feeB sdk.Int) {
+	invariant := p.calculateInvariant(p.reservesA.BigInt(), p.reservesB.BigInt())
+	newInvariant := p.calculateInvariant(newReservesA.Sub(feeA).BigInt(), newReservesB.Sub(feeB).BigInt())
+
+	p.assertInvariant(invariant, newInvariant)
+
+	p.reservesA = newReservesA
+	p.reservesB = newReservesB
+}
+
+// withinOne returns true if a and b differ by at most one
+func withinOne(a, b *big.Int) bool {
+	var diff big.Int
+	diff.Sub(a, b)
+	return diff.CmpAbs(big.NewInt(1)) <= 0
+}
+
+// validateAmplification returns an error if the amplification is not within the allowed range
+func validateAmplification(amplification uint64) error {
+	if amplification == 0 || amplification > MaxAmplification {
+		return fmt.Errorf("amplification must be between 1 and %d, got %d", MaxAmplification, amplification)
+	}
+
+	return nil
+}
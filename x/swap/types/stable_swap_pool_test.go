@@ -0,0 +1,219 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	types "github.com/kava-labs/kava/x/swap/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStableSwapPool_NewPool_Validation(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdk.Int
+		reservesB     sdk.Int
+		amplification uint64
+		expectedErr   string
+	}{
+		{i(1e6), i(1e6), 0, "amplification must be between 1 and 1000000, got 0: invalid pool"},
+		{i(1e6), i(1e6), 1000001, "amplification must be between 1 and 1000000, got 1000001: invalid pool"},
+		{i(0), i(1e6), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(-1), 100, "reserves must be greater than zero: invalid pool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d", tc.reservesA, tc.reservesB, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+
+			pool, err = types.NewStableSwapPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+		})
+	}
+}
+
+func TestStableSwapPool_Invariant(t *testing.T) {
+	testCases := []struct {
+		reservesA         sdk.Int
+		reservesB         sdk.Int
+		amplification     uint64
+		expectedInvariant sdk.Int
+	}{
+		// balanced pools have an invariant equal to the sum of reserves
+		{i(1e6), i(1e6), 1, i(2e6)},
+		{i(1e6), i(1e6), 100, i(2e6)},
+		{i(1e12), i(1e12), 1000000, i(2e12)},
+		// imbalanced pools approach the sum of reserves as amplification increases
+		{i(1e6), i(4e6), 1, i(4616685)},
+		{i(1e6), i(4e6), 100, i(4993032)},
+		{i(1e6), i(4e6), 1000000, i(4999999)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d", tc.reservesA, tc.reservesB, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedInvariant, pool.Invariant())
+
+			// the invariant of a constant product pool 2*sqrt(a*b) is a lower bound
+			assert.True(t, pool.Invariant().GTE(i(2).Mul(pool.TotalShares())))
+			// the invariant of a constant sum pool a+b is an upper bound
+			assert.True(t, pool.Invariant().LTE(tc.reservesA.Add(tc.reservesB)))
+		})
+	}
+}
+
+func TestStableSwapPool_SpotPrice(t *testing.T) {
+	testCases := []struct {
+		reservesA      sdk.Int
+		reservesB      sdk.Int
+		amplification  uint64
+		expectedPriceA sdk.Dec
+	}{
+		// balanced pools always price at parity
+		{i(1e6), i(1e6), 1, d("1")},
+		{i(1e6), i(1e6), 100, d("1")},
+		// imbalanced pools move from the constant product price towards parity as amplification increases
+		{i(1e6), i(4e6), 1, d("1.832951058380719801")},
+		{i(1e6), i(4e6), 100, d("1.014516694255705182")},
+		{i(1e6), i(4e6), 1000000, d("1.000001464842155839")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d", tc.reservesA, tc.reservesB, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedPriceA, pool.SpotPriceA())
+			assert.True(t, pool.SpotPriceA().GTE(sdk.OneDec()), "expected price of scarce reserves to be at least parity")
+			assert.True(t, pool.SpotPriceA().LTE(sdk.NewDecFromInt(tc.reservesB).QuoInt(tc.reservesA)), "expected price to be at most the constant product price")
+
+			pool, err = types.NewStableSwapPool(tc.reservesB, tc.reservesA, tc.amplification)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPriceA, pool.SpotPriceB(), "expected pool to be symmetric")
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_ExactInput(t *testing.T) {
+	testCases := []struct {
+		reservesA      sdk.Int
+		reservesB      sdk.Int
+		amplification  uint64
+		exactInput     sdk.Int
+		fee            sdk.Dec
+		expectedOutput sdk.Int
+		expectedFee    sdk.Int
+	}{
+		{i(1e6), i(1e6), 100, i(1000), d("0.003"), i(996), i(3)},
+		{i(1e6), i(1e6), 100, i(100e3), d("0.003"), i(99650), i(300)},
+		{i(1e6), i(1e6), 1, i(100e3), d("0.003"), i(96479), i(300)},
+		{i(1e6), i(4e6), 100, i(100e3), d("0.003"), i(101005), i(300)},
+		{i(100e9), i(100e9), 1000, i(10e9), d("0.0025"), i(9974497778), i(25000000)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d exactInput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactInput, tc.fee), func(t *testing.T) {
+			poolA, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			invariant := poolA.Invariant()
+			swapA, feeA := poolA.SwapExactAForB(tc.exactInput, tc.fee)
+
+			poolB, err := types.NewStableSwapPool(tc.reservesB, tc.reservesA, tc.amplification)
+			require.NoError(t, err)
+			swapB, feeB := poolB.SwapExactBForA(tc.exactInput, tc.fee)
+
+			// pool must be symmetric - if we swap reserves, then swap opposite direction
+			// then the results should be equal
+			require.Equal(t, swapA, swapB, "expected swap methods to have equal swap results")
+			require.Equal(t, feeA, feeB, "expected swap methods to have equal fee results")
+			require.Equal(t, poolA.ReservesA(), poolB.ReservesB(), "expected reserves A to be equal")
+			require.Equal(t, poolA.ReservesB(), poolB.ReservesA(), "expected reserves B to be equal")
+
+			assert.Equal(t, tc.expectedOutput, swapA, "returned swap not equal")
+			assert.Equal(t, tc.expectedFee, feeA, "returned fee not equal")
+
+			assert.Equal(t, tc.reservesA.Add(tc.exactInput), poolA.ReservesA(), "expected new reserves A not equal")
+			assert.Equal(t, tc.reservesB.Sub(tc.expectedOutput), poolA.ReservesB(), "expected new reserves B not equal")
+			assert.True(t, poolA.Invariant().GTE(invariant), "expected invariant to not decrease")
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_ExactOutput(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdk.Int
+		reservesB     sdk.Int
+		amplification uint64
+		exactOutput   sdk.Int
+		fee           sdk.Dec
+		expectedInput sdk.Int
+		expectedFee   sdk.Int
+	}{
+		{i(1e6), i(1e6), 100, i(996), d("0.003"), i(1000), i(3)},
+		{i(1e6), i(1e6), 100, i(99650), d("0.003"), i(100000), i(300)},
+		{i(1e6), i(1e6), 1, i(96479), d("0.003"), i(100000), i(300)},
+		{i(1e6), i(4e6), 100, i(101005), d("0.003"), i(100000), i(300)},
+		{i(100e9), i(100e9), 1000, i(9974497778), d("0.0025"), i(10e9), i(25000000)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d exactOutput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactOutput, tc.fee), func(t *testing.T) {
+			poolA, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			invariant := poolA.Invariant()
+			swapA, feeA := poolA.SwapAForExactB(tc.exactOutput, tc.fee)
+
+			poolB, err := types.NewStableSwapPool(tc.reservesB, tc.reservesA, tc.amplification)
+			require.NoError(t, err)
+			swapB, feeB := poolB.SwapBForExactA(tc.exactOutput, tc.fee)
+
+			// pool must be symmetric - if we swap reserves, then swap opposite direction
+			// then the results should be equal
+			require.Equal(t, swapA, swapB, "expected swap methods to have equal swap results")
+			require.Equal(t, feeA, feeB, "expected swap methods to have equal fee results")
+			require.Equal(t, poolA.ReservesA(), poolB.ReservesB(), "expected reserves A to be equal")
+			require.Equal(t, poolA.ReservesB(), poolB.ReservesA(), "expected reserves B to be equal")
+
+			assert.Equal(t, tc.expectedInput, swapA, "returned swap not equal")
+			assert.Equal(t, tc.expectedFee, feeA, "returned fee not equal")
+
+			assert.Equal(t, tc.reservesA.Add(tc.expectedInput), poolA.ReservesA(), "expected new reserves A not equal")
+			assert.Equal(t, tc.reservesB.Sub(tc.exactOutput), poolA.ReservesB(), "expected new reserves B not equal")
+			assert.True(t, poolA.Invariant().GTE(invariant), "expected invariant to not decrease")
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_LowerSlippageThanBasePool(t *testing.T) {
+	basePool, err := types.NewBasePool(i(1e12), i(1e12))
+	require.NoError(t, err)
+	stablePool, err := types.NewStableSwapPool(i(1e12), i(1e12), 100)
+	require.NoError(t, err)
+
+	baseOutput, _ := basePool.SwapExactAForB(i(100e9), d("0.0025"))
+	stableOutput, _ := stablePool.SwapExactAForB(i(100e9), d("0.0025"))
+
+	assert.True(t, stableOutput.GT(baseOutput), "expected stable swap output %s to be greater than constant product output %s", stableOutput, baseOutput)
+}
+
+func TestStableSwapPool_Liquidity(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(4e6), 100)
+	require.NoError(t, err)
+	assert.Equal(t, i(2e6), pool.TotalShares())
+
+	depositA, depositB, shares := pool.AddLiquidity(i(1e6), i(8e6))
+	assert.Equal(t, i(1e6), depositA)
+	assert.Equal(t, i(4e6), depositB)
+	assert.Equal(t, i(2e6), shares)
+
+	withdrawA, withdrawB := pool.RemoveLiquidity(i(1e6))
+	assert.Equal(t, i(5e5), withdrawA)
+	assert.Equal(t, i(2e6), withdrawB)
+	assert.Equal(t, i(3e6), pool.TotalShares())
+}
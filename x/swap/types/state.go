@@ -4,12 +4,19 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// PoolIDSep represents the separator used in pool ids to separate two denominations
-const PoolIDSep = ":"
+const (
+	// PoolIDSep represents the separator used in pool ids to separate two denominations
+	PoolIDSep = ":"
+
+	// MaxTWAPWindow is the longest window time weighted average prices may be calculated over, and bounds
+	// how long pool price observations are kept
+	MaxTWAPWindow = 24 * time.Hour
+)
 
 // PoolIDFromCoins returns a poolID from a coins object
 func PoolIDFromCoins(coins sdk.Coins) string {
@@ -50,10 +57,12 @@ func NewPoolRecordFromPool(pool *DenominatedPool) PoolRecord {
 	poolID := PoolIDFromCoins(reserves)
 
 	return PoolRecord{
-		PoolID:      poolID,
-		ReservesA:   reserves[0],
-		ReservesB:   reserves[1],
-		TotalShares: pool.TotalShares(),
+		PoolID:        poolID,
+		ReservesA:     reserves[0],
+		ReservesB:     reserves[1],
+		TotalShares:   pool.TotalShares(),
+		PoolType:      pool.PoolType(),
+		Amplification: pool.Amplification(),
 	}
 }
 
@@ -86,6 +95,22 @@ func (p PoolRecord) Validate() error {
 		return fmt.Errorf("pool '%s' has invalid total shares: %s", p.PoolID, p.TotalShares)
 	}
 
+	if err := validatePoolType(p.PoolType, p.Amplification); err != nil {
+		return fmt.Errorf("pool '%s' is invalid: %w", p.PoolID, err)
+	}
+
+	if p.AmplificationRamp != nil {
+		if !p.PoolType.IsStableSwap() {
+			return fmt.Errorf("pool '%s' is invalid: amplification ramp can only be set for stable swap pools", p.PoolID)
+		}
+		if err := validateAmplification(p.AmplificationRamp.FutureAmplification); err != nil {
+			return fmt.Errorf("pool '%s' has invalid amplification ramp: %w", p.PoolID, err)
+		}
+		if p.AmplificationRamp.EndTime.Before(p.AmplificationRamp.StartTime) {
+			return fmt.Errorf("pool '%s' has invalid amplification ramp: end time is before start time", p.PoolID)
+		}
+	}
+
 	return nil
 }
 
@@ -94,6 +119,34 @@ func (p PoolRecord) Reserves() sdk.Coins {
 	return sdk.NewCoins(p.ReservesA, p.ReservesB)
 }
 
+// AmplificationAt returns the amplification of a pool at a time.  During a ramp the amplification changes
+// linearly from the record amplification at the start time to the future amplification at the end time,
+// rounded towards the record amplification, and it remains at the future amplification after the end time.
+func (p PoolRecord) AmplificationAt(t time.Time) uint64 {
+	ramp := p.AmplificationRamp
+	if ramp == nil || !t.After(ramp.StartTime) {
+		return p.Amplification
+	}
+	if !t.Before(ramp.EndTime) {
+		return ramp.FutureAmplification
+	}
+
+	initial := sdk.NewIntFromUint64(p.Amplification)
+	change := sdk.NewIntFromUint64(ramp.FutureAmplification).Sub(initial)
+	elapsed := sdk.NewInt(int64(t.Sub(ramp.StartTime)))
+	duration := sdk.NewInt(int64(ramp.EndTime.Sub(ramp.StartTime)))
+
+	return initial.Add(change.Mul(elapsed).Quo(duration)).Uint64()
+}
+
+// TargetAmplification returns the amplification a pool remains at once any ramp has ended
+func (p PoolRecord) TargetAmplification() uint64 {
+	if p.AmplificationRamp == nil {
+		return p.Amplification
+	}
+	return p.AmplificationRamp.FutureAmplification
+}
+
 // PoolRecords is a slice of PoolRecord
 type PoolRecords []PoolRecord
 
@@ -177,3 +230,152 @@ func (srs ShareRecords) Validate() error {
 
 	return nil
 }
+
+// NewPriceObservation takes a poolID, block time and cumulative prices and returns
+// a new price observation for storage in state.
+func NewPriceObservation(poolID string, timestamp time.Time, priceACumulative, priceBCumulative sdk.Dec) PriceObservation {
+	return PriceObservation{
+		PoolID:           poolID,
+		Timestamp:        timestamp,
+		PriceACumulative: priceACumulative,
+		PriceBCumulative: priceBCumulative,
+	}
+}
+
+// Validate performs basic validation checks of the observation data
+func (o PriceObservation) Validate() error {
+	if o.PoolID == "" {
+		return errors.New("poolID must be set")
+	}
+
+	tokens := strings.Split(o.PoolID, PoolIDSep)
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" || tokens[1] < tokens[0] || tokens[0] == tokens[1] {
+		return fmt.Errorf("poolID '%s' is invalid", o.PoolID)
+	}
+	if sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
+		return fmt.Errorf("poolID '%s' is invalid", o.PoolID)
+	}
+
+	if o.Timestamp.IsZero() {
+		return fmt.Errorf("price observation for pool '%s' must have a timestamp", o.PoolID)
+	}
+
+	if o.PriceACumulative.IsNil() || o.PriceACumulative.IsNegative() {
+		return fmt.Errorf("pool '%s' has invalid cumulative price: %s", o.PoolID, o.PriceACumulative)
+	}
+
+	if o.PriceBCumulative.IsNil() || o.PriceBCumulative.IsNegative() {
+		return fmt.Errorf("pool '%s' has invalid cumulative price: %s", o.PoolID, o.PriceBCumulative)
+	}
+
+	return nil
+}
+
+// PriceObservations is a slice of PriceObservation
+type PriceObservations []PriceObservation
+
+// Validate performs basic validation checks on all observations in the slice
+func (pos PriceObservations) Validate() error {
+	seenObservations := make(map[string]bool)
+
+	for _, o := range pos {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		key := string(PriceObservationKey(o.PoolID, o.Timestamp))
+		if seenObservations[key] {
+			return fmt.Errorf("duplicate price observation for poolID '%s' at %s", o.PoolID, o.Timestamp)
+		}
+
+		seenObservations[key] = true
+	}
+
+	return nil
+}
+
+// NewLimitOrder returns a new limit order for storage in state
+func NewLimitOrder(id uint64, owner sdk.AccAddress, tokenIn sdk.Coin, denomOut string, limitPrice sdk.Dec, expiry time.Time) LimitOrder {
+	return LimitOrder{
+		ID:         id,
+		Owner:      owner,
+		PoolID:     PoolID(tokenIn.Denom, denomOut),
+		TokenIn:    tokenIn,
+		DenomOut:   denomOut,
+		LimitPrice: limitPrice,
+		Expiry:     expiry,
+	}
+}
+
+// Validate performs basic validation checks of the order data
+func (o LimitOrder) Validate() error {
+	if o.ID == 0 {
+		return errors.New("limit order id must be positive")
+	}
+
+	if o.Owner.Empty() {
+		return fmt.Errorf("limit order %d cannot have empty owner address", o.ID)
+	}
+
+	if !o.TokenIn.IsValid() || !o.TokenIn.IsPositive() {
+		return fmt.Errorf("limit order %d has invalid token in: %s", o.ID, o.TokenIn)
+	}
+
+	if err := sdk.ValidateDenom(o.DenomOut); err != nil {
+		return fmt.Errorf("limit order %d has invalid denom out: %w", o.ID, err)
+	}
+
+	if o.TokenIn.Denom == o.DenomOut {
+		return fmt.Errorf("limit order %d cannot buy and sell %s", o.ID, o.DenomOut)
+	}
+
+	if o.PoolID != PoolID(o.TokenIn.Denom, o.DenomOut) {
+		return fmt.Errorf("limit order %d poolID '%s' does not match denoms", o.ID, o.PoolID)
+	}
+
+	if o.LimitPrice.IsNil() || !o.LimitPrice.IsPositive() || !sdk.ValidSortableDec(o.LimitPrice) {
+		return fmt.Errorf("limit order %d has invalid limit price: %s", o.ID, o.LimitPrice)
+	}
+
+	if o.Expiry.IsZero() {
+		return fmt.Errorf("limit order %d must have an expiry", o.ID)
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the order can no longer be filled at the block time
+func (o LimitOrder) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(o.Expiry)
+}
+
+// LimitOrders is a slice of LimitOrder
+type LimitOrders []LimitOrder
+
+// Validate performs basic validation checks on all orders in the slice
+func (los LimitOrders) Validate() error {
+	seenIDs := make(map[uint64]bool)
+
+	for _, o := range los {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		if seenIDs[o.ID] {
+			return fmt.Errorf("duplicate limit order id %d", o.ID)
+		}
+
+		seenIDs[o.ID] = true
+	}
+
+	return nil
+}
+
+// Escrow returns the total coins escrowed by the orders
+func (los LimitOrders) Escrow() sdk.Coins {
+	escrow := sdk.NewCoins()
+	for _, o := range los {
+		escrow = escrow.Add(o.TokenIn)
+	}
+	return escrow
+}
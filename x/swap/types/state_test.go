@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	types "github.com/kava-labs/kava/x/swap/types"
 
@@ -261,6 +262,41 @@ func TestState_PoolRecord_Validations(t *testing.T) {
 	}
 }
 
+func TestState_PoolRecord_AmplificationAt(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	record := types.NewPoolRecord(sdk.NewCoins(usdx(500e6), ukava(100e6)), i(300e6))
+	record.PoolType = types.POOL_TYPE_STABLE_SWAP
+	record.Amplification = 100
+
+	assert.Equal(t, uint64(100), record.AmplificationAt(start))
+	assert.Equal(t, uint64(100), record.TargetAmplification())
+
+	record.AmplificationRamp = &types.AmplificationRamp{
+		FutureAmplification: 200,
+		StartTime:           start,
+		EndTime:             start.Add(24 * time.Hour),
+	}
+	require.NoError(t, record.Validate())
+	assert.Equal(t, uint64(200), record.TargetAmplification())
+
+	assert.Equal(t, uint64(100), record.AmplificationAt(start.Add(-time.Hour)))
+	assert.Equal(t, uint64(100), record.AmplificationAt(start))
+	assert.Equal(t, uint64(150), record.AmplificationAt(start.Add(12*time.Hour)))
+	assert.Equal(t, uint64(200), record.AmplificationAt(start.Add(24*time.Hour)))
+	assert.Equal(t, uint64(200), record.AmplificationAt(start.Add(48*time.Hour)))
+
+	// ramping down rounds towards the starting amplification
+	record.AmplificationRamp.FutureAmplification = 1
+	assert.Equal(t, uint64(51), record.AmplificationAt(start.Add(12*time.Hour)))
+
+	record.AmplificationRamp.EndTime = start.Add(-time.Second)
+	assert.EqualError(t, record.Validate(), "pool 'ukava:usdx' has invalid amplification ramp: end time is before start time")
+
+	record.PoolType = types.POOL_TYPE_CONSTANT_PRODUCT
+	record.Amplification = 0
+	assert.EqualError(t, record.Validate(), "pool 'ukava:usdx' is invalid: amplification ramp can only be set for stable swap pools")
+}
+
 func TestState_PoolRecord_OrderedReserves(t *testing.T) {
 	invalidOrder := types.NewPoolRecord(
 		// force order to not be sorted
@@ -528,3 +564,104 @@ func TestState_ShareRecords_ValidateUniqueShareRecords(t *testing.T) {
 	invalidRecords := types.ShareRecords{record_1, record_3, record_2, record_4}
 	assert.EqualError(t, invalidRecords.Validate(), "duplicate depositor 'kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w' and poolID 'ukava:usdx'")
 }
+
+func TestState_LimitOrder_Validations(t *testing.T) {
+	owner, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	validOrder := func() types.LimitOrder {
+		return types.NewLimitOrder(1, owner, ukava(1e6), "usdx", sdk.MustNewDecFromStr("5"), expiry)
+	}
+	assert.Equal(t, "ukava:usdx", validOrder().PoolID)
+
+	testCases := []struct {
+		name        string
+		modify      func(*types.LimitOrder)
+		expectedErr string
+	}{
+		{
+			name:        "valid order",
+			modify:      func(o *types.LimitOrder) {},
+			expectedErr: "",
+		},
+		{
+			name:        "zero id",
+			modify:      func(o *types.LimitOrder) { o.ID = 0 },
+			expectedErr: "limit order id must be positive",
+		},
+		{
+			name:        "empty owner",
+			modify:      func(o *types.LimitOrder) { o.Owner = sdk.AccAddress{} },
+			expectedErr: "limit order 1 cannot have empty owner address",
+		},
+		{
+			name:        "zero token in",
+			modify:      func(o *types.LimitOrder) { o.TokenIn = ukava(0) },
+			expectedErr: "limit order 1 has invalid token in: 0ukava",
+		},
+		{
+			name:        "same denoms",
+			modify:      func(o *types.LimitOrder) { o.DenomOut = "ukava" },
+			expectedErr: "limit order 1 cannot buy and sell ukava",
+		},
+		{
+			name:        "mismatched pool id",
+			modify:      func(o *types.LimitOrder) { o.PoolID = "hard:usdx" },
+			expectedErr: "limit order 1 poolID 'hard:usdx' does not match denoms",
+		},
+		{
+			name:        "negative limit price",
+			modify:      func(o *types.LimitOrder) { o.LimitPrice = sdk.NewDec(-1) },
+			expectedErr: "limit order 1 has invalid limit price: -1.000000000000000000",
+		},
+		{
+			name:        "zero expiry",
+			modify:      func(o *types.LimitOrder) { o.Expiry = time.Time{} },
+			expectedErr: "limit order 1 must have an expiry",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := validOrder()
+			tc.modify(&order)
+
+			err := order.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestState_LimitOrder_IsExpired(t *testing.T) {
+	owner, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	order := types.NewLimitOrder(1, owner, ukava(1e6), "usdx", sdk.MustNewDecFromStr("5"), expiry)
+
+	assert.False(t, order.IsExpired(expiry.Add(-time.Second)))
+	assert.True(t, order.IsExpired(expiry))
+	assert.True(t, order.IsExpired(expiry.Add(time.Second)))
+}
+
+func TestState_LimitOrders_Validation(t *testing.T) {
+	owner, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	order_1 := types.NewLimitOrder(1, owner, ukava(1e6), "usdx", sdk.MustNewDecFromStr("5"), expiry)
+	order_2 := types.NewLimitOrder(2, owner, usdx(5e6), "ukava", sdk.MustNewDecFromStr("0.2"), expiry)
+	duplicate := types.NewLimitOrder(1, owner, hard(1e6), "usdx", sdk.MustNewDecFromStr("2"), expiry)
+
+	orders := types.LimitOrders{order_1, order_2}
+	assert.NoError(t, orders.Validate())
+	assert.Equal(t, sdk.NewCoins(ukava(1e6), usdx(5e6)), orders.Escrow())
+
+	orders = append(orders, duplicate)
+	assert.EqualError(t, orders.Validate(), "duplicate limit order id 1")
+}
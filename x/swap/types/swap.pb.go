@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,12 +30,98 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProtocolFeeDestination defines where the protocol fees of swaps are paid
+type ProtocolFeeDestination int32
+
+const (
+	// PROTOCOL_FEE_DESTINATION_UNSPECIFIED pays protocol fees to the swap
+	// protocol fee module account
+	PROTOCOL_FEE_DESTINATION_UNSPECIFIED ProtocolFeeDestination = 0
+	// PROTOCOL_FEE_DESTINATION_MODULE_ACCOUNT pays protocol fees to the swap
+	// protocol fee module account
+	PROTOCOL_FEE_DESTINATION_MODULE_ACCOUNT ProtocolFeeDestination = 1
+	// PROTOCOL_FEE_DESTINATION_COMMUNITY_POOL pays protocol fees to the
+	// community pool
+	PROTOCOL_FEE_DESTINATION_COMMUNITY_POOL ProtocolFeeDestination = 2
+)
+
+var ProtocolFeeDestination_name = map[int32]string{
+	0: "PROTOCOL_FEE_DESTINATION_UNSPECIFIED",
+	1: "PROTOCOL_FEE_DESTINATION_MODULE_ACCOUNT",
+	2: "PROTOCOL_FEE_DESTINATION_COMMUNITY_POOL",
+}
+
+var ProtocolFeeDestination_value = map[string]int32{
+	"PROTOCOL_FEE_DESTINATION_UNSPECIFIED":    0,
+	"PROTOCOL_FEE_DESTINATION_MODULE_ACCOUNT": 1,
+	"PROTOCOL_FEE_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x ProtocolFeeDestination) String() string {
+	return proto.EnumName(ProtocolFeeDestination_name, int32(x))
+}
+
+func (ProtocolFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{0}
+}
+
+// PoolType defines the invariant used by a liquidity pool
+type PoolType int32
+
+const (
+	// POOL_TYPE_UNSPECIFIED represents a constant product pool, the type of all
+	// pools allowed before pool types were introduced
+	POOL_TYPE_UNSPECIFIED PoolType = 0
+	// POOL_TYPE_CONSTANT_PRODUCT represents a pool using the x*y=k invariant
+	POOL_TYPE_CONSTANT_PRODUCT PoolType = 1
+	// POOL_TYPE_STABLE_SWAP represents a pool using the StableSwap invariant,
+	// for assets expected to trade near a 1:1 price
+	POOL_TYPE_STABLE_SWAP PoolType = 2
+)
+
+var PoolType_name = map[int32]string{
+	0: "POOL_TYPE_UNSPECIFIED",
+	1: "POOL_TYPE_CONSTANT_PRODUCT",
+	2: "POOL_TYPE_STABLE_SWAP",
+}
+
+var PoolType_value = map[string]int32{
+	"POOL_TYPE_UNSPECIFIED":      0,
+	"POOL_TYPE_CONSTANT_PRODUCT": 1,
+	"POOL_TYPE_STABLE_SWAP":      2,
+}
+
+func (x PoolType) String() string {
+	return proto.EnumName(PoolType_name, int32(x))
+}
+
+func (PoolType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{1}
+}
+
 // Params defines the parameters for the swap module.
 type Params struct {
 	// allowed_pools defines that pools that are allowed to be created
 	AllowedPools AllowedPools `protobuf:"bytes,1,rep,name=allowed_pools,json=allowedPools,proto3,castrepeated=AllowedPools" json:"allowed_pools"`
-	// swap_fee defines the swap fee for all pools
+	// swap_fee defines the swap fee for all pools without a swap fee override
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
+	// protocol_fee_fraction defines the fraction of each swap fee that is paid
+	// to the protocol fee destination instead of liquidity providers
+	ProtocolFeeFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=protocol_fee_fraction,json=protocolFeeFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_fraction"`
+	// protocol_fee_destination defines where protocol fees are paid
+	ProtocolFeeDestination ProtocolFeeDestination `protobuf:"varint,4,opt,name=protocol_fee_destination,json=protocolFeeDestination,proto3,enum=kava.swap.v1beta1.ProtocolFeeDestination" json:"protocol_fee_destination,omitempty"`
+	// min_limit_order_size defines the minimum token in of a limit order, as a
+	// fraction of the pool reserves of the token
+	MinLimitOrderSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_limit_order_size,json=minLimitOrderSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_limit_order_size"`
+	// max_limit_order_duration defines the longest time in seconds a limit
+	// order can remain open after it is placed
+	MaxLimitOrderDuration uint64 `protobuf:"varint,6,opt,name=max_limit_order_duration,json=maxLimitOrderDuration,proto3" json:"max_limit_order_duration,omitempty"`
+	// max_limit_order_fills_per_block defines the maximum number of limit orders
+	// filled for each pool and direction each block
+	MaxLimitOrderFillsPerBlock uint64 `protobuf:"varint,7,opt,name=max_limit_order_fills_per_block,json=maxLimitOrderFillsPerBlock,proto3" json:"max_limit_order_fills_per_block,omitempty"`
+	// amplification_ramp_duration defines the time in seconds over which a
+	// stable swap pool ramps to a new allowed pool amplification
+	AmplificationRampDuration uint64 `protobuf:"varint,8,opt,name=amplification_ramp_duration,json=amplificationRampDuration,proto3" json:"amplification_ramp_duration,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,12 +163,49 @@ func (m *Params) GetAllowedPools() AllowedPools {
 	return nil
 }
 
+func (m *Params) GetProtocolFeeDestination() ProtocolFeeDestination {
+	if m != nil {
+		return m.ProtocolFeeDestination
+	}
+	return PROTOCOL_FEE_DESTINATION_UNSPECIFIED
+}
+
+func (m *Params) GetMaxLimitOrderDuration() uint64 {
+	if m != nil {
+		return m.MaxLimitOrderDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLimitOrderFillsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxLimitOrderFillsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetAmplificationRampDuration() uint64 {
+	if m != nil {
+		return m.AmplificationRampDuration
+	}
+	return 0
+}
+
 // AllowedPool defines a pool that is allowed to be created
 type AllowedPool struct {
 	// token_a represents the a token allowed
 	TokenA string `protobuf:"bytes,1,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
 	// token_b represents the b token allowed
 	TokenB string `protobuf:"bytes,2,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	// pool_type represents the invariant used by the pool
+	PoolType PoolType `protobuf:"varint,3,opt,name=pool_type,json=poolType,proto3,enum=kava.swap.v1beta1.PoolType" json:"pool_type,omitempty"`
+	// amplification represents the amplification coefficient of a stable swap
+	// pool, which existing pools ramp to over the amplification ramp duration
+	Amplification uint64 `protobuf:"varint,4,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	// swap_fee overrides the swap fee of the pool when set, including to zero,
+	// otherwise the global swap fee is used. It is always present in json, as
+	// null when unset, so committees can add and remove overrides.
+	SwapFee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
 }
 
 func (m *AllowedPool) Reset()      { *m = AllowedPool{} }
@@ -127,6 +254,20 @@ func (m *AllowedPool) GetTokenB() string {
 	return ""
 }
 
+func (m *AllowedPool) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return POOL_TYPE_UNSPECIFIED
+}
+
+func (m *AllowedPool) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
 // PoolRecord represents the state of a liquidity pool
 // and is used to store the state of a denominated pool
 type PoolRecord struct {
@@ -138,6 +279,14 @@ type PoolRecord struct {
 	ReservesB types.Coin `protobuf:"bytes,3,opt,name=reserves_b,json=reservesB,proto3" json:"reserves_b"`
 	// total_shares is the total distrubuted shares of the pool
 	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shares"`
+	// pool_type represents the invariant used by the pool
+	PoolType PoolType `protobuf:"varint,5,opt,name=pool_type,json=poolType,proto3,enum=kava.swap.v1beta1.PoolType" json:"pool_type,omitempty"`
+	// amplification represents the amplification coefficient of a stable swap
+	// pool at the start of its amplification ramp
+	Amplification uint64 `protobuf:"varint,6,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	// amplification_ramp represents the ramp of a stable swap pool from its
+	// amplification to a new allowed pool amplification, if one has started
+	AmplificationRamp *AmplificationRamp `protobuf:"bytes,7,opt,name=amplification_ramp,json=amplificationRamp,proto3" json:"amplification_ramp,omitempty"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -194,6 +343,93 @@ func (m *PoolRecord) GetReservesB() types.Coin {
 	return types.Coin{}
 }
 
+func (m *PoolRecord) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return POOL_TYPE_UNSPECIFIED
+}
+
+func (m *PoolRecord) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
+func (m *PoolRecord) GetAmplificationRamp() *AmplificationRamp {
+	if m != nil {
+		return m.AmplificationRamp
+	}
+	return nil
+}
+
+// AmplificationRamp defines a linear change of the amplification coefficient of
+// a stable swap pool over time
+type AmplificationRamp struct {
+	// future_amplification represents the amplification coefficient at the end
+	// of the ramp
+	FutureAmplification uint64 `protobuf:"varint,1,opt,name=future_amplification,json=futureAmplification,proto3" json:"future_amplification,omitempty"`
+	// start_time represents the time the ramp started
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time represents the time the ramp reaches the future amplification
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *AmplificationRamp) Reset()         { *m = AmplificationRamp{} }
+func (m *AmplificationRamp) String() string { return proto.CompactTextString(m) }
+func (*AmplificationRamp) ProtoMessage()    {}
+func (*AmplificationRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{3}
+}
+func (m *AmplificationRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationRamp.Merge(m, src)
+}
+func (m *AmplificationRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationRamp proto.InternalMessageInfo
+
+func (m *AmplificationRamp) GetFutureAmplification() uint64 {
+	if m != nil {
+		return m.FutureAmplification
+	}
+	return 0
+}
+
+func (m *AmplificationRamp) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationRamp) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 // ShareRecord stores the shares owned for a depositor and pool
 type ShareRecord struct {
 	// depositor represents the owner of the shares
@@ -208,7 +444,7 @@ func (m *ShareRecord) Reset()         { *m = ShareRecord{} }
 func (m *ShareRecord) String() string { return proto.CompactTextString(m) }
 func (*ShareRecord) ProtoMessage()    {}
 func (*ShareRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df359be90eb28cb, []int{3}
+	return fileDescriptor_9df359be90eb28cb, []int{4}
 }
 func (m *ShareRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,50 +487,255 @@ func (m *ShareRecord) GetPoolID() string {
 	return ""
 }
 
+// PriceObservation records the cumulative prices of a pool at a point in time.
+// The most recent observation of a pool is its current price accumulator.
+type PriceObservation struct {
+	// pool_id represents the pool the prices belong to
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// timestamp represents the block time the cumulative prices were recorded
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// price_a_cumulative is the sum of the price of token a, denominated in
+	// token b, weighted by the seconds it was held
+	PriceACumulative github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_a_cumulative,json=priceACumulative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_a_cumulative"`
+	// price_b_cumulative is the sum of the price of token b, denominated in
+	// token a, weighted by the seconds it was held
+	PriceBCumulative github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price_b_cumulative,json=priceBCumulative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_b_cumulative"`
+}
+
+func (m *PriceObservation) Reset()         { *m = PriceObservation{} }
+func (m *PriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PriceObservation) ProtoMessage()    {}
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{5}
+}
+func (m *PriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceObservation.Merge(m, src)
+}
+func (m *PriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceObservation proto.InternalMessageInfo
+
+func (m *PriceObservation) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// LimitOrder represents an order to sell an escrowed token to a pool once the
+// pool offers at least the limit price
+type LimitOrder struct {
+	// id represents the unique id of the order
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner represents the address that placed the order
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// pool_id represents the pool the order trades against
+	PoolID string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// token_in represents the escrowed coin to sell
+	TokenIn types.Coin `protobuf:"bytes,4,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	// denom_out represents the denom to buy
+	DenomOut string `protobuf:"bytes,5,opt,name=denom_out,json=denomOut,proto3" json:"denom_out,omitempty"`
+	// limit_price represents the minimum amount of denom_out received per unit
+	// of token_in, after swap fees
+	LimitPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=limit_price,json=limitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"limit_price"`
+	// expiry represents the time the order is cancelled and refunded if unfilled
+	Expiry time.Time `protobuf:"bytes,7,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{6}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *LimitOrder) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *LimitOrder) GetDenomOut() string {
+	if m != nil {
+		return m.DenomOut
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("kava.swap.v1beta1.ProtocolFeeDestination", ProtocolFeeDestination_name, ProtocolFeeDestination_value)
+	proto.RegisterEnum("kava.swap.v1beta1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterType((*Params)(nil), "kava.swap.v1beta1.Params")
 	proto.RegisterType((*AllowedPool)(nil), "kava.swap.v1beta1.AllowedPool")
 	proto.RegisterType((*PoolRecord)(nil), "kava.swap.v1beta1.PoolRecord")
+	proto.RegisterType((*AmplificationRamp)(nil), "kava.swap.v1beta1.AmplificationRamp")
 	proto.RegisterType((*ShareRecord)(nil), "kava.swap.v1beta1.ShareRecord")
+	proto.RegisterType((*PriceObservation)(nil), "kava.swap.v1beta1.PriceObservation")
+	proto.RegisterType((*LimitOrder)(nil), "kava.swap.v1beta1.LimitOrder")
 }
 
 func init() { proto.RegisterFile("kava/swap/v1beta1/swap.proto", fileDescriptor_9df359be90eb28cb) }
 
 var fileDescriptor_9df359be90eb28cb = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6f, 0xd3, 0x5e,
-	0x14, 0x8d, 0xd3, 0x28, 0xf9, 0xe5, 0x25, 0xbf, 0x01, 0x53, 0x89, 0xb4, 0x42, 0x76, 0x15, 0x24,
-	0xd4, 0x25, 0xb6, 0x5a, 0x36, 0x84, 0x10, 0x31, 0x01, 0x91, 0x89, 0xca, 0x0c, 0x08, 0x96, 0xa7,
-	0x67, 0xfb, 0x36, 0xb5, 0xe2, 0xf8, 0x5a, 0x7e, 0x8f, 0x84, 0x7e, 0x0b, 0xc4, 0xc4, 0xc8, 0xcc,
-	0xdc, 0x4f, 0xc0, 0xd4, 0xb1, 0xea, 0x84, 0x18, 0x02, 0x4a, 0xbe, 0x05, 0x2c, 0xe8, 0xfd, 0xa1,
-	0x35, 0x42, 0x48, 0x54, 0x4c, 0xbe, 0xf7, 0x9e, 0x77, 0xce, 0xbd, 0xf7, 0x58, 0x97, 0xdc, 0x9c,
-	0xb2, 0x39, 0xf3, 0xf9, 0x82, 0x15, 0xfe, 0x7c, 0x2f, 0x02, 0xc1, 0xf6, 0x54, 0xe2, 0x15, 0x25,
-	0x0a, 0xb4, 0xaf, 0x49, 0xd4, 0x53, 0x05, 0x83, 0x6e, 0x3b, 0x31, 0xf2, 0x19, 0x72, 0x3f, 0x62,
-	0x1c, 0x2e, 0x28, 0x31, 0xa6, 0xb9, 0xa6, 0x6c, 0x6f, 0x69, 0x9c, 0xaa, 0xcc, 0xd7, 0x89, 0x81,
-	0x36, 0x27, 0x38, 0x41, 0x5d, 0x97, 0x91, 0xae, 0xf6, 0x3f, 0x5a, 0xa4, 0x79, 0xc0, 0x4a, 0x36,
-	0xe3, 0xf6, 0x0b, 0xf2, 0x3f, 0xcb, 0x32, 0x5c, 0x40, 0x42, 0x0b, 0xc4, 0x8c, 0xf7, 0xac, 0x9d,
-	0x8d, 0xdd, 0xce, 0xbe, 0xe3, 0xfd, 0x36, 0x86, 0x37, 0xd4, 0xef, 0x0e, 0x10, 0xb3, 0x60, 0xf3,
-	0x74, 0xe9, 0xd6, 0x3e, 0x7c, 0x71, 0xbb, 0x95, 0x22, 0x0f, 0xbb, 0xac, 0x92, 0xd9, 0xcf, 0xc9,
-	0x7f, 0x92, 0x4f, 0x0f, 0x01, 0x7a, 0xf5, 0x1d, 0x6b, 0xb7, 0x1d, 0xdc, 0x93, 0xac, 0xcf, 0x4b,
-	0xf7, 0xf6, 0x24, 0x15, 0x47, 0xaf, 0x22, 0x2f, 0xc6, 0x99, 0x19, 0xd7, 0x7c, 0x06, 0x3c, 0x99,
-	0xfa, 0xe2, 0xb8, 0x00, 0xee, 0x8d, 0x20, 0x3e, 0x3f, 0x19, 0x10, 0xb3, 0xcd, 0x08, 0xe2, 0xb0,
-	0x25, 0xd5, 0x1e, 0x03, 0xdc, 0x6d, 0xbc, 0x7b, 0xef, 0xd6, 0xfa, 0x8f, 0x48, 0xa7, 0xd2, 0xdc,
-	0xbe, 0x41, 0x5a, 0x02, 0xa7, 0x90, 0x53, 0xd6, 0xb3, 0x64, 0xb3, 0xb0, 0xa9, 0xd2, 0xe1, 0x25,
-	0x10, 0xe9, 0x29, 0x0c, 0x10, 0x18, 0x99, 0xb7, 0x75, 0x42, 0xa4, 0x40, 0x08, 0x31, 0x96, 0x89,
-	0x7d, 0x8b, 0xb4, 0xa4, 0x0f, 0x34, 0x4d, 0xb4, 0x4c, 0x40, 0x56, 0x4b, 0xb7, 0x29, 0x1f, 0x8c,
-	0x47, 0x61, 0x53, 0x42, 0xe3, 0xc4, 0xbe, 0x4f, 0x48, 0x09, 0x1c, 0xca, 0x39, 0x70, 0xca, 0x94,
-	0x6a, 0x67, 0x7f, 0xcb, 0x33, 0xa3, 0xca, 0xbf, 0x74, 0xe1, 0xd9, 0x43, 0x4c, 0xf3, 0xa0, 0x21,
-	0xd7, 0x0e, 0xdb, 0x3f, 0x29, 0xc3, 0x5f, 0xf8, 0x51, 0x6f, 0xe3, 0x8a, 0xfc, 0xc0, 0xa6, 0xa4,
-	0x2b, 0x50, 0xb0, 0x8c, 0xf2, 0x23, 0x56, 0x02, 0xef, 0x35, 0xae, 0xec, 0xee, 0x38, 0x17, 0x15,
-	0x77, 0xc7, 0xb9, 0x08, 0x3b, 0x4a, 0xf1, 0x99, 0x12, 0xec, 0x7f, 0xb7, 0x48, 0x47, 0x85, 0xc6,
-	0x95, 0x43, 0xd2, 0x4e, 0xa0, 0x40, 0x9e, 0x0a, 0x2c, 0x95, 0x2f, 0xdd, 0xe0, 0xc9, 0xb7, 0xa5,
-	0x3b, 0xf8, 0x8b, 0x4e, 0xc3, 0x38, 0x1e, 0x26, 0x49, 0x09, 0x9c, 0x9f, 0x9f, 0x0c, 0xae, 0x9b,
-	0x86, 0xa6, 0x12, 0x1c, 0x0b, 0xe0, 0xe1, 0xa5, 0x74, 0xd5, 0xfd, 0xfa, 0x1f, 0xdd, 0xa7, 0xa4,
-	0xab, 0xf7, 0xa6, 0xb8, 0xc8, 0x21, 0x51, 0xfe, 0xfd, 0xf3, 0xf6, 0x5a, 0xf1, 0xa9, 0x14, 0x0c,
-	0x1e, 0x9c, 0xae, 0x1c, 0xeb, 0x6c, 0xe5, 0x58, 0x5f, 0x57, 0x8e, 0xf5, 0x66, 0xed, 0xd4, 0xce,
-	0xd6, 0x4e, 0xed, 0xd3, 0xda, 0xa9, 0xbd, 0xac, 0x8a, 0xcb, 0x03, 0x19, 0x64, 0x2c, 0xe2, 0x2a,
-	0xf2, 0x5f, 0xeb, 0x8b, 0x56, 0x0d, 0xa2, 0xa6, 0xba, 0xb3, 0x3b, 0x3f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xee, 0x4a, 0x16, 0x69, 0xeb, 0x03, 0x00, 0x00,
+	// 1214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x16, 0x25, 0x59, 0x96, 0x47, 0x4e, 0x60, 0x6f, 0x9c, 0xbc, 0xb4, 0xf3, 0x42, 0x34, 0xd4,
+	0xa0, 0x75, 0x53, 0x58, 0x42, 0xdc, 0x43, 0x8b, 0x20, 0x48, 0x2b, 0xea, 0x03, 0x11, 0xe0, 0x88,
+	0x02, 0x25, 0x23, 0x48, 0x81, 0x76, 0xb1, 0x22, 0x57, 0x0e, 0x6b, 0x92, 0x4b, 0x90, 0x94, 0x63,
+	0xe7, 0x17, 0x14, 0x3d, 0xe5, 0xd8, 0x5b, 0x8b, 0xf6, 0xd6, 0x73, 0x7e, 0x43, 0x9b, 0x43, 0x0f,
+	0x41, 0x7a, 0x29, 0x7a, 0x50, 0x0a, 0xe7, 0xd6, 0x9f, 0xd0, 0x5e, 0x8a, 0x5d, 0x52, 0x5f, 0xb6,
+	0x5c, 0xd8, 0xa8, 0x4e, 0xe6, 0xce, 0xec, 0x3c, 0x33, 0x3b, 0xcf, 0xb3, 0x3b, 0x16, 0xfc, 0xff,
+	0x80, 0x1c, 0x92, 0x52, 0xf0, 0x94, 0x78, 0xa5, 0xc3, 0x3b, 0x5d, 0x1a, 0x92, 0x3b, 0x62, 0x51,
+	0xf4, 0x7c, 0x16, 0x32, 0xb4, 0xca, 0xbd, 0x45, 0x61, 0x88, 0xbd, 0x1b, 0x79, 0x83, 0x05, 0x0e,
+	0x0b, 0x4a, 0x5d, 0x12, 0xd0, 0x51, 0x88, 0xc1, 0x2c, 0x37, 0x0a, 0xd9, 0x58, 0x8f, 0xfc, 0x58,
+	0xac, 0x4a, 0xd1, 0x22, 0x76, 0xad, 0xed, 0xb3, 0x7d, 0x16, 0xd9, 0xf9, 0x57, 0x6c, 0x55, 0xf6,
+	0x19, 0xdb, 0xb7, 0x69, 0x49, 0xac, 0xba, 0xfd, 0x5e, 0x29, 0xb4, 0x1c, 0x1a, 0x84, 0xc4, 0x89,
+	0x8b, 0x28, 0xfc, 0xb4, 0x00, 0x99, 0x16, 0xf1, 0x89, 0x13, 0xa0, 0xc7, 0x70, 0x85, 0xd8, 0x36,
+	0x7b, 0x4a, 0x4d, 0xec, 0x31, 0x66, 0x07, 0xb2, 0xb4, 0x99, 0xda, 0xca, 0xed, 0xe4, 0x8b, 0x67,
+	0xea, 0x2c, 0x96, 0xa3, 0x7d, 0x2d, 0xc6, 0x6c, 0x75, 0xed, 0xe5, 0x40, 0x49, 0xfc, 0xf8, 0x46,
+	0x59, 0x9e, 0x30, 0x06, 0xfa, 0x32, 0x99, 0x58, 0xa1, 0x47, 0x90, 0xe5, 0xf1, 0xb8, 0x47, 0xa9,
+	0x9c, 0xdc, 0x94, 0xb6, 0x96, 0xd4, 0x7b, 0x3c, 0xea, 0xf7, 0x81, 0xf2, 0xee, 0xbe, 0x15, 0x3e,
+	0xe9, 0x77, 0x8b, 0x06, 0x73, 0xe2, 0xf3, 0xc4, 0x7f, 0xb6, 0x03, 0xf3, 0xa0, 0x14, 0x1e, 0x7b,
+	0x34, 0x28, 0x56, 0xa9, 0xf1, 0xfa, 0xc5, 0x36, 0xc4, 0xc7, 0xad, 0x52, 0x43, 0x5f, 0xe4, 0x68,
+	0x75, 0x4a, 0x91, 0x07, 0xd7, 0xc5, 0x39, 0x0c, 0x66, 0x73, 0x70, 0xdc, 0xf3, 0x89, 0x11, 0x5a,
+	0xcc, 0x95, 0x53, 0x73, 0xc8, 0x72, 0x6d, 0x08, 0x5d, 0xa7, 0xb4, 0x1e, 0x03, 0x23, 0x03, 0xe4,
+	0xa9, 0x8c, 0x26, 0x0d, 0x42, 0xcb, 0x25, 0x22, 0x69, 0x7a, 0x53, 0xda, 0xba, 0xba, 0xf3, 0xfe,
+	0x8c, 0x86, 0xb5, 0xc6, 0x48, 0xd5, 0x71, 0x80, 0x7e, 0xc3, 0x9b, 0x69, 0x47, 0x0e, 0xac, 0x39,
+	0x96, 0x8b, 0x6d, 0xcb, 0xb1, 0x42, 0xcc, 0x7c, 0x93, 0xfa, 0x38, 0xb0, 0x9e, 0x51, 0x79, 0x61,
+	0x0e, 0xa7, 0x5a, 0x75, 0x2c, 0x77, 0x97, 0x03, 0x6b, 0x1c, 0xb7, 0x6d, 0x3d, 0xa3, 0xe8, 0x23,
+	0x90, 0x1d, 0x72, 0x34, 0x95, 0xce, 0xec, 0xfb, 0xd1, 0x99, 0x32, 0x9b, 0xd2, 0x56, 0x5a, 0xbf,
+	0xee, 0x90, 0xa3, 0x71, 0x50, 0x35, 0x76, 0xa2, 0x0a, 0x28, 0xa7, 0x03, 0x7b, 0x96, 0x6d, 0x07,
+	0xd8, 0xa3, 0x3e, 0xee, 0xda, 0xcc, 0x38, 0x90, 0x17, 0x45, 0xfc, 0xc6, 0x54, 0x7c, 0x9d, 0xef,
+	0x69, 0x51, 0x5f, 0xe5, 0x3b, 0xd0, 0x7d, 0xb8, 0x49, 0x1c, 0xcf, 0xb6, 0x7a, 0x96, 0x21, 0x50,
+	0xb1, 0x4f, 0x1c, 0x6f, 0x5c, 0x40, 0x56, 0x00, 0xac, 0x4f, 0x6d, 0xd1, 0x89, 0xe3, 0x0d, 0x8b,
+	0xb8, 0x9b, 0xfe, 0xe6, 0x3b, 0x25, 0x51, 0xf8, 0x3a, 0x09, 0xb9, 0x09, 0x05, 0xa2, 0xff, 0xc1,
+	0x62, 0xc8, 0x0e, 0xa8, 0x8b, 0x89, 0x2c, 0xf1, 0xae, 0xe9, 0x19, 0xb1, 0x2c, 0x8f, 0x1d, 0x5d,
+	0x39, 0x39, 0xe1, 0x50, 0xd1, 0xc7, 0xb0, 0xc4, 0x75, 0x8f, 0x79, 0xe3, 0x84, 0x7e, 0xae, 0xee,
+	0xdc, 0x9c, 0x45, 0x25, 0x63, 0x76, 0xe7, 0xd8, 0xa3, 0x7a, 0xd6, 0x8b, 0xbf, 0xd0, 0x2d, 0xb8,
+	0x32, 0x55, 0x9e, 0x10, 0x42, 0x5a, 0x9f, 0x36, 0x22, 0x63, 0xe2, 0x12, 0x44, 0x44, 0x3e, 0x78,
+	0x39, 0x50, 0xa4, 0x8b, 0x13, 0xf9, 0xe7, 0x40, 0x19, 0x21, 0x9c, 0x73, 0x21, 0xe2, 0x66, 0xfc,
+	0x9c, 0x02, 0xe0, 0x75, 0xea, 0xd4, 0x60, 0xbe, 0x89, 0xde, 0x81, 0x45, 0x71, 0x32, 0xcb, 0x8c,
+	0x7a, 0xa1, 0xc2, 0xc9, 0x40, 0xc9, 0xf0, 0x0d, 0x8d, 0xaa, 0x9e, 0xe1, 0xae, 0x86, 0x89, 0xee,
+	0x03, 0xf8, 0x34, 0xa0, 0xfe, 0x21, 0x0d, 0x30, 0x11, 0xad, 0xc9, 0xed, 0xac, 0x17, 0xe3, 0x1c,
+	0xfc, 0x41, 0x1a, 0x75, 0xa0, 0xc2, 0x2c, 0x57, 0x4d, 0x73, 0x11, 0xea, 0x4b, 0xc3, 0x90, 0xf2,
+	0x54, 0x7c, 0x57, 0x4e, 0x5d, 0x32, 0x5e, 0x45, 0x18, 0x96, 0x43, 0x16, 0x12, 0x1b, 0x07, 0x4f,
+	0x88, 0x4f, 0x03, 0x39, 0x7d, 0x69, 0xad, 0x37, 0xdc, 0x70, 0xa2, 0x2d, 0x0d, 0x37, 0xd4, 0x73,
+	0x02, 0xb1, 0x2d, 0x00, 0xa7, 0xf9, 0x5d, 0xf8, 0x4f, 0xfc, 0x66, 0x66, 0xf1, 0xdb, 0x06, 0x74,
+	0x56, 0xc7, 0x42, 0xff, 0xb9, 0x9d, 0x5b, 0xb3, 0x1e, 0xd1, 0xd3, 0x8a, 0xd6, 0x57, 0xcf, 0x88,
+	0xbc, 0xf0, 0x8b, 0x04, 0xab, 0x67, 0x36, 0xa2, 0x3b, 0xb0, 0xd6, 0xeb, 0x87, 0x7d, 0x9f, 0xe2,
+	0xe9, 0xba, 0x24, 0x51, 0xd7, 0xb5, 0xc8, 0x37, 0x15, 0x86, 0x2a, 0x00, 0x41, 0x48, 0xfc, 0x10,
+	0xf3, 0x09, 0x10, 0xd3, 0xbb, 0x51, 0x8c, 0xc6, 0x43, 0x71, 0x38, 0x1e, 0x8a, 0x9d, 0xe1, 0x78,
+	0x50, 0xb3, 0xbc, 0xf1, 0xcf, 0xdf, 0x28, 0x92, 0xbe, 0x24, 0xe2, 0xb8, 0x07, 0x7d, 0x02, 0x59,
+	0xea, 0x9a, 0x11, 0x44, 0xea, 0x12, 0x10, 0x8b, 0xd4, 0x35, 0xb9, 0xbd, 0xf0, 0xb7, 0x04, 0x39,
+	0x41, 0x47, 0xac, 0xcc, 0x1e, 0x2c, 0x99, 0xd4, 0x63, 0x81, 0x15, 0x32, 0x5f, 0x54, 0xbf, 0xac,
+	0x3e, 0xf8, 0x6b, 0xa0, 0x6c, 0x5f, 0x80, 0xed, 0xb2, 0x61, 0x94, 0x4d, 0xd3, 0xa7, 0x41, 0xf0,
+	0xfa, 0xc5, 0xf6, 0xb5, 0x98, 0xf4, 0xd8, 0xa2, 0x1e, 0x87, 0x34, 0xd0, 0xc7, 0xd0, 0x93, 0x37,
+	0x20, 0x79, 0xee, 0x0d, 0xc0, 0xb0, 0x1c, 0x69, 0x0f, 0xb3, 0xa7, 0x2e, 0x35, 0xe5, 0xd4, 0x3c,
+	0x14, 0x18, 0x21, 0x6a, 0x1c, 0xb0, 0xf0, 0x6b, 0x12, 0x56, 0x5a, 0xbe, 0x65, 0x50, 0xad, 0xcb,
+	0x55, 0x1f, 0x11, 0x73, 0xa1, 0xcb, 0xa9, 0xc2, 0xd2, 0x68, 0x72, 0x5f, 0x8e, 0xbc, 0x51, 0x18,
+	0xfa, 0x12, 0x90, 0xc7, 0x93, 0x63, 0x82, 0x8d, 0xbe, 0xd3, 0xb7, 0x49, 0x68, 0x1d, 0xd2, 0xb9,
+	0x0c, 0xca, 0x15, 0x81, 0x5b, 0xae, 0x8c, 0x50, 0xc7, 0xb9, 0xba, 0x93, 0xb9, 0xd2, 0x73, 0xcb,
+	0xa5, 0x8e, 0x73, 0x15, 0xbe, 0x4d, 0x01, 0x8c, 0x67, 0x0b, 0xba, 0x01, 0xc9, 0xb8, 0x95, 0x69,
+	0x35, 0x73, 0x32, 0x50, 0x92, 0x8d, 0xaa, 0x9e, 0xb4, 0x4c, 0xf4, 0x05, 0x2c, 0x70, 0x5a, 0x7d,
+	0x39, 0x39, 0x67, 0x99, 0x45, 0xb0, 0x93, 0x3c, 0xa6, 0xce, 0xe5, 0xf1, 0x2e, 0x64, 0xa3, 0xe1,
+	0x63, 0x45, 0x43, 0xe2, 0x02, 0x4f, 0x64, 0x34, 0xad, 0x1a, 0x2e, 0xba, 0xc9, 0xef, 0x8a, 0xcb,
+	0x1c, 0xcc, 0xfa, 0x61, 0x34, 0x40, 0xf4, 0xac, 0x30, 0x68, 0xfd, 0x10, 0x7d, 0x0e, 0xb9, 0x68,
+	0x0a, 0x8b, 0xf6, 0xc8, 0x99, 0x39, 0x74, 0x1a, 0x04, 0xa0, 0xd0, 0x2b, 0xba, 0x07, 0x19, 0x7a,
+	0xe4, 0x59, 0xfe, 0xb1, 0xbc, 0x78, 0x09, 0xf1, 0xc5, 0x31, 0xb7, 0xbf, 0x97, 0xe0, 0xc6, 0xec,
+	0xff, 0x80, 0xd0, 0x16, 0xdc, 0x6a, 0xe9, 0x5a, 0x47, 0xab, 0x68, 0xbb, 0xb8, 0x5e, 0xab, 0xe1,
+	0x6a, 0xad, 0xdd, 0x69, 0x34, 0xcb, 0x9d, 0x86, 0xd6, 0xc4, 0x7b, 0xcd, 0x76, 0xab, 0x56, 0x69,
+	0xd4, 0x1b, 0xb5, 0xea, 0x4a, 0x02, 0x7d, 0x00, 0xef, 0x9d, 0xbb, 0xf3, 0xa1, 0x56, 0xdd, 0xdb,
+	0xad, 0xe1, 0x72, 0xa5, 0xa2, 0xed, 0x35, 0x3b, 0x2b, 0xd2, 0xbf, 0x6e, 0xae, 0x68, 0x0f, 0x1f,
+	0xee, 0x35, 0x1b, 0x9d, 0xc7, 0xb8, 0xa5, 0x69, 0xbb, 0x2b, 0xc9, 0x8d, 0xf4, 0x57, 0x3f, 0xe4,
+	0x13, 0xb7, 0x7b, 0x90, 0x1d, 0x3e, 0xfd, 0x68, 0x1d, 0xae, 0x73, 0x1f, 0xee, 0x3c, 0x6e, 0xd5,
+	0x4e, 0x95, 0x91, 0x87, 0x8d, 0xb1, 0xab, 0xa2, 0x35, 0xdb, 0x9d, 0x72, 0xb3, 0x83, 0x5b, 0xba,
+	0x56, 0xdd, 0xab, 0xf0, 0xcc, 0x53, 0xa1, 0xed, 0x4e, 0x59, 0xdd, 0xad, 0xe1, 0xf6, 0xa3, 0x72,
+	0x6b, 0x98, 0x47, 0xfd, 0xf4, 0xe5, 0x49, 0x5e, 0x7a, 0x75, 0x92, 0x97, 0xfe, 0x38, 0xc9, 0x4b,
+	0xcf, 0xdf, 0xe6, 0x13, 0xaf, 0xde, 0xe6, 0x13, 0xbf, 0xbd, 0xcd, 0x27, 0x3e, 0x9b, 0xa4, 0x89,
+	0x8f, 0x8b, 0x6d, 0x9b, 0x74, 0x03, 0xf1, 0x55, 0x3a, 0x8a, 0x7e, 0x45, 0x08, 0xaa, 0xba, 0x19,
+	0xd1, 0xf4, 0x0f, 0xff, 0x19, 0x00, 0x06, 0x6a, 0x34, 0x7b, 0x5f, 0x0c, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -317,6 +758,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AmplificationRampDuration != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.AmplificationRampDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxLimitOrderFillsPerBlock != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.MaxLimitOrderFillsPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxLimitOrderDuration != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.MaxLimitOrderDuration))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinLimitOrderSize.Size()
+		i -= size
+		if _, err := m.MinLimitOrderSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ProtocolFeeDestination != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ProtocolFeeDestination))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ProtocolFeeFraction.Size()
+		i -= size
+		if _, err := m.ProtocolFeeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.SwapFee.Size()
 		i -= size
@@ -364,6 +845,28 @@ func (m *AllowedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SwapFee != nil {
+		{
+			size := m.SwapFee.Size()
+			i -= size
+			if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TokenB) > 0 {
 		i -= len(m.TokenB)
 		copy(dAtA[i:], m.TokenB)
@@ -401,6 +904,28 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AmplificationRamp != nil {
+		{
+			size, err := m.AmplificationRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.TotalShares.Size()
 		i -= size
@@ -441,6 +966,50 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AmplificationRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmplificationRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmplificationRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSwap(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSwap(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.FutureAmplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.FutureAmplification))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ShareRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -488,17 +1057,152 @@ func (m *ShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSwap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *PriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceBCumulative.Size()
+		i -= size
+		if _, err := m.PriceBCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceACumulative.Size()
+		i -= size
+		if _, err := m.PriceACumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSwap(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintSwap(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LimitPrice.Size()
+		i -= size
+		if _, err := m.LimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DenomOut) > 0 {
+		i -= len(m.DenomOut)
+		copy(dAtA[i:], m.DenomOut)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.DenomOut)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -513,6 +1217,22 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.SwapFee.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	l = m.ProtocolFeeFraction.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	if m.ProtocolFeeDestination != 0 {
+		n += 1 + sovSwap(uint64(m.ProtocolFeeDestination))
+	}
+	l = m.MinLimitOrderSize.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	if m.MaxLimitOrderDuration != 0 {
+		n += 1 + sovSwap(uint64(m.MaxLimitOrderDuration))
+	}
+	if m.MaxLimitOrderFillsPerBlock != 0 {
+		n += 1 + sovSwap(uint64(m.MaxLimitOrderFillsPerBlock))
+	}
+	if m.AmplificationRampDuration != 0 {
+		n += 1 + sovSwap(uint64(m.AmplificationRampDuration))
+	}
 	return n
 }
 
@@ -530,6 +1250,16 @@ func (m *AllowedPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSwap(uint64(l))
 	}
+	if m.PoolType != 0 {
+		n += 1 + sovSwap(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
+	if m.SwapFee != nil {
+		l = m.SwapFee.Size()
+		n += 1 + l + sovSwap(uint64(l))
+	}
 	return n
 }
 
@@ -543,41 +1273,822 @@ func (m *PoolRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSwap(uint64(l))
 	}
-	l = m.ReservesA.Size()
-	n += 1 + l + sovSwap(uint64(l))
-	l = m.ReservesB.Size()
-	n += 1 + l + sovSwap(uint64(l))
-	l = m.TotalShares.Size()
-	n += 1 + l + sovSwap(uint64(l))
-	return n
-}
+	l = m.ReservesA.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.ReservesB.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.TotalShares.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	if m.PoolType != 0 {
+		n += 1 + sovSwap(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
+	if m.AmplificationRamp != nil {
+		l = m.AmplificationRamp.Size()
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *AmplificationRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FutureAmplification != 0 {
+		n += 1 + sovSwap(uint64(m.FutureAmplification))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovSwap(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func (m *ShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.SharesOwned.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func (m *PriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceACumulative.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceBCumulative.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovSwap(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = len(m.DenomOut)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.LimitPrice.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func sovSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwap(x uint64) (n int) {
+	return sovSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPools = append(m.AllowedPools, AllowedPool{})
+			if err := m.AllowedPools[len(m.AllowedPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeDestination", wireType)
+			}
+			m.ProtocolFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeeDestination |= ProtocolFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLimitOrderSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLimitOrderSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLimitOrderDuration", wireType)
+			}
+			m.MaxLimitOrderDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLimitOrderDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLimitOrderFillsPerBlock", wireType)
+			}
+			m.MaxLimitOrderFillsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLimitOrderFillsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationRampDuration", wireType)
+			}
+			m.AmplificationRampDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmplificationRampDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SwapFee = &v
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservesA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservesB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AmplificationRamp == nil {
+				m.AmplificationRamp = &AmplificationRamp{}
+			}
+			if err := m.AmplificationRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ShareRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
-	}
-	l = len(m.PoolID)
-	if l > 0 {
-		n += 1 + l + sovSwap(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.SharesOwned.Size()
-	n += 1 + l + sovSwap(uint64(l))
-	return n
-}
-
-func sovSwap(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSwap(x uint64) (n int) {
-	return sovSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AmplificationRamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -600,15 +2111,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AmplificationRamp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AmplificationRamp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			m.FutureAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -635,16 +2165,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedPools = append(m.AllowedPools, AllowedPool{})
-			if err := m.AllowedPools[len(m.AllowedPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -654,23 +2183,22 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -695,7 +2223,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedPool) Unmarshal(dAtA []byte) error {
+func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -718,15 +2246,49 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -754,11 +2316,11 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenA = string(dAtA[iNdEx:postIndex])
+			m.PoolID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesOwned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -786,7 +2348,9 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenB = string(dAtA[iNdEx:postIndex])
+			if err := m.SharesOwned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -809,7 +2373,7 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolRecord) Unmarshal(dAtA []byte) error {
+func (m *PriceObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -832,10 +2396,10 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -872,7 +2436,7 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservesA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -899,15 +2463,15 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservesA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservesB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceACumulative", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -917,28 +2481,29 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservesB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceACumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBCumulative", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -966,7 +2531,7 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceBCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -991,7 +2556,7 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShareRecord) Unmarshal(dAtA []byte) error {
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,15 +2579,34 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShareRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1049,12 +2633,12 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
@@ -1086,9 +2670,42 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.PoolID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesOwned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1116,7 +2733,72 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesOwned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex